@@ -360,18 +360,19 @@ func (c *TokenAuth) RevokeTreeWithContext(ctx context.Context, token string) err
 
 // TokenCreateRequest is the options structure for creating a token.
 type TokenCreateRequest struct {
-	ID              string            `json:"id,omitempty"`
-	Policies        []string          `json:"policies,omitempty"`
-	Metadata        map[string]string `json:"meta,omitempty"`
-	Lease           string            `json:"lease,omitempty"`
-	TTL             string            `json:"ttl,omitempty"`
-	ExplicitMaxTTL  string            `json:"explicit_max_ttl,omitempty"`
-	Period          string            `json:"period,omitempty"`
-	NoParent        bool              `json:"no_parent,omitempty"`
-	NoDefaultPolicy bool              `json:"no_default_policy,omitempty"`
-	DisplayName     string            `json:"display_name"`
-	NumUses         int               `json:"num_uses"`
-	Renewable       *bool             `json:"renewable,omitempty"`
-	Type            string            `json:"type"`
-	EntityAlias     string            `json:"entity_alias"`
+	ID              string              `json:"id,omitempty"`
+	Policies        []string            `json:"policies,omitempty"`
+	Metadata        map[string]string   `json:"meta,omitempty"`
+	Lease           string              `json:"lease,omitempty"`
+	TTL             string              `json:"ttl,omitempty"`
+	ExplicitMaxTTL  string              `json:"explicit_max_ttl,omitempty"`
+	Period          string              `json:"period,omitempty"`
+	NoParent        bool                `json:"no_parent,omitempty"`
+	NoDefaultPolicy bool                `json:"no_default_policy,omitempty"`
+	DisplayName     string              `json:"display_name"`
+	NumUses         int                 `json:"num_uses"`
+	Renewable       *bool               `json:"renewable,omitempty"`
+	Type            string              `json:"type"`
+	EntityAlias     string              `json:"entity_alias"`
+	Scope           map[string][]string `json:"scope,omitempty"`
 }
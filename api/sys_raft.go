@@ -533,3 +533,107 @@ func (c *Sys) raftUnloadSnapshotWithContext(ctx context.Context, snapID string,
 
 	return ParseSecret(resp.Body)
 }
+
+// RaftCompactRequest configures a rolling compaction of the raft cluster.
+type RaftCompactRequest struct {
+	NodeIDs     []string `json:"node_ids,omitempty"`
+	MaxLag      *int     `json:"max_lag,omitempty"`
+	NodeTimeout string   `json:"node_timeout,omitempty"`
+}
+
+// RaftCompactionStatus is the progress of a rolling compaction of the raft
+// cluster.
+type RaftCompactionStatus struct {
+	ID             string                      `mapstructure:"id"`
+	State          string                      `mapstructure:"state"`
+	Error          string                      `mapstructure:"error"`
+	StartTime      string                      `mapstructure:"start_time"`
+	EndTime        string                      `mapstructure:"end_time"`
+	MaxLag         uint64                      `mapstructure:"max_lag"`
+	NodeTimeout    int64                       `mapstructure:"node_timeout"`
+	Nodes          []*RaftCompactionNodeStatus `mapstructure:"nodes"`
+	NodesCompleted int                         `mapstructure:"nodes_completed"`
+	NodesTotal     int                         `mapstructure:"nodes_total"`
+}
+
+// RaftCompactionNodeStatus is the progress of the compaction of a node.
+type RaftCompactionNodeStatus struct {
+	NodeID       string `mapstructure:"node_id"`
+	State        string `mapstructure:"state"`
+	Error        string `mapstructure:"error"`
+	RequestIndex uint64 `mapstructure:"request_index"`
+	AppliedIndex uint64 `mapstructure:"applied_index"`
+	StartTime    string `mapstructure:"start_time"`
+	EndTime      string `mapstructure:"end_time"`
+}
+
+// RaftCompact wraps RaftCompactWithContext using context.Background.
+func (c *Sys) RaftCompact(opts *RaftCompactRequest) (*RaftCompactionStatus, error) {
+	return c.RaftCompactWithContext(context.Background(), opts)
+}
+
+// RaftCompactWithContext starts a rolling compaction of the FSM databases of
+// the nodes in the raft cluster.
+func (c *Sys) RaftCompactWithContext(ctx context.Context, opts *RaftCompactRequest) (*RaftCompactionStatus, error) {
+	ctx, cancelFunc := c.c.withConfiguredTimeout(ctx)
+	defer cancelFunc()
+
+	r := c.c.NewRequest(http.MethodPost, "/v1/sys/storage/raft/compact")
+	if opts == nil {
+		opts = &RaftCompactRequest{}
+	}
+	if err := r.SetJSONBody(opts); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.c.rawRequestWithContext(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return parseRaftCompactionStatus(resp)
+}
+
+// RaftCompactionStatus wraps RaftCompactionStatusWithContext using context.Background.
+func (c *Sys) RaftCompactionStatus() (*RaftCompactionStatus, error) {
+	return c.RaftCompactionStatusWithContext(context.Background())
+}
+
+// RaftCompactionStatusWithContext returns the progress of the last rolling
+// compaction of the raft cluster, or nil if there hasn't been one.
+func (c *Sys) RaftCompactionStatusWithContext(ctx context.Context) (*RaftCompactionStatus, error) {
+	ctx, cancelFunc := c.c.withConfiguredTimeout(ctx)
+	defer cancelFunc()
+
+	r := c.c.NewRequest(http.MethodGet, "/v1/sys/storage/raft/compact")
+
+	resp, err := c.c.rawRequestWithContext(ctx, r)
+	if resp != nil {
+		defer resp.Body.Close()
+		if resp.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRaftCompactionStatus(resp)
+}
+
+func parseRaftCompactionStatus(resp *Response) (*RaftCompactionStatus, error) {
+	secret, err := ParseSecret(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil || secret.Data == nil {
+		return nil, errors.New("data from server response is empty")
+	}
+
+	var result RaftCompactionStatus
+	if err := mapstructure.Decode(secret.Data, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
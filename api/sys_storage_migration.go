@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// StorageMigrationRequest starts an online migration of Vault's storage to
+// another backend, or resumes the last one.
+type StorageMigrationRequest struct {
+	Type   string            `json:"type,omitempty"`
+	Config map[string]string `json:"config,omitempty"`
+	Resume bool              `json:"resume,omitempty"`
+}
+
+// StorageMigrationStatus is the progress of an online storage migration.
+type StorageMigrationStatus struct {
+	ID           string `mapstructure:"id"`
+	Type         string `mapstructure:"type"`
+	State        string `mapstructure:"state"`
+	Error        string `mapstructure:"error"`
+	Checkpoint   string `mapstructure:"checkpoint"`
+	KeysCopied   uint64 `mapstructure:"keys_copied"`
+	KeysVerified uint64 `mapstructure:"keys_verified"`
+	KeysRepaired uint64 `mapstructure:"keys_repaired"`
+	StartTime    string `mapstructure:"start_time"`
+	CopyEndTime  string `mapstructure:"copy_end_time"`
+	EndTime      string `mapstructure:"end_time"`
+}
+
+// StorageMigrationStart wraps StorageMigrationStartWithContext using context.Background.
+func (c *Sys) StorageMigrationStart(opts *StorageMigrationRequest) (*StorageMigrationStatus, error) {
+	return c.StorageMigrationStartWithContext(context.Background(), opts)
+}
+
+// StorageMigrationStartWithContext starts mirroring writes to another storage
+// backend and copying the existing keys to it.
+func (c *Sys) StorageMigrationStartWithContext(ctx context.Context, opts *StorageMigrationRequest) (*StorageMigrationStatus, error) {
+	ctx, cancelFunc := c.c.withConfiguredTimeout(ctx)
+	defer cancelFunc()
+
+	r := c.c.NewRequest(http.MethodPost, "/v1/sys/storage/migration")
+	if err := r.SetJSONBody(opts); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.c.rawRequestWithContext(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return parseStorageMigrationStatus(resp)
+}
+
+// StorageMigrationStatus wraps StorageMigrationStatusWithContext using context.Background.
+func (c *Sys) StorageMigrationStatus() (*StorageMigrationStatus, error) {
+	return c.StorageMigrationStatusWithContext(context.Background())
+}
+
+// StorageMigrationStatusWithContext returns the progress of the last online
+// storage migration, or nil if there hasn't been one.
+func (c *Sys) StorageMigrationStatusWithContext(ctx context.Context) (*StorageMigrationStatus, error) {
+	ctx, cancelFunc := c.c.withConfiguredTimeout(ctx)
+	defer cancelFunc()
+
+	r := c.c.NewRequest(http.MethodGet, "/v1/sys/storage/migration")
+
+	resp, err := c.c.rawRequestWithContext(ctx, r)
+	if resp != nil {
+		defer resp.Body.Close()
+		if resp.StatusCode == 404 {
+			return nil, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parseStorageMigrationStatus(resp)
+}
+
+// StorageMigrationCancel wraps StorageMigrationCancelWithContext using context.Background.
+func (c *Sys) StorageMigrationCancel() (*StorageMigrationStatus, error) {
+	return c.StorageMigrationCancelWithContext(context.Background())
+}
+
+// StorageMigrationCancelWithContext cancels the running online storage
+// migration.
+func (c *Sys) StorageMigrationCancelWithContext(ctx context.Context) (*StorageMigrationStatus, error) {
+	ctx, cancelFunc := c.c.withConfiguredTimeout(ctx)
+	defer cancelFunc()
+
+	r := c.c.NewRequest(http.MethodDelete, "/v1/sys/storage/migration")
+
+	resp, err := c.c.rawRequestWithContext(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return parseStorageMigrationStatus(resp)
+}
+
+// StorageMigrationCutover wraps StorageMigrationCutoverWithContext using context.Background.
+func (c *Sys) StorageMigrationCutover() (*StorageMigrationStatus, error) {
+	return c.StorageMigrationCutoverWithContext(context.Background())
+}
+
+// StorageMigrationCutoverWithContext cuts over to the new storage of a
+// verified migration. The active node switches to the new storage while
+// sealed and unseals again, and the standbys follow.
+func (c *Sys) StorageMigrationCutoverWithContext(ctx context.Context) (*StorageMigrationStatus, error) {
+	ctx, cancelFunc := c.c.withConfiguredTimeout(ctx)
+	defer cancelFunc()
+
+	r := c.c.NewRequest(http.MethodPost, "/v1/sys/storage/migration/cutover")
+
+	resp, err := c.c.rawRequestWithContext(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return parseStorageMigrationStatus(resp)
+}
+
+func parseStorageMigrationStatus(resp *Response) (*StorageMigrationStatus, error) {
+	secret, err := ParseSecret(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil || secret.Data == nil {
+		return nil, errors.New("data from server response is empty")
+	}
+
+	var result StorageMigrationStatus
+	if err := mapstructure.Decode(secret.Data, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -629,6 +629,7 @@ func TestAwsEc2_RoleCrud(t *testing.T) {
 		"disallow_reauthentication":      false,
 		"period":                         int64(60),
 		"token_period":                   int64(60),
+		"token_bind_client_cert":         false,
 		"token_bound_cidrs":              []string{},
 		"token_no_default_policy":        false,
 		"token_num_uses":                 0,
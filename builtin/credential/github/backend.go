@@ -5,12 +5,15 @@ package github
 
 import (
 	"context"
+	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/google/go-github/v83/github"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/vault/sdk/framework"
 	"github.com/hashicorp/vault/sdk/logical"
+	gocache "github.com/patrickmn/go-cache"
 	"golang.org/x/oauth2"
 )
 
@@ -26,6 +29,9 @@ func Factory(ctx context.Context, conf *logical.BackendConfig) (logical.Backend,
 
 func Backend() *backend {
 	var b backend
+	b.appCache = gocache.New(gocache.NoExpiration, time.Minute)
+	b.membershipCache = gocache.New(gocache.NoExpiration, time.Minute)
+
 	b.TeamMap = &framework.PolicyMap{
 		PathMap: framework.PathMap{
 			Name: "teams",
@@ -43,6 +49,8 @@ func Backend() *backend {
 		OperationPrefix: operationPrefixGithub,
 		OperationSuffix: "team-mapping",
 	}
+	// Teams of additional organizations are mapped as <org>:<team-slug>
+	teamMapPaths[1].Pattern = `map/teams/(?P<key>[-\w]+(:[-\w]+)?)`
 	teamMapPaths[0].Operations = map[logical.Operation]framework.OperationHandler{
 		logical.ListOperation: &framework.PathOperation{
 			Callback: teamMapPaths[0].Callbacks[logical.ListOperation],
@@ -104,6 +112,7 @@ func Backend() *backend {
 
 		Paths:       append([]*framework.Path{pathConfig(&b), pathLogin(&b)}, allPaths...),
 		AuthRenew:   b.pathLoginRenew,
+		Invalidate:  b.invalidate,
 		BackendType: logical.TypeCredential,
 	}
 
@@ -116,6 +125,28 @@ type backend struct {
 	TeamMap *framework.PolicyMap
 
 	UserMap *framework.PolicyMap
+
+	// appCache holds the installations of the configured GitHub App and the
+	// installation tokens minted for them.
+	appCache *gocache.Cache
+
+	// membershipCache holds the organization and team memberships of users
+	// for the configured membership_cache_ttl, keyed by GitHub user ID.
+	membershipCache *gocache.Cache
+}
+
+func (b *backend) invalidate(_ context.Context, key string) {
+	switch key {
+	case "config":
+		b.flushCaches()
+	}
+}
+
+// flushCaches drops all cached GitHub App credentials and memberships, which
+// may have been obtained with a previous configuration.
+func (b *backend) flushCaches() {
+	b.appCache.Flush()
+	b.membershipCache.Flush()
 }
 
 // Client returns the GitHub client to communicate to GitHub via the
@@ -137,6 +168,25 @@ func (b *backend) Client(token string) (*github.Client, error) {
 	return client, nil
 }
 
+// configuredClient returns a GitHub client authenticated with the given token
+// which talks to the base URL set in the config, if any.
+func (b *backend) configuredClient(c *config, token string) (*github.Client, error) {
+	client, err := b.Client(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.BaseURL != "" {
+		parsedURL, err := url.Parse(c.BaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("successfully parsed base_url when set but failing to parse now: %w", err)
+		}
+		client.BaseURL = parsedURL
+	}
+
+	return client, nil
+}
+
 // tokenSource is an oauth2.TokenSource implementation.
 type tokenSource struct {
 	Value string
@@ -152,7 +202,9 @@ The GitHub credential provider allows authentication via GitHub.
 Users provide a personal access token to log in, and the credential
 provider verifies they're part of the correct organization and then
 maps the user to a set of Vault policies according to the teams they're
-part of.
+part of. If a GitHub App is configured, organization and team membership
+is looked up using the app's installation tokens instead of the user's
+token.
 
 After enabling the credential provider, use the "config" route to
 configure it.
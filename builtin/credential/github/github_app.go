@@ -0,0 +1,311 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package github
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/google/go-github/v83/github"
+)
+
+const (
+	// appJWTLifetime is how long the JWTs used to authenticate as the GitHub
+	// App are valid for. GitHub rejects JWTs with an expiry more than ten
+	// minutes in the future.
+	appJWTLifetime = 9 * time.Minute
+
+	// installationTokenExpiryWindow is how long before their expiry cached
+	// installation tokens are considered stale and a new one is requested.
+	installationTokenExpiryWindow = 5 * time.Minute
+
+	// installationCacheTTL is how long the installation of the GitHub App on
+	// an organization is cached for, so that reinstalling the app is picked
+	// up without reconfiguring the mount.
+	installationCacheTTL = time.Hour
+)
+
+// parseAppPrivateKey parses the PEM encoded RSA private key GitHub issues to
+// Apps. Both PKCS #1 and PKCS #8 encodings are accepted.
+func parseAppPrivateKey(keyPEM string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, errors.New("no PEM encoded key found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+
+	return key, nil
+}
+
+// appJWT returns a short-lived JWT that authenticates requests as the
+// configured GitHub App.
+func (c *config) appJWT() (string, error) {
+	key, err := parseAppPrivateKey(c.AppPrivateKey)
+	if err != nil {
+		return "", err
+	}
+
+	sig, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return "", err
+	}
+
+	// Backdate the issued at time to allow for clock drift between Vault
+	// and GitHub, as recommended by the GitHub documentation.
+	now := time.Now()
+	claims := jwt.Claims{
+		Issuer:   strconv.FormatInt(c.AppID, 10),
+		IssuedAt: jwt.NewNumericDate(now.Add(-60 * time.Second)),
+		Expiry:   jwt.NewNumericDate(now.Add(appJWTLifetime)),
+	}
+
+	return jwt.Signed(sig).Claims(claims).CompactSerialize()
+}
+
+// findInstallation returns the installation of the configured GitHub App on
+// the given organization. The primary organization is matched by its ID so
+// that renaming it does not break lookups; additional organizations are
+// matched by name.
+func (b *backend) findInstallation(ctx context.Context, c *config, orgName string) (*github.Installation, error) {
+	cacheKey := "installation/" + strings.ToLower(orgName)
+	if installation, ok := b.appCache.Get(cacheKey); ok {
+		return installation.(*github.Installation), nil
+	}
+
+	appJWT, err := c.appJWT()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := b.configuredClient(c, appJWT)
+	if err != nil {
+		return nil, err
+	}
+
+	opt := &github.ListOptions{
+		PerPage: 100,
+	}
+	for {
+		installations, resp, err := client.Apps.ListInstallations(ctx, opt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list GitHub App installations: %w", err)
+		}
+		for _, i := range installations {
+			if installationMatches(c, i, orgName) {
+				b.appCache.Set(cacheKey, i, installationCacheTTL)
+				return i, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	return nil, fmt.Errorf("GitHub App %d is not installed on organization %q", c.AppID, orgName)
+}
+
+func installationMatches(c *config, installation *github.Installation, orgName string) bool {
+	account := installation.GetAccount()
+	if c.OrganizationID != 0 && strings.EqualFold(orgName, c.Organization) {
+		return account.GetID() == c.OrganizationID
+	}
+	return strings.EqualFold(account.GetLogin(), orgName)
+}
+
+// installationClient returns a client authenticated with an installation
+// access token for the given app installation. Installation tokens are
+// cached until shortly before they expire.
+func (b *backend) installationClient(ctx context.Context, c *config, installationID int64) (*github.Client, error) {
+	cacheKey := "token/" + strconv.FormatInt(installationID, 10)
+	if token, ok := b.appCache.Get(cacheKey); ok {
+		return b.configuredClient(c, token.(string))
+	}
+
+	appJWT, err := c.appJWT()
+	if err != nil {
+		return nil, err
+	}
+
+	appClient, err := b.configuredClient(c, appJWT)
+	if err != nil {
+		return nil, err
+	}
+
+	token, _, err := appClient.Apps.CreateInstallationToken(ctx, installationID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitHub App installation token: %w", err)
+	}
+
+	if ttl := time.Until(token.GetExpiresAt().Time) - installationTokenExpiryWindow; ttl > 0 {
+		b.appCache.Set(cacheKey, token.GetToken(), ttl)
+	}
+
+	return b.configuredClient(c, token.GetToken())
+}
+
+// appMemberships determines which of the configured organizations the user
+// is a member of, and of which teams within them, by querying GitHub with
+// installation tokens of the configured GitHub App rather than the token
+// supplied by the user. Additional organizations the app is not installed on
+// are skipped, so that one misconfigured organization doesn't prevent every
+// user from logging in.
+func (b *backend) appMemberships(ctx context.Context, c *config, user *github.User) ([]*orgMembership, error) {
+	var memberships []*orgMembership
+	for _, orgName := range c.organizations() {
+		installation, err := b.findInstallation(ctx, c, orgName)
+		if err != nil {
+			if strings.EqualFold(orgName, c.Organization) {
+				return nil, err
+			}
+			b.Logger().Warn("skipping additional organization", "organization", orgName, "error", err)
+			continue
+		}
+
+		client, err := b.installationClient(ctx, c, installation.GetID())
+		if err != nil {
+			return nil, err
+		}
+
+		account := installation.GetAccount()
+		org := &github.Organization{
+			ID:    account.ID,
+			Login: account.Login,
+		}
+
+		isMember, _, err := client.Organizations.IsMember(ctx, org.GetLogin(), user.GetLogin())
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			continue
+		}
+
+		teams, err := userTeams(ctx, client, org.GetLogin(), user.GetLogin())
+		if err != nil {
+			return nil, err
+		}
+
+		memberships = append(memberships, &orgMembership{
+			Org:   org,
+			Teams: teams,
+		})
+	}
+
+	return memberships, nil
+}
+
+// userTeamsQuery lists the teams of an organization the user is a member of.
+// Installation tokens can't list the teams of a user through the REST API, and
+// checking the membership of each team would take a request per team.
+const userTeamsQuery = `query($org: String!, $user: String!, $cursor: String) {
+  organization(login: $org) {
+    teams(first: 100, userLogins: [$user], after: $cursor) {
+      nodes { databaseId name slug }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+type userTeamsResponse struct {
+	Data struct {
+		Organization struct {
+			Teams struct {
+				Nodes []struct {
+					DatabaseID int64  `json:"databaseId"`
+					Name       string `json:"name"`
+					Slug       string `json:"slug"`
+				} `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"teams"`
+		} `json:"organization"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// userTeams returns the teams of the organization the user is a member of,
+// using the GraphQL API.
+func userTeams(ctx context.Context, client *github.Client, orgName, userLogin string) ([]*github.Team, error) {
+	variables := map[string]interface{}{
+		"org":  orgName,
+		"user": userLogin,
+	}
+
+	var teams []*github.Team
+	for {
+		req, err := client.NewRequest(http.MethodPost, graphQLURL(client.BaseURL), map[string]interface{}{
+			"query":     userTeamsQuery,
+			"variables": variables,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var resp userTeamsResponse
+		if _, err := client.Do(ctx, req, &resp); err != nil {
+			return nil, fmt.Errorf("failed to list teams of organization %q: %w", orgName, err)
+		}
+		if len(resp.Errors) > 0 {
+			return nil, fmt.Errorf("failed to list teams of organization %q: %s", orgName, resp.Errors[0].Message)
+		}
+
+		result := resp.Data.Organization.Teams
+		for _, t := range result.Nodes {
+			teams = append(teams, &github.Team{
+				ID:   github.Ptr(t.DatabaseID),
+				Name: github.Ptr(t.Name),
+				Slug: github.Ptr(t.Slug),
+			})
+		}
+		if !result.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = result.PageInfo.EndCursor
+	}
+
+	return teams, nil
+}
+
+// graphQLURL returns the GraphQL endpoint for the REST API base URL. GitHub
+// Enterprise Server serves the REST API under /api/v3/ and GraphQL at
+// /api/graphql, while GitHub.com serves both from the root of its API host.
+func graphQLURL(baseURL *url.URL) string {
+	u := *baseURL
+	if strings.HasSuffix(u.Path, "/api/v3/") {
+		u.Path = strings.TrimSuffix(u.Path, "v3/") + "graphql"
+	} else {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/graphql"
+	}
+	return u.String()
+}
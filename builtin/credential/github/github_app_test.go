@@ -0,0 +1,492 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGitHubApp is a stand-in for the parts of the GitHub API used when a
+// GitHub App is configured. It only answers membership queries made with
+// installation tokens it issued to the app.
+type fakeGitHubApp struct {
+	t   *testing.T
+	key *rsa.PrivateKey
+
+	// members maps organization names to the members of each of their
+	// teams, keyed by team slug. Members of the organization who are not
+	// part of any team are listed under the empty slug.
+	members map[string]map[string][]string
+
+	l        sync.Mutex
+	requests map[string]int
+}
+
+func newFakeGitHubApp(t *testing.T) *fakeGitHubApp {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return &fakeGitHubApp{
+		t:   t,
+		key: key,
+		members: map[string]map[string][]string{
+			"foo-org": {
+				"foo-team":     {"user-foo"},
+				"another-team": {"user-foo"},
+				"other-team":   {"user-bar"},
+			},
+			"bar-org": {
+				"bar-team": {"user-foo"},
+			},
+			"baz-org": {
+				"": {"user-foo"},
+			},
+		},
+		requests: map[string]int{},
+	}
+}
+
+func (f *fakeGitHubApp) privateKeyPEM() string {
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(f.key),
+	}))
+}
+
+func (f *fakeGitHubApp) requestCount(path string) int {
+	f.l.Lock()
+	defer f.l.Unlock()
+	return f.requests[path]
+}
+
+// installations are the organizations the fake app is installed on
+var installations = []map[string]interface{}{
+	{"id": 1001, "account": map[string]interface{}{"login": "foo-org", "id": 12345}},
+	{"id": 1002, "account": map[string]interface{}{"login": "bar-org", "id": 23456}},
+}
+
+func (f *fakeGitHubApp) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.l.Lock()
+	f.requests[r.URL.Path]++
+	f.l.Unlock()
+
+	w.Header().Add("Content-Type", "application/json")
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case r.URL.Path == "/user":
+		w.Write([]byte(getUserResponse))
+
+	case r.URL.Path == "/app/installations":
+		if !f.validAppJWT(token) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(installations)
+
+	case len(parts) == 4 && parts[0] == "app" && parts[3] == "access_tokens" && r.Method == http.MethodPost:
+		if !f.validAppJWT(token) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"token":      "installation-" + parts[2],
+			"expires_at": time.Now().Add(time.Hour).Format(time.RFC3339),
+		})
+
+	case r.URL.Path == "/graphql" && r.Method == http.MethodPost:
+		if !strings.HasPrefix(token, "installation-") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.serveUserTeams(w, r)
+
+	case len(parts) >= 3 && parts[0] == "orgs":
+		if !strings.HasPrefix(token, "installation-") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.serveOrg(w, parts[1], parts[2:])
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeGitHubApp) serveOrg(w http.ResponseWriter, org string, parts []string) {
+	teams, ok := f.members[org]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch {
+	// GET /orgs/{org}/members/{user}
+	case len(parts) == 2 && parts[0] == "members":
+		for _, members := range teams {
+			for _, m := range members {
+				if m == parts[1] {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// serveUserTeams answers the GraphQL query for the teams of an organization
+// the user is a member of, one team per page to exercise pagination.
+func (f *fakeGitHubApp) serveUserTeams(w http.ResponseWriter, r *http.Request) {
+	var query struct {
+		Variables struct {
+			Org    string `json:"org"`
+			User   string `json:"user"`
+			Cursor string `json:"cursor"`
+		} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var slugs []string
+	for slug, members := range f.members[query.Variables.Org] {
+		if slug != "" && slices.Contains(members, query.Variables.User) {
+			slugs = append(slugs, slug)
+		}
+	}
+	sort.Strings(slugs)
+
+	var nodes []map[string]interface{}
+	next := ""
+	for i, slug := range slugs {
+		if slug <= query.Variables.Cursor {
+			continue
+		}
+		nodes = append(nodes, map[string]interface{}{
+			"databaseId": 100 + i,
+			"name":       strings.ToUpper(slug),
+			"slug":       slug,
+		})
+		if i < len(slugs)-1 {
+			next = slug
+		}
+		break
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data": map[string]interface{}{
+			"organization": map[string]interface{}{
+				"teams": map[string]interface{}{
+					"nodes": nodes,
+					"pageInfo": map[string]interface{}{
+						"hasNextPage": next != "",
+						"endCursor":   next,
+					},
+				},
+			},
+		},
+	})
+}
+
+func (f *fakeGitHubApp) validAppJWT(token string) bool {
+	parsed, err := jwt.ParseSigned(token)
+	if err != nil {
+		return false
+	}
+	var claims jwt.Claims
+	if err := parsed.Claims(&f.key.PublicKey, &claims); err != nil {
+		return false
+	}
+	return claims.Issuer == "42" && claims.Validate(jwt.Expected{Time: time.Now()}) == nil
+}
+
+func writeAppConfig(t *testing.T, b *backend, s logical.Storage, data map[string]interface{}) {
+	t.Helper()
+	resp, err := b.HandleRequest(context.Background(), &logical.Request{
+		Path:      "config",
+		Operation: logical.UpdateOperation,
+		Data:      data,
+		Storage:   s,
+	})
+	require.NoError(t, err)
+	require.NoError(t, resp.Error())
+}
+
+func writeTeamMapping(t *testing.T, b *backend, s logical.Storage, team, policy string) {
+	t.Helper()
+	resp, err := b.HandleRequest(context.Background(), &logical.Request{
+		Path:      "map/teams/" + team,
+		Operation: logical.UpdateOperation,
+		Data: map[string]interface{}{
+			"value": policy,
+		},
+		Storage: s,
+	})
+	require.NoError(t, err)
+	require.NoError(t, resp.Error())
+}
+
+// TestGitHub_AppLogin tests that memberships are looked up with installation
+// tokens of the configured GitHub App across multiple organizations, and that
+// they are cached for the configured TTL
+func TestGitHub_AppLogin(t *testing.T) {
+	b, s := createBackendWithStorage(t)
+	app := newFakeGitHubApp(t)
+	ts := httptest.NewServer(app)
+	defer ts.Close()
+
+	writeAppConfig(t, b, s, map[string]interface{}{
+		"organization":             "foo-org",
+		"additional_organizations": "bar-org",
+		"base_url":                 ts.URL,
+		"app_id":                   42,
+		"app_private_key":          app.privateKeyPEM(),
+		"membership_cache_ttl":     "1m",
+	})
+
+	resp, err := b.HandleRequest(context.Background(), &logical.Request{
+		Path:      "config",
+		Operation: logical.ReadOperation,
+		Storage:   s,
+	})
+	require.NoError(t, err)
+	require.NoError(t, resp.Error())
+
+	// the ID of the organization is taken from the app installation, and the
+	// private key is never returned
+	require.Equal(t, int64(12345), resp.Data["organization_id"])
+	require.Equal(t, int64(42), resp.Data["app_id"])
+	require.Equal(t, []string{"bar-org"}, resp.Data["additional_organizations"])
+	require.Equal(t, int64(60), resp.Data["membership_cache_ttl"])
+	require.NotContains(t, resp.Data, "app_private_key")
+
+	writeTeamMapping(t, b, s, "foo-team", "foo-policy")
+	writeTeamMapping(t, b, s, "bar-org:bar-team", "bar-policy")
+	writeTeamMapping(t, b, s, "other-team", "other-policy")
+	writeTeamMapping(t, b, s, "another-team", "another-policy")
+
+	for i := 0; i < 2; i++ {
+		resp, err = b.HandleRequest(context.Background(), &logical.Request{
+			Path:      "login",
+			Operation: logical.UpdateOperation,
+			Data: map[string]interface{}{
+				"token": "user-token",
+			},
+			Storage:    s,
+			Connection: &logical.Connection{},
+		})
+		require.NoError(t, err)
+		require.NoError(t, resp.Error())
+
+		require.Equal(t, map[string]string{"org": "foo-org", "username": "user-foo"}, resp.Auth.Metadata)
+		require.ElementsMatch(t, []string{"foo-policy", "another-policy", "bar-policy"}, resp.Auth.Policies)
+
+		var aliases []string
+		for _, a := range resp.Auth.GroupAliases {
+			aliases = append(aliases, a.Name)
+		}
+		require.ElementsMatch(t, []string{
+			"FOO-TEAM", "foo-team", "foo-org:foo-team",
+			"ANOTHER-TEAM", "another-team", "foo-org:another-team",
+			"bar-org:bar-team",
+		}, aliases)
+	}
+
+	// the user is looked up on each login, but installations, installation
+	// tokens and memberships are only looked up once. The teams of the user
+	// take a GraphQL request per page rather than a request per team.
+	require.Equal(t, 2, app.requestCount("/user"))
+	require.Equal(t, 1, app.requestCount("/app/installations/1001/access_tokens"))
+	require.Equal(t, 1, app.requestCount("/app/installations/1002/access_tokens"))
+	require.Equal(t, 1, app.requestCount("/orgs/foo-org/members/user-foo"))
+	require.Equal(t, 3, app.requestCount("/graphql"))
+	require.Zero(t, app.requestCount("/orgs/foo-org/teams"))
+}
+
+// TestGitHub_AppLogin_NotMember tests that users who are not part of any of
+// the configured organizations cannot log in
+func TestGitHub_AppLogin_NotMember(t *testing.T) {
+	b, s := createBackendWithStorage(t)
+	app := newFakeGitHubApp(t)
+	app.members["foo-org"]["foo-team"] = nil
+	app.members["foo-org"]["another-team"] = nil
+	app.members["bar-org"]["bar-team"] = nil
+	ts := httptest.NewServer(app)
+	defer ts.Close()
+
+	writeAppConfig(t, b, s, map[string]interface{}{
+		"organization":             "foo-org",
+		"additional_organizations": "bar-org",
+		"base_url":                 ts.URL,
+		"app_id":                   42,
+		"app_private_key":          app.privateKeyPEM(),
+	})
+
+	resp, err := b.HandleRequest(context.Background(), &logical.Request{
+		Path:      "login",
+		Operation: logical.UpdateOperation,
+		Data: map[string]interface{}{
+			"token": "user-token",
+		},
+		Storage:    s,
+		Connection: &logical.Connection{},
+	})
+	require.Nil(t, resp)
+	require.EqualError(t, err, "user is not part of required org")
+}
+
+// TestGitHub_AppLogin_AdditionalNotInstalled tests that additional
+// organizations the app is not installed on are skipped rather than failing
+// every login
+func TestGitHub_AppLogin_AdditionalNotInstalled(t *testing.T) {
+	b, s := createBackendWithStorage(t)
+	app := newFakeGitHubApp(t)
+	ts := httptest.NewServer(app)
+	defer ts.Close()
+
+	writeAppConfig(t, b, s, map[string]interface{}{
+		"organization":             "foo-org",
+		"additional_organizations": "baz-org,bar-org",
+		"base_url":                 ts.URL,
+		"app_id":                   42,
+		"app_private_key":          app.privateKeyPEM(),
+	})
+	writeTeamMapping(t, b, s, "bar-org:bar-team", "bar-policy")
+
+	resp, err := b.HandleRequest(context.Background(), &logical.Request{
+		Path:      "login",
+		Operation: logical.UpdateOperation,
+		Data: map[string]interface{}{
+			"token": "user-token",
+		},
+		Storage:    s,
+		Connection: &logical.Connection{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, resp.Error())
+	require.Contains(t, resp.Auth.Policies, "bar-policy")
+}
+
+// TestGitHub_AppLogin_NotInstalled tests that configuring an organization the
+// app is not installed on fails when the organization ID must be looked up
+func TestGitHub_AppLogin_NotInstalled(t *testing.T) {
+	b, s := createBackendWithStorage(t)
+	app := newFakeGitHubApp(t)
+	ts := httptest.NewServer(app)
+	defer ts.Close()
+
+	_, err := b.HandleRequest(context.Background(), &logical.Request{
+		Path:      "config",
+		Operation: logical.UpdateOperation,
+		Data: map[string]interface{}{
+			"organization":    "baz-org",
+			"base_url":        ts.URL,
+			"app_id":          42,
+			"app_private_key": app.privateKeyPEM(),
+		},
+		Storage: s,
+	})
+	require.ErrorContains(t, err, `GitHub App 42 is not installed on organization "baz-org"`)
+}
+
+// TestGitHub_WriteConfig_AppValidation tests that the GitHub App ID and
+// private key must be provided together, and that the key must be valid
+func TestGitHub_WriteConfig_AppValidation(t *testing.T) {
+	b, s := createBackendWithStorage(t)
+
+	for name, tc := range map[string]struct {
+		data        map[string]interface{}
+		expectedErr string
+	}{
+		"no key": {
+			data:        map[string]interface{}{"app_id": 42},
+			expectedErr: "app_private_key is required when app_id is set",
+		},
+		"no app ID": {
+			data:        map[string]interface{}{"app_private_key": "key"},
+			expectedErr: "app_id is required when app_private_key is set",
+		},
+		"invalid key": {
+			data:        map[string]interface{}{"app_id": 42, "app_private_key": "key"},
+			expectedErr: "error parsing given app_private_key: no PEM encoded key found",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tc.data["organization"] = "foo-org"
+			tc.data["organization_id"] = 12345
+			resp, err := b.HandleRequest(context.Background(), &logical.Request{
+				Path:      "config",
+				Operation: logical.UpdateOperation,
+				Data:      tc.data,
+				Storage:   s,
+			})
+			require.NoError(t, err)
+			require.EqualError(t, resp.Error(), tc.expectedErr)
+		})
+	}
+}
+
+// TestGitHub_WriteConfig_AdditionalOrganizations tests that additional
+// organizations must be named once and not repeat the primary organization
+func TestGitHub_WriteConfig_AdditionalOrganizations(t *testing.T) {
+	b, s := createBackendWithStorage(t)
+
+	for name, tc := range map[string]struct {
+		orgs        interface{}
+		expectedErr string
+	}{
+		"empty name": {
+			orgs:        []string{"bar-org", ""},
+			expectedErr: "additional_organizations must not contain empty names",
+		},
+		"primary organization": {
+			orgs:        "bar-org,Foo-Org",
+			expectedErr: `additional_organizations must not contain the primary organization "foo-org"`,
+		},
+		"duplicate": {
+			orgs:        "bar-org,baz-org,BAR-ORG",
+			expectedErr: `additional_organizations contains "BAR-ORG" more than once`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := b.HandleRequest(context.Background(), &logical.Request{
+				Path:      "config",
+				Operation: logical.UpdateOperation,
+				Data: map[string]interface{}{
+					"organization":             "foo-org",
+					"organization_id":          12345,
+					"additional_organizations": tc.orgs,
+				},
+				Storage: s,
+			})
+			require.NoError(t, err)
+			require.EqualError(t, resp.Error(), tc.expectedErr)
+		})
+	}
+}
@@ -5,6 +5,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -45,6 +46,46 @@ API-compatible authentication server.`,
 					Group: "GitHub Options",
 				},
 			},
+			"additional_organizations": {
+				Type: framework.TypeCommaStringSlice,
+				Description: `Additional organizations users may be part of
+instead of the primary organization. Teams of these organizations are
+mapped as "<organization>:<team-slug>". When app_id is set, organizations
+the app isn't installed on are skipped at login.`,
+				DisplayAttrs: &framework.DisplayAttributes{
+					Group: "GitHub Options",
+				},
+			},
+			"app_id": {
+				Type: framework.TypeInt64,
+				Description: `The ID of a GitHub App installed on the
+configured organizations. If set, organization and team membership is
+looked up with the app's installation tokens rather than the token
+supplied at login.`,
+				DisplayAttrs: &framework.DisplayAttributes{
+					Name:  "App ID",
+					Group: "GitHub App",
+				},
+			},
+			"app_private_key": {
+				Type:        framework.TypeString,
+				Description: "The PEM encoded private key of the GitHub App.",
+				DisplayAttrs: &framework.DisplayAttributes{
+					Name:      "App private key",
+					Group:     "GitHub App",
+					Sensitive: true,
+				},
+			},
+			"membership_cache_ttl": {
+				Type: framework.TypeDurationSecond,
+				Description: `How long the organization and team
+memberships of a user are cached for. Defaults to 0, which disables
+caching.`,
+				DisplayAttrs: &framework.DisplayAttributes{
+					Name:  "Membership cache TTL",
+					Group: "GitHub Options",
+				},
+			},
 			"ttl": {
 				Type:        framework.TypeDurationSecond,
 				Description: tokenutil.DeprecationText("token_ttl"),
@@ -113,6 +154,46 @@ func (b *backend) pathConfigWrite(ctx context.Context, req *logical.Request, dat
 		c.BaseURL = baseURL
 	}
 
+	if additionalOrgsRaw, ok := data.GetOk("additional_organizations"); ok {
+		c.AdditionalOrganizations = additionalOrgsRaw.([]string)
+	}
+	if err := c.validateAdditionalOrganizations(); err != nil {
+		return logical.ErrorResponse(err.Error()), nil
+	}
+
+	if appIDRaw, ok := data.GetOk("app_id"); ok {
+		c.AppID = appIDRaw.(int64)
+	}
+	if appPrivateKeyRaw, ok := data.GetOk("app_private_key"); ok {
+		c.AppPrivateKey = appPrivateKeyRaw.(string)
+	}
+	if c.AppID != 0 {
+		if c.AppPrivateKey == "" {
+			return logical.ErrorResponse("app_private_key is required when app_id is set"), nil
+		}
+		if _, err := parseAppPrivateKey(c.AppPrivateKey); err != nil {
+			return logical.ErrorResponse(fmt.Sprintf("error parsing given app_private_key: %s", err)), nil
+		}
+	} else if c.AppPrivateKey != "" {
+		return logical.ErrorResponse("app_id is required when app_private_key is set"), nil
+	}
+
+	if membershipCacheTTLRaw, ok := data.GetOk("membership_cache_ttl"); ok {
+		c.MembershipCacheTTL = time.Duration(membershipCacheTTLRaw.(int)) * time.Second
+	}
+
+	if c.OrganizationID == 0 && c.AppID != 0 {
+		// the installation of the app on the organization carries the
+		// organization's ID
+		installation, err := b.findInstallation(ctx, c, c.Organization)
+		if err != nil {
+			errorMsg := fmt.Errorf("unable to fetch the organization_id, you must manually set it in the config: %s", err)
+			b.Logger().Error(errorMsg.Error())
+			return nil, errorMsg
+		}
+		c.OrganizationID = installation.GetAccount().GetID()
+	}
+
 	if c.OrganizationID == 0 {
 		githubToken := os.Getenv("VAULT_AUTH_CONFIG_GITHUB_TOKEN")
 		client, err := b.Client(githubToken)
@@ -158,6 +239,10 @@ func (b *backend) pathConfigWrite(ctx context.Context, req *logical.Request, dat
 		return nil, err
 	}
 
+	// Cached installations, tokens and memberships may no longer be valid
+	// with the new configuration
+	b.flushCaches()
+
 	if len(resp.Warnings) == 0 {
 		return nil, nil
 	}
@@ -175,9 +260,12 @@ func (b *backend) pathConfigRead(ctx context.Context, req *logical.Request, data
 	}
 
 	d := map[string]interface{}{
-		"organization_id": config.OrganizationID,
-		"organization":    config.Organization,
-		"base_url":        config.BaseURL,
+		"organization_id":          config.OrganizationID,
+		"organization":             config.Organization,
+		"additional_organizations": config.AdditionalOrganizations,
+		"base_url":                 config.BaseURL,
+		"app_id":                   config.AppID,
+		"membership_cache_ttl":     int64(config.MembershipCacheTTL.Seconds()),
 	}
 	config.PopulateTokenData(d)
 
@@ -223,11 +311,44 @@ func (b *backend) Config(ctx context.Context, s logical.Storage) (*config, error
 type config struct {
 	tokenutil.TokenParams
 
-	OrganizationID int64         `json:"organization_id" structs:"organization_id" mapstructure:"organization_id"`
-	Organization   string        `json:"organization" structs:"organization" mapstructure:"organization"`
-	BaseURL        string        `json:"base_url" structs:"base_url" mapstructure:"base_url"`
-	TTL            time.Duration `json:"ttl" structs:"ttl" mapstructure:"ttl"`
-	MaxTTL         time.Duration `json:"max_ttl" structs:"max_ttl" mapstructure:"max_ttl"`
+	OrganizationID          int64         `json:"organization_id" structs:"organization_id" mapstructure:"organization_id"`
+	Organization            string        `json:"organization" structs:"organization" mapstructure:"organization"`
+	AdditionalOrganizations []string      `json:"additional_organizations" structs:"additional_organizations" mapstructure:"additional_organizations"`
+	BaseURL                 string        `json:"base_url" structs:"base_url" mapstructure:"base_url"`
+	AppID                   int64         `json:"app_id" structs:"app_id" mapstructure:"app_id"`
+	AppPrivateKey           string        `json:"app_private_key" structs:"app_private_key" mapstructure:"app_private_key"`
+	MembershipCacheTTL      time.Duration `json:"membership_cache_ttl" structs:"membership_cache_ttl" mapstructure:"membership_cache_ttl"`
+	TTL                     time.Duration `json:"ttl" structs:"ttl" mapstructure:"ttl"`
+	MaxTTL                  time.Duration `json:"max_ttl" structs:"max_ttl" mapstructure:"max_ttl"`
+}
+
+// organizations returns the names of all organizations users may be part of,
+// starting with the primary organization.
+func (c *config) organizations() []string {
+	return append([]string{c.Organization}, c.AdditionalOrganizations...)
+}
+
+// validateAdditionalOrganizations checks that each additional organization is
+// named once, and isn't the primary organization. Organization names are case
+// insensitive.
+func (c *config) validateAdditionalOrganizations() error {
+	seen := map[string]bool{
+		strings.ToLower(c.Organization): true,
+	}
+	for i, orgName := range c.AdditionalOrganizations {
+		orgName = strings.TrimSpace(orgName)
+		switch {
+		case orgName == "":
+			return errors.New("additional_organizations must not contain empty names")
+		case strings.EqualFold(orgName, c.Organization):
+			return fmt.Errorf("additional_organizations must not contain the primary organization %q", c.Organization)
+		case seen[strings.ToLower(orgName)]:
+			return fmt.Errorf("additional_organizations contains %q more than once", orgName)
+		}
+		seen[strings.ToLower(orgName)] = true
+		c.AdditionalOrganizations[i] = orgName
+	}
+	return nil
 }
 
 func (c *config) setOrganizationID(ctx context.Context, client *github.Client) error {
@@ -7,7 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/google/go-github/v83/github"
 	"github.com/hashicorp/vault/sdk/framework"
@@ -161,19 +162,11 @@ func (b *backend) verifyCredentials(ctx context.Context, req *logical.Request, t
 		}
 	}
 
-	client, err := b.Client(token)
+	client, err := b.configuredClient(config, token)
 	if err != nil {
 		return nil, err
 	}
 
-	if config.BaseURL != "" {
-		parsedURL, err := url.Parse(config.BaseURL)
-		if err != nil {
-			return nil, fmt.Errorf("successfully parsed base_url when set but failing to parse now: %w", err)
-		}
-		client.BaseURL = parsedURL
-	}
-
 	if config.OrganizationID == 0 {
 		// Previously we did not verify using the Org ID. So if the Org ID is
 		// not set, we will trust-on-first-use and set it now.
@@ -201,8 +194,100 @@ func (b *backend) verifyCredentials(ctx context.Context, req *logical.Request, t
 	}
 
 	// Verify that the user is part of the organization
-	var org *github.Organization
+	memberships, err := b.memberships(ctx, config, client, user)
+	if err != nil {
+		return nil, err
+	}
+	if len(memberships) == 0 {
+		return nil, errors.New("user is not part of required org")
+	}
+
+	// Report the primary organization if the user is part of it, and the
+	// first additional organization they are part of otherwise
+	org := memberships[0].Org
+	if org.GetID() == config.OrganizationID && org.GetLogin() != config.Organization {
+		warningMsg := fmt.Sprintf(
+			"the organization name has changed to %q. It is recommended to verify and update the organization name in the config: %s=%d",
+			org.GetLogin(),
+			"organization_id",
+			config.OrganizationID,
+		)
+		b.Logger().Warn(warningMsg)
+		warnings = append(warnings, warningMsg)
+	}
+
+	// Get the teams that this user is part of to determine the policies
+	var teamNames []string
+	for _, m := range memberships {
+		for _, t := range m.Teams {
+			// Append the names so we can get the policies
+			if m.Org.GetID() == config.OrganizationID {
+				teamNames = append(teamNames, t.GetName())
+				if t.GetName() != t.GetSlug() {
+					teamNames = append(teamNames, t.GetSlug())
+				}
+			}
+			if len(config.AdditionalOrganizations) > 0 {
+				teamNames = append(teamNames, m.Org.GetLogin()+":"+t.GetSlug())
+			}
+		}
+	}
+
+	groupPoliciesList, err := b.TeamMap.Policies(ctx, req.Storage, teamNames...)
+	if err != nil {
+		return nil, err
+	}
+
+	userPoliciesList, err := b.UserMap.Policies(ctx, req.Storage, []string{*user.Login}...)
+	if err != nil {
+		return nil, err
+	}
+
+	verifyResp := &verifyCredentialsResp{
+		User:      user,
+		Org:       org,
+		Policies:  append(groupPoliciesList, userPoliciesList...),
+		TeamNames: teamNames,
+		Config:    config,
+		Warnings:  warnings,
+	}
+
+	return verifyResp, nil
+}
+
+// memberships returns the configured organizations the user is part of, along
+// with the teams they are part of within each. Results are cached for the
+// configured membership_cache_ttl.
+func (b *backend) memberships(ctx context.Context, config *config, client *github.Client, user *github.User) ([]*orgMembership, error) {
+	cacheKey := strconv.FormatInt(user.GetID(), 10)
+	if config.MembershipCacheTTL > 0 {
+		if memberships, ok := b.membershipCache.Get(cacheKey); ok {
+			return memberships.([]*orgMembership), nil
+		}
+	}
+
+	var memberships []*orgMembership
+	var err error
+	if config.AppID != 0 {
+		memberships, err = b.appMemberships(ctx, config, user)
+	} else {
+		memberships, err = tokenMemberships(ctx, config, client)
+	}
+	if err != nil {
+		return nil, err
+	}
 
+	if config.MembershipCacheTTL > 0 {
+		b.membershipCache.Set(cacheKey, memberships, config.MembershipCacheTTL)
+	}
+
+	return memberships, nil
+}
+
+// tokenMemberships determines which of the configured organizations the user
+// is a member of, and of which teams within them, using the token supplied
+// by the user.
+func tokenMemberships(ctx context.Context, config *config, client *github.Client) ([]*orgMembership, error) {
 	orgOpt := &github.ListOptions{
 		PerPage: 100,
 	}
@@ -220,32 +305,20 @@ func (b *backend) verifyCredentials(ctx context.Context, req *logical.Request, t
 		orgOpt.Page = resp.NextPage
 	}
 
-	orgLoginName := ""
-	for _, o := range allOrgs {
-		if o.GetID() == config.OrganizationID {
-			org = o
-			orgLoginName = *o.Login
-			break
+	var memberships []*orgMembership
+	for _, orgName := range config.organizations() {
+		for _, o := range allOrgs {
+			if orgName == config.Organization && o.GetID() == config.OrganizationID ||
+				orgName != config.Organization && strings.EqualFold(o.GetLogin(), orgName) {
+				memberships = append(memberships, &orgMembership{Org: o})
+				break
+			}
 		}
 	}
-	if org == nil {
-		return nil, errors.New("user is not part of required org")
-	}
-
-	if orgLoginName != config.Organization {
-		warningMsg := fmt.Sprintf(
-			"the organization name has changed to %q. It is recommended to verify and update the organization name in the config: %s=%d",
-			orgLoginName,
-			"organization_id",
-			config.OrganizationID,
-		)
-		b.Logger().Warn(warningMsg)
-		warnings = append(warnings, warningMsg)
+	if len(memberships) == 0 {
+		return nil, nil
 	}
 
-	// Get the teams that this user is part of to determine the policies
-	var teamNames []string
-
 	teamOpt := &github.ListOptions{
 		PerPage: 100,
 	}
@@ -264,38 +337,23 @@ func (b *backend) verifyCredentials(ctx context.Context, req *logical.Request, t
 	}
 
 	for _, t := range allTeams {
-		// We only care about teams that are part of the organization we use
-		if *t.Organization.ID != *org.ID {
-			continue
-		}
-
-		// Append the names so we can get the policies
-		teamNames = append(teamNames, *t.Name)
-		if *t.Name != *t.Slug {
-			teamNames = append(teamNames, *t.Slug)
+		// We only care about teams that are part of the organizations we use
+		for _, m := range memberships {
+			if t.GetOrganization().GetID() == m.Org.GetID() {
+				m.Teams = append(m.Teams, t)
+				break
+			}
 		}
 	}
 
-	groupPoliciesList, err := b.TeamMap.Policies(ctx, req.Storage, teamNames...)
-	if err != nil {
-		return nil, err
-	}
-
-	userPoliciesList, err := b.UserMap.Policies(ctx, req.Storage, []string{*user.Login}...)
-	if err != nil {
-		return nil, err
-	}
-
-	verifyResp := &verifyCredentialsResp{
-		User:      user,
-		Org:       org,
-		Policies:  append(groupPoliciesList, userPoliciesList...),
-		TeamNames: teamNames,
-		Config:    config,
-		Warnings:  warnings,
-	}
+	return memberships, nil
+}
 
-	return verifyResp, nil
+// orgMembership describes a user's membership of an organization and the
+// teams within it.
+type orgMembership struct {
+	Org   *github.Organization
+	Teams []*github.Team
 }
 
 type verifyCredentialsResp struct {
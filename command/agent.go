@@ -33,12 +33,17 @@ import (
 	"github.com/hashicorp/vault/command/agent/exec"
 	"github.com/hashicorp/vault/command/agent/pkiexternalca"
 	"github.com/hashicorp/vault/command/agent/template"
+	"github.com/hashicorp/vault/command/agent/workload"
 	"github.com/hashicorp/vault/command/agentproxyshared"
 	"github.com/hashicorp/vault/command/agentproxyshared/auth"
 	"github.com/hashicorp/vault/command/agentproxyshared/cache"
 	"github.com/hashicorp/vault/command/agentproxyshared/sink"
+	execsink "github.com/hashicorp/vault/command/agentproxyshared/sink/exec"
 	"github.com/hashicorp/vault/command/agentproxyshared/sink/file"
 	"github.com/hashicorp/vault/command/agentproxyshared/sink/inmem"
+	"github.com/hashicorp/vault/command/agentproxyshared/sink/kubernetes"
+	"github.com/hashicorp/vault/command/agentproxyshared/sink/socket"
+	"github.com/hashicorp/vault/command/agentproxyshared/upstream"
 	"github.com/hashicorp/vault/command/agentproxyshared/winsvc"
 	"github.com/hashicorp/vault/helper/logging"
 	"github.com/hashicorp/vault/helper/metricsutil"
@@ -313,6 +318,30 @@ func (c *AgentCommand) Run(args []string) int {
 	}
 	c.metricsHelper = metricsutil.NewMetricsHelper(inmemMetrics, prometheusEnabled)
 
+	// Fail over between multiple Vault servers based on their health
+	var upstreams *upstream.Manager
+	if config.Vault != nil && len(config.Vault.Addresses) > 1 {
+		upstreamConfig := &upstream.ManagerConfig{
+			Client:           client,
+			Addresses:        config.Vault.Addresses,
+			Logger:           c.logger.Named("upstream"),
+			MetricsSignifier: "agent",
+		}
+		if config.Vault.HealthCheck != nil {
+			upstreamConfig.HealthCheckInterval = config.Vault.HealthCheck.Interval
+			upstreamConfig.HealthCheckTimeout = config.Vault.HealthCheck.Timeout
+		}
+		upstreams, err = upstream.NewManager(upstreamConfig)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error configuring Vault upstreams: %v", err))
+			return 1
+		}
+		if err := upstreams.Register(client); err != nil {
+			c.UI.Error(fmt.Sprintf("Error configuring Vault upstreams: %v", err))
+			return 1
+		}
+	}
+
 	var templateNamespace string
 	// This indicates whether the namespace for the client has been set by environment variable.
 	// If it has, we don't touch it
@@ -350,41 +379,92 @@ func (c *AgentCommand) Run(args []string) int {
 			sinkClient.SetDisableKeepAlives(true)
 		}
 
+		if upstreams != nil {
+			if err := upstreams.Register(sinkClient); err != nil {
+				c.UI.Error(fmt.Sprintf("Error configuring client for file sink: %v", err))
+				return 1
+			}
+		}
+
 		for _, sc := range config.AutoAuth.Sinks {
+			var newSink func(*sink.SinkConfig) (sink.Sink, error)
 			switch sc.Type {
 			case "file":
-				config := &sink.SinkConfig{
-					Logger:    c.logger.Named("sink.file"),
-					Config:    sc.Config,
-					Client:    sinkClient,
-					WrapTTL:   sc.WrapTTL,
-					DHType:    sc.DHType,
-					DeriveKey: sc.DeriveKey,
-					DHPath:    sc.DHPath,
-					AAD:       sc.AAD,
-				}
-				s, err := file.NewFileSink(config)
-				if err != nil {
-					c.UI.Error(fmt.Errorf("error creating file sink: %w", err).Error())
-					return 1
-				}
-				config.Sink = s
-				sinks = append(sinks, config)
+				newSink = file.NewFileSink
+			case "kubernetes_secret":
+				newSink = kubernetes.NewSecretSink
+			case "unix_socket":
+				newSink = socket.NewSocketSink
+			case "exec":
+				newSink = execsink.NewExecSink
 			default:
 				c.UI.Error(fmt.Sprintf("Unknown sink type %q", sc.Type))
 				return 1
 			}
-		}
 
-		authConfig := &auth.AuthConfig{
-			Logger:    c.logger.Named(fmt.Sprintf("auth.%s", config.AutoAuth.Method.Type)),
-			MountPath: config.AutoAuth.Method.MountPath,
-			Config:    config.AutoAuth.Method.Config,
+			config := &sink.SinkConfig{
+				Logger:    c.logger.Named("sink." + sc.Type),
+				Config:    sc.Config,
+				Client:    sinkClient,
+				WrapTTL:   sc.WrapTTL,
+				DHType:    sc.DHType,
+				DeriveKey: sc.DeriveKey,
+				DHPath:    sc.DHPath,
+				AAD:       sc.AAD,
+			}
+			s, err := newSink(config)
+			if err != nil {
+				c.UI.Error(fmt.Errorf("error creating %s sink: %w", sc.Type, err).Error())
+				return 1
+			}
+			config.Sink = s
+			sinks = append(sinks, config)
 		}
-		method, err = agentproxyshared.GetAutoAuthMethodFromConfig(config.AutoAuth.Method.Type, authConfig, config.Vault.Address)
-		if err != nil {
-			c.UI.Error(fmt.Sprintf("Error creating %s auth method: %v", config.AutoAuth.Method.Type, err))
-			return 1
+
+		if len(config.AutoAuth.MethodChain) > 0 {
+			var chained []*auth.ChainedMethod
+			for _, mc := range config.AutoAuth.MethodChain {
+				authConfig := &auth.AuthConfig{
+					Logger:    c.logger.Named(fmt.Sprintf("auth.%s", mc.Type)),
+					MountPath: mc.MountPath,
+					Config:    mc.Config,
+				}
+				m, err := agentproxyshared.GetAutoAuthMethodFromConfig(mc.Type, authConfig, config.Vault.Address)
+				if err != nil {
+					for _, cm := range chained {
+						cm.Method.Shutdown()
+					}
+					c.UI.Error(fmt.Sprintf("Error creating %s auth method: %v", mc.Type, err))
+					return 1
+				}
+				chained = append(chained, &auth.ChainedMethod{
+					Name:       mc.Type,
+					MountPath:  mc.MountPath,
+					Method:     m,
+					MinBackoff: mc.MinBackoff,
+					MaxBackoff: mc.MaxBackoff,
+				})
+			}
+			method, err = auth.NewMethodChain(&auth.MethodChainConfig{
+				Logger:           c.logger.Named("auth.method_chain"),
+				Methods:          chained,
+				MetricsSignifier: "agent",
+			})
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error creating auth method chain: %v", err))
+				return 1
+			}
+		} else {
+			authConfig := &auth.AuthConfig{
+				Logger:    c.logger.Named(fmt.Sprintf("auth.%s", config.AutoAuth.Method.Type)),
+				MountPath: config.AutoAuth.Method.MountPath,
+				Config:    config.AutoAuth.Method.Config,
+			}
+			method, err = agentproxyshared.GetAutoAuthMethodFromConfig(config.AutoAuth.Method.Type, authConfig, config.Vault.Address)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error creating %s auth method: %v", config.AutoAuth.Method.Type, err))
+				return 1
+			}
 		}
 	}
 
@@ -499,6 +579,13 @@ func (c *AgentCommand) Run(args []string) int {
 		proxyClient.SetDisableKeepAlives(true)
 	}
 
+	if upstreams != nil {
+		if err := upstreams.Register(proxyClient); err != nil {
+			c.UI.Error(fmt.Sprintf("Error configuring client for proxying: %v", err))
+			return 1
+		}
+	}
+
 	apiProxyLogger := c.logger.Named("apiproxy")
 
 	// The API proxy to be used, if listeners are configured
@@ -509,6 +596,7 @@ func (c *AgentCommand) Run(args []string) int {
 		WhenInconsistentAction:  whenInconsistent,
 		UserAgentStringFunction: useragent.AgentProxyStringWithProxiedUserAgent,
 		UserAgentString:         useragent.AgentProxyString(),
+		Upstreams:               upstreams,
 	})
 	if err != nil {
 		c.UI.Error(fmt.Sprintf("Error creating API proxy: %v", err))
@@ -558,10 +646,12 @@ func (c *AgentCommand) Run(args []string) int {
 	var ts *template.Server
 	var es *exec.Server
 	var ps *pkiexternalca.Server
+	var ws *workload.Server
 	if method != nil {
 		enableTemplateTokenCh := len(config.Templates) > 0
 		enableEnvTemplateTokenCh := len(config.EnvTemplates) > 0
 		enablePKIExternalCATokenCh := len(config.PKIExternalCAs) > 0
+		enableWorkloadAPITokenCh := config.WorkloadAPI != nil
 
 		// Auth Handler is going to set its own retry values, so we want to
 		// work on a copy of the client to not affect other subsystems.
@@ -584,6 +674,13 @@ func (c *AgentCommand) Run(args []string) int {
 			ahClient.SetDisableKeepAlives(true)
 		}
 
+		if upstreams != nil {
+			if err := upstreams.Register(ahClient); err != nil {
+				c.UI.Error(fmt.Sprintf("Error configuring client for auth handler: %v", err))
+				return 1
+			}
+		}
+
 		ah = auth.NewAuthHandler(&auth.AuthHandlerConfig{
 			Logger:                       c.logger.Named("auth.handler"),
 			Client:                       ahClient,
@@ -594,6 +691,7 @@ func (c *AgentCommand) Run(args []string) int {
 			EnableTemplateTokenCh:        enableTemplateTokenCh,
 			EnableExecTokenCh:            enableEnvTemplateTokenCh,
 			EnablePKIExternalCATokenCh:   enablePKIExternalCATokenCh,
+			EnableWorkloadAPITokenCh:     enableWorkloadAPITokenCh,
 			Token:                        previousToken,
 			ExitOnError:                  config.AutoAuth.Method.ExitOnError,
 			UserAgent:                    useragent.AgentAutoAuthString(),
@@ -643,6 +741,20 @@ func (c *AgentCommand) Run(args []string) int {
 			c.logger.Error("could not create exec server", "error", err)
 			return 1
 		}
+
+		if config.WorkloadAPI != nil {
+			ws, err = workload.NewServer(&workload.ServerConfig{
+				Logger:      c.logger.Named("workload_api.server"),
+				AgentConfig: c.config,
+				Client:      c.client,
+				LogLevel:    c.logger.GetLevel(),
+				LogWriter:   c.logWriter,
+			})
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error creating workload API server: %v", err))
+				return 1
+			}
+		}
 	}
 
 	var listeners []net.Listener
@@ -820,6 +932,16 @@ func (c *AgentCommand) Run(args []string) int {
 		}
 	}, func(error) {})
 
+	// Start health checks of the Vault servers
+	if upstreams != nil {
+		g.Add(func() error {
+			upstreams.Run(ctx)
+			return nil
+		}, func(error) {
+			cancelFunc()
+		})
+	}
+
 	// Start auto-auth and sink servers
 	if method != nil {
 
@@ -934,6 +1056,15 @@ func (c *AgentCommand) Run(args []string) int {
 			})
 		}
 
+		if ws != nil {
+			g.Add(func() error {
+				return ws.Run(ctx, ah.WorkloadAPITokenCh)
+			}, func(error) {
+				cancelFunc()
+				ws.Stop()
+			})
+		}
+
 	}
 
 	// Server configuration output
@@ -1013,6 +1144,11 @@ func (c *AgentCommand) applyConfigOverrides(f *FlagSets, config *agentConfig.Con
 		Normalizers: []func(string) string{configutil.NormalizeAddr},
 	})
 	config.Vault.Address = c.flagAddress
+	// An address set by flag or environment variable replaces the addresses
+	// in the config
+	if len(config.Vault.Addresses) > 0 && config.Vault.Addresses[0] != config.Vault.Address {
+		config.Vault.Addresses = nil
+	}
 	c.setStringFlag(f, config.Vault.CACert, &StringVar{
 		Name:    flagNameCACert,
 		Target:  &c.flagCACert,
@@ -72,6 +72,8 @@ type Config struct {
 	Exec *ExecConfig `hcl:"exec,optional"`
 	// EnvTemplates lists env_template stanzas rendered into the exec environment.
 	EnvTemplates []*ctconfig.TemplateConfig `hcl:"env_template,optional"`
+	// WorkloadAPI configures the gRPC workload API served to local processes.
+	WorkloadAPI *WorkloadAPI `hcl:"-"`
 }
 
 const (
@@ -102,6 +104,15 @@ type Retry struct {
 	NumRetries int `hcl:"num_retries"`
 }
 
+// HealthCheck contains configuration for the active health checks of the
+// Vault servers in Vault.Addresses
+type HealthCheck struct {
+	Interval    time.Duration `hcl:"-"`
+	IntervalRaw interface{}   `hcl:"interval"`
+	Timeout     time.Duration `hcl:"-"`
+	TimeoutRaw  interface{}   `hcl:"timeout"`
+}
+
 // Vault contains configuration for connecting to Vault servers
 type Vault struct {
 	Address          string      `hcl:"address"`
@@ -114,6 +125,12 @@ type Vault struct {
 	TLSServerName    string      `hcl:"tls_server_name"`
 	Namespace        string      `hcl:"namespace"`
 	Retry            *Retry      `hcl:"retry"`
+
+	// Addresses are the addresses of Vault servers in order of preference,
+	// which requests fail over between based on their health. Address is
+	// set to the first address.
+	Addresses   []string     `hcl:"addresses"`
+	HealthCheck *HealthCheck `hcl:"health_check"`
 }
 
 // transportDialer is an interface that allows passing a custom dialer function
@@ -149,7 +166,10 @@ type Cache struct {
 // AutoAuth is the configured authentication method and sinks
 type AutoAuth struct {
 	Method *Method `hcl:"-"`
-	Sinks  []*Sink `hcl:"sinks"`
+	// MethodChain is the ordered list of methods to authenticate with when a
+	// method_chain block is configured. Method is set to its first entry.
+	MethodChain []*Method `hcl:"-"`
+	Sinks       []*Sink   `hcl:"sinks"`
 
 	EnableReauthOnNewCredentials bool `hcl:"enable_reauth_on_new_credentials"`
 }
@@ -326,6 +346,11 @@ func (c *Config) Merge(c2 *Config) *Config {
 		result.PKIExternalCAs = append(result.PKIExternalCAs, pkiExternalCA)
 	}
 
+	result.WorkloadAPI = c.WorkloadAPI
+	if c2.WorkloadAPI != nil {
+		result.WorkloadAPI = c2.WorkloadAPI
+	}
+
 	return result
 }
 
@@ -387,8 +412,9 @@ func (c *Config) ValidateConfig(logger hclog.Logger) error {
 		if len(c.AutoAuth.Sinks) == 0 &&
 			(c.APIProxy == nil || !c.APIProxy.UseAutoAuthToken) &&
 			len(c.Templates) == 0 &&
-			len(c.EnvTemplates) == 0 {
-			return fmt.Errorf("auto_auth requires at least one sink or at least one template or api_proxy.use_auto_auth_token=true")
+			len(c.EnvTemplates) == 0 &&
+			c.WorkloadAPI == nil {
+			return fmt.Errorf("auto_auth requires at least one sink or at least one template or api_proxy.use_auto_auth_token=true or workload_api")
 		}
 	}
 
@@ -400,6 +426,10 @@ func (c *Config) ValidateConfig(logger hclog.Logger) error {
 		return err
 	}
 
+	if err := c.validateWorkloadAPIConfig(); err != nil {
+		return err
+	}
+
 	return c.validateEnvTemplateConfig()
 }
 
@@ -723,6 +753,10 @@ func LoadConfigFileCheckDuplicates(path string) (cfg *Config, duplicate bool, er
 		return nil, duplicate, fmt.Errorf("error parsing 'pki_external_ca': %w", err)
 	}
 
+	if err := parseWorkloadAPI(result, list); err != nil {
+		return nil, duplicate, fmt.Errorf("error parsing 'workload_api': %w", err)
+	}
+
 	if result.Cache != nil && result.APIProxy == nil && (result.Cache.UseAutoAuthToken || result.Cache.ForceAutoAuthToken) {
 		result.APIProxy = &APIProxy{
 			UseAutoAuthToken:   result.Cache.UseAutoAuthToken,
@@ -819,6 +853,19 @@ func parseVault(result *Config, list *ast.ObjectList) error {
 		v.Address = configutil.NormalizeAddr(v.Address)
 	}
 
+	if len(v.Addresses) > 0 {
+		if v.Address != "" {
+			return fmt.Errorf("only one of 'address' and 'addresses' may be set")
+		}
+		for i, address := range v.Addresses {
+			if address == "" {
+				return fmt.Errorf("'addresses' must not contain empty addresses")
+			}
+			v.Addresses[i] = configutil.NormalizeAddr(address)
+		}
+		v.Address = v.Addresses[0]
+	}
+
 	if v.TLSSkipVerifyRaw != nil {
 		v.TLSSkipVerify, err = parseutil.ParseBool(v.TLSSkipVerifyRaw)
 		if err != nil {
@@ -837,6 +884,10 @@ func parseVault(result *Config, list *ast.ObjectList) error {
 		return fmt.Errorf("error parsing 'retry': %w", err)
 	}
 
+	if err := parseHealthCheck(result, subs.List); err != nil {
+		return fmt.Errorf("error parsing 'health_check': %w", err)
+	}
+
 	return nil
 }
 
@@ -865,6 +916,45 @@ func parseRetry(result *Config, list *ast.ObjectList) error {
 	return nil
 }
 
+func parseHealthCheck(result *Config, list *ast.ObjectList) error {
+	name := "health_check"
+
+	healthCheckList := list.Filter(name)
+	if len(healthCheckList.Items) == 0 {
+		return nil
+	}
+
+	if len(healthCheckList.Items) > 1 {
+		return fmt.Errorf("one and only one %q block is required", name)
+	}
+
+	item := healthCheckList.Items[0]
+
+	var h HealthCheck
+	err := hcl.DecodeObject(&h, item.Val)
+	if err != nil {
+		return err
+	}
+
+	if h.IntervalRaw != nil {
+		if h.Interval, err = parseutil.ParseDurationSecond(h.IntervalRaw); err != nil {
+			return err
+		}
+		h.IntervalRaw = nil
+	}
+
+	if h.TimeoutRaw != nil {
+		if h.Timeout, err = parseutil.ParseDurationSecond(h.TimeoutRaw); err != nil {
+			return err
+		}
+		h.TimeoutRaw = nil
+	}
+
+	result.Vault.HealthCheck = &h
+
+	return nil
+}
+
 func parseAPIProxy(result *Config, list *ast.ObjectList) error {
 	name := "api_proxy"
 
@@ -1023,7 +1113,14 @@ func parseAutoAuth(result *Config, list *ast.ObjectList) error {
 	}
 	subList := subs.List
 
-	if err := parseMethod(result, subList); err != nil {
+	if len(subList.Filter("method_chain").Items) > 0 {
+		if len(subList.Filter("method").Items) > 0 {
+			return fmt.Errorf("only one of 'method' and 'method_chain' can be specified")
+		}
+		if err := parseMethodChain(result, subList); err != nil {
+			return fmt.Errorf("error parsing 'method_chain': %w", err)
+		}
+	} else if err := parseMethod(result, subList); err != nil {
 		return fmt.Errorf("error parsing 'method': %w", err)
 	}
 	if a.Method == nil {
@@ -1044,22 +1141,6 @@ func parseAutoAuth(result *Config, list *ast.ObjectList) error {
 		}
 	}
 
-	if result.AutoAuth.Method.MaxBackoffRaw != nil {
-		var err error
-		if result.AutoAuth.Method.MaxBackoff, err = parseutil.ParseDurationSecond(result.AutoAuth.Method.MaxBackoffRaw); err != nil {
-			return err
-		}
-		result.AutoAuth.Method.MaxBackoffRaw = nil
-	}
-
-	if result.AutoAuth.Method.MinBackoffRaw != nil {
-		var err error
-		if result.AutoAuth.Method.MinBackoff, err = parseutil.ParseDurationSecond(result.AutoAuth.Method.MinBackoffRaw); err != nil {
-			return err
-		}
-		result.AutoAuth.Method.MinBackoffRaw = nil
-	}
-
 	return nil
 }
 
@@ -1071,12 +1152,61 @@ func parseMethod(result *Config, list *ast.ObjectList) error {
 		return fmt.Errorf("one and only one %q block is required", name)
 	}
 
-	// Get our item
-	item := methodList.Items[0]
+	m, err := decodeMethod(methodList.Items[0])
+	if err != nil {
+		return err
+	}
+
+	result.AutoAuth.Method = m
+	return nil
+}
+
+// parseMethodChain parses a method_chain block, whose method blocks are tried
+// in order when authenticating.
+func parseMethodChain(result *Config, list *ast.ObjectList) error {
+	name := "method_chain"
+
+	chainList := list.Filter(name)
+	if len(chainList.Items) != 1 {
+		return fmt.Errorf("at most one %q block is allowed", name)
+	}
+
+	chain, ok := chainList.Items[0].Val.(*ast.ObjectType)
+	if !ok {
+		return fmt.Errorf("could not parse %q as an object", name)
+	}
+
+	methodList := chain.List.Filter("method")
+	if len(methodList.Items) == 0 {
+		return errors.New("at least one 'method' block is required")
+	}
 
+	var methods []*Method
+	for i, item := range methodList.Items {
+		m, err := decodeMethod(item)
+		if err != nil {
+			return err
+		}
+
+		// The token from any method in the chain is handled the same way, so
+		// these are taken from the first method only.
+		if i > 0 && (m.WrapTTL > 0 || m.Namespace != "" || m.ExitOnError) {
+			return fmt.Errorf("wrap_ttl, namespace and exit_on_err can only be set on the first method in the chain, but are set on %q", m.Type)
+		}
+
+		methods = append(methods, m)
+	}
+
+	result.AutoAuth.Method = methods[0]
+	result.AutoAuth.MethodChain = methods
+	return nil
+}
+
+// decodeMethod decodes a method block.
+func decodeMethod(item *ast.ObjectItem) (*Method, error) {
 	var m Method
 	if err := hcl.DecodeObject(&m, item.Val); err != nil {
-		return err
+		return nil, err
 	}
 
 	if m.Type == "" {
@@ -1084,7 +1214,7 @@ func parseMethod(result *Config, list *ast.ObjectList) error {
 			m.Type = strings.ToLower(item.Keys[0].Token.Value().(string))
 		}
 		if m.Type == "" {
-			return errors.New("method type must be specified")
+			return nil, errors.New("method type must be specified")
 		}
 	}
 
@@ -1098,11 +1228,27 @@ func parseMethod(result *Config, list *ast.ObjectList) error {
 	if m.WrapTTLRaw != nil {
 		var err error
 		if m.WrapTTL, err = parseutil.ParseDurationSecond(m.WrapTTLRaw); err != nil {
-			return err
+			return nil, err
 		}
 		m.WrapTTLRaw = nil
 	}
 
+	if m.MaxBackoffRaw != nil {
+		var err error
+		if m.MaxBackoff, err = parseutil.ParseDurationSecond(m.MaxBackoffRaw); err != nil {
+			return nil, err
+		}
+		m.MaxBackoffRaw = nil
+	}
+
+	if m.MinBackoffRaw != nil {
+		var err error
+		if m.MinBackoff, err = parseutil.ParseDurationSecond(m.MinBackoffRaw); err != nil {
+			return nil, err
+		}
+		m.MinBackoffRaw = nil
+	}
+
 	// Canonicalize namespace path if provided
 	m.Namespace = namespace.Canonicalize(m.Namespace)
 
@@ -1116,13 +1262,12 @@ func parseMethod(result *Config, list *ast.ObjectList) error {
 			}
 			m.Config[k], err = normalizeAutoAuthMethod(m.Type, k, vStr)
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
 	}
 
-	result.AutoAuth.Method = &m
-	return nil
+	return &m, nil
 }
 
 // autoAuthMethodKeys maps an auto-auth method type to its associated
@@ -687,6 +687,74 @@ func TestLoadConfigFile_Method_ExitOnErr(t *testing.T) {
 	}
 }
 
+func TestLoadConfigFile_MethodChain(t *testing.T) {
+	config, err := LoadConfigFile("./test-fixtures/config-method-chain.hcl")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	kubernetes := &Method{
+		Type:        "kubernetes",
+		MountPath:   "auth/kubernetes",
+		Namespace:   "my-namespace/",
+		ExitOnError: true,
+		MinBackoff:  1 * time.Second,
+		MaxBackoff:  10 * time.Second,
+		Config: map[string]interface{}{
+			"role": "foobar",
+		},
+	}
+	approle := &Method{
+		Type:      "approle",
+		MountPath: "auth/approle-fallback",
+		Config: map[string]interface{}{
+			"role_id_file_path":   "/tmp/role-id",
+			"secret_id_file_path": "/tmp/secret-id",
+		},
+	}
+
+	expected := &Config{
+		SharedConfig: &configutil.SharedConfig{
+			PidFile: "./pidfile",
+		},
+		AutoAuth: &AutoAuth{
+			Method:      kubernetes,
+			MethodChain: []*Method{kubernetes, approle},
+			Sinks: []*Sink{
+				{
+					Type: "file",
+					Config: map[string]interface{}{
+						"path": "/tmp/file-foo",
+					},
+				},
+			},
+		},
+		TemplateConfig: &TemplateConfig{
+			MaxConnectionsPerHost: DefaultTemplateConfigMaxConnsPerHost,
+		},
+	}
+
+	config.Prune()
+	if diff := deep.Equal(config, expected); diff != nil {
+		t.Fatal(diff)
+	}
+}
+
+func TestLoadConfigFile_Bad_MethodChain(t *testing.T) {
+	for _, fixture := range []string{
+		"bad-config-method-chain-and-method.hcl",
+		"bad-config-method-chain-namespace.hcl",
+		"bad-config-method-chain-empty.hcl",
+	} {
+		t.Run(fixture, func(t *testing.T) {
+			_, err := LoadConfigFile("./test-fixtures/" + fixture)
+			if err == nil {
+				t.Fatalf("LoadConfigFile should return an error for this config")
+			}
+		})
+	}
+}
+
 func TestLoadConfigFile_AgentCache_NoAutoAuth(t *testing.T) {
 	config, err := LoadConfigFile("./test-fixtures/config-cache-no-auto_auth.hcl")
 	if err != nil {
@@ -1544,6 +1612,37 @@ func TestLoadConfigFile_Vault_Retry_Empty(t *testing.T) {
 	}
 }
 
+func TestLoadConfigFile_Vault_Addresses(t *testing.T) {
+	config, err := LoadConfigFile("./test-fixtures/config-vault-addresses.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &Vault{
+		Address:   "http://127.0.0.1:1111",
+		Addresses: []string{"http://127.0.0.1:1111", "http://127.0.0.1:2222"},
+		HealthCheck: &HealthCheck{
+			Interval: 30 * time.Second,
+			Timeout:  2 * time.Second,
+		},
+		Retry: &Retry{
+			NumRetries: 12,
+		},
+	}
+
+	config.Prune()
+	if diff := deep.Equal(config.Vault, expected); diff != nil {
+		t.Fatal(diff)
+	}
+}
+
+func TestLoadConfigFile_Bad_Vault_AddressAndAddresses(t *testing.T) {
+	_, err := LoadConfigFile("./test-fixtures/bad-config-vault-address-and-addresses.hcl")
+	if err == nil {
+		t.Fatal("LoadConfigFile should return an error for this config")
+	}
+}
+
 func TestLoadConfigFile_EnforceConsistency(t *testing.T) {
 	config, err := LoadConfigFile("./test-fixtures/config-consistency.hcl")
 	if err != nil {
@@ -2559,3 +2658,56 @@ func parseHCLObjectListForPKITemplateRef(t *testing.T, src string) *ast.ObjectLi
 
 	return list
 }
+
+// TestLoadConfigFile_WorkloadAPI loads and validates a workload_api config
+func TestLoadConfigFile_WorkloadAPI(t *testing.T) {
+	cfg, err := LoadConfigFile("./test-fixtures/config-workload-api.hcl")
+	require.NoError(t, err)
+	require.NoError(t, cfg.ValidateConfig(hclog.NewNullLogger()))
+
+	workloadAPI := cfg.WorkloadAPI
+	require.NotNil(t, workloadAPI)
+	require.Equal(t, "/run/vault-agent/workload.sock", workloadAPI.Address)
+	require.Equal(t, "0666", workloadAPI.SocketMode)
+
+	require.Len(t, workloadAPI.Workloads, 2)
+	require.Equal(t, &Workload{
+		Name:           "web",
+		UIDs:           []int{1000},
+		Cgroups:        []string{"/system.slice/web.service"},
+		TokenPolicies:  []string{"web"},
+		TokenTTL:       time.Hour,
+		WrapTTL:        DefaultWorkloadWrapTTL,
+		Secrets:        []string{"db"},
+		RateLimit:      DefaultWorkloadRateLimit,
+		RateLimitBurst: DefaultWorkloadRateLimitBurst,
+	}, workloadAPI.Workloads[0])
+	require.Equal(t, &Workload{
+		Name:           "admin",
+		GIDs:           []int{0},
+		AllowToken:     true,
+		WrapTTL:        30 * time.Second,
+		RateLimit:      0.5,
+		RateLimitBurst: 1,
+	}, workloadAPI.Workloads[1])
+
+	require.Len(t, workloadAPI.Secrets, 1)
+	require.Equal(t, "db", workloadAPI.Secrets[0].Name)
+	require.Equal(t, `{{ with secret "database/creds/web" }}{{ .Data.password }}{{ end }}`, *workloadAPI.Secrets[0].Template.Contents)
+	require.True(t, *workloadAPI.Secrets[0].Template.ErrMissingKey)
+}
+
+// TestLoadConfigFile_Bad_WorkloadAPI ensures that ValidateConfig errors for
+// invalid workload_api configs
+func TestLoadConfigFile_Bad_WorkloadAPI(t *testing.T) {
+	for _, fixture := range []string{
+		"bad-config-workload-api-unknown-secret.hcl",
+		"bad-config-workload-api-no-selectors.hcl",
+	} {
+		t.Run(fixture, func(t *testing.T) {
+			cfg, err := LoadConfigFile("./test-fixtures/" + fixture)
+			require.NoError(t, err)
+			require.Error(t, cfg.ValidateConfig(hclog.NewNullLogger()))
+		})
+	}
+}
@@ -0,0 +1,27 @@
+# Copyright IBM Corp. 2016, 2025
+# SPDX-License-Identifier: BUSL-1.1
+
+pid_file = "./pidfile"
+
+auto_auth {
+	method "aws" {
+		config = {
+			role = "foobar"
+		}
+	}
+
+	method_chain {
+		method "approle" {
+			config = {
+				role_id_file_path = "/tmp/role-id"
+			}
+		}
+	}
+
+	sink {
+		type = "file"
+		config = {
+			path = "/tmp/file-foo"
+		}
+	}
+}
@@ -0,0 +1,28 @@
+# Copyright IBM Corp. 2016, 2025
+# SPDX-License-Identifier: BUSL-1.1
+
+pid_file = "./pidfile"
+
+auto_auth {
+	method_chain {
+		method "kubernetes" {
+			config = {
+				role = "foobar"
+			}
+		}
+
+		method "approle" {
+			namespace = "my-namespace/"
+			config = {
+				role_id_file_path = "/tmp/role-id"
+			}
+		}
+	}
+
+	sink {
+		type = "file"
+		config = {
+			path = "/tmp/file-foo"
+		}
+	}
+}
@@ -0,0 +1,9 @@
+# Copyright IBM Corp. 2016, 2025
+# SPDX-License-Identifier: BUSL-1.1
+
+pid_file = "./pidfile"
+
+vault {
+  address   = "http://127.0.0.1:1111"
+  addresses = ["http://127.0.0.1:2222"]
+}
@@ -0,0 +1,21 @@
+# Copyright IBM Corp. 2016, 2025
+# SPDX-License-Identifier: BUSL-1.1
+
+pid_file = "./pidfile"
+
+auto_auth {
+	method "approle" {
+		config = {
+			role_id_file_path = "/tmp/role-id"
+		}
+	}
+}
+
+workload_api {
+	address = "/run/vault-agent/workload.sock"
+
+	workload "web" {
+		uids = [1000]
+		secrets = ["db"]
+	}
+}
@@ -0,0 +1,33 @@
+# Copyright IBM Corp. 2016, 2025
+# SPDX-License-Identifier: BUSL-1.1
+
+pid_file = "./pidfile"
+
+auto_auth {
+	method_chain {
+		method "kubernetes" {
+			namespace = "my-namespace/"
+			exit_on_err = true
+			min_backoff = "1s"
+			max_backoff = "10s"
+			config = {
+				role = "foobar"
+			}
+		}
+
+		method "approle" {
+			mount_path = "auth/approle-fallback/"
+			config = {
+				role_id_file_path = "/tmp/role-id"
+				secret_id_file_path = "/tmp/secret-id"
+			}
+		}
+	}
+
+	sink {
+		type = "file"
+		config = {
+			path = "/tmp/file-foo"
+		}
+	}
+}
@@ -0,0 +1,39 @@
+# Copyright IBM Corp. 2016, 2025
+# SPDX-License-Identifier: BUSL-1.1
+
+pid_file = "./pidfile"
+
+auto_auth {
+	method "approle" {
+		config = {
+			role_id_file_path = "/tmp/role-id"
+			secret_id_file_path = "/tmp/secret-id"
+		}
+	}
+}
+
+workload_api {
+	address = "/run/vault-agent/workload.sock"
+	socket_mode = "0666"
+
+	workload "web" {
+		uids = [1000]
+		cgroups = ["/system.slice/web.service"]
+		token_policies = ["web"]
+		token_ttl = "1h"
+		secrets = ["db"]
+	}
+
+	workload "admin" {
+		gids = [0]
+		allow_token = true
+		wrap_ttl = "30s"
+		rate_limit = 0.5
+		rate_limit_burst = 1
+	}
+
+	secret "db" {
+		contents = "{{ with secret \"database/creds/web\" }}{{ .Data.password }}{{ end }}"
+		error_on_missing_key = true
+	}
+}
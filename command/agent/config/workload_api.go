@@ -0,0 +1,279 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	ctconfig "github.com/hashicorp/consul-template/config"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
+	"github.com/mitchellh/mapstructure"
+)
+
+// DefaultWorkloadWrapTTL is the default TTL of the wrapping tokens returned
+// by the workload API.
+const DefaultWorkloadWrapTTL = 60 * time.Second
+
+const (
+	// DefaultWorkloadRateLimit is the default number of requests per second
+	// a workload may make to the workload API.
+	DefaultWorkloadRateLimit = 10
+	// DefaultWorkloadRateLimitBurst is the default number of requests a
+	// workload may make at once.
+	DefaultWorkloadRateLimitBurst = 20
+)
+
+// WorkloadAPI is the configuration for the gRPC workload API, which hands the
+// auto-auth token, child tokens and rendered secrets to local processes over a
+// Unix socket.
+type WorkloadAPI struct {
+	// Address is the path of the Unix socket to listen on.
+	Address     string `hcl:"address"`
+	SocketMode  string `hcl:"socket_mode"`
+	SocketUser  string `hcl:"socket_user"`
+	SocketGroup string `hcl:"socket_group"`
+
+	// Workloads are the processes that may use the API, in the order they
+	// are matched against a caller.
+	Workloads []*Workload `hcl:"-"`
+	// Secrets are the templates that workloads may fetch rendered.
+	Secrets []*WorkloadSecret `hcl:"-"`
+}
+
+// Workload identifies a group of local processes by the credentials of their
+// connection, and what they may fetch from the workload API. A caller matches
+// a workload if it matches all of the workload's selectors.
+type Workload struct {
+	Name string `hcl:"-"`
+
+	// UIDs and GIDs are the user and group IDs a caller may run as. Only
+	// the primary group ID of a caller is matched, not its supplementary
+	// groups.
+	UIDs []int `hcl:"uids"`
+	GIDs []int `hcl:"gids"`
+	// Cgroups are the cgroups a caller may be in, including their
+	// descendants. Only supported on Linux.
+	Cgroups []string `hcl:"cgroups"`
+
+	// AllowToken allows the workload to fetch the auto-auth token itself.
+	AllowToken bool `hcl:"allow_token"`
+
+	// TokenPolicies are the policies of the child tokens the workload may
+	// fetch. If empty, the workload can't fetch child tokens.
+	TokenPolicies []string      `hcl:"token_policies"`
+	TokenTTLRaw   interface{}   `hcl:"token_ttl"`
+	TokenTTL      time.Duration `hcl:"-"`
+	WrapTTLRaw    interface{}   `hcl:"wrap_ttl"`
+	WrapTTL       time.Duration `hcl:"-"`
+
+	// Secrets are the names of the secrets the workload may fetch.
+	Secrets []string `hcl:"secrets"`
+
+	// RateLimit is the number of requests per second the workload may make,
+	// in bursts of up to RateLimitBurst requests. Requests over the limit
+	// are rejected.
+	RateLimit      float64 `hcl:"rate_limit"`
+	RateLimitBurst int     `hcl:"rate_limit_burst"`
+}
+
+// WorkloadSecret is a template that is rendered on request for a workload,
+// and never written to disk.
+type WorkloadSecret struct {
+	Name     string
+	Template *ctconfig.TemplateConfig
+}
+
+func parseWorkloadAPI(result *Config, list *ast.ObjectList) error {
+	name := "workload_api"
+
+	workloadAPIList := list.Filter(name)
+	if len(workloadAPIList.Items) == 0 {
+		return nil
+	}
+	if len(workloadAPIList.Items) > 1 {
+		return fmt.Errorf("at most one %q block is allowed", name)
+	}
+
+	item := workloadAPIList.Items[0]
+
+	var w WorkloadAPI
+	if err := hcl.DecodeObject(&w, item.Val); err != nil {
+		return err
+	}
+	if w.Address == "" {
+		return errors.New("'address' must be specified")
+	}
+
+	subs, ok := item.Val.(*ast.ObjectType)
+	if !ok {
+		return fmt.Errorf("could not parse %q as an object", name)
+	}
+
+	for _, workloadItem := range subs.List.Filter("workload").Items {
+		workload, err := parseWorkload(workloadItem)
+		if err != nil {
+			return fmt.Errorf("error parsing 'workload': %w", err)
+		}
+		w.Workloads = append(w.Workloads, workload)
+	}
+	if len(w.Workloads) == 0 {
+		return errors.New("at least one 'workload' block is required")
+	}
+
+	for _, secretItem := range subs.List.Filter("secret").Items {
+		secret, err := parseWorkloadSecret(secretItem)
+		if err != nil {
+			return fmt.Errorf("error parsing 'secret': %w", err)
+		}
+		w.Secrets = append(w.Secrets, secret)
+	}
+
+	result.WorkloadAPI = &w
+	return nil
+}
+
+func parseWorkload(item *ast.ObjectItem) (*Workload, error) {
+	if len(item.Keys) != 1 {
+		return nil, errors.New("workload name must be specified")
+	}
+
+	var w Workload
+	if err := hcl.DecodeObject(&w, item.Val); err != nil {
+		return nil, err
+	}
+	w.Name = strings.Trim(item.Keys[0].Token.Text, `"`)
+
+	if w.TokenTTLRaw != nil {
+		var err error
+		if w.TokenTTL, err = parseutil.ParseDurationSecond(w.TokenTTLRaw); err != nil {
+			return nil, err
+		}
+		w.TokenTTLRaw = nil
+	}
+
+	if w.RateLimit == 0 {
+		w.RateLimit = DefaultWorkloadRateLimit
+	}
+	if w.RateLimitBurst == 0 {
+		w.RateLimitBurst = DefaultWorkloadRateLimitBurst
+	}
+
+	w.WrapTTL = DefaultWorkloadWrapTTL
+	if w.WrapTTLRaw != nil {
+		var err error
+		if w.WrapTTL, err = parseutil.ParseDurationSecond(w.WrapTTLRaw); err != nil {
+			return nil, err
+		}
+		w.WrapTTLRaw = nil
+	}
+
+	return &w, nil
+}
+
+func parseWorkloadSecret(item *ast.ObjectItem) (*WorkloadSecret, error) {
+	if len(item.Keys) != 1 {
+		return nil, errors.New("secret name must be specified")
+	}
+
+	var shadow interface{}
+	if err := hcl.DecodeObject(&shadow, item.Val); err != nil {
+		return nil, fmt.Errorf("error decoding config: %s", err)
+	}
+
+	parsed, ok := shadow.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("error converting config")
+	}
+
+	var templateConfig ctconfig.TemplateConfig
+	var md mapstructure.Metadata
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToSliceHookFunc(","),
+			mapstructure.StringToTimeDurationHookFunc(),
+		),
+		ErrorUnused: true,
+		Metadata:    &md,
+		Result:      &templateConfig,
+	})
+	if err != nil {
+		return nil, errors.New("mapstructure decoder creation failed")
+	}
+	if err := decoder.Decode(parsed); err != nil {
+		return nil, err
+	}
+
+	return &WorkloadSecret{
+		Name:     strings.Trim(item.Keys[0].Token.Text, `"`),
+		Template: &templateConfig,
+	}, nil
+}
+
+func (c *Config) validateWorkloadAPIConfig() error {
+	if c.WorkloadAPI == nil {
+		return nil
+	}
+
+	if c.AutoAuth == nil {
+		return errors.New("workload_api requires auto_auth to be configured")
+	}
+	if c.AutoAuth.Method != nil && c.AutoAuth.Method.WrapTTL > 0 {
+		return errors.New("workload_api cannot be used when auto_auth uses wrapping")
+	}
+
+	secrets := make(map[string]struct{}, len(c.WorkloadAPI.Secrets))
+	for _, secret := range c.WorkloadAPI.Secrets {
+		if _, exists := secrets[secret.Name]; exists {
+			return fmt.Errorf("workload_api: duplicate secret name: %q", secret.Name)
+		}
+		secrets[secret.Name] = struct{}{}
+
+		tmpl := secret.Template
+		if tmpl.Contents == nil && tmpl.Source == nil {
+			return fmt.Errorf("workload_api: secret %q: must specify either 'source' or 'contents'", secret.Name)
+		}
+		if tmpl.Contents != nil && tmpl.Source != nil {
+			return fmt.Errorf("workload_api: secret %q: 'source' and 'contents' cannot be specified together", secret.Name)
+		}
+		// Secrets are only ever returned to workloads
+		if tmpl.Destination != nil || tmpl.Command != nil || tmpl.Exec != nil {
+			return fmt.Errorf("workload_api: secret %q: 'destination', 'command' and 'exec' are not supported", secret.Name)
+		}
+	}
+
+	workloads := make(map[string]struct{}, len(c.WorkloadAPI.Workloads))
+	for _, w := range c.WorkloadAPI.Workloads {
+		if _, exists := workloads[w.Name]; exists {
+			return fmt.Errorf("workload_api: duplicate workload name: %q", w.Name)
+		}
+		workloads[w.Name] = struct{}{}
+
+		if len(w.UIDs) == 0 && len(w.GIDs) == 0 && len(w.Cgroups) == 0 {
+			return fmt.Errorf("workload_api: workload %q: at least one of 'uids', 'gids' or 'cgroups' must be specified", w.Name)
+		}
+		for _, cgroup := range w.Cgroups {
+			if !strings.HasPrefix(cgroup, "/") {
+				return fmt.Errorf("workload_api: workload %q: cgroup %q must be an absolute path", w.Name, cgroup)
+			}
+		}
+		if w.TokenTTL < 0 || w.WrapTTL <= 0 {
+			return fmt.Errorf("workload_api: workload %q: 'token_ttl' cannot be negative and 'wrap_ttl' must be positive", w.Name)
+		}
+		if w.RateLimit < 0 || w.RateLimitBurst < 0 {
+			return fmt.Errorf("workload_api: workload %q: 'rate_limit' and 'rate_limit_burst' cannot be negative", w.Name)
+		}
+		for _, name := range w.Secrets {
+			if _, ok := secrets[name]; !ok {
+				return fmt.Errorf("workload_api: workload %q: unknown secret %q", w.Name, name)
+			}
+		}
+	}
+
+	return nil
+}
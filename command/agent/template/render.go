@@ -0,0 +1,545 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package template
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul-template/manager"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/command/agent/config"
+	"github.com/hashicorp/vault/command/agent/internal/ctmanager"
+	"github.com/hashicorp/vault/internalshared/listenerutil"
+	"github.com/hashicorp/vault/sdk/helper/pointerutil"
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// DefaultRenderTimeout is the default time to wait for all templates to
+// render once.
+const DefaultRenderTimeout = 30 * time.Second
+
+// RenderConfig is the configuration for rendering the agent's templates once,
+// outside of a running agent.
+type RenderConfig struct {
+	Logger      hclog.Logger
+	AgentConfig *config.Config
+
+	// Client is used to read secrets for the templates, in place of the
+	// agent's auto-auth token.
+	Client *api.Client
+
+	// DryRun renders the templates without writing them to their
+	// destinations or running their commands.
+	DryRun bool
+
+	// RevokeLeases revokes the leases and tokens of the secrets read while
+	// rendering once rendering completes, for when nothing uses them, such
+	// as in a dry run. Writes to Vault, such as issuing certificates, can't
+	// be undone.
+	RevokeLeases bool
+
+	// Timeout is how long to wait for all templates to render.
+	Timeout time.Duration
+
+	LogLevel  hclog.Level
+	LogWriter io.Writer
+}
+
+// RenderResult is the result of rendering a single template destination.
+type RenderResult struct {
+	// Source is the template's source file, or empty if the template's
+	// contents are inline.
+	Source      string
+	Destination string
+
+	// Rendered is whether all of the template's dependencies were available
+	// and the template rendered.
+	Rendered bool
+	Contents []byte
+
+	// Existing is the contents of the destination before rendering, or nil
+	// if it didn't exist.
+	Existing []byte
+
+	Dependencies        []*RenderDependency
+	MissingDependencies []string
+}
+
+// RenderDependency is a dependency of a template and the lease of the secret
+// it returned, if it was read from Vault.
+type RenderDependency struct {
+	// Name is the consul-template name of the dependency, such as
+	// vault.read(secret/foo).
+	Name string
+
+	// Path is the Vault API path the dependency was read from, or empty if
+	// it wasn't read from Vault.
+	Path          string
+	LeaseID       string
+	LeaseDuration time.Duration
+	Renewable     bool
+
+	// MinRerender and MaxRerender are the bounds of when the secret will be
+	// fetched again and the template re-rendered, relative to the time it
+	// was read. The agent picks a random time in between so that many
+	// agents don't hit Vault at once.
+	MinRerender time.Duration
+	MaxRerender time.Duration
+
+	// Write is whether the dependency writes to Vault, such as
+	// vault.write(...) or vault.pki(...), which a dry run can't undo.
+	Write bool
+
+	// Revoked is whether the lease or token of the secret was revoked after
+	// rendering.
+	Revoked bool
+}
+
+// Changed returns whether rendering the template changes its destination.
+func (r *RenderResult) Changed() bool {
+	return r.Rendered && (r.Existing == nil || !bytes.Equal(r.Existing, r.Contents))
+}
+
+// Diff returns a unified diff between the destination before rendering and
+// the rendered template.
+func (r *RenderResult) Diff() (string, error) {
+	from := "/dev/null"
+	if r.Existing != nil {
+		from = r.Destination
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(r.Existing)),
+		B:        difflib.SplitLines(string(r.Contents)),
+		FromFile: from,
+		ToFile:   r.Destination,
+		Context:  3,
+	})
+}
+
+// Render renders each of the agent's templates once against the Vault server
+// of the given client, and returns the result for each destination along
+// with the secrets it depends on. The requests made by the templates are
+// proxied in process, so that the leases of the secrets they read can be
+// reported, and revoked if nothing uses them.
+//
+// If not all templates render before the timeout, the results are returned
+// along with an error.
+func Render(ctx context.Context, conf *RenderConfig) ([]*RenderResult, error) {
+	if conf.AgentConfig == nil {
+		return nil, errors.New("nil agent configuration provided")
+	}
+	if conf.Client == nil {
+		return nil, errors.New("nil client provided")
+	}
+	if len(conf.AgentConfig.Templates) == 0 {
+		return nil, errors.New("no templates configured")
+	}
+	logger := conf.Logger
+	if logger == nil {
+		logger = hclog.NewNullLogger()
+	}
+	timeout := conf.Timeout
+	if timeout <= 0 {
+		timeout = DefaultRenderTimeout
+	}
+	logWriter := conf.LogWriter
+	if logWriter == nil {
+		logWriter = io.Discard
+	}
+
+	// Read the destinations before the runner overwrites them
+	existing := make(map[string][]byte)
+	for _, tmpl := range conf.AgentConfig.Templates {
+		if tmpl.Destination == nil || *tmpl.Destination == "" {
+			continue
+		}
+		contents, err := os.ReadFile(*tmpl.Destination)
+		switch {
+		case err == nil:
+			existing[*tmpl.Destination] = contents
+		case !errors.Is(err, os.ErrNotExist):
+			return nil, fmt.Errorf("error reading template destination: %w", err)
+		}
+	}
+
+	recorder := &secretRecorder{
+		client:  conf.Client,
+		logger:  logger,
+		secrets: make(map[string]*api.Secret),
+		revoked: make(map[string]bool),
+	}
+	listener := bufconn.Listen(1024 * 1024)
+	srv := &http.Server{
+		Handler:           recorder,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go srv.Serve(listener)
+	defer srv.Close()
+
+	// Route the runner's requests through the recorder, the same way they're
+	// routed through the agent's in-process listener when it's caching
+	agentConfig := *conf.AgentConfig
+	agentConfig.Cache = &config.Cache{
+		InProcDialer: listenerutil.NewBufConnWrapper(listener),
+	}
+	runnerConfig, err := ctmanager.NewConfig(ctmanager.ManagerConfig{
+		AgentConfig: &agentConfig,
+		LogLevel:    conf.LogLevel,
+		LogWriter:   logWriter,
+	}, agentConfig.Templates)
+	if err != nil {
+		return nil, fmt.Errorf("error creating runner config: %w", err)
+	}
+	runnerConfig.Once = true
+	runnerConfig.Vault.Token = pointerutil.StringPtr(conf.Client.Token())
+
+	runner, err := manager.NewRunner(runnerConfig, conf.DryRun)
+	if err != nil {
+		return nil, fmt.Errorf("error creating runner: %w", err)
+	}
+	// In dry-run mode the runner writes the rendered templates to its output
+	// stream; they're returned in the results instead
+	runner.SetOutStream(io.Discard)
+
+	logger.Debug("rendering templates", "templates", len(agentConfig.Templates), "dry_run", conf.DryRun)
+	go runner.Start()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var renderErr error
+	select {
+	case <-runner.DoneCh:
+	case err := <-runner.ErrCh:
+		renderErr = err
+	case <-ctx.Done():
+		renderErr = fmt.Errorf("timed out waiting for templates to render after %s", timeout)
+	}
+	runner.StopImmediately()
+
+	// The render timeout may have expired, so revocation gets its own
+	var revokeErr error
+	if conf.RevokeLeases {
+		revokeCtx, revokeCancel := context.WithTimeout(context.Background(), timeout)
+		revokeErr = recorder.revoke(revokeCtx)
+		revokeCancel()
+	}
+
+	defaultLease := *runnerConfig.Vault.DefaultLeaseDuration
+	threshold := *runnerConfig.Vault.LeaseRenewalThreshold
+
+	var results []*RenderResult
+	for _, event := range runner.RenderEvents() {
+		var deps []*RenderDependency
+		if event.UsedDeps != nil {
+			for _, d := range event.UsedDeps.List() {
+				deps = append(deps, recorder.dependency(d.String(), defaultLease, threshold))
+			}
+		}
+		sort.Slice(deps, func(i, j int) bool { return deps[i].Name < deps[j].Name })
+
+		var missing []string
+		if event.MissingDeps != nil {
+			for _, d := range event.MissingDeps.List() {
+				missing = append(missing, d.String())
+			}
+		}
+		sort.Strings(missing)
+
+		for _, tmpl := range event.TemplateConfigs {
+			result := &RenderResult{
+				Rendered:            event.WouldRender,
+				Contents:            event.Contents,
+				Dependencies:        deps,
+				MissingDependencies: missing,
+			}
+			if tmpl.Source != nil {
+				result.Source = *tmpl.Source
+			}
+			if tmpl.Destination != nil {
+				result.Destination = *tmpl.Destination
+				result.Existing = existing[result.Destination]
+			}
+			results = append(results, result)
+		}
+	}
+
+	// Templates the runner never got to aren't in the render events
+	seen := make(map[string]bool, len(results))
+	for _, result := range results {
+		seen[result.Destination] = true
+	}
+	for _, tmpl := range agentConfig.Templates {
+		if tmpl.Destination == nil || seen[*tmpl.Destination] {
+			continue
+		}
+		result := &RenderResult{
+			Destination: *tmpl.Destination,
+			Existing:    existing[*tmpl.Destination],
+		}
+		if tmpl.Source != nil {
+			result.Source = *tmpl.Source
+		}
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Destination < results[j].Destination })
+
+	if renderErr == nil {
+		for _, result := range results {
+			if !result.Rendered {
+				renderErr = errors.New("not all templates rendered")
+				break
+			}
+		}
+	}
+	if revokeErr != nil {
+		renderErr = multierror.Append(renderErr, revokeErr)
+	}
+
+	return results, renderErr
+}
+
+// secretRecorder proxies requests to Vault using its client and records the
+// secrets returned, keyed by their path.
+type secretRecorder struct {
+	client *api.Client
+	logger hclog.Logger
+
+	l       sync.Mutex
+	secrets map[string]*api.Secret
+	revoked map[string]bool
+}
+
+func (s *secretRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+
+	req := s.client.NewRequest(r.Method, r.URL.Path)
+	req.Params = r.URL.Query()
+	req.Body = r.Body
+	if token := r.Header.Get(api.AuthHeaderName); token != "" {
+		req.ClientToken = token
+	}
+	if namespace := r.Header.Get(api.NamespaceHeaderName); namespace != "" {
+		req.Headers.Set(api.NamespaceHeaderName, namespace)
+	}
+
+	//nolint:staticcheck // the request is proxied as-is
+	resp, err := s.client.RawRequestWithContext(r.Context(), req)
+	if resp == nil {
+		s.logger.Debug("error proxying template request", "path", path, "error", err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if secret, err := api.ParseSecret(bytes.NewReader(body)); err == nil && secret != nil {
+			s.l.Lock()
+			s.secrets[path] = secret
+			s.l.Unlock()
+		}
+	}
+
+	for k, v := range resp.Header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(resp.StatusCode)
+	w.Write(body)
+}
+
+// dependency returns the dependency with the given consul-template name, and
+// the lease of the secret it read, if any.
+func (s *secretRecorder) dependency(name string, defaultLease time.Duration, threshold float64) *RenderDependency {
+	dep := &RenderDependency{
+		Name:  name,
+		Write: strings.HasPrefix(name, "vault.write(") || strings.HasPrefix(name, "vault.pki("),
+	}
+
+	path := dependencyPath(name)
+	if path == "" {
+		return dep
+	}
+
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	var secret *api.Secret
+	for p, candidate := range s.secrets {
+		if p == path || kvV2Path(p) == path {
+			dep.Path, secret = p, candidate
+			break
+		}
+	}
+	if secret == nil {
+		return dep
+	}
+
+	dep.LeaseID = secret.LeaseID
+	dep.Revoked = s.revoked[dep.Path]
+	dep.LeaseDuration = time.Duration(secret.LeaseDuration) * time.Second
+	dep.Renewable = secret.Renewable
+	if secret.Auth != nil {
+		dep.LeaseDuration = time.Duration(secret.Auth.LeaseDuration) * time.Second
+		dep.Renewable = secret.Auth.Renewable
+	}
+	dep.MinRerender, dep.MaxRerender = rerenderSchedule(secret, defaultLease, threshold)
+
+	return dep
+}
+
+// revoke revokes the leases of the secrets that were read, and the tokens
+// that were created, by revoking each token with itself.
+func (s *secretRecorder) revoke(ctx context.Context) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	var errs *multierror.Error
+	for path, secret := range s.secrets {
+		var err error
+		switch {
+		case secret.Auth != nil && secret.Auth.ClientToken != "":
+			var client *api.Client
+			client, err = s.client.Clone()
+			if err == nil {
+				client.SetToken(secret.Auth.ClientToken)
+				err = client.Auth().Token().RevokeSelfWithContext(ctx, "")
+			}
+		case secret.LeaseID != "":
+			err = s.client.Sys().RevokeWithContext(ctx, secret.LeaseID)
+		default:
+			continue
+		}
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error revoking the lease of %s: %w", path, err))
+			continue
+		}
+
+		s.logger.Debug("revoked lease read during dry run", "path", path)
+		s.revoked[path] = true
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// dependencyPath returns the Vault path of a consul-template dependency name,
+// such as vault.read(secret/foo), or empty if the dependency isn't read from
+// a Vault path.
+func dependencyPath(name string) string {
+	var path string
+	for _, prefix := range []string{"vault.read(", "vault.list(", "vault.write(", "vault.pki("} {
+		if strings.HasPrefix(name, prefix) && strings.HasSuffix(name, ")") {
+			path = strings.TrimSuffix(strings.TrimPrefix(name, prefix), ")")
+			break
+		}
+	}
+
+	// Writes and PKI certificates include the data hash or file they're
+	// written to
+	if i := strings.Index(path, "->"); i >= 0 {
+		path = strings.TrimSpace(path[:i])
+	}
+	// Versioned KV reads are suffixed with the version
+	if i := strings.LastIndex(path, ".v"); i >= 0 && strings.Trim(path[i+2:], "0123456789") == "" && i+2 < len(path) {
+		path = path[:i]
+	}
+
+	return strings.Trim(path, "/")
+}
+
+// kvV2Path returns the path a KV v2 secret is referred to by in a template,
+// by removing the data segment from the API path, or empty if the path has no
+// data segment.
+func kvV2Path(path string) string {
+	segments := strings.Split(path, "/")
+	for i := 1; i < len(segments)-1; i++ {
+		if segments[i] == "data" {
+			return strings.Join(append(segments[:i:i], segments[i+1:]...), "/")
+		}
+	}
+	return ""
+}
+
+// rerenderSchedule returns the bounds of when consul-template will fetch the
+// given secret again, relative to when it was read. It follows the same rules
+// as consul-template: renewable secrets are renewed at between 1/6 and 1/3 of
+// their lease, rotating secrets are fetched once their TTL expires, and other
+// secrets are fetched at the lease renewal threshold, plus or minus 5% of the
+// lease. Secrets without a lease, such as KV secrets, use the default lease
+// duration.
+func rerenderSchedule(secret *api.Secret, defaultLease time.Duration, threshold float64) (time.Duration, time.Duration) {
+	base := time.Duration(secret.LeaseDuration) * time.Second
+	renewable := secret.Renewable
+	if secret.Auth != nil {
+		renewable = secret.Auth.Renewable
+		if secret.Auth.LeaseDuration > 0 {
+			base = time.Duration(secret.Auth.LeaseDuration) * time.Second
+		}
+	}
+
+	dataInt := func(key string) (int64, bool) {
+		n, ok := secret.Data[key].(json.Number)
+		if !ok {
+			return 0, false
+		}
+		i, err := n.Int64()
+		return i, err == nil
+	}
+
+	var rotating bool
+	if secret.LeaseID == "" {
+		if _, ok := secret.Data["certificate"]; ok {
+			if expiration, ok := dataInt("expiration"); ok {
+				base = time.Until(time.Unix(expiration, 0)).Truncate(time.Second)
+			}
+		}
+		if _, ok := secret.Data["secret_id"]; ok {
+			if ttl, ok := dataInt("secret_id_ttl"); ok && ttl > 0 {
+				base = time.Duration(ttl+1) * time.Second
+			}
+		}
+		if _, ok := secret.Data["rotation_period"]; ok {
+			if ttl, ok := dataInt("ttl"); ok {
+				base = time.Duration(ttl+1) * time.Second
+				rotating = true
+			}
+		}
+	}
+
+	if base <= 0 {
+		base = defaultLease
+	}
+
+	switch {
+	case renewable:
+		return base / 6, base / 3
+	case rotating:
+		return base, base
+	default:
+		minFraction, maxFraction := threshold-0.05, threshold+0.05
+		if minFraction <= 0 || maxFraction >= 1 {
+			minFraction, maxFraction = threshold, threshold
+		}
+		return time.Duration(float64(base) * minFraction), time.Duration(float64(base) * maxFraction)
+	}
+}
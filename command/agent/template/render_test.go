@@ -0,0 +1,241 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package template
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	ctconfig "github.com/hashicorp/consul-template/config"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/command/agent/config"
+	"github.com/hashicorp/vault/sdk/helper/pointerutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRender tests that templates are rendered once with their dependencies,
+// and that nothing is written in dry-run mode
+func TestRender(t *testing.T) {
+	ts := createHttpTestServer()
+	defer ts.Close()
+
+	client, err := api.NewClient(&api.Config{Address: ts.URL})
+	require.NoError(t, err)
+	client.SetToken("test")
+
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "existing.json")
+	created := filepath.Join(dir, "created.json")
+	require.NoError(t, os.WriteFile(existing, []byte("old contents\n"), 0o600))
+
+	agentConfig := &config.Config{
+		Vault: &config.Vault{Address: ts.URL},
+		Templates: []*ctconfig.TemplateConfig{
+			{
+				Contents:    pointerutil.StringPtr(templateContents),
+				Destination: pointerutil.StringPtr(existing),
+			},
+			{
+				Contents:    pointerutil.StringPtr(`{{ with secret "kv/myapp/config" }}{{ .Data.data.username }}{{ end }}`),
+				Destination: pointerutil.StringPtr(created),
+			},
+		},
+	}
+
+	results, err := Render(context.Background(), &RenderConfig{
+		AgentConfig: agentConfig,
+		Client:      client,
+		DryRun:      true,
+		Timeout:     10 * time.Second,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	// Results are sorted by destination
+	require.Equal(t, created, results[0].Destination)
+	require.True(t, results[0].Rendered)
+	require.True(t, results[0].Changed())
+	require.Nil(t, results[0].Existing)
+	require.Equal(t, "appuser", string(results[0].Contents))
+
+	require.Equal(t, existing, results[1].Destination)
+	require.True(t, results[1].Changed())
+	require.Equal(t, "old contents\n", string(results[1].Existing))
+	diff, err := results[1].Diff()
+	require.NoError(t, err)
+	require.Contains(t, diff, "-old contents")
+	require.Contains(t, diff, `+"username":"appuser",`)
+
+	require.Len(t, results[1].Dependencies, 1)
+	dep := results[1].Dependencies[0]
+	require.Equal(t, "vault.read(kv/myapp/config)", dep.Name)
+	require.Equal(t, "kv/myapp/config", dep.Path)
+	require.False(t, dep.Renewable)
+	// Static secrets are fetched again at 85-95% of the default lease duration
+	require.Equal(t, time.Duration(float64(ctconfig.DefaultVaultLeaseDuration)*0.85), dep.MinRerender)
+	require.Equal(t, time.Duration(float64(ctconfig.DefaultVaultLeaseDuration)*0.95), dep.MaxRerender)
+
+	// Nothing is written in dry-run mode
+	contents, err := os.ReadFile(existing)
+	require.NoError(t, err)
+	require.Equal(t, "old contents\n", string(contents))
+	_, err = os.Stat(created)
+	require.True(t, os.IsNotExist(err))
+}
+
+// TestRender_RevokeLeases tests that the leases of secrets read while
+// rendering are revoked when requested, and that writes are reported
+func TestRender_RevokeLeases(t *testing.T) {
+	var l sync.Mutex
+	var revoked []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/database/creds/app", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"lease_id":"database/creds/app/abcd","lease_duration":3600,"renewable":true,"data":{"username":"v-app"}}`)
+	})
+	mux.HandleFunc("/v1/pki/issue/app", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"data":{"certificate":"cert"}}`)
+	})
+	mux.HandleFunc("/v1/sys/leases/revoke", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			LeaseID string `json:"lease_id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		l.Lock()
+		revoked = append(revoked, body.LeaseID)
+		l.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	client, err := api.NewClient(&api.Config{Address: ts.URL})
+	require.NoError(t, err)
+	client.SetToken("test")
+
+	agentConfig := &config.Config{
+		Vault: &config.Vault{Address: ts.URL},
+		Templates: []*ctconfig.TemplateConfig{
+			{
+				Contents:    pointerutil.StringPtr(`{{ with secret "database/creds/app" }}{{ .Data.username }}{{ end }}`),
+				Destination: pointerutil.StringPtr(filepath.Join(t.TempDir(), "creds")),
+			},
+			{
+				Contents:    pointerutil.StringPtr(`{{ with secret "pki/issue/app" "common_name=app" }}{{ .Data.certificate }}{{ end }}`),
+				Destination: pointerutil.StringPtr(filepath.Join(t.TempDir(), "cert")),
+			},
+		},
+	}
+
+	results, err := Render(context.Background(), &RenderConfig{
+		AgentConfig:  agentConfig,
+		Client:       client,
+		DryRun:       true,
+		RevokeLeases: true,
+		Timeout:      10 * time.Second,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	l.Lock()
+	require.Equal(t, []string{"database/creds/app/abcd"}, revoked)
+	l.Unlock()
+
+	var creds, cert *RenderDependency
+	for _, result := range results {
+		require.Len(t, result.Dependencies, 1)
+		switch dep := result.Dependencies[0]; dep.Path {
+		case "database/creds/app":
+			creds = dep
+		case "pki/issue/app":
+			cert = dep
+		}
+	}
+	require.NotNil(t, creds)
+	require.True(t, creds.Revoked)
+	require.False(t, creds.Write)
+	require.NotNil(t, cert)
+	require.False(t, cert.Revoked)
+	require.True(t, cert.Write)
+}
+
+// TestRender_MissingDependency tests that templates whose secrets can't be
+// read are reported as not rendered
+func TestRender_MissingDependency(t *testing.T) {
+	ts := createHttpTestServer()
+	defer ts.Close()
+
+	client, err := api.NewClient(&api.Config{Address: ts.URL})
+	require.NoError(t, err)
+
+	agentConfig := &config.Config{
+		Vault:          &config.Vault{Address: ts.URL},
+		TemplateConfig: &config.TemplateConfig{ExitOnRetryFailure: true},
+		Templates: []*ctconfig.TemplateConfig{
+			{
+				Contents:    pointerutil.StringPtr(templateContentsPermDenied),
+				Destination: pointerutil.StringPtr(filepath.Join(t.TempDir(), "denied.json")),
+			},
+		},
+	}
+
+	results, err := Render(context.Background(), &RenderConfig{
+		AgentConfig: agentConfig,
+		Client:      client,
+		DryRun:      true,
+		Timeout:     time.Second,
+	})
+	require.Error(t, err)
+	require.Len(t, results, 1)
+	require.False(t, results[0].Rendered)
+	require.False(t, results[0].Changed())
+}
+
+func TestDependencyPath(t *testing.T) {
+	for name, expected := range map[string]string{
+		"vault.read(secret/foo)":            "secret/foo",
+		"vault.read(secret/foo.v2)":         "secret/foo",
+		"vault.read(secret/foo.vx)":         "secret/foo.vx",
+		"vault.list(secret/)":               "secret",
+		"vault.write(pki/issue/a -> 1a2b3)": "pki/issue/a",
+		"vault.pki(pki/issue/a->/tmp/cert)": "pki/issue/a",
+		"vault.token":                       "",
+		"file(/tmp/foo)":                    "",
+	} {
+		require.Equal(t, expected, dependencyPath(name), name)
+	}
+
+	require.Equal(t, "secret/foo", kvV2Path("secret/data/foo"))
+	require.Equal(t, "team/secret/foo/data", kvV2Path("team/secret/data/foo/data"))
+	require.Equal(t, "", kvV2Path("data/foo"))
+}
+
+func TestRerenderSchedule(t *testing.T) {
+	defaultLease := 5 * time.Minute
+
+	// Renewable leases are renewed at 1/6 to 1/3 of the lease
+	lo, hi := rerenderSchedule(&api.Secret{LeaseID: "a", LeaseDuration: 600, Renewable: true}, defaultLease, 0.9)
+	require.Equal(t, 100*time.Second, lo)
+	require.Equal(t, 200*time.Second, hi)
+
+	// Non-renewable leases are fetched around the renewal threshold
+	lo, hi = rerenderSchedule(&api.Secret{LeaseID: "a", LeaseDuration: 1000}, defaultLease, 0.9)
+	require.Equal(t, 850*time.Second, lo)
+	require.Equal(t, 950*time.Second, hi)
+
+	// Rotating secrets are fetched once their TTL expires
+	lo, hi = rerenderSchedule(&api.Secret{Data: map[string]interface{}{
+		"rotation_period": json.Number("3600"),
+		"ttl":             json.Number("59"),
+	}}, defaultLease, 0.9)
+	require.Equal(t, time.Minute, lo)
+	require.Equal(t, time.Minute, hi)
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package workload
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/vault/command/agent/config"
+	"google.golang.org/grpc/credentials"
+)
+
+// attestationAuthType is the auth type of the connections to the workload API.
+const attestationAuthType = "unix-peer"
+
+// PeerAttestation is what is known about the process on the other end of a
+// connection to the workload API, as attested by the kernel when it
+// connected.
+type PeerAttestation struct {
+	PID int
+	UID int
+	// GID is the primary group ID of the process. Its supplementary groups
+	// aren't known.
+	GID int
+	// Cgroups are the cgroup paths of the process. They're only known on
+	// Linux.
+	Cgroups []string
+}
+
+// AuthType implements credentials.AuthInfo.
+func (p *PeerAttestation) AuthType() string {
+	return attestationAuthType
+}
+
+// matches returns whether the peer matches all of the workload's selectors.
+func (p *PeerAttestation) matches(w *config.Workload) bool {
+	if len(w.UIDs) > 0 && !slices.Contains(w.UIDs, p.UID) {
+		return false
+	}
+	if len(w.GIDs) > 0 && !slices.Contains(w.GIDs, p.GID) {
+		return false
+	}
+	if len(w.Cgroups) > 0 && !slices.ContainsFunc(w.Cgroups, p.inCgroup) {
+		return false
+	}
+	return true
+}
+
+// inCgroup returns whether the peer is in the given cgroup or one of its
+// descendants.
+func (p *PeerAttestation) inCgroup(cgroup string) bool {
+	cgroup = strings.TrimSuffix(cgroup, "/")
+	for _, c := range p.Cgroups {
+		if c == cgroup || strings.HasPrefix(c, cgroup+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// attestingCredentials are server transport credentials for Unix socket
+// connections, which attest the connecting process rather than performing a
+// handshake. Clients connect with insecure credentials.
+type attestingCredentials struct{}
+
+var _ credentials.TransportCredentials = attestingCredentials{}
+
+func (attestingCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	attestation, err := attestPeer(conn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return conn, attestation, nil
+}
+
+func (attestingCredentials) ClientHandshake(context.Context, string, net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("attesting credentials are server-only")
+}
+
+func (attestingCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: attestationAuthType}
+}
+
+func (attestingCredentials) Clone() credentials.TransportCredentials {
+	return attestingCredentials{}
+}
+
+func (attestingCredentials) OverrideServerName(string) error {
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build darwin
+
+package workload
+
+import (
+	"errors"
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+// attestationSupported is whether peers can be attested on this platform.
+const attestationSupported = true
+
+// attestPeer returns the process ID and credentials of the process on the
+// other end of the given Unix socket connection. Cgroups aren't supported, so
+// workloads with cgroup selectors never match.
+func attestPeer(conn net.Conn) (*PeerAttestation, error) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return nil, errors.New("not a unix socket connection")
+	}
+	rawConn, err := unixConn.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var cred *unix.Xucred
+	var pid int
+	var credErr error
+	if err := rawConn.Control(func(fd uintptr) {
+		cred, credErr = unix.GetsockoptXucred(int(fd), unix.SOL_LOCAL, unix.LOCAL_PEERCRED)
+		if credErr == nil {
+			pid, credErr = unix.GetsockoptInt(int(fd), unix.SOL_LOCAL, unix.LOCAL_PEERPID)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	if credErr != nil {
+		return nil, credErr
+	}
+	if cred.Ngroups == 0 {
+		return nil, errors.New("peer credentials have no groups")
+	}
+
+	return &PeerAttestation{
+		PID: pid,
+		UID: int(cred.Uid),
+		GID: int(cred.Groups[0]),
+	}, nil
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux
+
+package workload
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// attestationSupported is whether peers can be attested on this platform.
+const attestationSupported = true
+
+// attestPeer returns the process ID, credentials and cgroups of the process on
+// the other end of the given Unix socket connection. The credentials are the
+// ones the process had when it connected.
+//
+// The cgroups are read from /proc by process ID, which is only safe while the
+// process is alive: once it exits, its ID can be reused by another process,
+// possibly in another cgroup. On kernels that support SO_PEERPIDFD, the
+// process is checked to still be alive after its cgroups are read through a
+// pidfd, which can't refer to another process. On older kernels, the start
+// time of the process is compared before and after instead.
+func attestPeer(conn net.Conn) (*PeerAttestation, error) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return nil, errors.New("not a unix socket connection")
+	}
+	rawConn, err := unixConn.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var cred *unix.Ucred
+	var credErr error
+	pidfd := -1
+	var pidfdErr error
+	if err := rawConn.Control(func(fd uintptr) {
+		cred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+		if credErr == nil {
+			pidfd, pidfdErr = unix.GetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_PEERPIDFD)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	if credErr != nil {
+		return nil, credErr
+	}
+	switch {
+	case pidfdErr == nil:
+		defer unix.Close(pidfd)
+	case errors.Is(pidfdErr, unix.ENOPROTOOPT):
+		pidfd = -1
+	default:
+		return nil, fmt.Errorf("error getting pidfd of peer: %w", pidfdErr)
+	}
+
+	var startTime string
+	if pidfd == -1 {
+		if startTime, err = readStartTime(fmt.Sprintf("/proc/%d/stat", cred.Pid)); err != nil {
+			return nil, fmt.Errorf("error reading start time of peer: %w", err)
+		}
+	}
+
+	cgroups, err := readCgroups(fmt.Sprintf("/proc/%d/cgroup", cred.Pid))
+	if err != nil {
+		return nil, fmt.Errorf("error reading cgroups of peer: %w", err)
+	}
+
+	if pidfd != -1 {
+		// Signal 0 only checks that the process exists
+		if err := unix.PidfdSendSignal(pidfd, 0, nil, 0); err != nil {
+			return nil, fmt.Errorf("peer exited during attestation: %w", err)
+		}
+	} else {
+		after, err := readStartTime(fmt.Sprintf("/proc/%d/stat", cred.Pid))
+		if err != nil {
+			return nil, fmt.Errorf("error reading start time of peer: %w", err)
+		}
+		if after != startTime {
+			return nil, errors.New("peer exited during attestation")
+		}
+	}
+
+	return &PeerAttestation{
+		PID:     int(cred.Pid),
+		UID:     int(cred.Uid),
+		GID:     int(cred.Gid),
+		Cgroups: cgroups,
+	}, nil
+}
+
+// readStartTime returns the start time of a process, in clock ticks since
+// boot, from the given /proc/<pid>/stat file. It's the 22nd field, counting
+// from the closing parenthesis of the command name, which may contain spaces.
+func readStartTime(path string) (string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	i := bytes.LastIndexByte(contents, ')')
+	if i == -1 {
+		return "", errors.New("malformed stat file")
+	}
+	// The fields after the command name start with the state, the 3rd field
+	fields := strings.Fields(string(contents[i+1:]))
+	if len(fields) < 20 {
+		return "", errors.New("malformed stat file")
+	}
+	return fields[19], nil
+}
+
+// readCgroups returns the cgroup paths listed in the given /proc/<pid>/cgroup
+// file, whose lines are of the form hierarchy-ID:controller-list:cgroup-path.
+func readCgroups(path string) ([]string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cgroups []string
+	for _, line := range strings.Split(strings.TrimSpace(string(contents)), "\n") {
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		cgroups = append(cgroups, fields[2])
+	}
+	return cgroups, nil
+}
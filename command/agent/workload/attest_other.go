@@ -0,0 +1,20 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux && !darwin
+
+package workload
+
+import (
+	"errors"
+	"net"
+)
+
+// attestationSupported is whether peers can be attested on this platform.
+const attestationSupported = false
+
+// attestPeer is not supported on this platform, so every connection is
+// rejected.
+func attestPeer(net.Conn) (*PeerAttestation, error) {
+	return nil, errors.New("peer attestation is not supported on this platform")
+}
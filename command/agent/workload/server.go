@@ -0,0 +1,389 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package workload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	ctconfig "github.com/hashicorp/consul-template/config"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/command/agent/config"
+	"github.com/hashicorp/vault/command/agent/template"
+	"github.com/hashicorp/vault/internalshared/listenerutil"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ServerConfig is the configuration for the workload API server.
+type ServerConfig struct {
+	Logger      hclog.Logger
+	AgentConfig *config.Config
+
+	// Client is used to create child tokens and render secrets, with the
+	// auto-auth token.
+	Client *api.Client
+
+	// LogLevel and LogWriter are used by the Consul Template runner that
+	// renders secrets.
+	LogLevel  hclog.Level
+	LogWriter io.Writer
+}
+
+// Server serves the workload API on a Unix socket. Each caller is attested by
+// the credentials of its connection and matched against the configured
+// workloads, which determine what it may fetch.
+type Server struct {
+	UnimplementedWorkloadServiceServer
+
+	logger      hclog.Logger
+	config      *ServerConfig
+	workloadAPI *config.WorkloadAPI
+	secrets     map[string]*config.WorkloadSecret
+	rendered    map[string]*renderedSecret
+	limiters    map[string]*rate.Limiter
+
+	listener   net.Listener
+	grpcServer *grpc.Server
+	stopOnce   sync.Once
+
+	l     sync.RWMutex
+	token string
+}
+
+// renderedSecret is the last rendering of a secret, which is returned to
+// workloads until the first of its leases is due to be renewed, or the
+// auto-auth token changes. Its lock is held while rendering, so concurrent
+// requests for the secret render it once.
+type renderedSecret struct {
+	l        sync.Mutex
+	token    string
+	contents []byte
+	expires  time.Time
+}
+
+// NewServer creates a workload API server and starts listening on its socket.
+// Requests are served once Run is called.
+func NewServer(conf *ServerConfig) (*Server, error) {
+	if conf == nil {
+		return nil, errors.New("nil configuration provided")
+	}
+	if conf.Logger == nil {
+		return nil, errors.New("nil logger provided")
+	}
+	if conf.AgentConfig == nil || conf.AgentConfig.WorkloadAPI == nil {
+		return nil, errors.New("no workload_api configuration provided")
+	}
+	if conf.Client == nil {
+		return nil, errors.New("nil client provided")
+	}
+	if !attestationSupported {
+		return nil, errors.New("the workload API is not supported on this platform")
+	}
+
+	workloadAPI := conf.AgentConfig.WorkloadAPI
+	s := &Server{
+		logger:      conf.Logger,
+		config:      conf,
+		workloadAPI: workloadAPI,
+		secrets:     make(map[string]*config.WorkloadSecret, len(workloadAPI.Secrets)),
+		rendered:    make(map[string]*renderedSecret, len(workloadAPI.Secrets)),
+		limiters:    make(map[string]*rate.Limiter, len(workloadAPI.Workloads)),
+	}
+	for _, secret := range workloadAPI.Secrets {
+		s.secrets[secret.Name] = secret
+		s.rendered[secret.Name] = &renderedSecret{}
+	}
+	for _, w := range workloadAPI.Workloads {
+		if w.RateLimit > 0 {
+			s.limiters[w.Name] = rate.NewLimiter(rate.Limit(w.RateLimit), max(w.RateLimitBurst, 1))
+		}
+	}
+
+	var socketConfig *listenerutil.UnixSocketsConfig
+	if workloadAPI.SocketMode != "" || workloadAPI.SocketUser != "" || workloadAPI.SocketGroup != "" {
+		socketConfig = &listenerutil.UnixSocketsConfig{
+			Mode:  workloadAPI.SocketMode,
+			User:  workloadAPI.SocketUser,
+			Group: workloadAPI.SocketGroup,
+		}
+	}
+	listener, err := listenerutil.UnixSocketListener(workloadAPI.Address, socketConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error listening on %s: %w", workloadAPI.Address, err)
+	}
+	s.listener = listener
+
+	s.grpcServer = grpc.NewServer(grpc.Creds(attestingCredentials{}))
+	RegisterWorkloadServiceServer(s.grpcServer, s)
+
+	return s, nil
+}
+
+// Run serves the workload API, and listens for changes to the auto-auth token
+// from the AuthHandler, until the context is done.
+func (s *Server) Run(ctx context.Context, incoming chan string) error {
+	if incoming == nil {
+		return errors.New("workload api server: incoming channel is nil")
+	}
+
+	s.logger.Info("starting workload api server", "address", s.workloadAPI.Address)
+	defer s.logger.Info("workload api server stopped")
+
+	serveErrCh := make(chan error, 1)
+	go func() {
+		serveErrCh <- s.grpcServer.Serve(s.listener)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.Stop()
+			return nil
+
+		case err := <-serveErrCh:
+			if err == nil || errors.Is(err, grpc.ErrServerStopped) {
+				return nil
+			}
+			return fmt.Errorf("workload api server: %w", err)
+
+		case token, ok := <-incoming:
+			if !ok {
+				// The auth handler has stopped; keep serving the last token
+				// until shutdown.
+				incoming = nil
+				continue
+			}
+			s.l.Lock()
+			s.token = token
+			s.l.Unlock()
+			s.logger.Debug("received new token")
+		}
+	}
+}
+
+// Stop stops serving the workload API and removes its socket.
+func (s *Server) Stop() {
+	s.stopOnce.Do(func() {
+		s.grpcServer.Stop()
+		s.listener.Close()
+	})
+}
+
+// FetchToken returns the auto-auth token to workloads that are allowed it.
+func (s *Server) FetchToken(ctx context.Context, _ *FetchTokenRequest) (*FetchTokenResponse, error) {
+	w, attestation, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !w.AllowToken {
+		return nil, status.Errorf(codes.PermissionDenied, "workload %q may not fetch the auto-auth token", w.Name)
+	}
+
+	token, err := s.currentToken()
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("auto-auth token fetched", "workload", w.Name, "pid", attestation.PID, "uid", attestation.UID)
+	return &FetchTokenResponse{Token: token}, nil
+}
+
+// FetchWrappedToken creates a child token of the auto-auth token with the
+// workload's policies, or the requested subset of them, and returns it
+// response-wrapped.
+func (s *Server) FetchWrappedToken(ctx context.Context, req *FetchWrappedTokenRequest) (*FetchWrappedTokenResponse, error) {
+	w, attestation, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(w.TokenPolicies) == 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "workload %q may not fetch tokens", w.Name)
+	}
+
+	policies := w.TokenPolicies
+	if len(req.GetPolicies()) > 0 {
+		for _, policy := range req.GetPolicies() {
+			if !slices.Contains(w.TokenPolicies, policy) {
+				return nil, status.Errorf(codes.PermissionDenied, "workload %q may not fetch tokens with policy %q", w.Name, policy)
+			}
+		}
+		policies = req.GetPolicies()
+	}
+
+	token, err := s.currentToken()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.config.Client.Clone()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating client: %v", err)
+	}
+	client.SetToken(token)
+	wrapTTL := w.WrapTTL.String()
+	client.SetWrappingLookupFunc(func(string, string) string {
+		return wrapTTL
+	})
+
+	createReq := &api.TokenCreateRequest{
+		Policies:    policies,
+		DisplayName: "workload-" + w.Name,
+		Metadata: map[string]string{
+			"workload":     w.Name,
+			"workload_pid": strconv.Itoa(attestation.PID),
+			"workload_uid": strconv.Itoa(attestation.UID),
+		},
+	}
+	if w.TokenTTL > 0 {
+		createReq.TTL = w.TokenTTL.String()
+	}
+
+	secret, err := client.Auth().Token().CreateWithContext(ctx, createReq)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error creating token: %v", err)
+	}
+	if secret == nil || secret.WrapInfo == nil || secret.WrapInfo.Token == "" {
+		return nil, status.Error(codes.Internal, "token creation did not return a wrapped token")
+	}
+
+	s.logger.Info("wrapped token fetched", "workload", w.Name, "pid", attestation.PID, "uid", attestation.UID,
+		"policies", policies, "wrapping_accessor", secret.WrapInfo.Accessor)
+	return &FetchWrappedTokenResponse{
+		WrappingToken:    secret.WrapInfo.Token,
+		WrappingAccessor: secret.WrapInfo.Accessor,
+		WrapTtlSeconds:   int64(secret.WrapInfo.TTL),
+		CreationTime:     timestamppb.New(secret.WrapInfo.CreationTime),
+	}, nil
+}
+
+// FetchSecret renders one of the workload's secrets with the auto-auth token
+// and returns it. Secrets are never written to disk. The rendered secret is
+// returned to later requests, from any workload allowed it, until the first of
+// its leases is due to be renewed; the leases aren't renewed or revoked, so
+// they remain valid for the rest of their TTL after it's returned. Secrets
+// that don't depend on a Vault lease are rendered on every request.
+func (s *Server) FetchSecret(ctx context.Context, req *FetchSecretRequest) (*FetchSecretResponse, error) {
+	w, attestation, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	secret, ok := s.secrets[req.GetName()]
+	if !ok || !slices.Contains(w.Secrets, req.GetName()) {
+		return nil, status.Errorf(codes.PermissionDenied, "workload %q may not fetch secret %q", w.Name, req.GetName())
+	}
+
+	token, err := s.currentToken()
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := s.rendered[secret.Name]
+	rendered.l.Lock()
+	defer rendered.l.Unlock()
+
+	if rendered.token == token && time.Now().Before(rendered.expires) {
+		s.logger.Info("secret fetched", "workload", w.Name, "pid", attestation.PID, "uid", attestation.UID,
+			"secret", secret.Name, "cached", true)
+		return &FetchSecretResponse{Contents: rendered.contents}, nil
+	}
+
+	client, err := s.config.Client.Clone()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating client: %v", err)
+	}
+	client.SetToken(token)
+
+	// Render a copy of the template, as the runner finalizes it in place
+	agentConfig := *s.config.AgentConfig
+	agentConfig.Templates = []*ctconfig.TemplateConfig{secret.Template.Copy()}
+
+	results, err := template.Render(ctx, &template.RenderConfig{
+		Logger:      s.logger.Named("render"),
+		AgentConfig: &agentConfig,
+		Client:      client,
+		DryRun:      true,
+		Timeout:     template.DefaultRenderTimeout,
+		LogLevel:    s.config.LogLevel,
+		LogWriter:   s.config.LogWriter,
+	})
+	if err != nil || len(results) != 1 || !results[0].Rendered {
+		if err == nil {
+			err = errors.New("secret did not render")
+		}
+		if len(results) == 1 && len(results[0].MissingDependencies) > 0 {
+			err = fmt.Errorf("%w: missing dependencies: %v", err, results[0].MissingDependencies)
+		}
+		s.logger.Warn("error rendering secret", "workload", w.Name, "secret", secret.Name, "error", err)
+		return nil, status.Errorf(codes.Unavailable, "error rendering secret %q: %v", secret.Name, err)
+	}
+
+	// The secrets are fetched again at the earliest rerender time of their
+	// dependencies, which is before any of their leases expire
+	var ttl time.Duration
+	for _, dep := range results[0].Dependencies {
+		if dep.MinRerender > 0 && (ttl == 0 || dep.MinRerender < ttl) {
+			ttl = dep.MinRerender
+		}
+	}
+	rendered.token = token
+	rendered.contents = results[0].Contents
+	rendered.expires = time.Now().Add(ttl)
+
+	s.logger.Info("secret fetched", "workload", w.Name, "pid", attestation.PID, "uid", attestation.UID,
+		"secret", secret.Name, "cached", false)
+	return &FetchSecretResponse{Contents: results[0].Contents}, nil
+}
+
+// authorize returns the first configured workload that the caller matches, if
+// the workload hasn't exceeded its rate limit.
+func (s *Server) authorize(ctx context.Context) (*config.Workload, *PeerAttestation, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, nil, status.Error(codes.Unauthenticated, "no peer information")
+	}
+	attestation, ok := p.AuthInfo.(*PeerAttestation)
+	if !ok {
+		return nil, nil, status.Error(codes.Unauthenticated, "peer was not attested")
+	}
+
+	for _, w := range s.workloadAPI.Workloads {
+		if !attestation.matches(w) {
+			continue
+		}
+		if limiter, ok := s.limiters[w.Name]; ok && !limiter.Allow() {
+			s.logger.Warn("rate limited request from workload", "workload", w.Name, "pid", attestation.PID, "uid", attestation.UID)
+			return nil, nil, status.Errorf(codes.ResourceExhausted, "workload %q exceeded its rate limit", w.Name)
+		}
+		return w, attestation, nil
+	}
+
+	s.logger.Warn("rejected request from unknown workload", "pid", attestation.PID, "uid", attestation.UID,
+		"gid", attestation.GID, "cgroups", attestation.Cgroups)
+	return nil, nil, status.Error(codes.PermissionDenied, "caller does not match any workload")
+}
+
+// currentToken returns the auto-auth token, or an error if auto-auth hasn't
+// authenticated yet.
+func (s *Server) currentToken() (string, error) {
+	s.l.RLock()
+	defer s.l.RUnlock()
+
+	if s.token == "" {
+		return "", status.Error(codes.Unavailable, "agent has not authenticated yet")
+	}
+	return s.token, nil
+}
@@ -0,0 +1,241 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package workload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ctconfig "github.com/hashicorp/consul-template/config"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/command/agent/config"
+	"github.com/hashicorp/vault/sdk/helper/logging"
+	"github.com/hashicorp/vault/sdk/helper/pointerutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// createVaultTestServer returns a server that mocks the Vault API used by the
+// workload API: reading a KV secret and creating wrapped child tokens. Reads
+// of the secret are counted in reads.
+func createVaultTestServer(t *testing.T, reads *atomic.Int64) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/secret/db", func(w http.ResponseWriter, r *http.Request) {
+		reads.Add(1)
+		if r.Header.Get(api.AuthHeaderName) != "auto-auth-token" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintln(w, `{"errors":["permission denied"]}`)
+			return
+		}
+		fmt.Fprintln(w, `{"data": {"password": "hunter2"}, "lease_duration": 2764800}`)
+	})
+	mux.HandleFunc("/v1/auth/token/create", func(w http.ResponseWriter, r *http.Request) {
+		var req api.TokenCreateRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "auto-auth-token", r.Header.Get(api.AuthHeaderName))
+		assert.Equal(t, "1m0s", r.Header.Get("X-Vault-Wrap-TTL"))
+		assert.Equal(t, "workload-web", req.DisplayName)
+		assert.Equal(t, "web", req.Metadata["workload"])
+		assert.Equal(t, []string{"web-read"}, req.Policies)
+
+		fmt.Fprintf(w, `{"wrap_info": {"token": "wrapping-token", "accessor": "wrapping-accessor", "ttl": 60, "creation_time": %q}}`,
+			time.Now().UTC().Format(time.RFC3339))
+	})
+
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// testWorkloadServer starts a workload API server with the given workloads,
+// and returns a client connected to it and the number of times the secret was
+// read from Vault.
+func testWorkloadServer(t *testing.T, workloads ...*config.Workload) (WorkloadServiceClient, *atomic.Int64) {
+	t.Helper()
+
+	if !attestationSupported {
+		t.Skip("peer attestation is not supported on this platform")
+	}
+
+	reads := new(atomic.Int64)
+	vaultServer := createVaultTestServer(t, reads)
+	client, err := api.NewClient(&api.Config{Address: vaultServer.URL})
+	require.NoError(t, err)
+
+	// Unix socket paths are limited in length, so use a short directory
+	dir, err := os.MkdirTemp("", "workload")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	socketPath := filepath.Join(dir, "workload.sock")
+
+	server, err := NewServer(&ServerConfig{
+		Logger: logging.NewVaultLogger(hclog.Trace).Named("workload_api.server"),
+		AgentConfig: &config.Config{
+			Vault: &config.Vault{Address: vaultServer.URL},
+			WorkloadAPI: &config.WorkloadAPI{
+				Address:   socketPath,
+				Workloads: workloads,
+				Secrets: []*config.WorkloadSecret{
+					{
+						Name: "db",
+						Template: &ctconfig.TemplateConfig{
+							Contents: pointerutil.StringPtr(`{{ with secret "secret/db" }}{{ .Data.password }}{{ end }}`),
+						},
+					},
+				},
+			},
+		},
+		Client: client,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tokenCh := make(chan string, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.Run(ctx, tokenCh)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-errCh)
+		_, err := os.Stat(socketPath)
+		require.True(t, os.IsNotExist(err))
+	})
+	tokenCh <- "auto-auth-token"
+
+	conn, err := grpc.NewClient("unix://"+socketPath, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	// Wait for the token to be received
+	workloadClient := NewWorkloadServiceClient(conn)
+	require.Eventually(t, func() bool {
+		server.l.RLock()
+		defer server.l.RUnlock()
+		return server.token != ""
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return workloadClient, reads
+}
+
+func TestServer_FetchToken(t *testing.T) {
+	client, _ := testWorkloadServer(t,
+		&config.Workload{Name: "other", UIDs: []int{os.Getuid() + 1}, AllowToken: true},
+		&config.Workload{Name: "web", UIDs: []int{os.Getuid()}, AllowToken: true},
+	)
+
+	resp, err := client.FetchToken(context.Background(), &FetchTokenRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "auto-auth-token", resp.GetToken())
+}
+
+func TestServer_FetchWrappedToken(t *testing.T) {
+	client, _ := testWorkloadServer(t, &config.Workload{
+		Name:          "web",
+		UIDs:          []int{os.Getuid()},
+		TokenPolicies: []string{"web-read", "web-write"},
+		WrapTTL:       time.Minute,
+	})
+
+	resp, err := client.FetchWrappedToken(context.Background(), &FetchWrappedTokenRequest{Policies: []string{"web-read"}})
+	require.NoError(t, err)
+	require.Equal(t, "wrapping-token", resp.GetWrappingToken())
+	require.Equal(t, "wrapping-accessor", resp.GetWrappingAccessor())
+	require.Equal(t, int64(60), resp.GetWrapTtlSeconds())
+
+	// Workloads may only restrict their policies
+	_, err = client.FetchWrappedToken(context.Background(), &FetchWrappedTokenRequest{Policies: []string{"root"}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// TestServer_FetchSecret tests that secrets are rendered for workloads, and
+// that the rendered secret is returned until its lease is due to be renewed.
+func TestServer_FetchSecret(t *testing.T) {
+	client, reads := testWorkloadServer(t, &config.Workload{
+		Name:    "web",
+		GIDs:    []int{os.Getgid()},
+		Secrets: []string{"db"},
+	})
+
+	for range 3 {
+		resp, err := client.FetchSecret(context.Background(), &FetchSecretRequest{Name: "db"})
+		require.NoError(t, err)
+		require.Equal(t, "hunter2", string(resp.GetContents()))
+	}
+	require.Equal(t, int64(1), reads.Load())
+
+	_, err := client.FetchSecret(context.Background(), &FetchSecretRequest{Name: "unknown"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// TestServer_Unauthorized tests that callers that don't match any workload,
+// or that match a workload without the requested permission, are rejected.
+func TestServer_Unauthorized(t *testing.T) {
+	client, _ := testWorkloadServer(t,
+		&config.Workload{Name: "other", UIDs: []int{os.Getuid() + 1}, AllowToken: true},
+		&config.Workload{Name: "web", Cgroups: []string{"/vault-workload-test"}, AllowToken: true},
+	)
+
+	_, err := client.FetchToken(context.Background(), &FetchTokenRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	client, _ = testWorkloadServer(t, &config.Workload{Name: "web", UIDs: []int{os.Getuid()}})
+
+	_, err = client.FetchToken(context.Background(), &FetchTokenRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.FetchWrappedToken(context.Background(), &FetchWrappedTokenRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.FetchSecret(context.Background(), &FetchSecretRequest{Name: "db"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// TestServer_RateLimit tests that workloads exceeding their rate limit are
+// rejected.
+func TestServer_RateLimit(t *testing.T) {
+	client, _ := testWorkloadServer(t, &config.Workload{
+		Name:           "web",
+		UIDs:           []int{os.Getuid()},
+		AllowToken:     true,
+		RateLimit:      0.01,
+		RateLimitBurst: 2,
+	})
+
+	for range 2 {
+		_, err := client.FetchToken(context.Background(), &FetchTokenRequest{})
+		require.NoError(t, err)
+	}
+	_, err := client.FetchToken(context.Background(), &FetchTokenRequest{})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestPeerAttestation_Matches(t *testing.T) {
+	attestation := &PeerAttestation{
+		PID:     1234,
+		UID:     1000,
+		GID:     1000,
+		Cgroups: []string{"/system.slice/web.service/worker"},
+	}
+
+	require.True(t, attestation.matches(&config.Workload{UIDs: []int{0, 1000}}))
+	require.True(t, attestation.matches(&config.Workload{UIDs: []int{1000}, Cgroups: []string{"/system.slice/web.service/"}}))
+	require.True(t, attestation.matches(&config.Workload{Cgroups: []string{"/system.slice/web.service/worker"}}))
+	require.False(t, attestation.matches(&config.Workload{UIDs: []int{1000}, GIDs: []int{0}}))
+	require.False(t, attestation.matches(&config.Workload{Cgroups: []string{"/system.slice/web"}}))
+	require.False(t, attestation.matches(&config.Workload{Cgroups: []string{"/system.slice/web.service/worker/child"}}))
+}
@@ -0,0 +1,428 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: command/agent/workload/workload.proto
+
+package workload
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FetchTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchTokenRequest) Reset() {
+	*x = FetchTokenRequest{}
+	mi := &file_command_agent_workload_workload_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchTokenRequest) ProtoMessage() {}
+
+func (x *FetchTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_agent_workload_workload_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchTokenRequest.ProtoReflect.Descriptor instead.
+func (*FetchTokenRequest) Descriptor() ([]byte, []int) {
+	return file_command_agent_workload_workload_proto_rawDescGZIP(), []int{0}
+}
+
+type FetchTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchTokenResponse) Reset() {
+	*x = FetchTokenResponse{}
+	mi := &file_command_agent_workload_workload_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchTokenResponse) ProtoMessage() {}
+
+func (x *FetchTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_agent_workload_workload_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchTokenResponse.ProtoReflect.Descriptor instead.
+func (*FetchTokenResponse) Descriptor() ([]byte, []int) {
+	return file_command_agent_workload_workload_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FetchTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type FetchWrappedTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// policies optionally restricts the child token to a subset of the
+	// workload's policies.
+	Policies      []string `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchWrappedTokenRequest) Reset() {
+	*x = FetchWrappedTokenRequest{}
+	mi := &file_command_agent_workload_workload_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchWrappedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchWrappedTokenRequest) ProtoMessage() {}
+
+func (x *FetchWrappedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_agent_workload_workload_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchWrappedTokenRequest.ProtoReflect.Descriptor instead.
+func (*FetchWrappedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_command_agent_workload_workload_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FetchWrappedTokenRequest) GetPolicies() []string {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type FetchWrappedTokenResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	WrappingToken    string                 `protobuf:"bytes,1,opt,name=wrapping_token,json=wrappingToken,proto3" json:"wrapping_token,omitempty"`
+	WrappingAccessor string                 `protobuf:"bytes,2,opt,name=wrapping_accessor,json=wrappingAccessor,proto3" json:"wrapping_accessor,omitempty"`
+	// wrap_ttl_seconds is how long the wrapping token can be unwrapped for.
+	WrapTtlSeconds int64                  `protobuf:"varint,3,opt,name=wrap_ttl_seconds,json=wrapTtlSeconds,proto3" json:"wrap_ttl_seconds,omitempty"`
+	CreationTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FetchWrappedTokenResponse) Reset() {
+	*x = FetchWrappedTokenResponse{}
+	mi := &file_command_agent_workload_workload_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchWrappedTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchWrappedTokenResponse) ProtoMessage() {}
+
+func (x *FetchWrappedTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_agent_workload_workload_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchWrappedTokenResponse.ProtoReflect.Descriptor instead.
+func (*FetchWrappedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_command_agent_workload_workload_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FetchWrappedTokenResponse) GetWrappingToken() string {
+	if x != nil {
+		return x.WrappingToken
+	}
+	return ""
+}
+
+func (x *FetchWrappedTokenResponse) GetWrappingAccessor() string {
+	if x != nil {
+		return x.WrappingAccessor
+	}
+	return ""
+}
+
+func (x *FetchWrappedTokenResponse) GetWrapTtlSeconds() int64 {
+	if x != nil {
+		return x.WrapTtlSeconds
+	}
+	return 0
+}
+
+func (x *FetchWrappedTokenResponse) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTime
+	}
+	return nil
+}
+
+type FetchSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchSecretRequest) Reset() {
+	*x = FetchSecretRequest{}
+	mi := &file_command_agent_workload_workload_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchSecretRequest) ProtoMessage() {}
+
+func (x *FetchSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_command_agent_workload_workload_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchSecretRequest.ProtoReflect.Descriptor instead.
+func (*FetchSecretRequest) Descriptor() ([]byte, []int) {
+	return file_command_agent_workload_workload_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FetchSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FetchSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contents      []byte                 `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchSecretResponse) Reset() {
+	*x = FetchSecretResponse{}
+	mi := &file_command_agent_workload_workload_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchSecretResponse) ProtoMessage() {}
+
+func (x *FetchSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_command_agent_workload_workload_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchSecretResponse.ProtoReflect.Descriptor instead.
+func (*FetchSecretResponse) Descriptor() ([]byte, []int) {
+	return file_command_agent_workload_workload_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FetchSecretResponse) GetContents() []byte {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+var File_command_agent_workload_workload_proto protoreflect.FileDescriptor
+
+var file_command_agent_workload_workload_proto_rawDesc = string([]byte{
+	0x0a, 0x25, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f,
+	0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61,
+	0x64, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x13, 0x0a, 0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x36, 0x0a, 0x18, 0x46, 0x65, 0x74, 0x63, 0x68, 0x57, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x19,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x77, 0x72, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x2b, 0x0a, 0x11, 0x77, 0x72, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x28, 0x0a,
+	0x10, 0x77, 0x72, 0x61, 0x70, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x77, 0x72, 0x61, 0x70, 0x54, 0x74, 0x6c,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x31, 0x0a, 0x13, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x73, 0x32, 0x84, 0x02, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
+	0x61, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x57, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x57, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4a, 0x0a, 0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x33, 0x5a, 0x31,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61,
+	0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_command_agent_workload_workload_proto_rawDescOnce sync.Once
+	file_command_agent_workload_workload_proto_rawDescData []byte
+)
+
+func file_command_agent_workload_workload_proto_rawDescGZIP() []byte {
+	file_command_agent_workload_workload_proto_rawDescOnce.Do(func() {
+		file_command_agent_workload_workload_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_command_agent_workload_workload_proto_rawDesc), len(file_command_agent_workload_workload_proto_rawDesc)))
+	})
+	return file_command_agent_workload_workload_proto_rawDescData
+}
+
+var file_command_agent_workload_workload_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_command_agent_workload_workload_proto_goTypes = []any{
+	(*FetchTokenRequest)(nil),         // 0: workload.FetchTokenRequest
+	(*FetchTokenResponse)(nil),        // 1: workload.FetchTokenResponse
+	(*FetchWrappedTokenRequest)(nil),  // 2: workload.FetchWrappedTokenRequest
+	(*FetchWrappedTokenResponse)(nil), // 3: workload.FetchWrappedTokenResponse
+	(*FetchSecretRequest)(nil),        // 4: workload.FetchSecretRequest
+	(*FetchSecretResponse)(nil),       // 5: workload.FetchSecretResponse
+	(*timestamppb.Timestamp)(nil),     // 6: google.protobuf.Timestamp
+}
+var file_command_agent_workload_workload_proto_depIdxs = []int32{
+	6, // 0: workload.FetchWrappedTokenResponse.creation_time:type_name -> google.protobuf.Timestamp
+	0, // 1: workload.WorkloadService.FetchToken:input_type -> workload.FetchTokenRequest
+	2, // 2: workload.WorkloadService.FetchWrappedToken:input_type -> workload.FetchWrappedTokenRequest
+	4, // 3: workload.WorkloadService.FetchSecret:input_type -> workload.FetchSecretRequest
+	1, // 4: workload.WorkloadService.FetchToken:output_type -> workload.FetchTokenResponse
+	3, // 5: workload.WorkloadService.FetchWrappedToken:output_type -> workload.FetchWrappedTokenResponse
+	5, // 6: workload.WorkloadService.FetchSecret:output_type -> workload.FetchSecretResponse
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_command_agent_workload_workload_proto_init() }
+func file_command_agent_workload_workload_proto_init() {
+	if File_command_agent_workload_workload_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_command_agent_workload_workload_proto_rawDesc), len(file_command_agent_workload_workload_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_command_agent_workload_workload_proto_goTypes,
+		DependencyIndexes: file_command_agent_workload_workload_proto_depIdxs,
+		MessageInfos:      file_command_agent_workload_workload_proto_msgTypes,
+	}.Build()
+	File_command_agent_workload_workload_proto = out.File
+	file_command_agent_workload_workload_proto_goTypes = nil
+	file_command_agent_workload_workload_proto_depIdxs = nil
+}
@@ -0,0 +1,356 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/vault/api"
+	agentConfig "github.com/hashicorp/vault/command/agent/config"
+	"github.com/hashicorp/vault/command/agent/template"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*AgentTemplateCommand)(nil)
+	_ cli.Command             = (*AgentTemplateRenderCommand)(nil)
+	_ cli.CommandAutocomplete = (*AgentTemplateRenderCommand)(nil)
+)
+
+type AgentTemplateCommand struct {
+	*BaseCommand
+}
+
+func (c *AgentTemplateCommand) Synopsis() string {
+	return "Interact with Vault Agent templates"
+}
+
+func (c *AgentTemplateCommand) Help() string {
+	helpText := `
+Usage: vault agent template <subcommand> [options] [args]
+
+  This command groups subcommands for working with the templates of a Vault
+  Agent configuration outside of a running agent.
+
+  Render the templates once and show what would change, without writing them:
+
+      $ vault agent template render -config=agent.hcl -dry-run
+
+  Please see the individual subcommand help for detailed usage information.
+`
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *AgentTemplateCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
+
+type AgentTemplateRenderCommand struct {
+	*BaseCommand
+
+	flagConfigs  []string
+	flagDryRun   bool
+	flagDiff     bool
+	flagTimeout  time.Duration
+	flagLogLevel string
+}
+
+func (c *AgentTemplateRenderCommand) Synopsis() string {
+	return "Render Vault Agent templates once"
+}
+
+func (c *AgentTemplateRenderCommand) Help() string {
+	helpText := `
+Usage: vault agent template render [options]
+
+  Renders each template in the given Vault Agent configuration once against
+  the Vault server, using the token of this command rather than the agent's
+  auto-auth method. For each template, it prints the secrets and leases the
+  template depends on, and when each secret would be fetched again and the
+  template re-rendered.
+
+  With -dry-run, templates are not written and their commands are not run.
+  Instead, the output shows the difference between each rendered template and
+  the file on disk. Note that the difference includes secret values; use
+  -diff=false to omit it.
+
+  A dry run still reads the secrets from Vault, so it has the same side effects
+  on the server as rendering. Dynamic secrets such as database credentials are
+  generated, and their leases are revoked once rendering completes. Templates
+  that write to Vault, such as those issuing PKI certificates with pkiCert or
+  using a secret with parameters, perform the write; it can't be undone, and
+  the command warns about each such template.
+
+  If the configuration has a vault address and no address is given with
+  -address or VAULT_ADDR, the configured address is used.
+
+  Show what the agent would render, without writing any files:
+
+      $ vault agent template render -config=agent.hcl -dry-run
+
+` + c.Flags().Help()
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *AgentTemplateRenderCommand) Flags() *FlagSets {
+	set := c.flagSet(FlagSetHTTP)
+
+	f := set.NewFlagSet("Command Options")
+
+	f.StringSliceVar(&StringSliceVar{
+		Name:   "config",
+		Target: &c.flagConfigs,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: "Path to a Vault Agent configuration file. This can be specified " +
+			"multiple times.",
+	})
+
+	f.BoolVar(&BoolVar{
+		Name:    "dry-run",
+		Target:  &c.flagDryRun,
+		Default: false,
+		Usage: "Render the templates without writing them to disk or running " +
+			"their commands, and show how they differ from the files on disk. " +
+			"Secrets are still read from Vault; their leases are revoked afterwards.",
+	})
+
+	f.BoolVar(&BoolVar{
+		Name:    "diff",
+		Target:  &c.flagDiff,
+		Default: true,
+		Usage: "Show the difference between each rendered template and the " +
+			"file on disk in -dry-run mode. This includes secret values.",
+	})
+
+	f.DurationVar(&DurationVar{
+		Name:       "timeout",
+		Target:     &c.flagTimeout,
+		Default:    template.DefaultRenderTimeout,
+		Completion: complete.PredictAnything,
+		Usage:      "Maximum time to wait for all templates to render.",
+	})
+
+	f.StringVar(&StringVar{
+		Name:       "log-level",
+		Target:     &c.flagLogLevel,
+		Default:    "off",
+		Completion: complete.PredictSet("trace", "debug", "info", "warn", "error", "off"),
+		Usage: "Log level of the template runner, written to stderr. " +
+			"Supported values are \"trace\", \"debug\", \"info\", \"warn\", " +
+			"\"error\" and \"off\".",
+	})
+
+	return set
+}
+
+func (c *AgentTemplateRenderCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *AgentTemplateRenderCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *AgentTemplateRenderCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if len(f.Args()) > 0 {
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 0, got %d)", len(f.Args())))
+		return 1
+	}
+
+	if len(c.flagConfigs) == 0 {
+		c.UI.Error("Must specify at least one config path using -config")
+		return 1
+	}
+
+	logLevel := hclog.LevelFromString(c.flagLogLevel)
+	if logLevel == hclog.NoLevel {
+		c.UI.Error(fmt.Sprintf("Invalid log level: %s", c.flagLogLevel))
+		return 1
+	}
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:   "template",
+		Level:  logLevel,
+		Output: os.Stderr,
+	})
+
+	config, err := c.loadConfig(logger)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 2
+	}
+
+	var addressSet bool
+	f.Visit(func(fl *flag.Flag) {
+		if fl.Name == flagNameAddress {
+			addressSet = true
+		}
+	})
+	if !addressSet && os.Getenv(api.EnvVaultAddress) == "" && config.Vault != nil && config.Vault.Address != "" {
+		if err := client.SetAddress(config.Vault.Address); err != nil {
+			c.UI.Error(fmt.Sprintf("Error setting address: %s", err))
+			return 1
+		}
+	}
+
+	renderConfig := &template.RenderConfig{
+		Logger:       logger,
+		AgentConfig:  config,
+		Client:       client,
+		DryRun:       c.flagDryRun,
+		RevokeLeases: c.flagDryRun,
+		Timeout:      c.flagTimeout,
+		LogLevel:     logLevel,
+	}
+	if logLevel != hclog.Off {
+		renderConfig.LogWriter = os.Stderr
+	}
+
+	results, renderErr := template.Render(context.Background(), renderConfig)
+	for i, result := range results {
+		if i > 0 {
+			c.UI.Output("")
+		}
+		c.outputResult(result)
+	}
+
+	if c.flagDryRun {
+		for _, result := range results {
+			for _, dep := range result.Dependencies {
+				if dep.Write {
+					c.UI.Warn(fmt.Sprintf("WARNING: Template %s wrote to Vault with %s during the dry run; the write can't be undone.", result.Destination, dep.Name))
+				}
+			}
+		}
+	}
+
+	if renderErr != nil {
+		c.UI.Error(fmt.Sprintf("Error rendering templates: %s", renderErr))
+		return 2
+	}
+
+	return 0
+}
+
+// loadConfig loads and validates the agent configuration files.
+func (c *AgentTemplateRenderCommand) loadConfig(logger hclog.Logger) (*agentConfig.Config, error) {
+	var errs *multierror.Error
+	cfg := agentConfig.NewConfig()
+
+	for _, configPath := range c.flagConfigs {
+		configFromPath, duplicate, err := agentConfig.LoadConfigCheckDuplicates(configPath)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error loading configuration from %s: %w", configPath, err))
+			continue
+		}
+		cfg = cfg.Merge(configFromPath)
+		if duplicate {
+			c.UI.Warn(fmt.Sprintf(
+				"WARNING: Duplicate keys found in the Vault agent configuration file %q, duplicate keys in HCL files are deprecated and will be forbidden in a future release.", configPath))
+		}
+	}
+
+	if errs != nil {
+		return nil, errs
+	}
+
+	if err := cfg.ValidateConfig(logger); err != nil {
+		return nil, fmt.Errorf("error validating configuration: %w", err)
+	}
+
+	return cfg, nil
+}
+
+func (c *AgentTemplateRenderCommand) outputResult(result *template.RenderResult) {
+	source := result.Source
+	if source == "" {
+		source = "(inline contents)"
+	}
+
+	var status string
+	switch {
+	case !result.Rendered:
+		status = "not rendered"
+	case !result.Changed():
+		status = "unchanged"
+	case result.Existing == nil:
+		status = "created"
+	default:
+		status = "changed"
+	}
+	if c.flagDryRun && result.Rendered && result.Changed() {
+		status = "would be " + status
+	}
+
+	c.UI.Output(fmt.Sprintf("Template %s", result.Destination))
+	c.UI.Output(fmt.Sprintf("  Source:  %s", source))
+	c.UI.Output(fmt.Sprintf("  Status:  %s", status))
+
+	if len(result.Dependencies) > 0 {
+		c.UI.Output("  Dependencies:")
+		for _, dep := range result.Dependencies {
+			c.UI.Output(fmt.Sprintf("    %s", dep.Name))
+			if dep.Path == "" {
+				continue
+			}
+
+			lease := "none"
+			if dep.LeaseID != "" {
+				lease = dep.LeaseID
+			}
+			if dep.Revoked {
+				lease += ", revoked"
+			}
+			c.UI.Output(fmt.Sprintf("      Path:       %s", dep.Path))
+			c.UI.Output(fmt.Sprintf("      Lease:      %s (duration %s, renewable %t)", lease, dep.LeaseDuration, dep.Renewable))
+			if dep.MinRerender == dep.MaxRerender {
+				c.UI.Output(fmt.Sprintf("      Re-render:  after %s", dep.MinRerender))
+			} else {
+				c.UI.Output(fmt.Sprintf("      Re-render:  between %s and %s", dep.MinRerender, dep.MaxRerender))
+			}
+		}
+	}
+
+	if len(result.MissingDependencies) > 0 {
+		c.UI.Output("  Missing dependencies:")
+		for _, dep := range result.MissingDependencies {
+			c.UI.Output(fmt.Sprintf("    %s", dep))
+		}
+	}
+
+	if c.flagDryRun && c.flagDiff && result.Changed() {
+		diff, err := result.Diff()
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error computing diff for %s: %s", result.Destination, err))
+			return
+		}
+		c.UI.Output("")
+		c.UI.Output(strings.TrimRight(diff, "\n"))
+	}
+}
@@ -62,6 +62,8 @@ type AuthHandler struct {
 	TemplateTokenCh chan string
 	// ExecTokenCh delivers tokens to the exec/env-template server.
 	ExecTokenCh chan string
+	// WorkloadAPITokenCh delivers tokens to the agent's workload API server.
+	WorkloadAPITokenCh chan string
 	// PKIExternalCATokenCh delivers tokens to the PKI external CA server.
 	// Kept separate from TemplateTokenCh so that only one consumer receives each token.
 	PKIExternalCATokenCh         chan string
@@ -82,6 +84,7 @@ type AuthHandler struct {
 	enableTemplateTokenCh      bool
 	enableExecTokenCh          bool
 	enablePKIExternalCATokenCh bool
+	enableWorkloadAPITokenCh   bool
 	exitOnError                bool
 }
 
@@ -102,6 +105,7 @@ type AuthHandlerConfig struct {
 	EnableTemplateTokenCh        bool
 	EnableExecTokenCh            bool
 	EnablePKIExternalCATokenCh   bool
+	EnableWorkloadAPITokenCh     bool
 	ExitOnError                  bool
 }
 
@@ -113,6 +117,7 @@ func NewAuthHandler(conf *AuthHandlerConfig) *AuthHandler {
 		TemplateTokenCh:              make(chan string, 1),
 		ExecTokenCh:                  make(chan string, 1),
 		PKIExternalCATokenCh:         make(chan string, 1),
+		WorkloadAPITokenCh:           make(chan string, 1),
 		InvalidToken:                 make(chan error, 1),
 		AuthInProgress:               &atomic.Bool{},
 		token:                        conf.Token,
@@ -126,6 +131,7 @@ func NewAuthHandler(conf *AuthHandlerConfig) *AuthHandler {
 		enableTemplateTokenCh:        conf.EnableTemplateTokenCh,
 		enableExecTokenCh:            conf.EnableExecTokenCh,
 		enablePKIExternalCATokenCh:   conf.EnablePKIExternalCATokenCh,
+		enableWorkloadAPITokenCh:     conf.EnableWorkloadAPITokenCh,
 		exitOnError:                  conf.ExitOnError,
 		userAgent:                    conf.UserAgent,
 		metricsSignifier:             conf.MetricsSignifier,
@@ -192,6 +198,7 @@ func (ah *AuthHandler) Run(ctx context.Context, am AuthMethod) error {
 		close(ah.TemplateTokenCh)
 		close(ah.ExecTokenCh)
 		close(ah.PKIExternalCATokenCh)
+		close(ah.WorkloadAPITokenCh)
 		ah.logger.Info("auth handler stopped")
 		// Set unauthenticated when shutting down
 		metrics.SetGauge([]string{ah.metricsSignifier, "authenticated"}, 0)
@@ -455,6 +462,9 @@ func (ah *AuthHandler) Run(ctx context.Context, am AuthMethod) error {
 			if ah.enablePKIExternalCATokenCh {
 				ah.PKIExternalCATokenCh <- string(wrappedResp)
 			}
+			if ah.enableWorkloadAPITokenCh {
+				ah.WorkloadAPITokenCh <- string(wrappedResp)
+			}
 
 			am.CredSuccess()
 			backoffCfg.backoff.Reset()
@@ -521,6 +531,9 @@ func (ah *AuthHandler) Run(ctx context.Context, am AuthMethod) error {
 				if ah.enablePKIExternalCATokenCh {
 					ah.PKIExternalCATokenCh <- token
 				}
+				if ah.enableWorkloadAPITokenCh {
+					ah.WorkloadAPITokenCh <- token
+				}
 
 				tokenType := secret.Data["type"].(string)
 				if tokenType == "batch" {
@@ -562,6 +575,9 @@ func (ah *AuthHandler) Run(ctx context.Context, am AuthMethod) error {
 				if ah.enablePKIExternalCATokenCh {
 					ah.PKIExternalCATokenCh <- secret.Auth.ClientToken
 				}
+				if ah.enableWorkloadAPITokenCh {
+					ah.WorkloadAPITokenCh <- secret.Auth.ClientToken
+				}
 			}
 
 			am.CredSuccess()
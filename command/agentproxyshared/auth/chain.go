@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	metrics "github.com/hashicorp/go-metrics/compat"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/backoff"
+	"github.com/hashicorp/vault/sdk/helper/consts"
+)
+
+// ChainedMethod is an auth method in a MethodChain.
+type ChainedMethod struct {
+	// Name identifies the method in logs, e.g. its type.
+	Name string
+	// MountPath is the mount path the method authenticates against.
+	MountPath string
+	Method    AuthMethod
+	// MinBackoff and MaxBackoff bound how long the method is skipped for
+	// after it fails. They default to the auto-auth defaults.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+type MethodChainConfig struct {
+	Logger  hclog.Logger
+	Methods []*ChainedMethod
+
+	// MetricsSignifier is the first argument that will be provided to
+	// metrics.SetGaugeWithLabels, signifying what the name of the application
+	// is. It defaults to "agent".
+	MetricsSignifier string
+}
+
+// MethodChain is an AuthMethod that authenticates with the first of an ordered
+// list of auth methods that works. When a method fails, either to provide its
+// login data or to log in, it is skipped in favor of the methods after it until
+// its backoff has passed, after which it is preferred again.
+//
+// MethodChain relies on the order in which the AuthHandler calls it: AuthClient
+// selects the method for an authentication attempt, Authenticate provides its
+// login data, and CredSuccess reports that the login succeeded.
+type MethodChain struct {
+	logger           hclog.Logger
+	methods          []*chainedMethodState
+	metricsSignifier string
+
+	l sync.Mutex
+	// selected is the method chosen for the current authentication attempt.
+	selected *chainedMethodState
+	// pending is the method whose login is in progress: it has provided
+	// login data, but the login hasn't succeeded yet.
+	pending *chainedMethodState
+	// active is the method that produced the current token.
+	active *chainedMethodState
+
+	newCreds     chan struct{}
+	doneCh       chan struct{}
+	shutdownOnce sync.Once
+}
+
+type chainedMethodState struct {
+	*ChainedMethod
+	position int
+	backoff  *backoff.Backoff
+	retryAt  time.Time
+}
+
+var _ AuthMethodWithClient = (*MethodChain)(nil)
+
+// NewMethodChain creates a MethodChain from the given methods, in order of
+// preference.
+func NewMethodChain(conf *MethodChainConfig) (*MethodChain, error) {
+	if conf == nil {
+		return nil, errors.New("nil configuration provided")
+	}
+	if conf.Logger == nil {
+		return nil, errors.New("nil logger provided")
+	}
+	if len(conf.Methods) == 0 {
+		return nil, errors.New("at least one auth method is required")
+	}
+
+	c := &MethodChain{
+		logger:           conf.Logger,
+		metricsSignifier: conf.MetricsSignifier,
+		newCreds:         make(chan struct{}, 1),
+		doneCh:           make(chan struct{}),
+	}
+	if c.metricsSignifier == "" {
+		c.metricsSignifier = "agent"
+	}
+
+	for i, m := range conf.Methods {
+		if m == nil || m.Method == nil {
+			return nil, fmt.Errorf("nil auth method at position %d", i)
+		}
+
+		minBackoff, maxBackoff := m.MinBackoff, m.MaxBackoff
+		if minBackoff <= 0 {
+			minBackoff = consts.DefaultMinBackoff
+		}
+		if maxBackoff <= 0 {
+			maxBackoff = consts.DefaultMaxBackoff
+		}
+		if minBackoff > maxBackoff {
+			return nil, fmt.Errorf("min_backoff cannot be greater than max_backoff for %s auth method", m.Name)
+		}
+
+		c.methods = append(c.methods, &chainedMethodState{
+			ChainedMethod: m,
+			position:      i,
+			backoff:       backoff.NewBackoff(math.MaxInt, minBackoff, maxBackoff),
+		})
+	}
+	c.emitMetricsLocked()
+
+	// Re-authenticate when any method in the chain finds new credentials, as
+	// a preferred method may have become available.
+	for _, m := range c.methods {
+		credCh := m.Method.NewCreds()
+		if credCh == nil {
+			continue
+		}
+		go func(name string) {
+			for {
+				select {
+				case <-c.doneCh:
+					return
+				case <-credCh:
+					c.logger.Debug("auth method in chain found new credentials", "method", name)
+					select {
+					case c.newCreds <- struct{}{}:
+					default:
+					}
+				}
+			}
+		}(m.Name)
+	}
+
+	return c, nil
+}
+
+// AuthClient selects the method to authenticate with, and returns the client
+// it authenticates with.
+func (c *MethodChain) AuthClient(client *api.Client) (*api.Client, error) {
+	c.l.Lock()
+	defer c.l.Unlock()
+
+	// If a login is still pending, it didn't succeed.
+	if c.pending != nil {
+		c.failedLocked(c.pending, errors.New("login failed"))
+		c.pending = nil
+	}
+
+	m := c.selectLocked()
+	c.selected = m
+
+	withClient, ok := m.Method.(AuthMethodWithClient)
+	if !ok {
+		return client, nil
+	}
+
+	authClient, err := withClient.AuthClient(client)
+	if err != nil {
+		c.failedLocked(m, err)
+		c.selected = nil
+		return nil, fmt.Errorf("error creating client for %s auth method: %w", m.Name, err)
+	}
+
+	return authClient, nil
+}
+
+// Authenticate returns the login data of the selected method.
+func (c *MethodChain) Authenticate(ctx context.Context, client *api.Client) (string, http.Header, map[string]interface{}, error) {
+	c.l.Lock()
+	defer c.l.Unlock()
+
+	m := c.selected
+	if m == nil {
+		m = c.selectLocked()
+	}
+	c.selected = nil
+
+	path, header, data, err := m.Method.Authenticate(ctx, client)
+	if err != nil {
+		c.failedLocked(m, err)
+		return "", nil, nil, fmt.Errorf("error authenticating with %s auth method: %w", m.Name, err)
+	}
+
+	c.pending = m
+	return path, header, data, nil
+}
+
+// NewCreds returns a channel that receives when any method in the chain finds
+// new credentials.
+func (c *MethodChain) NewCreds() chan struct{} {
+	return c.newCreds
+}
+
+// CredSuccess records that the pending login succeeded, and that its method
+// produced the current token.
+func (c *MethodChain) CredSuccess() {
+	c.l.Lock()
+	m := c.pending
+	c.pending = nil
+	if m != nil {
+		m.backoff.Reset()
+		m.retryAt = time.Time{}
+		if c.active != m {
+			c.logger.Info("authenticated with auth method in chain", "method", m.Name, "mount_path", m.MountPath, "position", m.position)
+			c.active = m
+			c.emitMetricsLocked()
+		}
+	}
+	c.l.Unlock()
+
+	if m != nil {
+		m.Method.CredSuccess()
+	}
+}
+
+func (c *MethodChain) Shutdown() {
+	c.shutdownOnce.Do(func() {
+		close(c.doneCh)
+		for _, m := range c.methods {
+			m.Method.Shutdown()
+		}
+	})
+}
+
+// ActiveMethod returns the name and mount path of the method that produced
+// the current token. They are empty if no method has logged in yet.
+func (c *MethodChain) ActiveMethod() (string, string) {
+	c.l.Lock()
+	defer c.l.Unlock()
+
+	if c.active == nil {
+		return "", ""
+	}
+	return c.active.Name, c.active.MountPath
+}
+
+// emitMetricsLocked sets a gauge for each method in the chain to 1 if it
+// produced the current token, and 0 otherwise.
+func (c *MethodChain) emitMetricsLocked() {
+	for _, m := range c.methods {
+		labels := []metrics.Label{
+			{Name: "method", Value: m.Name},
+			{Name: "mount_path", Value: m.MountPath},
+		}
+
+		var active float32
+		if m == c.active {
+			active = 1
+		}
+		metrics.SetGaugeWithLabels([]string{c.metricsSignifier, "auth", "method_chain", "active"}, active, labels)
+	}
+}
+
+// selectLocked returns the first method that isn't backing off. If all
+// methods are backing off, it returns the one whose backoff ends first.
+func (c *MethodChain) selectLocked() *chainedMethodState {
+	now := time.Now()
+
+	var soonest *chainedMethodState
+	for _, m := range c.methods {
+		if !m.retryAt.After(now) {
+			if m.position > 0 {
+				c.logger.Info("falling back to auth method in chain", "method", m.Name, "mount_path", m.MountPath, "position", m.position)
+			}
+			return m
+		}
+		if soonest == nil || m.retryAt.Before(soonest.retryAt) {
+			soonest = m
+		}
+	}
+
+	c.logger.Warn("all auth methods in chain are backing off, using the one available soonest", "method", soonest.Name, "mount_path", soonest.MountPath)
+	return soonest
+}
+
+// failedLocked backs off from a method that failed.
+func (c *MethodChain) failedLocked(m *chainedMethodState, err error) {
+	next, _ := m.backoff.Next()
+	m.retryAt = time.Now().Add(next)
+	c.logger.Warn("auth method in chain failed", "method", m.Name, "mount_path", m.MountPath, "error", err, "backoff", next.Truncate(10*time.Millisecond))
+}
@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	metrics "github.com/hashicorp/go-metrics/compat"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/builtin/credential/userpass"
+	vaulthttp "github.com/hashicorp/vault/http"
+	"github.com/hashicorp/vault/sdk/helper/logging"
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/hashicorp/vault/vault"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeChainMethod is an auth method that fails to provide its login data
+// while unavailable.
+type fakeChainMethod struct {
+	path          string
+	unavailable   atomic.Bool
+	authenticated atomic.Int32
+	succeeded     atomic.Int32
+	shutdown      atomic.Bool
+}
+
+func (f *fakeChainMethod) Authenticate(context.Context, *api.Client) (string, http.Header, map[string]interface{}, error) {
+	if f.unavailable.Load() {
+		return "", nil, nil, errors.New("credentials not found")
+	}
+	f.authenticated.Add(1)
+	return f.path, nil, nil, nil
+}
+
+func (f *fakeChainMethod) NewCreds() chan struct{} { return nil }
+func (f *fakeChainMethod) CredSuccess()            { f.succeeded.Add(1) }
+func (f *fakeChainMethod) Shutdown()               { f.shutdown.Store(true) }
+
+func testNewMethodChain(t *testing.T, methods ...*ChainedMethod) *MethodChain {
+	t.Helper()
+
+	chain, err := NewMethodChain(&MethodChainConfig{
+		Logger:  logging.NewVaultLogger(hclog.Trace).Named("auth.method_chain"),
+		Methods: methods,
+	})
+	require.NoError(t, err)
+	return chain
+}
+
+// authenticateWithChain makes an authentication attempt with the chain the
+// same way the AuthHandler does, returning the login path.
+func authenticateWithChain(t *testing.T, chain *MethodChain, loginSucceeds bool) (string, error) {
+	t.Helper()
+
+	_, err := chain.AuthClient(nil)
+	require.NoError(t, err)
+	path, _, _, err := chain.Authenticate(context.Background(), nil)
+	if err == nil && loginSucceeds {
+		chain.CredSuccess()
+	}
+	return path, err
+}
+
+// TestMethodChain_Fallback tests that the chain falls back to the next method
+// when a method fails, and prefers the first method again once its backoff
+// has passed.
+func TestMethodChain_Fallback(t *testing.T) {
+	kubernetes := &fakeChainMethod{path: "auth/kubernetes/login"}
+	approle := &fakeChainMethod{path: "auth/approle/login"}
+	chain := testNewMethodChain(t,
+		&ChainedMethod{Name: "kubernetes", MountPath: "auth/kubernetes", Method: kubernetes, MinBackoff: 50 * time.Millisecond, MaxBackoff: 50 * time.Millisecond},
+		&ChainedMethod{Name: "approle", MountPath: "auth/approle", Method: approle},
+	)
+	defer chain.Shutdown()
+
+	name, _ := chain.ActiveMethod()
+	require.Empty(t, name)
+
+	// The first method is preferred
+	path, err := authenticateWithChain(t, chain, true)
+	require.NoError(t, err)
+	require.Equal(t, "auth/kubernetes/login", path)
+	name, mountPath := chain.ActiveMethod()
+	require.Equal(t, "kubernetes", name)
+	require.Equal(t, "auth/kubernetes", mountPath)
+
+	// When the first method can't provide its login data, the chain falls
+	// back to the next method on the next attempt
+	kubernetes.unavailable.Store(true)
+	_, err = authenticateWithChain(t, chain, true)
+	require.ErrorContains(t, err, "credentials not found")
+	path, err = authenticateWithChain(t, chain, true)
+	require.NoError(t, err)
+	require.Equal(t, "auth/approle/login", path)
+	name, _ = chain.ActiveMethod()
+	require.Equal(t, "approle", name)
+	require.Equal(t, int32(1), approle.succeeded.Load())
+
+	// Once its backoff has passed, the first method is preferred again
+	kubernetes.unavailable.Store(false)
+	time.Sleep(100 * time.Millisecond)
+	path, err = authenticateWithChain(t, chain, true)
+	require.NoError(t, err)
+	require.Equal(t, "auth/kubernetes/login", path)
+	name, _ = chain.ActiveMethod()
+	require.Equal(t, "kubernetes", name)
+
+	chain.Shutdown()
+	require.True(t, kubernetes.shutdown.Load())
+	require.True(t, approle.shutdown.Load())
+}
+
+// TestMethodChain_ActiveMethodMetric tests that the method that produced the
+// current token is reported by a gauge.
+func TestMethodChain_ActiveMethodMetric(t *testing.T) {
+	inmemSink := metrics.NewInmemSink(time.Hour, time.Hour)
+	metricsConfig := metrics.DefaultConfig("")
+	metricsConfig.EnableHostname = false
+	metricsConfig.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(metricsConfig, inmemSink)
+	require.NoError(t, err)
+	defer metrics.NewGlobal(metrics.DefaultConfig(""), &metrics.BlackholeSink{})
+
+	kubernetes := &fakeChainMethod{path: "auth/kubernetes/login"}
+	approle := &fakeChainMethod{path: "auth/approle/login"}
+	chain := testNewMethodChain(t,
+		&ChainedMethod{Name: "kubernetes", MountPath: "auth/kubernetes", Method: kubernetes},
+		&ChainedMethod{Name: "approle", MountPath: "auth/approle", Method: approle},
+	)
+	defer chain.Shutdown()
+
+	gauge := func(name, mountPath string) float32 {
+		t.Helper()
+		intervals := inmemSink.Data()
+		require.NotEmpty(t, intervals)
+		key := "agent.auth.method_chain.active;method=" + name + ";mount_path=" + mountPath
+		intervals[0].RLock()
+		defer intervals[0].RUnlock()
+		value, ok := intervals[0].Gauges[key]
+		require.True(t, ok, key)
+		return value.Value
+	}
+
+	require.Equal(t, float32(0), gauge("kubernetes", "auth/kubernetes"))
+	require.Equal(t, float32(0), gauge("approle", "auth/approle"))
+
+	kubernetes.unavailable.Store(true)
+	_, err = authenticateWithChain(t, chain, true)
+	require.Error(t, err)
+	_, err = authenticateWithChain(t, chain, true)
+	require.NoError(t, err)
+	require.Equal(t, float32(0), gauge("kubernetes", "auth/kubernetes"))
+	require.Equal(t, float32(1), gauge("approle", "auth/approle"))
+}
+
+// TestMethodChain_LoginFailure tests that a method whose login fails is backed
+// off from, even though it provided its login data.
+func TestMethodChain_LoginFailure(t *testing.T) {
+	jwt := &fakeChainMethod{path: "auth/jwt/login"}
+	cert := &fakeChainMethod{path: "auth/cert/login"}
+	chain := testNewMethodChain(t,
+		&ChainedMethod{Name: "jwt", MountPath: "auth/jwt", Method: jwt, MinBackoff: time.Hour, MaxBackoff: time.Hour},
+		&ChainedMethod{Name: "cert", MountPath: "auth/cert", Method: cert, MinBackoff: time.Hour, MaxBackoff: time.Hour},
+	)
+	defer chain.Shutdown()
+
+	path, err := authenticateWithChain(t, chain, false)
+	require.NoError(t, err)
+	require.Equal(t, "auth/jwt/login", path)
+
+	path, err = authenticateWithChain(t, chain, true)
+	require.NoError(t, err)
+	require.Equal(t, "auth/cert/login", path)
+	require.Equal(t, int32(0), jwt.succeeded.Load())
+	require.Equal(t, int32(1), cert.succeeded.Load())
+
+	// With every method backing off, the one available soonest is used
+	_, err = authenticateWithChain(t, chain, false)
+	require.NoError(t, err)
+	path, err = authenticateWithChain(t, chain, true)
+	require.NoError(t, err)
+	require.Equal(t, "auth/jwt/login", path)
+}
+
+func TestNewMethodChain_Errors(t *testing.T) {
+	logger := logging.NewVaultLogger(hclog.Trace)
+
+	_, err := NewMethodChain(&MethodChainConfig{Logger: logger})
+	require.Error(t, err)
+
+	_, err = NewMethodChain(&MethodChainConfig{Logger: logger, Methods: []*ChainedMethod{{Name: "approle"}}})
+	require.Error(t, err)
+
+	_, err = NewMethodChain(&MethodChainConfig{Logger: logger, Methods: []*ChainedMethod{{
+		Name:       "approle",
+		Method:     &fakeChainMethod{},
+		MinBackoff: time.Minute,
+		MaxBackoff: time.Second,
+	}}})
+	require.Error(t, err)
+}
+
+// TestAuthHandler_MethodChain tests that the auth handler authenticates with
+// the next method in a chain when the first method is unavailable.
+func TestAuthHandler_MethodChain(t *testing.T) {
+	coreConfig := &vault.CoreConfig{
+		CredentialBackends: map[string]logical.Factory{
+			"userpass": userpass.Factory,
+		},
+	}
+	cluster := vault.NewTestCluster(t, coreConfig, &vault.TestClusterOptions{
+		HandlerFunc: vaulthttp.Handler,
+	})
+	client := cluster.Cores[0].Client
+
+	unavailable := &fakeChainMethod{path: "auth/kubernetes/login"}
+	unavailable.unavailable.Store(true)
+	chain := testNewMethodChain(t,
+		&ChainedMethod{Name: "kubernetes", MountPath: "auth/kubernetes", Method: unavailable},
+		&ChainedMethod{Name: "userpass", MountPath: "auth/userpass", Method: newUserpassTestMethod(t, client)},
+	)
+
+	ah := NewAuthHandler(&AuthHandlerConfig{
+		Logger:     logging.NewVaultLogger(hclog.Trace).Named("auth.handler"),
+		Client:     client,
+		MinBackoff: 10 * time.Millisecond,
+		MaxBackoff: 10 * time.Millisecond,
+	})
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ah.Run(ctx, chain)
+	}()
+
+	select {
+	case token := <-ah.OutputCh:
+		require.NotEmpty(t, token)
+	case err := <-errCh:
+		t.Fatalf("auth handler stopped: %v", err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for token")
+	}
+
+	name, _ := chain.ActiveMethod()
+	require.Equal(t, "userpass", name)
+
+	cancelFunc()
+	for range ah.OutputCh {
+	}
+	require.NoError(t, <-errCh)
+}
@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 	gohttp "net/http"
+	"net/url"
 	"sync"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/command/agentproxyshared/upstream"
 	"github.com/hashicorp/vault/helper/namespace"
 	"github.com/hashicorp/vault/http"
 )
@@ -48,6 +50,7 @@ type APIProxy struct {
 	// (i.e. client.Namespace()) to avoid repeated calls and lock usage.
 	clientNamespace            string
 	prependConfiguredNamespace bool
+	upstreams                  *upstream.Manager
 }
 
 var _ Proxier = &APIProxy{}
@@ -66,6 +69,9 @@ type APIProxyConfig struct {
 	// PrependConfiguredNamespace configures whether the client's namespace
 	// should be prepended to proxied requests
 	PrependConfiguredNamespace bool
+	// Upstreams, if set, selects the Vault server that each request is
+	// forwarded to, and fails over to another server if it can't be reached.
+	Upstreams *upstream.Manager
 }
 
 func NewAPIProxy(config *APIProxyConfig) (Proxier, error) {
@@ -81,6 +87,7 @@ func NewAPIProxy(config *APIProxyConfig) (Proxier, error) {
 		userAgentStringFunction:    config.UserAgentStringFunction,
 		prependConfiguredNamespace: config.PrependConfiguredNamespace,
 		clientNamespace:            namespace.Canonicalize(config.Client.Namespace()),
+		upstreams:                  config.Upstreams,
 	}, nil
 }
 
@@ -120,6 +127,17 @@ func (ap *APIProxy) Send(ctx context.Context, req *SendRequest) (*SendResponse,
 		client.SetNamespace(newNamespace)
 	}
 
+	// Send writes to the active node so that they aren't forwarded by a
+	// standby, and reads to the most preferred healthy upstream
+	var upstreamAddress string
+	isWrite := isWriteRequest(req.Request.Method)
+	if ap.upstreams != nil {
+		upstreamAddress = ap.upstreamAddress(isWrite)
+		if err := client.SetAddress(upstreamAddress); err != nil {
+			return nil, err
+		}
+	}
+
 	fwReq := client.NewRequest(req.Request.Method, req.Request.URL.Path)
 	fwReq.BodyBytes = req.RequestBody
 
@@ -160,6 +178,21 @@ func (ap *APIProxy) Send(ctx context.Context, req *SendRequest) (*SendResponse,
 	ap.logger.Info("forwarding request to Vault", "method", req.Request.Method, "path", req.Request.URL.Path)
 
 	resp, err := client.RawRequestWithContext(ctx, fwReq)
+	if resp == nil && err != nil && upstreamAddress != "" && ctx.Err() == nil {
+		// The upstream couldn't be reached, so fail over and retry the
+		// request once against the next upstream
+		ap.upstreams.MarkFailed(upstreamAddress, err)
+		if next := ap.upstreamAddress(isWrite); next != upstreamAddress {
+			ap.logger.Warn("retrying request against another upstream", "method", req.Request.Method, "path", req.Request.URL.Path, "upstream", next)
+			u, parseErr := url.Parse(next)
+			if parseErr != nil {
+				return nil, parseErr
+			}
+			fwReq.URL.Scheme = u.Scheme
+			fwReq.URL.Host = u.Host
+			resp, err = client.RawRequestWithContext(ctx, fwReq)
+		}
+	}
 	if resp == nil && err != nil {
 		// We don't want to cache nil responses, so we simply return the error
 		return nil, err
@@ -189,3 +222,21 @@ func (ap *APIProxy) Send(ctx context.Context, req *SendRequest) (*SendResponse,
 	// Bubble back the api.Response as well for error checking/handling at the handler layer.
 	return sendResponse, err
 }
+
+func (ap *APIProxy) upstreamAddress(isWrite bool) string {
+	if isWrite {
+		return ap.upstreams.WriteAddress()
+	}
+	return ap.upstreams.Address()
+}
+
+// isWriteRequest returns true if the request with the given method may modify
+// state in Vault.
+func isWriteRequest(method string) bool {
+	switch method {
+	case gohttp.MethodGet, gohttp.MethodHead, gohttp.MethodOptions, "LIST":
+		return false
+	default:
+		return true
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/builtin/credential/userpass"
+	"github.com/hashicorp/vault/command/agentproxyshared/upstream"
 	"github.com/hashicorp/vault/helper/namespace"
 	"github.com/hashicorp/vault/helper/useragent"
 	vaulthttp "github.com/hashicorp/vault/http"
@@ -155,6 +157,63 @@ func TestAPIProxy_queryParams(t *testing.T) {
 	}
 }
 
+// TestAPIProxy_upstreamFailover tests that requests are retried against the
+// next upstream when the selected upstream can't be reached
+func TestAPIProxy_upstreamFailover(t *testing.T) {
+	cleanup, client, _, _ := setupClusterAndAgent(namespace.RootContext(nil), t, nil)
+	defer cleanup()
+
+	// Get the address of a server that is no longer listening
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	proxyClient, err := client.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxyClient.SetMaxRetries(0)
+
+	upstreams, err := upstream.NewManager(&upstream.ManagerConfig{
+		Client:    proxyClient,
+		Addresses: []string{unreachable.URL, client.Address()},
+		Logger:    logging.NewVaultLogger(hclog.Trace),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proxier, err := NewAPIProxy(&APIProxyConfig{
+		Client:                  proxyClient,
+		Logger:                  logging.NewVaultLogger(hclog.Trace),
+		UserAgentStringFunction: useragent.ProxyStringWithProxiedUserAgent,
+		UserAgentString:         useragent.ProxyAPIProxyString(),
+		Upstreams:               upstreams,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := client.NewRequest("GET", "/v1/sys/health")
+	req, err := r.ToHTTP()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := proxier.Send(namespace.RootContext(nil), &SendRequest{
+		Request: req,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Response.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got: %v", resp.Response.StatusCode)
+	}
+
+	if upstreams.Address() != client.Address() {
+		t.Fatalf("expected failover to %q, got: %q", client.Address(), upstreams.Address())
+	}
+}
+
 // setupClusterAndAgent is a helper func used to set up a test cluster and
 // caching agent against the active node. It returns a cleanup func that should
 // be deferred immediately along with two clients, one for direct cluster
@@ -136,6 +136,10 @@ func setHeaders(w http.ResponseWriter, resp *SendResponse) {
 
 			// Update the date value
 			w.Header().Set("Date", time.Now().Format(http.TimeFormat))
+
+			if resp.CacheMeta.Stale {
+				w.Header().Set("X-Cache-Stale", "true")
+			}
 		}
 
 		w.Header().Set("X-Cache", xCacheVal)
@@ -114,6 +114,23 @@ type LeaseCache struct {
 	// capabilityManager is used when static secrets are enabled to
 	// manage the capabilities of cached tokens.
 	capabilityManager *StaticSecretCapabilityManager
+
+	// staticSecretStaleWhileRevalidate is how long cached static secrets are
+	// served while they're revalidated with Vault, once they may be stale.
+	staticSecretStaleWhileRevalidate time.Duration
+
+	// staticSecretOfflineMaxStaleness is how long cached static secrets are
+	// served while Vault is unavailable, once they may be stale. Zero
+	// disables offline mode.
+	staticSecretOfflineMaxStaleness time.Duration
+
+	// staticSecretFreshness tracks whether cached static secrets are being
+	// kept up to date by the event stream.
+	staticSecretFreshness staticSecretFreshness
+
+	// staticSecretRevalidations holds the IDs of the static secrets being
+	// revalidated in the background.
+	staticSecretRevalidations sync.Map
 }
 
 // LeaseCacheConfig is the configuration for initializing a new
@@ -127,6 +144,19 @@ type LeaseCacheConfig struct {
 	Storage             *cacheboltdb.BoltStorage
 	CacheStaticSecrets  bool
 	CacheDynamicSecrets bool
+
+	// StaticSecretStaleWhileRevalidate is how long a cached static secret is
+	// served after it may have become stale, because the static secret cache
+	// updater isn't receiving events from Vault. It is revalidated with Vault
+	// in the background when it's served. Once this has passed, requests are
+	// forwarded to Vault.
+	StaticSecretStaleWhileRevalidate time.Duration
+
+	// StaticSecretOfflineMaxStaleness enables offline mode if non-zero. In
+	// offline mode, a cached static secret is served when Vault is unavailable
+	// for up to this long after it may have become stale, and it isn't evicted
+	// when it can't be updated because Vault is unavailable.
+	StaticSecretOfflineMaxStaleness time.Duration
 }
 
 type inflightRequest struct {
@@ -185,6 +215,9 @@ func NewLeaseCache(conf *LeaseCacheConfig) (*LeaseCache, error) {
 		ps:                  conf.Storage,
 		cacheStaticSecrets:  conf.CacheStaticSecrets,
 		cacheDynamicSecrets: conf.CacheDynamicSecrets,
+
+		staticSecretStaleWhileRevalidate: conf.StaticSecretStaleWhileRevalidate,
+		staticSecretOfflineMaxStaleness:  conf.StaticSecretOfflineMaxStaleness,
 	}, nil
 }
 
@@ -252,12 +285,14 @@ func (c *LeaseCache) checkCacheForRequest(id string, req *SendRequest) (*SendRes
 	defer index.IndexLock.RUnlock()
 
 	var token string
+	var lastRenewed time.Time
 	if req != nil {
 		// Req will be non-nil if we're checking for a static secret.
 		// Token might still be "" if it's going to an unauthenticated
 		// endpoint, or similar. For static secrets, we only care about
 		// requests with tokens attached, as KV is authenticated.
 		token = req.Token
+		lastRenewed = index.LastRenewed
 	}
 
 	if token != "" {
@@ -307,6 +342,10 @@ func (c *LeaseCache) checkCacheForRequest(id string, req *SendRequest) (*SendRes
 	}
 	sendResp.CacheMeta.Age = time.Now().Sub(respTime)
 
+	if token != "" {
+		sendResp.CacheMeta.Staleness, sendResp.CacheMeta.Stale = c.staticSecretStaleness(lastRenewed)
+	}
+
 	return sendResp, nil
 }
 
@@ -432,14 +471,25 @@ func (c *LeaseCache) Send(ctx context.Context, req *SendRequest) (*SendResponse,
 
 	// Check if the response for this request is already in the static secret cache.
 	// Exclude list requests (?list=true) — see hasListQueryParam declaration above.
+	var staleResp *SendResponse
 	if staticSecretCacheId != "" && req.Request.Method == http.MethodGet && !hasListQueryParam && req.Token != "" {
 		cachedResp, err = c.checkCacheForStaticSecretRequest(staticSecretCacheId, req)
 		if err != nil {
 			return nil, err
 		}
-		if cachedResp != nil {
+		switch {
+		case cachedResp == nil:
+		case !cachedResp.CacheMeta.Stale:
 			c.logger.Debug("returning cached static secret response", "id", staticSecretCacheId, "path", getStaticSecretPathFromRequest(req))
 			return cachedResp, nil
+		case cachedResp.CacheMeta.Staleness <= c.staticSecretStaleWhileRevalidate:
+			c.logger.Debug("returning stale cached static secret response and revalidating", "id", staticSecretCacheId, "path", getStaticSecretPathFromRequest(req), "staleness", cachedResp.CacheMeta.Staleness)
+			c.revalidateStaticSecret(staticSecretCacheId, req)
+			return cachedResp, nil
+		default:
+			// The cached response is too stale to be returned without asking
+			// Vault, but it is returned in offline mode if Vault is unavailable.
+			staleResp = cachedResp
 		}
 	}
 
@@ -447,6 +497,19 @@ func (c *LeaseCache) Send(ctx context.Context, req *SendRequest) (*SendResponse,
 
 	// Pass the request down and get a response
 	resp, err := c.proxier.Send(ctx, req)
+	if staleResp != nil && staleResp.CacheMeta.Staleness <= c.staticSecretOfflineMaxStaleness {
+		var apiResp *api.Response
+		if resp != nil {
+			apiResp = resp.Response
+		}
+		if vaultUnavailable(apiResp, err) {
+			if apiResp != nil && apiResp.Body != nil {
+				apiResp.Body.Close()
+			}
+			c.logger.Warn("vault is unavailable, returning stale cached static secret response", "id", staticSecretCacheId, "path", getStaticSecretPathFromRequest(req), "staleness", staleResp.CacheMeta.Staleness, "error", err)
+			return staleResp, nil
+		}
+	}
 	if err != nil {
 		return resp, err
 	}
@@ -679,7 +742,11 @@ func (c *LeaseCache) cacheStaticSecret(ctx context.Context, req *SendRequest, re
 			}
 		}
 
-		if !haveVersion {
+		// If the cached secret may be stale, refresh it with the response
+		// we've just received from Vault.
+		_, stale := c.staticSecretStaleness(indexFromCache.LastRenewed)
+
+		if !haveVersion || stale {
 			var respBytes bytes.Buffer
 			err = resp.Response.Write(&respBytes)
 			if err != nil {
@@ -696,6 +763,7 @@ func (c *LeaseCache) cacheStaticSecret(ctx context.Context, req *SendRequest, re
 			// Set the index's Response
 			if version == 0 {
 				indexFromCache.Response = respBytes.Bytes()
+				indexFromCache.LastRenewed = time.Now().UTC()
 				// For current KVv2 secrets, see if we can add the version that the secret is
 				// to the versions map, too. If we got the latest version and the version is #2,
 				// also update Versions[2]
@@ -39,6 +39,11 @@ type SendResponse struct {
 type CacheMeta struct {
 	Hit bool
 	Age time.Duration
+
+	// Stale is true if the response is a cached static secret that may be out
+	// of date, and Staleness is how long it may have been out of date for.
+	Stale     bool
+	Staleness time.Duration
 }
 
 // Proxier is the interface implemented by different components that are
@@ -172,6 +172,11 @@ func (updater *StaticSecretCacheUpdater) streamStaticSecretEvents(ctx context.Co
 		return fmt.Errorf("error when performing pre-event stream secret update: %w", err)
 	}
 
+	// The cache is now up to date, and will be kept up to date for as long as
+	// we receive events.
+	updater.leaseCache.setStaticSecretEventStreamConnected(true)
+	defer updater.leaseCache.setStaticSecretEventStreamConnected(false)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -490,6 +495,12 @@ func (updater *StaticSecretCacheUpdater) updateStaticSecret(ctx context.Context,
 			}
 		}
 
+		if err != nil && updater.leaseCache.staticSecretOfflineMaxStaleness != 0 && vaultUnavailable(resp, err) {
+			// In offline mode, keep serving the cached secret while Vault is
+			// unavailable. It will be updated once Vault is available again.
+			return fmt.Errorf("vault is unavailable, keeping cached static secret: %w", err)
+		}
+
 		if err != nil {
 			updater.logger.Trace("received error when trying to update cache", "path", path, "err", err, "token", token, "namespace", index.Namespace)
 			// We cannot access this secret with this token for whatever reason,
@@ -557,7 +568,7 @@ func (updater *StaticSecretCacheUpdater) updateStaticSecret(ctx context.Context,
 
 		// Lastly, store the secret
 		updater.logger.Debug("storing response into the cache due to update", "path", path)
-		err = updater.leaseCache.db.Set(index)
+		err = updater.leaseCache.Set(ctx, index)
 		if err != nil {
 			return err
 		}
@@ -565,7 +576,7 @@ func (updater *StaticSecretCacheUpdater) updateStaticSecret(ctx context.Context,
 		// No token could successfully update the secret, or secret was deleted.
 		// We should evict the cache instead of re-storing the secret.
 		updater.logger.Debug("evicting response from cache", "path", path)
-		err = updater.leaseCache.db.Evict(cachememdb.IndexNameID, indexId)
+		err = updater.leaseCache.Evict(index)
 		if err != nil {
 			return err
 		}
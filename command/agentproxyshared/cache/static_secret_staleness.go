@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/command/agentproxyshared/cache/cacheboltdb"
+	"github.com/hashicorp/vault/command/agentproxyshared/cache/cachememdb"
+	"github.com/hashicorp/vault/sdk/helper/consts"
+)
+
+// staticSecretFreshness tracks whether the static secret cache is being kept
+// up to date by the static secret cache updater, and since when it hasn't been.
+type staticSecretFreshness struct {
+	l sync.RWMutex
+
+	// connected is true while the updater is receiving events from Vault,
+	// after having brought the cache up to date.
+	connected bool
+
+	// disconnectedAt is when the updater last stopped receiving events. It is
+	// zero if the updater has never been connected.
+	disconnectedAt time.Time
+}
+
+// setStaticSecretEventStreamConnected records whether the static secret cache
+// updater is receiving events from Vault. While it is, cached static secrets
+// are up to date.
+func (c *LeaseCache) setStaticSecretEventStreamConnected(connected bool) {
+	c.staticSecretFreshness.l.Lock()
+	defer c.staticSecretFreshness.l.Unlock()
+
+	if c.staticSecretFreshness.connected == connected {
+		return
+	}
+
+	c.staticSecretFreshness.connected = connected
+	if !connected {
+		c.staticSecretFreshness.disconnectedAt = time.Now().UTC()
+	}
+}
+
+// staticSecretStaleness returns how long a cached static secret last updated
+// at lastRenewed may have been out of date for, and whether it is stale.
+// Cached static secrets are never stale if neither stale-while-revalidate nor
+// offline mode is configured, so that they're served regardless of the state
+// of the event stream.
+func (c *LeaseCache) staticSecretStaleness(lastRenewed time.Time) (time.Duration, bool) {
+	if c.staticSecretStaleWhileRevalidate == 0 && c.staticSecretOfflineMaxStaleness == 0 {
+		return 0, false
+	}
+
+	c.staticSecretFreshness.l.RLock()
+	connected := c.staticSecretFreshness.connected
+	disconnectedAt := c.staticSecretFreshness.disconnectedAt
+	c.staticSecretFreshness.l.RUnlock()
+
+	if connected {
+		return 0, false
+	}
+
+	// The secret was up to date until the later of when it was last fetched,
+	// and when we stopped receiving events for it.
+	freshAt := lastRenewed
+	if disconnectedAt.After(freshAt) {
+		freshAt = disconnectedAt
+	}
+
+	return time.Since(freshAt), true
+}
+
+// revalidateStaticSecret fetches the static secret for the given request from
+// Vault in the background, and updates the cache with the response. At most one
+// revalidation runs at a time for each cached static secret.
+func (c *LeaseCache) revalidateStaticSecret(id string, req *SendRequest) {
+	if _, inProgress := c.staticSecretRevalidations.LoadOrStore(id, struct{}{}); inProgress {
+		return
+	}
+
+	// The request's context ends once its response is returned, so revalidate
+	// under the lease cache's base context instead.
+	c.l.RLock()
+	ctx := c.baseCtxInfo.Ctx
+	c.l.RUnlock()
+
+	revalidateReq := &SendRequest{
+		Token:       req.Token,
+		Request:     req.Request.Clone(ctx),
+		RequestBody: req.RequestBody,
+	}
+
+	go func() {
+		defer c.staticSecretRevalidations.Delete(id)
+
+		if err := c.refreshStaticSecret(ctx, id, revalidateReq); err != nil {
+			c.logger.Warn("failed to revalidate stale static secret", "id", id, "path", getStaticSecretPathFromRequest(revalidateReq), "error", err)
+			return
+		}
+		c.logger.Debug("revalidated stale static secret", "id", id, "path", getStaticSecretPathFromRequest(revalidateReq))
+	}()
+}
+
+// refreshStaticSecret forwards the request for a cached static secret to Vault,
+// and updates the cached secret with the response. If Vault answers that the
+// token can't read the secret, the token is removed from the cached secret so
+// that its requests are forwarded to Vault.
+func (c *LeaseCache) refreshStaticSecret(ctx context.Context, id string, req *SendRequest) error {
+	resp, err := c.proxier.Send(ctx, req)
+
+	var apiResp *api.Response
+	if resp != nil {
+		apiResp = resp.Response
+	}
+	if vaultUnavailable(apiResp, err) {
+		if err == nil {
+			err = fmt.Errorf("received status %d", apiResp.StatusCode)
+		}
+		return fmt.Errorf("vault is unavailable: %w", err)
+	}
+	if apiResp == nil || apiResp.Response == nil {
+		return err
+	}
+
+	switch {
+	case apiResp.StatusCode == http.StatusForbidden || apiResp.StatusCode == http.StatusNotFound:
+		return c.removeStaticSecretToken(ctx, id, req.Token)
+	case apiResp.StatusCode >= 300 || apiResp.Header.Get("Content-Type") != "application/json":
+		return fmt.Errorf("unexpected response with status %d", apiResp.StatusCode)
+	}
+
+	secret, err := api.ParseSecret(bytes.NewReader(resp.ResponseBody))
+	if err != nil {
+		return fmt.Errorf("failed to parse response as secret: %w", err)
+	}
+	if secret == nil || secret.MountType != "kv" {
+		return nil
+	}
+
+	namespace := req.Request.Header.Get(consts.NamespaceHeaderName)
+	if namespace == "" {
+		namespace = "root/"
+	}
+
+	index := &cachememdb.Index{
+		ID:          id,
+		Type:        cacheboltdb.StaticSecretType,
+		Namespace:   namespace,
+		RequestPath: getStaticSecretPathFromRequest(req),
+		LastRenewed: time.Now().UTC(),
+	}
+
+	return c.cacheStaticSecret(ctx, req, resp, index, secret)
+}
+
+// removeStaticSecretToken removes a token from the tokens allowed to read a
+// cached static secret, and evicts the secret if no tokens remain.
+func (c *LeaseCache) removeStaticSecretToken(ctx context.Context, id string, token string) error {
+	index, err := c.db.Get(cachememdb.IndexNameID, id)
+	if errors.Is(err, cachememdb.ErrCacheItemNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	index.IndexLock.Lock()
+	defer index.IndexLock.Unlock()
+
+	delete(index.Tokens, token)
+	if len(index.Tokens) == 0 {
+		c.logger.Debug("evicting static secret from cache, as no tokens can read it", "path", index.RequestPath)
+		return c.Evict(index)
+	}
+
+	return c.Set(ctx, index)
+}
+
+// vaultUnavailable returns true if the response or error from a request to
+// Vault shows that Vault couldn't be reached or can't currently serve requests,
+// e.g. because it's sealed, rather than answering the request itself.
+func vaultUnavailable(resp *api.Response, err error) bool {
+	if resp == nil || resp.Response == nil {
+		return err != nil
+	}
+
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/command/agentproxyshared/cache/cachememdb"
+	"github.com/hashicorp/vault/sdk/helper/logging"
+	"github.com/stretchr/testify/require"
+)
+
+// mockStaticSecretProxier is a Proxier that returns a KV secret with the
+// current value, or a connection error while offline.
+type mockStaticSecretProxier struct {
+	l       sync.Mutex
+	value   string
+	offline bool
+	sends   int
+}
+
+func (p *mockStaticSecretProxier) Send(ctx context.Context, req *SendRequest) (*SendResponse, error) {
+	p.l.Lock()
+	defer p.l.Unlock()
+
+	p.sends++
+	if p.offline {
+		return nil, errors.New("dial tcp 127.0.0.1:8200: connect: connection refused")
+	}
+
+	resp := newTestSendResponse(http.StatusOK, fmt.Sprintf(`{"data": {"foo": %q}, "mount_type": "kv"}`, p.value))
+	resp.Response.Proto = "HTTP/1.1"
+	resp.Response.ProtoMajor = 1
+	resp.Response.ProtoMinor = 1
+	resp.CacheMeta = &CacheMeta{}
+	return resp, nil
+}
+
+func (p *mockStaticSecretProxier) set(value string, offline bool) {
+	p.l.Lock()
+	defer p.l.Unlock()
+	p.value = value
+	p.offline = offline
+}
+
+func (p *mockStaticSecretProxier) sendCount() int {
+	p.l.Lock()
+	defer p.l.Unlock()
+	return p.sends
+}
+
+func testNewStaticSecretStalenessLeaseCache(t *testing.T, proxier Proxier, staleWhileRevalidate, offlineMaxStaleness time.Duration) *LeaseCache {
+	t.Helper()
+
+	client, err := api.NewClient(api.DefaultConfig())
+	require.NoError(t, err)
+
+	lc, err := NewLeaseCache(&LeaseCacheConfig{
+		Client:                           client,
+		BaseContext:                      context.Background(),
+		Proxier:                          proxier,
+		Logger:                           logging.NewVaultLogger(hclog.Trace).Named("cache.leasecache"),
+		CacheStaticSecrets:               true,
+		CacheDynamicSecrets:              true,
+		UserAgentToUse:                   "test",
+		StaticSecretStaleWhileRevalidate: staleWhileRevalidate,
+		StaticSecretOfflineMaxStaleness:  offlineMaxStaleness,
+	})
+	require.NoError(t, err)
+	return lc
+}
+
+func sendStaticSecretRequest(t *testing.T, lc *LeaseCache) (*SendResponse, string, error) {
+	t.Helper()
+
+	resp, err := lc.Send(context.Background(), &SendRequest{
+		Token:   "token",
+		Request: httptest.NewRequest(http.MethodGet, "http://example.com/v1/secret/data/foo", nil),
+	})
+	if err != nil {
+		return resp, "", err
+	}
+
+	body, err := io.ReadAll(resp.Response.Body)
+	require.NoError(t, err)
+	return resp, string(body), nil
+}
+
+// TestLeaseCache_StaticSecretStaleWhileRevalidate tests that cached static
+// secrets are served as stale while the event stream is disconnected, and
+// are revalidated with Vault in the background.
+func TestLeaseCache_StaticSecretStaleWhileRevalidate(t *testing.T) {
+	proxier := &mockStaticSecretProxier{value: "bar"}
+	lc := testNewStaticSecretStalenessLeaseCache(t, proxier, time.Hour, 0)
+
+	resp, body, err := sendStaticSecretRequest(t, lc)
+	require.NoError(t, err)
+	require.False(t, resp.CacheMeta.Hit)
+	require.Contains(t, body, `"bar"`)
+
+	// While connected to the event stream, the cached secret is fresh
+	lc.setStaticSecretEventStreamConnected(true)
+	resp, body, err = sendStaticSecretRequest(t, lc)
+	require.NoError(t, err)
+	require.True(t, resp.CacheMeta.Hit)
+	require.False(t, resp.CacheMeta.Stale)
+	require.Contains(t, body, `"bar"`)
+	require.Equal(t, 1, proxier.sendCount())
+
+	// Once disconnected, the cached secret is served as stale, and revalidated
+	proxier.set("baz", false)
+	lc.setStaticSecretEventStreamConnected(false)
+	resp, body, err = sendStaticSecretRequest(t, lc)
+	require.NoError(t, err)
+	require.True(t, resp.CacheMeta.Hit)
+	require.True(t, resp.CacheMeta.Stale)
+	require.Contains(t, body, `"bar"`)
+
+	indexID := hashStaticSecretIndex("secret/data/foo")
+	require.Eventually(t, func() bool {
+		index, err := lc.db.Get(cachememdb.IndexNameID, indexID)
+		if err != nil {
+			return false
+		}
+		index.IndexLock.RLock()
+		defer index.IndexLock.RUnlock()
+		return strings.Contains(string(index.Response), `"baz"`)
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 2, proxier.sendCount())
+
+	// The revalidated secret is served from the cache
+	resp, body, err = sendStaticSecretRequest(t, lc)
+	require.NoError(t, err)
+	require.True(t, resp.CacheMeta.Hit)
+	require.Contains(t, body, `"baz"`)
+}
+
+// TestLeaseCache_StaticSecretOfflineMode tests that in offline mode, stale
+// cached static secrets are served while Vault is unreachable, up to the max
+// staleness, and updated once Vault is reachable again.
+func TestLeaseCache_StaticSecretOfflineMode(t *testing.T) {
+	proxier := &mockStaticSecretProxier{value: "bar"}
+	lc := testNewStaticSecretStalenessLeaseCache(t, proxier, 0, time.Hour)
+
+	_, _, err := sendStaticSecretRequest(t, lc)
+	require.NoError(t, err)
+
+	// Without stale-while-revalidate, stale secrets are fetched from Vault,
+	// but served from the cache while Vault is unreachable.
+	proxier.set("bar", true)
+	resp, body, err := sendStaticSecretRequest(t, lc)
+	require.NoError(t, err)
+	require.True(t, resp.CacheMeta.Hit)
+	require.True(t, resp.CacheMeta.Stale)
+	require.Contains(t, body, `"bar"`)
+	require.Equal(t, 2, proxier.sendCount())
+
+	// Once Vault is reachable again, the cached secret is updated
+	proxier.set("baz", false)
+	resp, body, err = sendStaticSecretRequest(t, lc)
+	require.NoError(t, err)
+	require.False(t, resp.CacheMeta.Hit)
+	require.Contains(t, body, `"baz"`)
+
+	index, err := lc.db.Get(cachememdb.IndexNameID, hashStaticSecretIndex("secret/data/foo"))
+	require.NoError(t, err)
+	require.Contains(t, string(index.Response), `"baz"`)
+
+	// Beyond the max staleness, the cached secret isn't served
+	index.LastRenewed = time.Now().Add(-2 * time.Hour)
+	require.NoError(t, lc.db.Set(index))
+	proxier.set("baz", true)
+	_, _, err = sendStaticSecretRequest(t, lc)
+	require.Error(t, err)
+}
+
+// TestLeaseCache_StaticSecretStalenessDisabled tests that without
+// stale-while-revalidate or offline mode, cached static secrets are served
+// regardless of the event stream.
+func TestLeaseCache_StaticSecretStalenessDisabled(t *testing.T) {
+	proxier := &mockStaticSecretProxier{value: "bar"}
+	lc := testNewStaticSecretStalenessLeaseCache(t, proxier, 0, 0)
+
+	_, _, err := sendStaticSecretRequest(t, lc)
+	require.NoError(t, err)
+
+	lc.setStaticSecretEventStreamConnected(true)
+	lc.setStaticSecretEventStreamConnected(false)
+
+	resp, _, err := sendStaticSecretRequest(t, lc)
+	require.NoError(t, err)
+	require.True(t, resp.CacheMeta.Hit)
+	require.False(t, resp.CacheMeta.Stale)
+	require.Equal(t, 1, proxier.sendCount())
+}
+
+func TestVaultUnavailable(t *testing.T) {
+	response := func(status int) *api.Response {
+		return &api.Response{Response: &http.Response{StatusCode: status}}
+	}
+	connErr := errors.New("connection refused")
+
+	require.True(t, vaultUnavailable(nil, connErr))
+	require.True(t, vaultUnavailable(response(http.StatusServiceUnavailable), connErr))
+	require.True(t, vaultUnavailable(response(http.StatusBadGateway), nil))
+	require.False(t, vaultUnavailable(nil, nil))
+	require.False(t, vaultUnavailable(response(http.StatusForbidden), connErr))
+	require.False(t, vaultUnavailable(response(http.StatusOK), nil))
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package exec
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/hashicorp/vault/command/agentproxyshared/sink"
+)
+
+const (
+	defaultTimeout = 30 * time.Second
+
+	// maxOutputLogged is the maximum number of bytes of a failed command's
+	// output that's included in the returned error.
+	maxOutputLogged = 1024
+)
+
+// execSink is a Sink implementation that runs a command each time a new
+// token is written, passing the token on the command's stdin. The token is
+// never passed in the command's arguments or environment, where it could be
+// read by other processes.
+type execSink struct {
+	command []string
+	timeout time.Duration
+	logger  hclog.Logger
+}
+
+// NewExecSink creates a new exec sink with the given configuration
+func NewExecSink(conf *sink.SinkConfig) (sink.Sink, error) {
+	if conf.Logger == nil {
+		return nil, errors.New("nil logger provided")
+	}
+
+	conf.Logger.Info("creating exec sink")
+
+	e := &execSink{
+		timeout: defaultTimeout,
+		logger:  conf.Logger,
+	}
+
+	commandRaw, ok := conf.Config["command"]
+	if !ok {
+		return nil, errors.New("'command' not specified for exec sink")
+	}
+	switch command := commandRaw.(type) {
+	case string:
+		e.command = []string{command}
+	case []string:
+		e.command = command
+	case []interface{}:
+		for _, arg := range command {
+			s, ok := arg.(string)
+			if !ok {
+				return nil, errors.New("could not parse 'command' as list of strings")
+			}
+			e.command = append(e.command, s)
+		}
+	default:
+		return nil, errors.New("could not parse 'command' as list of strings")
+	}
+	if len(e.command) == 0 || e.command[0] == "" {
+		return nil, errors.New("'command' must not be empty")
+	}
+
+	if timeoutRaw, ok := conf.Config["timeout"]; ok {
+		timeout, err := parseutil.ParseDurationSecond(timeoutRaw)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse 'timeout': %w", err)
+		}
+		if timeout <= 0 {
+			return nil, errors.New("'timeout' must be positive")
+		}
+
+		e.logger.Debug("overriding default exec sink", "timeout", timeout)
+		e.timeout = timeout
+	}
+
+	if err := e.WriteToken(""); err != nil {
+		return nil, fmt.Errorf("error during write check: %w", err)
+	}
+
+	e.logger.Info("exec sink configured", "command", e.command[0], "timeout", e.timeout)
+
+	return e, nil
+}
+
+// WriteToken implements the Sink interface and runs the configured command
+// with the token on its stdin. It returns an error if the command exits with
+// a non-zero status or doesn't finish within the timeout. If a blank token is
+// passed in, it only checks that the command can be found.
+func (e *execSink) WriteToken(token string) error {
+	e.logger.Trace("enter write_token", "command", e.command[0])
+	defer e.logger.Trace("exit write_token", "command", e.command[0])
+
+	if token == "" {
+		if _, err := exec.LookPath(e.command[0]); err != nil {
+			return fmt.Errorf("error finding command %q: %w", e.command[0], err)
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	var output bytes.Buffer
+	cmd := exec.CommandContext(ctx, e.command[0], e.command[1:]...)
+	cmd.Stdin = strings.NewReader(token)
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			err = fmt.Errorf("command timed out after %s", e.timeout)
+		}
+		out := output.Bytes()
+		if len(out) > maxOutputLogged {
+			out = out[:maxOutputLogged]
+		}
+		return fmt.Errorf("error running command %q: %w: %s", e.command[0], err, strings.TrimSpace(string(out)))
+	}
+
+	e.logger.Info("token written", "command", e.command[0])
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !windows
+
+package exec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/command/agentproxyshared/sink"
+	"github.com/stretchr/testify/require"
+)
+
+func testExecSink(t *testing.T, config map[string]interface{}) (sink.Sink, error) {
+	t.Helper()
+
+	return NewExecSink(&sink.SinkConfig{
+		Logger: hclog.NewNullLogger(),
+		Config: config,
+	})
+}
+
+func TestExecSink(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "token")
+
+	s, err := testExecSink(t, map[string]interface{}{
+		"command": []interface{}{"sh", "-c", `cat > "$0"`, out},
+	})
+	require.NoError(t, err)
+
+	// The write check doesn't run the command
+	_, err = os.Stat(out)
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, s.WriteToken("token"))
+	b, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Equal(t, "token", string(b))
+}
+
+func TestExecSink_Errors(t *testing.T) {
+	_, err := testExecSink(t, map[string]interface{}{})
+	require.Error(t, err)
+
+	_, err = testExecSink(t, map[string]interface{}{"command": []interface{}{}})
+	require.Error(t, err)
+
+	_, err = testExecSink(t, map[string]interface{}{"command": "vault-agent-sink-not-found"})
+	require.ErrorContains(t, err, "error during write check")
+
+	s, err := testExecSink(t, map[string]interface{}{
+		"command": []interface{}{"sh", "-c", "echo failed; exit 1"},
+	})
+	require.NoError(t, err)
+	require.ErrorContains(t, s.WriteToken("token"), "failed")
+
+	s, err = testExecSink(t, map[string]interface{}{
+		"command": []interface{}{"sleep", "10"},
+		"timeout": "100ms",
+	})
+	require.NoError(t, err)
+	require.ErrorContains(t, s.WriteToken("token"), "timed out")
+}
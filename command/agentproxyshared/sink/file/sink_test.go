@@ -156,3 +156,53 @@ func TestSinkServerRetry(t *testing.T) {
 		t.Fatal("should have reset tokenRenewalInProgress to false")
 	}
 }
+
+type closingSink struct {
+	closed atomic.Bool
+}
+
+func (c *closingSink) WriteToken(string) error {
+	return nil
+}
+
+func (c *closingSink) Close() error {
+	c.closed.Store(true)
+	return nil
+}
+
+// TestSinkServerClosesSinks tests that sinks implementing SinkCloser are
+// closed when the sink server stops.
+func TestSinkServerClosesSinks(t *testing.T) {
+	log := logging.NewVaultLogger(hclog.Trace)
+
+	c := &closingSink{}
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	ss := sink.NewSinkServer(&sink.SinkServerConfig{
+		Logger: log.Named("sink.server"),
+	})
+
+	in := make(chan string)
+	errCh := make(chan error)
+	go func() {
+		errCh <- ss.Run(ctx, in, []*sink.SinkConfig{{Sink: c}}, &atomic.Bool{})
+	}()
+
+	in <- "token"
+	if c.closed.Load() {
+		t.Fatal("sink closed before the sink server stopped")
+	}
+
+	cancelFunc()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("sink server did not stop")
+	}
+	if !c.closed.Load() {
+		t.Fatal("sink was not closed")
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/command/agentproxyshared/sink"
+)
+
+const (
+	defaultTokenPath     = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	defaultCACertPath    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+	defaultNamespacePath = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+	defaultKey           = "token"
+
+	requestTimeout = 30 * time.Second
+)
+
+// secretSink is a Sink implementation that writes a token to a key of a
+// Kubernetes Secret, so that it can be consumed by other pods without
+// sharing a volume.
+type secretSink struct {
+	host       string
+	namespace  string
+	name       string
+	key        string
+	tokenPath  string
+	httpClient *http.Client
+	logger     hclog.Logger
+}
+
+// NewSecretSink creates a new Kubernetes Secret sink with the given
+// configuration. By default the in-cluster configuration of the pod the
+// agent is running in is used to reach the Kubernetes API server.
+func NewSecretSink(conf *sink.SinkConfig) (sink.Sink, error) {
+	if conf.Logger == nil {
+		return nil, errors.New("nil logger provided")
+	}
+
+	conf.Logger.Info("creating kubernetes secret sink")
+
+	s := &secretSink{
+		key:       defaultKey,
+		tokenPath: defaultTokenPath,
+		logger:    conf.Logger,
+	}
+	caCertPath := defaultCACertPath
+	namespacePath := defaultNamespacePath
+
+	var err error
+	if s.name, err = stringValue(conf.Config, "secret_name"); err != nil {
+		return nil, err
+	}
+	if s.name == "" {
+		return nil, errors.New("'secret_name' not specified for kubernetes secret sink")
+	}
+	if s.namespace, err = stringValue(conf.Config, "namespace"); err != nil {
+		return nil, err
+	}
+	if key, err := stringValue(conf.Config, "key"); err != nil {
+		return nil, err
+	} else if key != "" {
+		s.key = key
+	}
+	if tokenPath, err := stringValue(conf.Config, "token_path"); err != nil {
+		return nil, err
+	} else if tokenPath != "" {
+		s.tokenPath = tokenPath
+	}
+	if path, err := stringValue(conf.Config, "ca_cert_path"); err != nil {
+		return nil, err
+	} else if path != "" {
+		caCertPath = path
+	}
+	if s.host, err = stringValue(conf.Config, "kubernetes_host"); err != nil {
+		return nil, err
+	}
+
+	if s.namespace == "" {
+		namespace, err := os.ReadFile(namespacePath)
+		if err != nil {
+			return nil, fmt.Errorf("'namespace' not specified and could not be read from %s: %w", namespacePath, err)
+		}
+		s.namespace = strings.TrimSpace(string(namespace))
+	}
+
+	if s.host == "" {
+		host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+		if host == "" || port == "" {
+			return nil, errors.New("'kubernetes_host' not specified and not running in a kubernetes cluster")
+		}
+		s.host = "https://" + net.JoinHostPort(host, port)
+	}
+	if _, err := url.Parse(s.host); err != nil {
+		return nil, fmt.Errorf("error parsing 'kubernetes_host': %w", err)
+	}
+	s.host = strings.TrimSuffix(s.host, "/")
+
+	transport := cleanhttp.DefaultPooledTransport()
+	if strings.HasPrefix(s.host, "https://") {
+		caCert, err := os.ReadFile(caCertPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading kubernetes CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid certificates found in %s", caCertPath)
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+	s.httpClient = &http.Client{
+		Transport: transport,
+		Timeout:   requestTimeout,
+	}
+
+	if err := s.WriteToken(""); err != nil {
+		return nil, fmt.Errorf("error during write check: %w", err)
+	}
+
+	s.logger.Info("kubernetes secret sink configured", "host", s.host, "namespace", s.namespace, "secret_name", s.name, "key", s.key)
+
+	return s, nil
+}
+
+// WriteToken implements the Sink interface and writes the token to the
+// configured key of the Secret, creating the Secret if it doesn't exist.
+// Other keys of an existing Secret are left untouched. If a blank token is
+// passed in, it checks that the Secret can be read but doesn't modify it.
+func (s *secretSink) WriteToken(token string) error {
+	s.logger.Trace("enter write_token", "secret_name", s.name)
+	defer s.logger.Trace("exit write_token", "secret_name", s.name)
+
+	secretsURL := fmt.Sprintf("%s/api/v1/namespaces/%s/secrets", s.host, url.PathEscape(s.namespace))
+	secretURL := secretsURL + "/" + url.PathEscape(s.name)
+
+	if token == "" {
+		status, err := s.do(http.MethodGet, secretURL, "", nil)
+		if err != nil {
+			return err
+		}
+		if status != http.StatusOK && status != http.StatusNotFound {
+			return fmt.Errorf("unexpected status reading secret %s/%s: %d", s.namespace, s.name, status)
+		}
+		return nil
+	}
+
+	data := map[string]string{
+		s.key: base64.StdEncoding.EncodeToString([]byte(token)),
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{"data": data})
+	if err != nil {
+		return err
+	}
+	status, err := s.do(http.MethodPatch, secretURL, "application/merge-patch+json", patch)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusNotFound {
+		secret, err := json.Marshal(map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"type":       "Opaque",
+			"metadata": map[string]interface{}{
+				"name":      s.name,
+				"namespace": s.namespace,
+				"labels": map[string]string{
+					"app.kubernetes.io/managed-by": "vault-agent",
+				},
+			},
+			"data": data,
+		})
+		if err != nil {
+			return err
+		}
+		status, err = s.do(http.MethodPost, secretsURL, "application/json", secret)
+		if err != nil {
+			return err
+		}
+	}
+	if status != http.StatusOK && status != http.StatusCreated {
+		return fmt.Errorf("unexpected status writing secret %s/%s: %d", s.namespace, s.name, status)
+	}
+
+	s.logger.Info("token written", "namespace", s.namespace, "secret_name", s.name)
+	return nil
+}
+
+// do makes a request to the Kubernetes API server, authenticated with the
+// service account token, and returns the response status code. The token is
+// read on each request as projected service account tokens are rotated.
+func (s *secretSink) do(method, url, contentType string, body []byte) (int, error) {
+	saToken, err := os.ReadFile(s.tokenPath)
+	if err != nil {
+		return 0, fmt.Errorf("error reading service account token: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(saToken)))
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error making %s request to kubernetes: %w", method, err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode, nil
+}
+
+func stringValue(config map[string]interface{}, key string) (string, error) {
+	raw, ok := config[key]
+	if !ok {
+		return "", nil
+	}
+	value, ok := raw.(string)
+	if !ok {
+		return "", fmt.Errorf("could not parse '%s' as string", key)
+	}
+	return value, nil
+}
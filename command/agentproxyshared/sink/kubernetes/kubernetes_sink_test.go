@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package kubernetes
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/command/agentproxyshared/sink"
+	"github.com/stretchr/testify/require"
+)
+
+// testAPIServer is a minimal stand-in for the Kubernetes API server that
+// stores secrets in memory.
+type testAPIServer struct {
+	*httptest.Server
+
+	l       sync.Mutex
+	secrets map[string]map[string]string
+	methods []string
+}
+
+func newTestAPIServer(t *testing.T) *testAPIServer {
+	t.Helper()
+
+	a := &testAPIServer{secrets: make(map[string]map[string]string)}
+	a.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.l.Lock()
+		defer a.l.Unlock()
+
+		if r.Header.Get("Authorization") != "Bearer sa-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		a.methods = append(a.methods, r.Method)
+
+		var body struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+			Data map[string]string `json:"data"`
+		}
+		if r.Body != nil {
+			json.NewDecoder(r.Body).Decode(&body)
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/namespaces/ns/secrets":
+			a.secrets[body.Metadata.Name] = body.Data
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/api/v1/namespaces/ns/secrets/app-token":
+			secret, ok := a.secrets["app-token"]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if r.Method == http.MethodPatch {
+				require.Equal(t, "application/merge-patch+json", r.Header.Get("Content-Type"))
+				for k, v := range body.Data {
+					secret[k] = v
+				}
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	t.Cleanup(a.Close)
+
+	return a
+}
+
+func testSinkConfig(t *testing.T, host string, cert []byte) *sink.SinkConfig {
+	t.Helper()
+
+	dir := t.TempDir()
+	tokenPath := filepath.Join(dir, "token")
+	caCertPath := filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("sa-token\n"), 0o600))
+	require.NoError(t, os.WriteFile(caCertPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}), 0o600))
+
+	return &sink.SinkConfig{
+		Logger: hclog.NewNullLogger(),
+		Config: map[string]interface{}{
+			"kubernetes_host": host,
+			"namespace":       "ns",
+			"secret_name":     "app-token",
+			"token_path":      tokenPath,
+			"ca_cert_path":    caCertPath,
+		},
+	}
+}
+
+func TestKubernetesSecretSink(t *testing.T) {
+	api := newTestAPIServer(t)
+
+	s, err := NewSecretSink(testSinkConfig(t, api.URL, api.Certificate().Raw))
+	require.NoError(t, err)
+	require.Equal(t, []string{http.MethodGet}, api.methods)
+
+	// The secret is created on the first write
+	require.NoError(t, s.WriteToken("token-1"))
+	require.Equal(t, map[string]string{"token": "dG9rZW4tMQ=="}, api.secrets["app-token"])
+
+	// Subsequent writes only patch the configured key
+	api.secrets["app-token"]["other"] = "b3RoZXI="
+	require.NoError(t, s.WriteToken("token-2"))
+	require.Equal(t, map[string]string{"token": "dG9rZW4tMg==", "other": "b3RoZXI="}, api.secrets["app-token"])
+	require.Equal(t, []string{http.MethodGet, http.MethodPatch, http.MethodPost, http.MethodPatch}, api.methods)
+}
+
+func TestKubernetesSecretSink_Config(t *testing.T) {
+	api := newTestAPIServer(t)
+
+	conf := testSinkConfig(t, api.URL, api.Certificate().Raw)
+	delete(conf.Config, "secret_name")
+	_, err := NewSecretSink(conf)
+	require.Error(t, err)
+
+	conf = testSinkConfig(t, api.URL, api.Certificate().Raw)
+	conf.Config["key"] = 1
+	_, err = NewSecretSink(conf)
+	require.Error(t, err)
+
+	// The write check fails if the secret can't be read
+	conf = testSinkConfig(t, api.URL, api.Certificate().Raw)
+	conf.Config["namespace"] = "other"
+	_, err = NewSecretSink(conf)
+	require.Error(t, err)
+}
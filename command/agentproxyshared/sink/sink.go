@@ -27,6 +27,12 @@ type SinkReader interface {
 	Token() string
 }
 
+// SinkCloser is implemented by sinks that hold resources, such as listeners,
+// that must be released. The sink server closes them when it stops.
+type SinkCloser interface {
+	Close() error
+}
+
 type SinkConfig struct {
 	Sink
 	Logger             hclog.Logger
@@ -102,6 +108,13 @@ func (ss *SinkServer) Run(ctx context.Context, incoming chan string, sinks []*Si
 	ss.logger.Info("starting sink server")
 	defer func() {
 		tokenWriteInProgress.Store(false)
+		for _, s := range sinks {
+			if closer, ok := s.Sink.(SinkCloser); ok {
+				if err := closer.Close(); err != nil {
+					ss.logger.Error("error closing sink", "error", err)
+				}
+			}
+		}
 		ss.logger.Info("sink server stopped")
 	}()
 
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build darwin
+
+package socket
+
+import (
+	"errors"
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+// peerCredentialsSupported is whether peer credentials can be read on this
+// platform.
+const peerCredentialsSupported = true
+
+// peerCredentials returns the user and primary group IDs of the process on
+// the other end of the given Unix socket connection.
+func peerCredentials(conn net.Conn) (int, int, error) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return 0, 0, errors.New("not a unix socket connection")
+	}
+	rawConn, err := unixConn.SyscallConn()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var cred *unix.Xucred
+	var credErr error
+	if err := rawConn.Control(func(fd uintptr) {
+		cred, credErr = unix.GetsockoptXucred(int(fd), unix.SOL_LOCAL, unix.LOCAL_PEERCRED)
+	}); err != nil {
+		return 0, 0, err
+	}
+	if credErr != nil {
+		return 0, 0, credErr
+	}
+	if cred.Ngroups == 0 {
+		return 0, 0, errors.New("peer credentials have no groups")
+	}
+
+	return int(cred.Uid), int(cred.Groups[0]), nil
+}
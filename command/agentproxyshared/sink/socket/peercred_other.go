@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !linux && !darwin
+
+package socket
+
+import (
+	"errors"
+	"net"
+)
+
+// peerCredentialsSupported is whether peer credentials can be read on this
+// platform.
+const peerCredentialsSupported = false
+
+// peerCredentials is not supported on this platform, so every connection is
+// rejected.
+func peerCredentials(net.Conn) (int, int, error) {
+	return 0, 0, errors.New("peer credentials are not supported on this platform")
+}
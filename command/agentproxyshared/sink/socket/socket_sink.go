@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package socket
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/command/agentproxyshared/sink"
+)
+
+// writeTimeout is the time a peer has to read the token before its
+// connection is closed.
+const writeTimeout = 5 * time.Second
+
+// socketSink is a Sink implementation that serves the token over a Unix
+// socket. Each connection is sent the current token and then closed, after
+// checking the credentials of the connecting process.
+type socketSink struct {
+	path        string
+	mode        os.FileMode
+	owner       int
+	group       int
+	allowedUIDs []int
+	allowedGIDs []int
+	logger      hclog.Logger
+	listener    net.Listener
+
+	l     sync.RWMutex
+	token string
+}
+
+// NewSocketSink creates a new Unix socket sink with the given configuration
+// and starts listening on the socket. Unless allowed_uids or allowed_gids
+// are configured, only processes running as the same user as the agent may
+// read the token.
+func NewSocketSink(conf *sink.SinkConfig) (sink.Sink, error) {
+	if conf.Logger == nil {
+		return nil, errors.New("nil logger provided")
+	}
+
+	if !peerCredentialsSupported {
+		return nil, errors.New("unix socket sink is not supported on this platform")
+	}
+
+	conf.Logger.Info("creating unix socket sink")
+
+	s := &socketSink{
+		logger: conf.Logger,
+		mode:   0o600,
+		owner:  os.Getuid(),
+		group:  os.Getgid(),
+	}
+
+	pathRaw, ok := conf.Config["path"]
+	if !ok {
+		return nil, errors.New("'path' not specified for unix socket sink")
+	}
+	path, ok := pathRaw.(string)
+	if !ok {
+		return nil, errors.New("could not parse 'path' as string")
+	}
+	s.path = path
+
+	if modeRaw, ok := conf.Config["mode"]; ok {
+		mode, typeOK := modeRaw.(int)
+		if !typeOK {
+			return nil, errors.New("could not parse 'mode' as integer")
+		}
+		if mode&^int(fs.ModePerm) != 0 {
+			return nil, errors.New("socket mode may only contain permission bits")
+		}
+
+		s.logger.Debug("overriding default unix socket sink", "mode", mode)
+		s.mode = os.FileMode(mode)
+	}
+
+	if ownerRaw, ok := conf.Config["owner"]; ok {
+		owner, typeOK := ownerRaw.(int)
+		if !typeOK {
+			return nil, errors.New("could not parse 'owner' as integer")
+		}
+
+		s.logger.Debug("overriding default unix socket sink", "owner", owner)
+		s.owner = owner
+	}
+
+	if groupRaw, ok := conf.Config["group"]; ok {
+		group, typeOK := groupRaw.(int)
+		if !typeOK {
+			return nil, errors.New("could not parse 'group' as integer")
+		}
+
+		s.logger.Debug("overriding default unix socket sink", "group", group)
+		s.group = group
+	}
+
+	var err error
+	if s.allowedUIDs, err = intSlice(conf.Config, "allowed_uids"); err != nil {
+		return nil, err
+	}
+	if s.allowedGIDs, err = intSlice(conf.Config, "allowed_gids"); err != nil {
+		return nil, err
+	}
+	if len(s.allowedUIDs) == 0 && len(s.allowedGIDs) == 0 {
+		s.allowedUIDs = []int{os.Getuid()}
+	}
+
+	if err := s.listen(); err != nil {
+		return nil, err
+	}
+	go s.serve()
+
+	s.logger.Info("unix socket sink configured", "path", s.path, "mode", s.mode, "owner", s.owner, "group", s.group,
+		"allowed_uids", s.allowedUIDs, "allowed_gids", s.allowedGIDs)
+
+	return s, nil
+}
+
+// listen creates the socket, replacing a stale socket left behind by a
+// previous run, and sets its ownership and permissions.
+func (s *socketSink) listen() error {
+	if fi, err := os.Lstat(s.path); err == nil {
+		if fi.Mode().Type() != fs.ModeSocket {
+			return fmt.Errorf("%s exists and is not a socket", s.path)
+		}
+		if err := os.Remove(s.path); err != nil {
+			return fmt.Errorf("error removing stale socket %s: %w", s.path, err)
+		}
+	}
+
+	listener, err := net.Listen("unix", s.path)
+	if err != nil {
+		return fmt.Errorf("error listening on %s: %w", s.path, err)
+	}
+	if err := os.Chmod(s.path, s.mode); err != nil {
+		listener.Close()
+		return fmt.Errorf("error changing mode of %s: %w", s.path, err)
+	}
+	if s.owner != os.Getuid() || s.group != os.Getgid() {
+		if err := os.Lchown(s.path, s.owner, s.group); err != nil {
+			listener.Close()
+			return fmt.Errorf("error changing ownership of %s: %w", s.path, err)
+		}
+	}
+
+	s.listener = listener
+	return nil
+}
+
+func (s *socketSink) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			s.logger.Error("error accepting connection", "error", err)
+			continue
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *socketSink) handle(conn net.Conn) {
+	defer conn.Close()
+
+	uid, gid, err := peerCredentials(conn)
+	if err != nil {
+		s.logger.Error("error reading peer credentials", "error", err)
+		return
+	}
+	if !slices.Contains(s.allowedUIDs, uid) && !slices.Contains(s.allowedGIDs, gid) {
+		s.logger.Warn("rejected connection from unauthorized peer", "uid", uid, "gid", gid)
+		return
+	}
+
+	s.l.RLock()
+	token := s.token
+	s.l.RUnlock()
+
+	if token == "" {
+		return
+	}
+
+	conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if _, err := conn.Write([]byte(token)); err != nil {
+		s.logger.Debug("error writing token to peer", "uid", uid, "error", err)
+		return
+	}
+	s.logger.Trace("token served", "uid", uid, "gid", gid)
+}
+
+// WriteToken implements the Sink interface and sets the token that's served
+// to new connections. A blank token is a write check and is ignored.
+func (s *socketSink) WriteToken(token string) error {
+	s.logger.Trace("enter write_token", "path", s.path)
+	defer s.logger.Trace("exit write_token", "path", s.path)
+
+	if token == "" {
+		return nil
+	}
+
+	s.l.Lock()
+	s.token = token
+	s.l.Unlock()
+
+	s.logger.Info("token written", "path", s.path)
+	return nil
+}
+
+// Close stops listening and removes the socket.
+func (s *socketSink) Close() error {
+	if err := s.listener.Close(); err != nil {
+		return err
+	}
+	if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func intSlice(config map[string]interface{}, key string) ([]int, error) {
+	raw, ok := config[key]
+	if !ok {
+		return nil, nil
+	}
+
+	switch raw := raw.(type) {
+	case []int:
+		return raw, nil
+	case []interface{}:
+		values := make([]int, 0, len(raw))
+		for _, v := range raw {
+			i, ok := v.(int)
+			if !ok {
+				return nil, fmt.Errorf("could not parse '%s' as list of integers", key)
+			}
+			values = append(values, i)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("could not parse '%s' as list of integers", key)
+	}
+}
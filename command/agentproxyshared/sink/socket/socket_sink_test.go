@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build linux || darwin
+
+package socket
+
+import (
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/command/agentproxyshared/sink"
+	"github.com/stretchr/testify/require"
+)
+
+func testSocketSink(t *testing.T, config map[string]interface{}) (*socketSink, string) {
+	t.Helper()
+
+	// Socket paths are limited in length, so avoid the long t.TempDir paths
+	dir, err := os.MkdirTemp("", "sink")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "agent.sock")
+	config["path"] = path
+
+	s, err := NewSocketSink(&sink.SinkConfig{
+		Logger: hclog.NewNullLogger(),
+		Config: config,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { s.(*socketSink).Close() })
+
+	return s.(*socketSink), path
+}
+
+func readSocket(t *testing.T, path string) string {
+	t.Helper()
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	b, err := io.ReadAll(conn)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestSocketSink(t *testing.T) {
+	s, path := testSocketSink(t, map[string]interface{}{})
+
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.ModeSocket|0o600, fi.Mode())
+
+	// Nothing is served until a token is written
+	require.Equal(t, "", readSocket(t, path))
+
+	require.NoError(t, s.WriteToken("token-1"))
+	require.Equal(t, "token-1", readSocket(t, path))
+
+	require.NoError(t, s.WriteToken(""))
+	require.NoError(t, s.WriteToken("token-2"))
+	require.Equal(t, "token-2", readSocket(t, path))
+}
+
+func TestSocketSink_PeerCredentials(t *testing.T) {
+	s, path := testSocketSink(t, map[string]interface{}{
+		"allowed_uids": []interface{}{os.Getuid() + 1},
+	})
+	require.NoError(t, s.WriteToken("token"))
+	require.Equal(t, "", readSocket(t, path))
+
+	s, path = testSocketSink(t, map[string]interface{}{
+		"allowed_uids": []interface{}{os.Getuid() + 1},
+		"allowed_gids": []interface{}{os.Getgid()},
+	})
+	require.NoError(t, s.WriteToken("token"))
+	require.Equal(t, "token", readSocket(t, path))
+}
+
+func TestSocketSink_StaleSocket(t *testing.T) {
+	s, path := testSocketSink(t, map[string]interface{}{"mode": 0o660})
+	s.listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, s.listener.Close())
+	_, err := os.Stat(path)
+	require.NoError(t, err)
+
+	// A socket left behind by a previous run is replaced
+	replacement, err := NewSocketSink(&sink.SinkConfig{
+		Logger: hclog.NewNullLogger(),
+		Config: map[string]interface{}{"path": path},
+	})
+	require.NoError(t, err)
+	require.NoError(t, replacement.(*socketSink).Close())
+
+	// Other files are never removed
+	file := filepath.Join(filepath.Dir(path), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+	_, err = NewSocketSink(&sink.SinkConfig{
+		Logger: hclog.NewNullLogger(),
+		Config: map[string]interface{}{"path": file},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,375 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package upstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	metrics "github.com/hashicorp/go-metrics/compat"
+	"github.com/hashicorp/vault/api"
+)
+
+const (
+	// DefaultHealthCheckInterval is the default interval between active
+	// health checks of each upstream Vault server.
+	DefaultHealthCheckInterval = 10 * time.Second
+
+	// DefaultHealthCheckTimeout is the default timeout of a single health
+	// check request.
+	DefaultHealthCheckTimeout = 5 * time.Second
+)
+
+// ManagerConfig is the configuration of the upstream Manager.
+type ManagerConfig struct {
+	// Client is used to make health check requests. It is cloned for each
+	// upstream address, so that the TLS configuration is shared.
+	Client *api.Client
+
+	// Addresses are the addresses of the upstream Vault servers, in
+	// order of preference.
+	Addresses []string
+
+	Logger              hclog.Logger
+	HealthCheckInterval time.Duration
+	HealthCheckTimeout  time.Duration
+
+	// MetricsSignifier is the first argument that will be provided to
+	// metrics calls, signifying the name of the application, e.g. "agent"
+	// or "proxy".
+	MetricsSignifier string
+}
+
+// Manager tracks the health of a set of upstream Vault servers and selects
+// the server that requests are sent to. Reads are sent to the most preferred
+// healthy server. Writes are sent to the active node of the cluster for as
+// long as it remains healthy, so that they aren't forwarded by a standby.
+type Manager struct {
+	logger              hclog.Logger
+	healthCheckInterval time.Duration
+	healthCheckTimeout  time.Duration
+	metricsSignifier    string
+
+	l         sync.RWMutex
+	upstreams []*upstreamState
+	current   string
+	leader    string
+	clients   []*api.Client
+}
+
+type upstreamState struct {
+	address string
+	client  *api.Client
+
+	// healthy and active are guarded by the Manager's lock. Upstreams
+	// are presumed healthy until a health check says otherwise, so that
+	// the most preferred address is used before the first check completes.
+	healthy bool
+	active  bool
+}
+
+// Status is the last known state of an upstream Vault server.
+type Status struct {
+	Address string
+	Healthy bool
+	Active  bool
+	Current bool
+}
+
+// NewManager creates a new upstream Manager. Run must be called to start
+// active health checking.
+func NewManager(conf *ManagerConfig) (*Manager, error) {
+	if conf == nil {
+		return nil, errors.New("nil configuration provided")
+	}
+	if conf.Client == nil {
+		return nil, errors.New("nil client provided")
+	}
+	if len(conf.Addresses) == 0 {
+		return nil, errors.New("at least one upstream address is required")
+	}
+	if conf.Logger == nil {
+		return nil, errors.New("nil logger provided")
+	}
+
+	m := &Manager{
+		logger:              conf.Logger,
+		healthCheckInterval: conf.HealthCheckInterval,
+		healthCheckTimeout:  conf.HealthCheckTimeout,
+		metricsSignifier:    conf.MetricsSignifier,
+	}
+	if m.healthCheckInterval <= 0 {
+		m.healthCheckInterval = DefaultHealthCheckInterval
+	}
+	if m.healthCheckTimeout <= 0 {
+		m.healthCheckTimeout = DefaultHealthCheckTimeout
+	}
+	if m.metricsSignifier == "" {
+		m.metricsSignifier = "agent"
+	}
+
+	seen := make(map[string]struct{}, len(conf.Addresses))
+	for _, address := range conf.Addresses {
+		if _, ok := seen[address]; ok {
+			return nil, fmt.Errorf("duplicate upstream address %q", address)
+		}
+		seen[address] = struct{}{}
+
+		client, err := conf.Client.Clone()
+		if err != nil {
+			return nil, fmt.Errorf("error cloning client for upstream %q: %w", address, err)
+		}
+		if err := client.SetAddress(address); err != nil {
+			return nil, fmt.Errorf("invalid upstream address %q: %w", address, err)
+		}
+		client.ClearToken()
+		client.SetMaxRetries(0)
+
+		m.upstreams = append(m.upstreams, &upstreamState{
+			address: address,
+			client:  client,
+			healthy: true,
+		})
+	}
+	m.current = conf.Addresses[0]
+	m.emitMetricsLocked()
+
+	return m, nil
+}
+
+// Run checks the health of the upstream servers every health check interval
+// until the context is cancelled.
+func (m *Manager) Run(ctx context.Context) {
+	m.logger.Info("starting upstream health checks", "upstreams", len(m.upstreams), "interval", m.healthCheckInterval)
+
+	ticker := time.NewTicker(m.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		m.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			m.logger.Info("upstream health checks stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check checks the health of each upstream server concurrently and updates
+// the selected upstreams.
+func (m *Manager) Check(ctx context.Context) {
+	type result struct {
+		healthy bool
+		active  bool
+	}
+	results := make([]result, len(m.upstreams))
+
+	var wg sync.WaitGroup
+	for i, u := range m.upstreams {
+		wg.Add(1)
+		go func(i int, u *upstreamState) {
+			defer wg.Done()
+			results[i].healthy, results[i].active = m.checkUpstream(ctx, u)
+		}(i, u)
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	for i, u := range m.upstreams {
+		if u.healthy != results[i].healthy {
+			m.logger.Info("upstream health changed", "address", u.address, "healthy", results[i].healthy)
+		}
+		u.healthy, u.active = results[i].healthy, results[i].active
+	}
+	m.updateLocked()
+}
+
+// checkUpstream returns whether the given upstream is healthy and whether it
+// is the active node of its cluster, according to its sys/health endpoint.
+func (m *Manager) checkUpstream(ctx context.Context, u *upstreamState) (bool, bool) {
+	ctx, cancel := context.WithTimeout(ctx, m.healthCheckTimeout)
+	defer cancel()
+
+	health, err := u.client.Sys().HealthWithContext(ctx)
+	if err != nil {
+		m.logger.Debug("upstream health check failed", "address", u.address, "error", err)
+		return false, false
+	}
+
+	switch {
+	case !health.Initialized, health.Sealed:
+		return false, false
+	case health.ReplicationDRMode == "secondary":
+		// DR secondaries can't serve client requests
+		return false, false
+	case health.RemovedFromCluster != nil && *health.RemovedFromCluster:
+		return false, false
+	}
+
+	return true, !health.Standby
+}
+
+// MarkFailed marks the given upstream as unhealthy until its next successful
+// health check. It's used to fail over as soon as a request to the upstream
+// fails to connect, rather than waiting for the next health check.
+func (m *Manager) MarkFailed(address string, err error) {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	for _, u := range m.upstreams {
+		if u.address == address && u.healthy {
+			m.logger.Warn("request to upstream failed, marking unhealthy", "address", address, "error", err)
+			u.healthy = false
+			u.active = false
+			m.updateLocked()
+			return
+		}
+	}
+}
+
+// Address returns the address that read requests should be sent to.
+func (m *Manager) Address() string {
+	m.l.RLock()
+	defer m.l.RUnlock()
+
+	return m.current
+}
+
+// WriteAddress returns the address that write requests should be sent to.
+// This is the last known active node if it's still healthy, and otherwise
+// the same address as Address.
+func (m *Manager) WriteAddress() string {
+	m.l.RLock()
+	defer m.l.RUnlock()
+
+	if m.leader != "" {
+		return m.leader
+	}
+	return m.current
+}
+
+// Register keeps the address of the given client pointed at WriteAddress.
+// It's meant for long-lived clients that mostly write, such as the client
+// used by auto-auth to log in and renew its token.
+func (m *Manager) Register(client *api.Client) error {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	if err := client.SetAddress(m.writeAddressLocked()); err != nil {
+		return err
+	}
+	m.clients = append(m.clients, client)
+
+	return nil
+}
+
+// Status returns the last known state of each upstream, in order of
+// preference.
+func (m *Manager) Status() []Status {
+	m.l.RLock()
+	defer m.l.RUnlock()
+
+	status := make([]Status, 0, len(m.upstreams))
+	for _, u := range m.upstreams {
+		status = append(status, Status{
+			Address: u.address,
+			Healthy: u.healthy,
+			Active:  u.active,
+			Current: u.address == m.current,
+		})
+	}
+
+	return status
+}
+
+func (m *Manager) writeAddressLocked() string {
+	if m.leader != "" {
+		return m.leader
+	}
+	return m.current
+}
+
+// updateLocked selects the current upstream and the active node after the
+// health of an upstream changes. It must be called with the lock held.
+func (m *Manager) updateLocked() {
+	previousWriteAddress := m.writeAddressLocked()
+
+	// The current upstream is the most preferred healthy upstream. If no
+	// upstream is healthy, keep the current one rather than cycling
+	// through unhealthy upstreams.
+	current := m.current
+	var currentHealthy bool
+	for _, u := range m.upstreams {
+		if u.healthy {
+			current = u.address
+			currentHealthy = true
+			break
+		}
+	}
+	if !currentHealthy {
+		m.logger.Warn("no healthy upstreams available", "current", m.current)
+	}
+	if current != m.current {
+		m.logger.Info("failing over to upstream", "from", m.current, "to", current)
+		metrics.IncrCounter([]string{m.metricsSignifier, "upstream", "failover"}, 1)
+		m.current = current
+	}
+
+	// The leader is sticky: it's only replaced once it's no longer
+	// healthy and active.
+	var leaderActive bool
+	for _, u := range m.upstreams {
+		if u.address == m.leader && u.healthy && u.active {
+			leaderActive = true
+			break
+		}
+	}
+	if !leaderActive {
+		m.leader = ""
+		for _, u := range m.upstreams {
+			if u.healthy && u.active {
+				m.leader = u.address
+				break
+			}
+		}
+	}
+
+	if writeAddress := m.writeAddressLocked(); writeAddress != previousWriteAddress {
+		for _, client := range m.clients {
+			if err := client.SetAddress(writeAddress); err != nil {
+				m.logger.Error("error updating client address", "address", writeAddress, "error", err)
+			}
+		}
+	}
+
+	m.emitMetricsLocked()
+}
+
+func (m *Manager) emitMetricsLocked() {
+	for _, u := range m.upstreams {
+		labels := []metrics.Label{{Name: "address", Value: u.address}}
+
+		var healthy, current float32
+		if u.healthy {
+			healthy = 1
+		}
+		if u.address == m.current {
+			current = 1
+		}
+		metrics.SetGaugeWithLabels([]string{m.metricsSignifier, "upstream", "healthy"}, healthy, labels)
+		metrics.SetGaugeWithLabels([]string{m.metricsSignifier, "upstream", "current"}, current, labels)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package upstream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/require"
+)
+
+// testUpstream is a fake Vault server whose sys/health response can be
+// changed during a test.
+type testUpstream struct {
+	*httptest.Server
+
+	l      sync.Mutex
+	health *api.HealthResponse
+}
+
+func newTestUpstream(t *testing.T, standby bool) *testUpstream {
+	t.Helper()
+
+	u := &testUpstream{
+		health: &api.HealthResponse{Initialized: true, Standby: standby},
+	}
+	u.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u.l.Lock()
+		defer u.l.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(u.health)
+	}))
+	t.Cleanup(u.Close)
+
+	return u
+}
+
+func (u *testUpstream) setHealth(health *api.HealthResponse) {
+	u.l.Lock()
+	defer u.l.Unlock()
+	u.health = health
+}
+
+func testManager(t *testing.T, addresses ...string) *Manager {
+	t.Helper()
+
+	client, err := api.NewClient(api.DefaultConfig())
+	require.NoError(t, err)
+
+	m, err := NewManager(&ManagerConfig{
+		Client:    client,
+		Addresses: addresses,
+		Logger:    hclog.NewNullLogger(),
+	})
+	require.NoError(t, err)
+	return m
+}
+
+func TestNewManager(t *testing.T) {
+	client, err := api.NewClient(api.DefaultConfig())
+	require.NoError(t, err)
+
+	_, err = NewManager(&ManagerConfig{Client: client, Logger: hclog.NewNullLogger()})
+	require.Error(t, err)
+
+	_, err = NewManager(&ManagerConfig{
+		Client:    client,
+		Addresses: []string{"https://a:8200", "https://a:8200"},
+		Logger:    hclog.NewNullLogger(),
+	})
+	require.Error(t, err)
+
+	// The most preferred address is used before the first health check
+	m := testManager(t, "https://a:8200", "https://b:8200")
+	require.Equal(t, "https://a:8200", m.Address())
+	require.Equal(t, "https://a:8200", m.WriteAddress())
+}
+
+// TestManager_Failover tests that requests fail over to the next healthy
+// upstream in order of preference, and fail back once it recovers
+func TestManager_Failover(t *testing.T) {
+	a := newTestUpstream(t, true)
+	b := newTestUpstream(t, false)
+	c := newTestUpstream(t, true)
+	m := testManager(t, a.URL, b.URL, c.URL)
+	ctx := context.Background()
+
+	// Reads go to the most preferred upstream, writes to the active node
+	m.Check(ctx)
+	require.Equal(t, a.URL, m.Address())
+	require.Equal(t, b.URL, m.WriteAddress())
+
+	// Sealed upstreams are unhealthy
+	a.setHealth(&api.HealthResponse{Initialized: true, Sealed: true})
+	m.Check(ctx)
+	require.Equal(t, b.URL, m.Address())
+	require.Equal(t, b.URL, m.WriteAddress())
+
+	// The leader is sticky while it's healthy and active, even if a more
+	// preferred upstream becomes active
+	a.setHealth(&api.HealthResponse{Initialized: true})
+	m.Check(ctx)
+	require.Equal(t, a.URL, m.Address())
+	require.Equal(t, b.URL, m.WriteAddress())
+
+	// Writes fail over once the leader steps down
+	b.setHealth(&api.HealthResponse{Initialized: true, Standby: true})
+	m.Check(ctx)
+	require.Equal(t, a.URL, m.WriteAddress())
+
+	// Unreachable upstreams are unhealthy
+	a.Close()
+	m.Check(ctx)
+	require.Equal(t, b.URL, m.Address())
+	require.Equal(t, b.URL, m.WriteAddress())
+
+	status := m.Status()
+	require.Len(t, status, 3)
+	require.False(t, status[0].Healthy)
+	require.True(t, status[1].Healthy)
+	require.True(t, status[1].Current)
+}
+
+// TestManager_MarkFailed tests that failed requests fail over without
+// waiting for the next health check, and that registered clients follow
+// the write address
+func TestManager_MarkFailed(t *testing.T) {
+	a := newTestUpstream(t, false)
+	b := newTestUpstream(t, true)
+	m := testManager(t, a.URL, b.URL)
+	m.Check(context.Background())
+
+	client, err := api.NewClient(api.DefaultConfig())
+	require.NoError(t, err)
+	require.NoError(t, m.Register(client))
+	require.Equal(t, a.URL, client.Address())
+
+	// Marking an upstream that isn't selected has no effect on selection
+	m.MarkFailed("https://unknown:8200", nil)
+	require.Equal(t, a.URL, m.Address())
+
+	m.MarkFailed(a.URL, nil)
+	require.Equal(t, b.URL, m.Address())
+	require.Equal(t, b.URL, m.WriteAddress())
+	require.Equal(t, b.URL, client.Address())
+
+	// The upstream recovers on its next successful health check
+	m.Check(context.Background())
+	require.Equal(t, a.URL, m.Address())
+	require.Equal(t, a.URL, client.Address())
+
+	// If no upstream is healthy, the current upstream is kept
+	m.MarkFailed(a.URL, nil)
+	m.MarkFailed(b.URL, nil)
+	require.Equal(t, b.URL, m.Address())
+}
@@ -222,6 +222,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) map[string]cli.Co
 				BaseCommand: getBaseCommand(),
 			}, nil
 		},
+		"agent template": func() (cli.Command, error) {
+			return &AgentTemplateCommand{
+				BaseCommand: getBaseCommand(),
+			}, nil
+		},
+		"agent template render": func() (cli.Command, error) {
+			return &AgentTemplateRenderCommand{
+				BaseCommand: getBaseCommand(),
+			}, nil
+		},
 		"audit": func() (cli.Command, error) {
 			return &AuditCommand{
 				BaseCommand: getBaseCommand(),
@@ -417,6 +427,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) map[string]cli.Co
 				BaseCommand: getBaseCommand(),
 			}, nil
 		},
+		"operator raft compact": func() (cli.Command, error) {
+			return &OperatorRaftCompactCommand{
+				BaseCommand: getBaseCommand(),
+			}, nil
+		},
 		"operator raft list-peers": func() (cli.Command, error) {
 			return &OperatorRaftListPeersCommand{
 				BaseCommand: getBaseCommand(),
@@ -427,6 +442,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) map[string]cli.Co
 				BaseCommand: getBaseCommand(),
 			}, nil
 		},
+		"operator raft migrate-logstore": func() (cli.Command, error) {
+			return &OperatorRaftMigrateLogStoreCommand{
+				BaseCommand: getBaseCommand(),
+			}, nil
+		},
 		"operator raft remove-peer": func() (cli.Command, error) {
 			return &OperatorRaftRemovePeerCommand{
 				BaseCommand: getBaseCommand(),
@@ -437,6 +457,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) map[string]cli.Co
 				BaseCommand: getBaseCommand(),
 			}, nil
 		},
+		"operator raft snapshot diff": func() (cli.Command, error) {
+			return &OperatorRaftSnapshotDiffCommand{
+				BaseCommand: getBaseCommand(),
+			}, nil
+		},
 		"operator raft snapshot inspect": func() (cli.Command, error) {
 			return &OperatorRaftSnapshotInspectCommand{
 				BaseCommand: getBaseCommand(),
@@ -477,6 +502,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) map[string]cli.Co
 				BaseCommand: getBaseCommand(),
 			}, nil
 		},
+		"operator usage storage": func() (cli.Command, error) {
+			return &OperatorUsageStorageCommand{
+				BaseCommand: getBaseCommand(),
+			}, nil
+		},
 		"operator utilization": func() (cli.Command, error) {
 			return &OperatorUtilizationCommand{
 				BaseCommand: getBaseCommand(),
@@ -748,6 +778,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) map[string]cli.Co
 				BaseCommand: getBaseCommand(),
 			}, nil
 		},
+		"token list": func() (cli.Command, error) {
+			return &TokenListCommand{
+				BaseCommand: getBaseCommand(),
+			}, nil
+		},
 		"token lookup": func() (cli.Command, error) {
 			return &TokenLookupCommand{
 				BaseCommand: getBaseCommand(),
@@ -37,6 +37,7 @@ import (
 	physPostgreSQL "github.com/hashicorp/vault/physical/postgresql"
 	physS3 "github.com/hashicorp/vault/physical/s3"
 	physSpanner "github.com/hashicorp/vault/physical/spanner"
+	physSQLite "github.com/hashicorp/vault/physical/sqlite"
 	physSwift "github.com/hashicorp/vault/physical/swift"
 	physZooKeeper "github.com/hashicorp/vault/physical/zookeeper"
 	"github.com/hashicorp/vault/sdk/physical"
@@ -66,6 +67,7 @@ func newFullAddonHandlers() (map[string]physical.Factory, map[string]LoginHandle
 		"postgresql":            physPostgreSQL.NewPostgreSQLBackend,
 		"s3":                    physS3.NewS3Backend,
 		"spanner":               physSpanner.NewBackend,
+		"sqlite":                physSQLite.NewSQLiteBackend,
 		"swift":                 physSwift.NewSwiftBackend,
 		"zookeeper":             physZooKeeper.NewZooKeeperBackend,
 	}
@@ -239,7 +239,8 @@ func (c *OperatorGenerateRootCommand) Flags() *FlagSets {
 		Completion: complete.PredictAnything,
 		Usage: "Path to a file on disk containing a binary or base64-encoded " +
 			"public PGP key. This can also be specified as a Keybase username " +
-			"using the format \"keybase:<username>\". When supplied, the generated " +
+			"using the format \"keybase:<username>\", or as an age X25519 " +
+			"recipient or ssh-ed25519 public key. When supplied, the generated " +
 			"root token will be encrypted and base64-encoded with the given public " +
 			"key. Must be used with \"-init\".",
 	})
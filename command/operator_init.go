@@ -84,6 +84,14 @@ Usage: vault operator init [options]
 
       $ vault operator init -root-token-pgp-key="keybase:hashicorp"
 
+  Initialize, but encrypt the unseal keys with age recipients or ssh-ed25519
+  public keys instead:
+
+      $ vault operator init \
+          -key-shares=3 \
+          -key-threshold=2 \
+          -pgp-keys="age1...,./recipients.txt,$HOME/.ssh/id_ed25519.pub"
+
 ` + c.Flags().Help()
 	return strings.TrimSpace(helpText)
 }
@@ -127,7 +135,9 @@ func (c *OperatorInitCommand) Flags() *FlagSets {
 		Completion: complete.PredictAnything,
 		Usage: "Comma-separated list of paths to files on disk containing " +
 			"public PGP keys OR a comma-separated list of Keybase usernames using " +
-			"the format \"keybase:<username>\". When supplied, the generated " +
+			"the format \"keybase:<username>\". Age X25519 recipients and " +
+			"ssh-ed25519 public keys can also be given directly or as paths to " +
+			"files containing them. When supplied, the generated " +
 			"unseal keys will be encrypted and base64-encoded in the order " +
 			"specified in this list. The number of entries must match -key-shares, " +
 			"unless -stored-shares are used.",
@@ -139,7 +149,8 @@ func (c *OperatorInitCommand) Flags() *FlagSets {
 		Completion: complete.PredictAnything,
 		Usage: "Path to a file on disk containing a binary or base64-encoded " +
 			"public PGP key. This can also be specified as a Keybase username " +
-			"using the format \"keybase:<username>\". When supplied, the generated " +
+			"using the format \"keybase:<username>\", or as an age X25519 " +
+			"recipient or ssh-ed25519 public key. When supplied, the generated " +
 			"root token will be encrypted and base64-encoded with the given public " +
 			"key.",
 	})
@@ -190,6 +190,13 @@ func (c *OperatorMigrateCommand) migrate(config *migratorConfig) error {
 		return fmt.Errorf("error mounting 'storage_source': %w", err)
 	}
 
+	// Entries split into chunks are read whole, and their chunks aren't
+	// copied, so that they're chunked as configured by the destination
+	from, err = physical.NewEntryCodec(from, &physical.EntryCodecConfig{}, c.logger.Named("storage_source.codec"))
+	if err != nil {
+		return fmt.Errorf("error mounting 'storage_source': %w", err)
+	}
+
 	if c.flagReset {
 		if err := SetStorageMigration(from, false); err != nil {
 			return fmt.Errorf("error resetting migration lock: %w", err)
@@ -201,6 +208,14 @@ func (c *OperatorMigrateCommand) migrate(config *migratorConfig) error {
 	if err != nil {
 		return fmt.Errorf("error mounting 'storage_destination': %w", err)
 	}
+	if config.StorageDestination.EntryChunkSize > 0 {
+		to, err = physical.NewEntryCodec(to, &physical.EntryCodecConfig{
+			ChunkSize: config.StorageDestination.EntryChunkSize,
+		}, c.logger.Named("storage_destination.codec"))
+		if err != nil {
+			return fmt.Errorf("error mounting 'storage_destination': %w", err)
+		}
+	}
 
 	migrationStatus, err := CheckStorageMigration(from)
 	if err != nil {
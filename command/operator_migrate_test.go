@@ -353,6 +353,43 @@ storage_destination "raft" {
 		})
 	})
 
+	t.Run("Chunked entries", func(t *testing.T) {
+		fromDir, toDir := t.TempDir(), t.TempDir()
+		from, err := handlers.physicalBackends["file"](map[string]string{"path": fromDir}, nil)
+		require.NoError(t, err)
+		codec, err := physical.NewEntryCodec(from, &physical.EntryCodecConfig{ChunkSize: 1024}, log.NewNullLogger())
+		require.NoError(t, err)
+		large := make([]byte, 5000)
+		rand.Read(large)
+		require.NoError(t, codec.Put(context.Background(), &physical.Entry{Key: "large", Value: large}))
+
+		cmd := &OperatorMigrateCommand{
+			logger:           log.NewNullLogger(),
+			flagMaxParallel:  1,
+			PhysicalBackends: handlers.physicalBackends,
+		}
+		require.NoError(t, cmd.migrate(&migratorConfig{
+			StorageSource: &server.Storage{
+				Type:   "file",
+				Config: map[string]string{"path": fromDir},
+			},
+			StorageDestination: &server.Storage{
+				Type:   "file",
+				Config: map[string]string{"path": toDir},
+			},
+		}))
+
+		// Entries are copied whole, without their chunks
+		to, err := handlers.physicalBackends["file"](map[string]string{"path": toDir}, nil)
+		require.NoError(t, err)
+		entry, err := to.Get(context.Background(), "large")
+		require.NoError(t, err)
+		require.Equal(t, large, entry.Value)
+		hasChunks, err := physical.HasEntryChunks(context.Background(), to)
+		require.NoError(t, err)
+		require.False(t, hasChunks)
+	})
+
 	t.Run("DFS Scan", func(t *testing.T) {
 		s, _ := handlers.physicalBackends["inmem"](map[string]string{}, nil)
 
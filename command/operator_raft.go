@@ -43,6 +43,14 @@ Usage: vault operator raft <subcommand> [options] [args]
 
       $ vault operator raft snapshot save out.snap
 
+  Compacts the storage databases of the raft cluster:
+
+      $ vault operator raft compact
+
+  Converts the log store of a stopped node to raft-wal:
+
+      $ vault operator raft migrate-logstore -path=/opt/vault/data -to=wal
+
   Please see the individual subcommand help for detailed usage information.
 `
 
@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/vault/api"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*OperatorRaftCompactCommand)(nil)
+	_ cli.CommandAutocomplete = (*OperatorRaftCompactCommand)(nil)
+)
+
+// raftCompactPollInterval is how often the progress of a compaction is
+// checked while waiting for it.
+var raftCompactPollInterval = 2 * time.Second
+
+// raftCompactMaxPollErrors is how many consecutive errors checking the
+// progress of a compaction are tolerated, which happen while the active node
+// steps down.
+const raftCompactMaxPollErrors = 30
+
+type OperatorRaftCompactCommand struct {
+	*BaseCommand
+
+	flagNodeIDs     []string
+	flagMaxLag      int
+	flagNodeTimeout time.Duration
+	flagStatus      bool
+	flagDetach      bool
+}
+
+func (c *OperatorRaftCompactCommand) Synopsis() string {
+	return "Compacts the raft storage databases of the cluster"
+}
+
+func (c *OperatorRaftCompactCommand) Help() string {
+	helpText := `
+Usage: vault operator raft compact [options]
+
+  Rewrites the FSM database of each node in the raft cluster, returning the
+  space left behind by deleted data to the filesystem. Followers are compacted
+  one at a time, once they're caught up with the leader, and the active node
+  steps down to be compacted last. Nodes can't apply writes while they're
+  compacting, and catch up with the leader afterwards. Every node must be
+  running Vault 2.2.0 or later, as reported by autopilot.
+
+  Compact every node in the cluster, waiting for the compaction to finish:
+
+      $ vault operator raft compact
+
+  Compact a single node, without waiting:
+
+      $ vault operator raft compact -node-id=vault_2 -detach
+
+  Check the progress of the last compaction:
+
+      $ vault operator raft compact -status
+
+` + c.Flags().Help()
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *OperatorRaftCompactCommand) Flags() *FlagSets {
+	set := c.flagSet(FlagSetHTTP | FlagSetOutputFormat)
+
+	f := set.NewFlagSet("Command Options")
+
+	f.StringSliceVar(&StringSliceVar{
+		Name:       "node-id",
+		Target:     &c.flagNodeIDs,
+		Completion: complete.PredictAnything,
+		Usage: "ID of a node to compact. This can be specified multiple times. " +
+			"Defaults to every node in the cluster.",
+	})
+
+	f.IntVar(&IntVar{
+		Name:    "max-lag",
+		Target:  &c.flagMaxLag,
+		Default: 1000,
+		Usage: "Number of logs a node can be behind the leader to be considered " +
+			"caught up, before it's compacted and before moving on to the next node.",
+	})
+
+	f.DurationVar(&DurationVar{
+		Name:    "node-timeout",
+		Target:  &c.flagNodeTimeout,
+		Default: time.Hour,
+		Usage: "How long to wait for each node to catch up and to compact before " +
+			"the compaction fails.",
+	})
+
+	f.BoolVar(&BoolVar{
+		Name:    "status",
+		Target:  &c.flagStatus,
+		Default: false,
+		Usage:   "Displays the progress of the last compaction, rather than starting one.",
+	})
+
+	f.BoolVar(&BoolVar{
+		Name:    "detach",
+		Target:  &c.flagDetach,
+		Default: false,
+		Usage:   "Returns once the compaction has started, rather than waiting for it to finish.",
+	})
+
+	return set
+}
+
+func (c *OperatorRaftCompactCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *OperatorRaftCompactCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *OperatorRaftCompactCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = f.Args()
+	if len(args) > 0 {
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 0, got %d)", len(args)))
+		return 1
+	}
+	if c.flagMaxLag < 0 {
+		c.UI.Error("Max lag must be equal to or greater than 0")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 2
+	}
+
+	var status *api.RaftCompactionStatus
+	if c.flagStatus {
+		status, err = client.Sys().RaftCompactionStatus()
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error reading compaction status: %s", err))
+			return 2
+		}
+		if status == nil {
+			c.UI.Error("No compaction found")
+			return 2
+		}
+		return c.output(status)
+	}
+
+	status, err = client.Sys().RaftCompact(&api.RaftCompactRequest{
+		NodeIDs:     c.flagNodeIDs,
+		MaxLag:      &c.flagMaxLag,
+		NodeTimeout: c.flagNodeTimeout.String(),
+	})
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error starting compaction: %s", err))
+		return 2
+	}
+	if c.flagDetach {
+		return c.output(status)
+	}
+
+	status, err = c.wait(client, status)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 2
+	}
+	if code := c.output(status); code != 0 {
+		return code
+	}
+	if status.State != "completed" {
+		return 2
+	}
+	return 0
+}
+
+// wait polls the compaction until it's no longer running, reporting progress
+// as each node's state changes.
+func (c *OperatorRaftCompactCommand) wait(client *api.Client, status *api.RaftCompactionStatus) (*api.RaftCompactionStatus, error) {
+	id := status.ID
+	states := make(map[string]string)
+	errs := 0
+	for {
+		for _, node := range status.Nodes {
+			if states[node.NodeID] != node.State {
+				states[node.NodeID] = node.State
+				if Format(c.UI) == "table" {
+					c.UI.Info(fmt.Sprintf("Node %s is %s (%d/%d nodes done)", node.NodeID, node.State, status.NodesCompleted, status.NodesTotal))
+				}
+			}
+		}
+		if status.State != "running" {
+			return status, nil
+		}
+
+		time.Sleep(raftCompactPollInterval)
+		next, err := client.Sys().RaftCompactionStatus()
+		switch {
+		case err != nil:
+			// The active node steps down to be compacted, so errors are
+			// expected while a new one takes over
+			errs++
+			if errs >= raftCompactMaxPollErrors {
+				return nil, fmt.Errorf("Error reading compaction status: %w", err)
+			}
+			continue
+		case next == nil || next.ID != id:
+			return nil, fmt.Errorf("Compaction %s not found", id)
+		}
+		errs = 0
+		status = next
+	}
+}
+
+func (c *OperatorRaftCompactCommand) output(status *api.RaftCompactionStatus) int {
+	if Format(c.UI) != "table" {
+		return OutputData(c.UI, status)
+	}
+
+	out := []string{"Node | State | Started | Ended | Error"}
+	for _, node := range status.Nodes {
+		out = append(out, fmt.Sprintf("%s | %s | %s | %s | %s", node.NodeID, node.State,
+			hyphenIfEmpty(node.StartTime), hyphenIfEmpty(node.EndTime), hyphenIfEmpty(node.Error)))
+	}
+	c.UI.Output(fmt.Sprintf("Compaction %s is %s", status.ID, status.State))
+	if status.Error != "" {
+		c.UI.Output(fmt.Sprintf("Error: %s", status.Error))
+	}
+	c.UI.Output("")
+	c.UI.Output(tableOutput(out, nil))
+	return 0
+}
+
+func hyphenIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+	"github.com/hashicorp/vault/physical/raft"
+	"github.com/hashicorp/vault/sdk/helper/logging"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*OperatorRaftMigrateLogStoreCommand)(nil)
+	_ cli.CommandAutocomplete = (*OperatorRaftMigrateLogStoreCommand)(nil)
+)
+
+type OperatorRaftMigrateLogStoreCommand struct {
+	*BaseCommand
+
+	flagPath     string
+	flagTo       string
+	flagLogLevel string
+}
+
+func (c *OperatorRaftMigrateLogStoreCommand) Synopsis() string {
+	return "Converts the raft log store of a stopped node"
+}
+
+func (c *OperatorRaftMigrateLogStoreCommand) Help() string {
+	helpText := `
+Usage: vault operator raft migrate-logstore [options]
+
+  Converts the raft log store of a node between BoltDB and raft-wal, copying
+  its logs and raft state into the new store. This operates directly on the
+  node's raft directory, and the node must be stopped first. The original
+  store is kept next to the new one with a ".bak" suffix, and can be removed
+  once the node has started successfully.
+
+  A node always uses the log store found in its raft directory, but the
+  raft_wal setting of its storage stanza should be updated to match.
+
+  Convert a node's log store to raft-wal:
+
+      $ vault operator raft migrate-logstore -path=/opt/vault/data -to=wal
+
+  Convert a node's log store back to BoltDB:
+
+      $ vault operator raft migrate-logstore -path=/opt/vault/data -to=boltdb
+
+` + c.Flags().Help()
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *OperatorRaftMigrateLogStoreCommand) Flags() *FlagSets {
+	set := c.flagSet(FlagSetOutputFormat)
+
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&StringVar{
+		Name:       "path",
+		Target:     &c.flagPath,
+		EnvVar:     raft.EnvVaultRaftPath,
+		Completion: complete.PredictDirs("*"),
+		Usage:      "Path of the node's raft storage, as set in its storage stanza.",
+	})
+
+	f.StringVar(&StringVar{
+		Name:       "to",
+		Target:     &c.flagTo,
+		Completion: complete.PredictSet(raft.LogStoreWAL, raft.LogStoreBoltDB),
+		Usage:      "Type of log store to convert to, either \"wal\" or \"boltdb\".",
+	})
+
+	f.StringVar(&StringVar{
+		Name:       "log-level",
+		Target:     &c.flagLogLevel,
+		Default:    "info",
+		EnvVar:     "VAULT_LOG_LEVEL",
+		Completion: complete.PredictSet("trace", "debug", "info", "warn", "error"),
+		Usage: "Log verbosity level. Supported values (in order of detail) are " +
+			"\"trace\", \"debug\", \"info\", \"warn\", and \"error\". These are not case sensitive.",
+	})
+
+	return set
+}
+
+func (c *OperatorRaftMigrateLogStoreCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *OperatorRaftMigrateLogStoreCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *OperatorRaftMigrateLogStoreCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = f.Args()
+	if len(args) > 0 {
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 0, got %d)", len(args)))
+		return 1
+	}
+	if c.flagPath == "" {
+		c.UI.Error("Must specify the node's raft storage path using -path")
+		return 1
+	}
+	if c.flagTo != raft.LogStoreWAL && c.flagTo != raft.LogStoreBoltDB {
+		c.UI.Error(fmt.Sprintf("Must specify -to as either %q or %q", raft.LogStoreWAL, raft.LogStoreBoltDB))
+		return 1
+	}
+	c.flagLogLevel = strings.ToLower(c.flagLogLevel)
+	validLevels := []string{"trace", "debug", "info", "warn", "error"}
+	if !strutil.StrListContains(validLevels, c.flagLogLevel) {
+		c.UI.Error(fmt.Sprintf("%s is an unknown log level. Valid log levels are: %s", c.flagLogLevel, validLevels))
+		return 1
+	}
+	logger := logging.NewVaultLogger(log.LevelFromString(c.flagLogLevel))
+
+	result, err := raft.MigrateLogStore(context.Background(), c.flagPath, c.flagTo, logger)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error migrating log store: %s", err))
+		return 2
+	}
+
+	if Format(c.UI) != "table" {
+		return OutputData(c.UI, result)
+	}
+	c.UI.Output(tableOutput([]string{
+		fmt.Sprintf("From | %s", result.From),
+		fmt.Sprintf("To | %s", result.To),
+		fmt.Sprintf("First Index | %d", result.FirstIndex),
+		fmt.Sprintf("Last Index | %d", result.LastIndex),
+		fmt.Sprintf("Backup Path | %s", result.BackupPath),
+	}, nil))
+	if c.flagTo == raft.LogStoreWAL {
+		c.UI.Warn("\nSet raft_wal = \"true\" in the node's storage stanza to match the new log store.")
+	} else {
+		c.UI.Warn("\nRemove raft_wal from the node's storage stanza to match the new log store.")
+	}
+	return 0
+}
@@ -39,6 +39,10 @@ Usage: vault operator raft snapshot <subcommand> [options] [args]
 
       $ vault operator raft snapshot inspect raft.snap
 
+  Compares the storage keys of two snapshots:
+
+      $ vault operator raft snapshot diff before.snap after.snap
+
   Please see the individual subcommand help for detailed usage information.
 `
 
@@ -0,0 +1,385 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/go-hclog"
+	protoio "github.com/hashicorp/vault/physical/raft"
+	"github.com/hashicorp/vault/sdk/plugin/pb"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*OperatorRaftSnapshotDiffCommand)(nil)
+	_ cli.CommandAutocomplete = (*OperatorRaftSnapshotDiffCommand)(nil)
+)
+
+type OperatorRaftSnapshotDiffCommand struct {
+	*BaseCommand
+	filter        string
+	keys          bool
+	resolveMounts bool
+}
+
+func (c *OperatorRaftSnapshotDiffCommand) Synopsis() string {
+	return "Compares the storage keys of two raft snapshots"
+}
+
+func (c *OperatorRaftSnapshotDiffCommand) Help() string {
+	helpText := `
+Usage: vault operator raft snapshot diff [options] <from_snapshot_file> <to_snapshot_file>
+
+  Compares the storage keys of two snapshot files, reporting the keys which
+  were added, removed or changed between them. Keys are grouped by the mount
+  they belong to, which is identified by its UUID in storage.
+
+  Values are compared as they're stored, so neither snapshot needs to be
+  unsealed. As the mount table is itself encrypted, UUIDs can't be mapped to
+  mount paths from the snapshots. With -resolve-mounts, they're mapped using
+  the current mount tables of the cluster Vault is connected to instead, which
+  may differ from the mount tables at the time of either snapshot. Mounts
+  which no longer exist in the cluster are reported by UUID only, and mounts
+  which were moved are reported with their current path.
+
+  Compare two snapshots:
+
+      $ vault operator raft snapshot diff before.snap after.snap
+
+  Compare two snapshots, listing the changed keys with their mount paths:
+
+      $ vault operator raft snapshot diff -keys -resolve-mounts before.snap after.snap
+
+` + c.Flags().Help()
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *OperatorRaftSnapshotDiffCommand) Flags() *FlagSets {
+	set := c.flagSet(FlagSetHTTP | FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&StringVar{
+		Name:    "filter",
+		Target:  &c.filter,
+		Default: "",
+		Usage:   "Limits the comparison to keys with this prefix.",
+	})
+
+	f.BoolVar(&BoolVar{
+		Name:    "keys",
+		Target:  &c.keys,
+		Default: false,
+		Usage: "Lists the keys which differ in table output, rather than only " +
+			"counting them. Keys are always listed in other formats.",
+	})
+
+	f.BoolVar(&BoolVar{
+		Name:    "resolve-mounts",
+		Target:  &c.resolveMounts,
+		Default: false,
+		Usage: "Maps mount UUIDs to their paths and types using the current " +
+			"mount tables of the cluster Vault is connected to, rather than the " +
+			"mount tables in the snapshots. This requires a token with access " +
+			"to sys/mounts and sys/auth.",
+	})
+
+	return set
+}
+
+func (c *OperatorRaftSnapshotDiffCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*")
+}
+
+func (c *OperatorRaftSnapshotDiffCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+// SnapshotDiff is the difference between the storage keys of two snapshots
+type SnapshotDiff struct {
+	From         *MetadataInfo
+	To           *MetadataInfo
+	TotalAdded   int
+	TotalRemoved int
+	TotalChanged int
+	Groups       []*snapshotDiffGroup
+
+	// MountsResolvedAgainst is the address of the cluster whose current mount
+	// tables were used to map mount UUIDs to paths, if any
+	MountsResolvedAgainst string
+}
+
+// snapshotDiffGroup holds the keys which differ under a storage prefix, which
+// is usually a mount.
+type snapshotDiffGroup struct {
+	Prefix    string
+	MountPath string
+	MountType string
+	Added     []string
+	Removed   []string
+	Changed   []string
+}
+
+// snapshotDiffMount is a current mount of the cluster Vault is connected to
+type snapshotDiffMount struct {
+	path      string
+	mountType string
+}
+
+func (c *OperatorRaftSnapshotDiffCommand) Run(args []string) int {
+	flags := c.Flags()
+
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	switch {
+	case len(args) < 2:
+		c.UI.Error(fmt.Sprintf("Not enough arguments (expected 2, got %d)", len(args)))
+		return 1
+	case len(args) > 2:
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 2, got %d)", len(args)))
+		return 1
+	}
+
+	var mounts map[string]*snapshotDiffMount
+	var mountsAddr string
+	if c.resolveMounts {
+		var err error
+		mounts, mountsAddr, err = c.clusterMounts()
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error reading mounts: %s", err))
+			return 1
+		}
+	}
+
+	fromMeta, fromKeys, err := c.readKeys(args[0])
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading snapshot %q: %s", args[0], err))
+		return 1
+	}
+	toMeta, toKeys, err := c.readKeys(args[1])
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading snapshot %q: %s", args[1], err))
+		return 1
+	}
+
+	diff := diffSnapshotKeys(fromKeys, toKeys, mounts)
+	diff.From = fromMeta
+	diff.To = toMeta
+	diff.MountsResolvedAgainst = mountsAddr
+
+	if Format(c.UI) != "table" {
+		return OutputData(c.UI, diff)
+	}
+
+	c.UI.Output(c.formatTable(diff))
+	return 0
+}
+
+// readKeys reads the snapshot file at the given path, returning its metadata
+// and a hash of the value of each key.
+func (c *OperatorRaftSnapshotDiffCommand) readKeys(file string) (*MetadataInfo, map[string][sha256.Size]byte, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	keys := make(map[string][sha256.Size]byte)
+	meta, err := readSnapshot(hclog.New(nil), f, func(r io.Reader) error {
+		protoReader := protoio.NewDelimitedReader(r, math.MaxInt32)
+		for {
+			s := new(pb.StorageEntry)
+			if err := protoReader.ReadMsg(s); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+			if s.Key == "" || !strings.HasPrefix(s.Key, c.filter) {
+				continue
+			}
+			keys[s.Key] = sha256.Sum256(s.Value)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &MetadataInfo{
+		ID:      meta.ID,
+		Size:    meta.Size,
+		Index:   meta.Index,
+		Term:    meta.Term,
+		Version: meta.Version,
+	}, keys, nil
+}
+
+// clusterMounts returns the current secret and auth mounts of the cluster,
+// keyed by their storage prefix, along with the cluster's address.
+func (c *OperatorRaftSnapshotDiffCommand) clusterMounts() (map[string]*snapshotDiffMount, string, error) {
+	client, err := c.Client()
+	if err != nil {
+		return nil, "", err
+	}
+
+	mounts := make(map[string]*snapshotDiffMount)
+	secretMounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return nil, "", err
+	}
+	for path, mount := range secretMounts {
+		if mount.UUID == "" {
+			continue
+		}
+		mounts["logical/"+mount.UUID+"/"] = &snapshotDiffMount{path: path, mountType: mount.Type}
+	}
+
+	authMounts, err := client.Sys().ListAuth()
+	if err != nil {
+		return nil, "", err
+	}
+	for path, mount := range authMounts {
+		if mount.UUID == "" {
+			continue
+		}
+		mounts["auth/"+mount.UUID+"/"] = &snapshotDiffMount{path: "auth/" + path, mountType: mount.Type}
+	}
+
+	return mounts, client.Address(), nil
+}
+
+// snapshotDiffPrefix returns the prefix keys are grouped by. Keys of secret
+// and auth mounts are grouped by the mount's UUID, and system keys by their
+// second path segment.
+func snapshotDiffPrefix(key string) string {
+	parts := strings.SplitN(key, "/", 3)
+	switch {
+	case len(parts) < 2:
+		return key
+	case len(parts) == 3 && (parts[0] == "logical" || parts[0] == "auth" || parts[0] == "sys"):
+		return parts[0] + "/" + parts[1] + "/"
+	default:
+		return parts[0] + "/"
+	}
+}
+
+// diffSnapshotKeys compares the keys of two snapshots, grouping the keys which
+// differ by prefix. Groups are sorted by prefix, and their keys by name.
+func diffSnapshotKeys(from, to map[string][sha256.Size]byte, mounts map[string]*snapshotDiffMount) *SnapshotDiff {
+	diff := &SnapshotDiff{}
+	groups := make(map[string]*snapshotDiffGroup)
+	group := func(key string) *snapshotDiffGroup {
+		prefix := snapshotDiffPrefix(key)
+		g, ok := groups[prefix]
+		if !ok {
+			g = &snapshotDiffGroup{
+				Prefix:  prefix,
+				Added:   []string{},
+				Removed: []string{},
+				Changed: []string{},
+			}
+			if mount, ok := mounts[prefix]; ok {
+				g.MountPath = mount.path
+				g.MountType = mount.mountType
+			}
+			groups[prefix] = g
+		}
+		return g
+	}
+
+	for key, fromHash := range from {
+		toHash, ok := to[key]
+		switch {
+		case !ok:
+			g := group(key)
+			g.Removed = append(g.Removed, key)
+			diff.TotalRemoved++
+		case toHash != fromHash:
+			g := group(key)
+			g.Changed = append(g.Changed, key)
+			diff.TotalChanged++
+		}
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			g := group(key)
+			g.Added = append(g.Added, key)
+			diff.TotalAdded++
+		}
+	}
+
+	diff.Groups = make([]*snapshotDiffGroup, 0, len(groups))
+	for _, g := range groups {
+		sort.Strings(g.Added)
+		sort.Strings(g.Removed)
+		sort.Strings(g.Changed)
+		diff.Groups = append(diff.Groups, g)
+	}
+	sort.Slice(diff.Groups, func(i, j int) bool {
+		return diff.Groups[i].Prefix < diff.Groups[j].Prefix
+	})
+
+	return diff
+}
+
+func (c *OperatorRaftSnapshotDiffCommand) formatTable(diff *SnapshotDiff) string {
+	var b bytes.Buffer
+	tw := tabwriter.NewWriter(&b, 8, 8, 6, ' ', 0)
+
+	fmt.Fprintf(tw, " \tFrom\tTo")
+	fmt.Fprintf(tw, "\n ID\t%s\t%s", diff.From.ID, diff.To.ID)
+	fmt.Fprintf(tw, "\n Index\t%d\t%d", diff.From.Index, diff.To.Index)
+	fmt.Fprintf(tw, "\n Term\t%d\t%d", diff.From.Term, diff.To.Term)
+	fmt.Fprintf(tw, "\n")
+
+	mountHeader := "Mount"
+	if diff.MountsResolvedAgainst != "" {
+		fmt.Fprintf(tw, "\n\n Mount paths are resolved against the current mount tables of %s, not the snapshots", diff.MountsResolvedAgainst)
+		mountHeader = "Current Mount"
+	}
+
+	fmt.Fprintf(tw, "\n\n Prefix\t%s\tAdded\tRemoved\tChanged\n", mountHeader)
+	fmt.Fprintf(tw, " %s\t%s\t%s\t%s\t%s", "----", "----", "----", "----", "----")
+	for _, g := range diff.Groups {
+		mount := "n/a"
+		if g.MountPath != "" {
+			mount = fmt.Sprintf("%s (%s)", g.MountPath, g.MountType)
+		}
+		fmt.Fprintf(tw, "\n %s\t%s\t%d\t%d\t%d", g.Prefix, mount, len(g.Added), len(g.Removed), len(g.Changed))
+	}
+	fmt.Fprintf(tw, "\n %s\t\t%s\t%s\t%s", "----", "----", "----", "----")
+	fmt.Fprintf(tw, "\n Total\t\t%d\t%d\t%d", diff.TotalAdded, diff.TotalRemoved, diff.TotalChanged)
+	fmt.Fprintf(tw, "\n")
+	tw.Flush()
+
+	if c.keys {
+		for _, g := range diff.Groups {
+			for _, key := range g.Added {
+				fmt.Fprintf(&b, "\n+ %s", key)
+			}
+			for _, key := range g.Removed {
+				fmt.Fprintf(&b, "\n- %s", key)
+			}
+			for _, key := range g.Changed {
+				fmt.Fprintf(&b, "\n~ %s", key)
+			}
+		}
+	}
+
+	return b.String()
+}
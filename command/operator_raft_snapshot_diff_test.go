@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/vault/physical/raft"
+	"github.com/hashicorp/vault/sdk/physical"
+)
+
+func testOperatorRaftSnapshotDiffCommand(tb testing.TB) (*cli.MockUi, *OperatorRaftSnapshotDiffCommand) {
+	tb.Helper()
+
+	ui := cli.NewMockUi()
+	return ui, &OperatorRaftSnapshotDiffCommand{
+		BaseCommand: &BaseCommand{
+			UI: ui,
+		},
+	}
+}
+
+// saveSnapshot writes a snapshot of the raft backend to a temporary file
+func saveSnapshot(tb testing.TB, r *raft.RaftBackend) string {
+	tb.Helper()
+
+	snap, err := os.CreateTemp(tb.TempDir(), "temp_snapshot.snap")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer snap.Close()
+	if err := r.Snapshot(snap, nil); err != nil {
+		tb.Fatal(err)
+	}
+	return snap.Name()
+}
+
+func TestOperatorRaftSnapshotDiffCommand_Run(t *testing.T) {
+	t.Parallel()
+
+	r, raftDir := raft.GetRaft(t, true, false)
+	defer os.RemoveAll(raftDir)
+	ctx := context.Background()
+
+	put := func(key, value string) {
+		if err := r.Put(ctx, &physical.Entry{Key: key, Value: []byte(value)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	put("logical/1234/foo", "foo")
+	put("logical/1234/bar", "bar")
+	put("auth/5678/users/alice", "alice")
+	put("sys/policy/default", "default")
+	from := saveSnapshot(t, r)
+
+	put("logical/1234/bar", "baz")
+	if err := r.Delete(ctx, "logical/1234/foo"); err != nil {
+		t.Fatal(err)
+	}
+	put("auth/5678/users/bob", "bob")
+	put("sys/policy/admin", "admin")
+	to := saveSnapshot(t, r)
+
+	t.Run("not_enough_args", func(t *testing.T) {
+		t.Parallel()
+
+		ui, cmd := testOperatorRaftSnapshotDiffCommand(t)
+		code := cmd.Run([]string{from})
+		if code != 1 {
+			t.Errorf("expected 1 to be %d", code)
+		}
+		if !strings.Contains(ui.ErrorWriter.String(), "Not enough arguments") {
+			t.Errorf("unexpected output: %s", ui.ErrorWriter.String())
+		}
+	})
+
+	t.Run("table", func(t *testing.T) {
+		t.Parallel()
+
+		ui, cmd := testOperatorRaftSnapshotDiffCommand(t)
+		code := cmd.Run([]string{"-keys", from, to})
+		if code != 0 {
+			t.Fatalf("expected 0 to be %d: %s", code, ui.ErrorWriter.String())
+		}
+		combined := ui.OutputWriter.String()
+		for _, expected := range []string{
+			"+ auth/5678/users/bob",
+			"- logical/1234/foo",
+			"~ logical/1234/bar",
+			"+ sys/policy/admin",
+		} {
+			if !strings.Contains(combined, expected) {
+				t.Errorf("expected %q to contain %q", combined, expected)
+			}
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		ui, cmd := testOperatorRaftSnapshotDiffCommand(t)
+		code := cmd.Run([]string{"-format=json", "-filter=logical/", from, to})
+		if code != 0 {
+			t.Fatalf("expected 0 to be %d: %s", code, ui.ErrorWriter.String())
+		}
+
+		var diff SnapshotDiff
+		if err := json.Unmarshal(ui.OutputWriter.Bytes(), &diff); err != nil {
+			t.Fatal(err)
+		}
+		if diff.TotalAdded != 0 || diff.TotalRemoved != 1 || diff.TotalChanged != 1 {
+			t.Fatalf("unexpected totals: %+v", diff)
+		}
+		if len(diff.Groups) != 1 || diff.Groups[0].Prefix != "logical/1234/" {
+			t.Fatalf("unexpected groups: %+v", diff.Groups)
+		}
+		if diff.From.Index >= diff.To.Index {
+			t.Fatalf("expected index %d to be before %d", diff.From.Index, diff.To.Index)
+		}
+	})
+}
+
+func TestSnapshotDiffPrefix(t *testing.T) {
+	t.Parallel()
+
+	for key, expected := range map[string]string{
+		"logical/1234/foo/bar":  "logical/1234/",
+		"auth/5678/users/alice": "auth/5678/",
+		"sys/policy/default":    "sys/policy/",
+		"core/mounts":           "core/",
+		"sys/counters":          "sys/",
+		"key":                   "key",
+	} {
+		if actual := snapshotDiffPrefix(key); actual != expected {
+			t.Errorf("expected prefix of %q to be %q, got %q", key, expected, actual)
+		}
+	}
+}
+
+func TestOperatorRaftSnapshotDiffCommand_ResolveMounts(t *testing.T) {
+	t.Parallel()
+
+	client, closer := testVaultServer(t)
+	defer closer()
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	uuid := mounts["cubbyhole/"].UUID
+
+	r, raftDir := raft.GetRaft(t, true, false)
+	defer os.RemoveAll(raftDir)
+	from := saveSnapshot(t, r)
+	if err := r.Put(context.Background(), &physical.Entry{Key: "logical/" + uuid + "/foo", Value: []byte("foo")}); err != nil {
+		t.Fatal(err)
+	}
+	to := saveSnapshot(t, r)
+
+	t.Run("table", func(t *testing.T) {
+		t.Parallel()
+
+		ui, cmd := testOperatorRaftSnapshotDiffCommand(t)
+		cmd.client = client
+		code := cmd.Run([]string{"-resolve-mounts", from, to})
+		if code != 0 {
+			t.Fatalf("expected 0 to be %d: %s", code, ui.ErrorWriter.String())
+		}
+		combined := ui.OutputWriter.String()
+		for _, expected := range []string{
+			"resolved against the current mount tables of " + client.Address(),
+			"Current Mount",
+			"cubbyhole/ (cubbyhole)",
+		} {
+			if !strings.Contains(combined, expected) {
+				t.Errorf("expected %q to contain %q", combined, expected)
+			}
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		ui, cmd := testOperatorRaftSnapshotDiffCommand(t)
+		cmd.client = client
+		code := cmd.Run([]string{"-format=json", "-resolve-mounts", from, to})
+		if code != 0 {
+			t.Fatalf("expected 0 to be %d: %s", code, ui.ErrorWriter.String())
+		}
+
+		var diff SnapshotDiff
+		if err := json.Unmarshal(ui.OutputWriter.Bytes(), &diff); err != nil {
+			t.Fatal(err)
+		}
+		if diff.MountsResolvedAgainst != client.Address() {
+			t.Fatalf("expected mounts to be resolved against %q, got %q", client.Address(), diff.MountsResolvedAgainst)
+		}
+		if len(diff.Groups) != 1 || diff.Groups[0].MountPath != "cubbyhole/" {
+			t.Fatalf("unexpected groups: %+v", diff.Groups)
+		}
+	})
+}
@@ -269,10 +269,25 @@ func (c *OperatorRaftSnapshotInspectCommand) parseState(r io.Reader) (SnapshotIn
 // Read contents of snapshot. Parse metadata and snapshot info
 // Also, verify validity of snapshot
 func (c *OperatorRaftSnapshotInspectCommand) Read(logger hclog.Logger, in io.Reader) (*SnapshotInfo, *raft.SnapshotMeta, error) {
+	var snapshotInfo SnapshotInfo
+	metadata, err := readSnapshot(logger, in, func(r io.Reader) error {
+		var err error
+		snapshotInfo, err = c.parseState(r)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return &snapshotInfo, metadata, nil
+}
+
+// readSnapshot decompresses a snapshot file, passing its state to parseState,
+// and verifies its integrity.
+func readSnapshot(logger hclog.Logger, in io.Reader, parseState func(io.Reader) error) (*raft.SnapshotMeta, error) {
 	// Wrap the reader in a gzip decompressor.
 	decomp, err := gzip.NewReader(in)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to decompress snapshot: %v", err)
+		return nil, fmt.Errorf("failed to decompress snapshot: %v", err)
 	}
 
 	defer func() {
@@ -286,20 +301,20 @@ func (c *OperatorRaftSnapshotInspectCommand) Read(logger hclog.Logger, in io.Rea
 	}()
 
 	// Read the archive.
-	snapshotInfo, metadata, err := c.read(decomp)
+	metadata, err := readSnapshotArchive(decomp, parseState)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to read snapshot file: %v", err)
+		return nil, fmt.Errorf("failed to read snapshot file: %v", err)
 	}
 
 	if err := concludeGzipRead(decomp); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	if err := decomp.Close(); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	decomp = nil
-	return snapshotInfo, metadata, nil
+	return metadata, nil
 }
 
 func formatTable(info *OutputFormat) (string, error) {
@@ -474,9 +489,10 @@ func (hl *hashList) DecodeAndVerify(r io.Reader) error {
 	return nil
 }
 
-// read takes a reader and extracts the snapshot metadata and snapshot
-// info. It also checks the integrity of the snapshot data.
-func (c *OperatorRaftSnapshotInspectCommand) read(in io.Reader) (*SnapshotInfo, *raft.SnapshotMeta, error) {
+// readSnapshotArchive takes a reader and extracts the snapshot metadata,
+// passing the snapshot state to parseState. It also checks the integrity of
+// the snapshot data.
+func readSnapshotArchive(in io.Reader, parseState func(io.Reader) error) (*raft.SnapshotMeta, error) {
 	// Start a new tar reader.
 	archive := tar.NewReader(in)
 
@@ -492,7 +508,6 @@ func (c *OperatorRaftSnapshotInspectCommand) read(in io.Reader) (*SnapshotInfo,
 
 	// Look through the archive for the pieces we care about.
 	var shaBuffer bytes.Buffer
-	var snapshotInfo SnapshotInfo
 	var metadata raft.SnapshotMeta
 	for {
 		hdr, err := archive.Next()
@@ -500,7 +515,7 @@ func (c *OperatorRaftSnapshotInspectCommand) read(in io.Reader) (*SnapshotInfo,
 			break
 		}
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed reading snapshot: %v", err)
+			return nil, fmt.Errorf("failed reading snapshot: %v", err)
 		}
 
 		switch hdr.Name {
@@ -515,23 +530,21 @@ func (c *OperatorRaftSnapshotInspectCommand) read(in io.Reader) (*SnapshotInfo,
 			// independent of how json.Decode works internally.
 			buf, err := io.ReadAll(io.TeeReader(archive, metaHash))
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to read snapshot metadata: %v", err)
+				return nil, fmt.Errorf("failed to read snapshot metadata: %v", err)
 			}
 			if err := json.Unmarshal(buf, &metadata); err != nil {
-				return nil, nil, fmt.Errorf("failed to decode snapshot metadata: %v", err)
+				return nil, fmt.Errorf("failed to decode snapshot metadata: %v", err)
 			}
 		case "state.bin":
 			// create reader that writes to snapHash what it reads from archive
 			wrappedReader := io.TeeReader(archive, snapHash)
-			var err error
-			snapshotInfo, err = c.parseState(wrappedReader)
-			if err != nil {
-				return nil, nil, fmt.Errorf("error parsing snapshot state: %v", err)
+			if err := parseState(wrappedReader); err != nil {
+				return nil, fmt.Errorf("error parsing snapshot state: %v", err)
 			}
 
 		case "SHA256SUMS":
 			if _, err := io.CopyN(&shaBuffer, archive, 10000); err != nil && err != io.EOF {
-				return nil, nil, fmt.Errorf("failed to read snapshot hashes: %v", err)
+				return nil, fmt.Errorf("failed to read snapshot hashes: %v", err)
 			}
 
 		case "SHA256SUMS.sealed":
@@ -539,16 +552,16 @@ func (c *OperatorRaftSnapshotInspectCommand) read(in io.Reader) (*SnapshotInfo,
 			continue
 
 		default:
-			return nil, nil, fmt.Errorf("unexpected file %q in snapshot", hdr.Name)
+			return nil, fmt.Errorf("unexpected file %q in snapshot", hdr.Name)
 		}
 	}
 
 	// Verify all the hashes.
 	if err := hl.DecodeAndVerify(&shaBuffer); err != nil {
-		return nil, nil, fmt.Errorf("failed checking integrity of snapshot: %v", err)
+		return nil, fmt.Errorf("failed checking integrity of snapshot: %v", err)
 	}
 
-	return &snapshotInfo, &metadata, nil
+	return &metadata, nil
 }
 
 // concludeGzipRead should be invoked after you think you've consumed all of
@@ -189,7 +189,9 @@ func (c *OperatorRekeyCommand) Flags() *FlagSets {
 		Completion: complete.PredictAnything,
 		Usage: "Comma-separated list of paths to files on disk containing " +
 			"public PGP keys OR a comma-separated list of Keybase usernames using " +
-			"the format \"keybase:<username>\". When supplied, the generated " +
+			"the format \"keybase:<username>\". Age X25519 recipients and " +
+			"ssh-ed25519 public keys can also be given directly or as paths to " +
+			"files containing them. When supplied, the generated " +
 			"unseal or recovery keys will be encrypted and base64-encoded in the order " +
 			"specified in this list.",
 	})
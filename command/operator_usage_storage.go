@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/hashicorp/cli"
+	"github.com/posener/complete"
+	"github.com/ryanuber/columnize"
+)
+
+var (
+	_ cli.Command             = (*OperatorUsageStorageCommand)(nil)
+	_ cli.CommandAutocomplete = (*OperatorUsageStorageCommand)(nil)
+)
+
+type OperatorUsageStorageCommand struct {
+	*BaseCommand
+}
+
+func (c *OperatorUsageStorageCommand) Synopsis() string {
+	return "Lists the storage used by each mount"
+}
+
+func (c *OperatorUsageStorageCommand) Help() string {
+	helpText := `
+Usage: vault operator usage storage
+
+  List the number of keys and bytes stored by each secrets engine and auth
+  method, largest first. Storage usage accounting must be enabled with
+  enable_storage_usage_accounting in the server config. The usage is tracked
+  by the active node as mounts are written to, and each mount is recounted
+  hourly.
+
+      $ vault operator usage storage
+
+` + c.Flags().Help()
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *OperatorUsageStorageCommand) Flags() *FlagSets {
+	return c.flagSet(FlagSetHTTP | FlagSetOutputFormat)
+}
+
+func (c *OperatorUsageStorageCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *OperatorUsageStorageCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *OperatorUsageStorageCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = f.Args()
+	if len(args) > 0 {
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 0, got %d)", len(args)))
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 2
+	}
+
+	resp, err := client.Logical().Read("sys/internal/counters/storage")
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error retrieving storage usage: %v", err))
+		return 2
+	}
+	if resp == nil || resp.Data == nil {
+		c.UI.Warn("No storage usage is available.")
+		return 0
+	}
+
+	switch Format(c.UI) {
+	case "table":
+	default:
+		return OutputData(c.UI, resp)
+	}
+
+	if lastReconciled, _ := resp.Data["last_reconciled"].(string); lastReconciled != "" {
+		c.UI.Output(fmt.Sprintf("Last reconciled: %s\n", lastReconciled))
+	} else {
+		c.UI.Warn("The storage usage hasn't been reconciled on the active node yet, so it may be incomplete.\n")
+	}
+
+	out := []string{"Namespace path | Mount | Type | Keys | Size"}
+
+	mounts, _ := resp.Data["mounts"].([]interface{})
+	for _, rawMount := range mounts {
+		mount, ok := rawMount.(map[string]interface{})
+		if !ok {
+			c.UI.Error("malformed mount in response")
+			continue
+		}
+		nsPath, _ := mount["namespace_path"].(string)
+		if nsPath == "" {
+			nsPath = "[root]"
+		}
+		keys, _ := jsonNumberOK(mount, "keys")
+		bytes, _ := jsonNumberOK(mount, "bytes")
+		out = append(out, fmt.Sprintf("%s | %s | %s | %d | %s",
+			nsPath, mount["mount_path"], mount["mount_type"], keys, humanize.IBytes(uint64(bytes))))
+	}
+
+	totalKeys, _ := jsonNumberOK(resp.Data, "total_keys")
+	totalBytes, _ := jsonNumberOK(resp.Data, "total_bytes")
+	out = append(out, "  |  |  |  |  ")
+	out = append(out, fmt.Sprintf("Total |  |  | %d | %s", totalKeys, humanize.IBytes(uint64(totalBytes))))
+
+	colConfig := columnize.DefaultConfig()
+	colConfig.Empty = " "
+	colConfig.Glue = "   "
+	c.UI.Output(tableOutput(out, colConfig))
+
+	for _, warning := range resp.Warnings {
+		c.UI.Warn(warning)
+	}
+
+	return 0
+}
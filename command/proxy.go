@@ -32,8 +32,12 @@ import (
 	"github.com/hashicorp/vault/command/agentproxyshared/auth"
 	"github.com/hashicorp/vault/command/agentproxyshared/cache"
 	"github.com/hashicorp/vault/command/agentproxyshared/sink"
+	execsink "github.com/hashicorp/vault/command/agentproxyshared/sink/exec"
 	"github.com/hashicorp/vault/command/agentproxyshared/sink/file"
 	"github.com/hashicorp/vault/command/agentproxyshared/sink/inmem"
+	"github.com/hashicorp/vault/command/agentproxyshared/sink/kubernetes"
+	"github.com/hashicorp/vault/command/agentproxyshared/sink/socket"
+	"github.com/hashicorp/vault/command/agentproxyshared/upstream"
 	"github.com/hashicorp/vault/command/agentproxyshared/winsvc"
 	proxyConfig "github.com/hashicorp/vault/command/proxy/config"
 	"github.com/hashicorp/vault/helper/logging"
@@ -288,6 +292,30 @@ func (c *ProxyCommand) Run(args []string) int {
 	}
 	c.metricsHelper = metricsutil.NewMetricsHelper(inmemMetrics, prometheusEnabled)
 
+	// Fail over between multiple Vault servers based on their health
+	var upstreams *upstream.Manager
+	if config.Vault != nil && len(config.Vault.Addresses) > 1 {
+		upstreamConfig := &upstream.ManagerConfig{
+			Client:           client,
+			Addresses:        config.Vault.Addresses,
+			Logger:           c.logger.Named("upstream"),
+			MetricsSignifier: "proxy",
+		}
+		if config.Vault.HealthCheck != nil {
+			upstreamConfig.HealthCheckInterval = config.Vault.HealthCheck.Interval
+			upstreamConfig.HealthCheckTimeout = config.Vault.HealthCheck.Timeout
+		}
+		upstreams, err = upstream.NewManager(upstreamConfig)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error configuring Vault upstreams: %v", err))
+			return 1
+		}
+		if err := upstreams.Register(client); err != nil {
+			c.UI.Error(fmt.Sprintf("Error configuring Vault upstreams: %v", err))
+			return 1
+		}
+	}
+
 	// This indicates whether the namespace for the client has been set by environment variable.
 	// If it has, we don't touch it
 	namespaceSetByEnvironmentVariable := client.Namespace() != ""
@@ -320,30 +348,46 @@ func (c *ProxyCommand) Run(args []string) int {
 			sinkClient.SetDisableKeepAlives(true)
 		}
 
+		if upstreams != nil {
+			if err := upstreams.Register(sinkClient); err != nil {
+				c.UI.Error(fmt.Sprintf("Error configuring client for file sink: %v", err))
+				return 1
+			}
+		}
+
 		for _, sc := range config.AutoAuth.Sinks {
+			var newSink func(*sink.SinkConfig) (sink.Sink, error)
 			switch sc.Type {
 			case "file":
-				config := &sink.SinkConfig{
-					Logger:    c.logger.Named("sink.file"),
-					Config:    sc.Config,
-					Client:    sinkClient,
-					WrapTTL:   sc.WrapTTL,
-					DHType:    sc.DHType,
-					DeriveKey: sc.DeriveKey,
-					DHPath:    sc.DHPath,
-					AAD:       sc.AAD,
-				}
-				s, err := file.NewFileSink(config)
-				if err != nil {
-					c.UI.Error(fmt.Errorf("error creating file sink: %w", err).Error())
-					return 1
-				}
-				config.Sink = s
-				sinks = append(sinks, config)
+				newSink = file.NewFileSink
+			case "kubernetes_secret":
+				newSink = kubernetes.NewSecretSink
+			case "unix_socket":
+				newSink = socket.NewSocketSink
+			case "exec":
+				newSink = execsink.NewExecSink
 			default:
 				c.UI.Error(fmt.Sprintf("Unknown sink type %q", sc.Type))
 				return 1
 			}
+
+			config := &sink.SinkConfig{
+				Logger:    c.logger.Named("sink." + sc.Type),
+				Config:    sc.Config,
+				Client:    sinkClient,
+				WrapTTL:   sc.WrapTTL,
+				DHType:    sc.DHType,
+				DeriveKey: sc.DeriveKey,
+				DHPath:    sc.DHPath,
+				AAD:       sc.AAD,
+			}
+			s, err := newSink(config)
+			if err != nil {
+				c.UI.Error(fmt.Errorf("error creating %s sink: %w", sc.Type, err).Error())
+				return 1
+			}
+			config.Sink = s
+			sinks = append(sinks, config)
 		}
 
 		authConfig := &auth.AuthConfig{
@@ -432,6 +476,13 @@ func (c *ProxyCommand) Run(args []string) int {
 		proxyClient.SetDisableKeepAlives(true)
 	}
 
+	if upstreams != nil {
+		if err := upstreams.Register(proxyClient); err != nil {
+			c.UI.Error(fmt.Sprintf("Error configuring client for proxying: %v", err))
+			return 1
+		}
+	}
+
 	apiProxyLogger := c.logger.Named("apiproxy")
 
 	// The API proxy to be used, if listeners are configured
@@ -443,6 +494,7 @@ func (c *ProxyCommand) Run(args []string) int {
 		UserAgentStringFunction:    useragent.ProxyStringWithProxiedUserAgent,
 		UserAgentString:            useragent.ProxyAPIProxyString(),
 		PrependConfiguredNamespace: config.APIProxy != nil && config.APIProxy.PrependConfiguredNamespace,
+		Upstreams:                  upstreams,
 	})
 	if err != nil {
 		c.UI.Error(fmt.Sprintf("Error creating API proxy: %v", err))
@@ -470,8 +522,10 @@ func (c *ProxyCommand) Run(args []string) int {
 			Logger:             cacheLogger.Named("leasecache"),
 			CacheStaticSecrets: config.Cache.CacheStaticSecrets,
 			// dynamic secrets are configured as default-on to preserve backwards compatibility
-			CacheDynamicSecrets: !config.Cache.DisableCachingDynamicSecrets,
-			UserAgentToUse:      useragent.AgentProxyString(),
+			CacheDynamicSecrets:              !config.Cache.DisableCachingDynamicSecrets,
+			UserAgentToUse:                   useragent.AgentProxyString(),
+			StaticSecretStaleWhileRevalidate: config.Cache.StaticSecretStaleWhileRevalidate,
+			StaticSecretOfflineMaxStaleness:  config.Cache.StaticSecretOfflineMaxStaleness,
 		})
 		if err != nil {
 			c.UI.Error(fmt.Sprintf("Error creating lease cache: %v", err))
@@ -479,6 +533,9 @@ func (c *ProxyCommand) Run(args []string) int {
 		}
 
 		cacheLogger.Info("cache configured", "cache_static_secrets", config.Cache.CacheStaticSecrets, "disable_caching_dynamic_secrets", config.Cache.DisableCachingDynamicSecrets)
+		if config.Cache.StaticSecretOfflineMode {
+			cacheLogger.Info("static secret offline mode enabled", "max_staleness", config.Cache.StaticSecretOfflineMaxStaleness)
+		}
 
 		// Configure persistent storage and add to LeaseCache
 		if config.Cache.Persist != nil {
@@ -563,6 +620,13 @@ func (c *ProxyCommand) Run(args []string) int {
 			ahClient.SetDisableKeepAlives(true)
 		}
 
+		if upstreams != nil {
+			if err := upstreams.Register(ahClient); err != nil {
+				c.UI.Error(fmt.Sprintf("Error configuring client for auth handler: %v", err))
+				return 1
+			}
+		}
+
 		ah = auth.NewAuthHandler(&auth.AuthHandlerConfig{
 			Logger:                       c.logger.Named("auth.handler"),
 			Client:                       ahClient,
@@ -756,6 +820,16 @@ func (c *ProxyCommand) Run(args []string) int {
 		}
 	}, func(error) {})
 
+	// Start health checks of the Vault servers
+	if upstreams != nil {
+		g.Add(func() error {
+			upstreams.Run(ctx)
+			return nil
+		}, func(error) {
+			cancelFunc()
+		})
+	}
+
 	// Start auto-auth and sink servers
 	if method != nil {
 		g.Add(func() error {
@@ -873,6 +947,11 @@ func (c *ProxyCommand) applyConfigOverrides(f *FlagSets, config *proxyConfig.Con
 		Normalizers: []func(string) string{configutil.NormalizeAddr},
 	})
 	config.Vault.Address = c.flagAddress
+	// An address set by flag or environment variable replaces the addresses
+	// in the config
+	if len(config.Vault.Addresses) > 0 && config.Vault.Addresses[0] != config.Vault.Address {
+		config.Vault.Addresses = nil
+	}
 	c.setStringFlag(f, config.Vault.CACert, &StringVar{
 		Name:    flagNameCACert,
 		Target:  &c.flagCACert,
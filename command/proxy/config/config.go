@@ -46,6 +46,11 @@ type Config struct {
 const (
 	DisableIdleConnsEnv  = "VAULT_PROXY_DISABLE_IDLE_CONNECTIONS"
 	DisableKeepAlivesEnv = "VAULT_PROXY_DISABLE_KEEP_ALIVES"
+
+	// DefaultStaticSecretOfflineMaxStaleness is how long cached static secrets
+	// are served while Vault is unreachable, if offline mode is enabled without
+	// a max staleness.
+	DefaultStaticSecretOfflineMaxStaleness = 24 * time.Hour
 )
 
 func (c *Config) Prune() {
@@ -69,6 +74,15 @@ type Retry struct {
 	NumRetries int `hcl:"num_retries"`
 }
 
+// HealthCheck contains configuration for the active health checks of the
+// Vault servers in Vault.Addresses
+type HealthCheck struct {
+	Interval    time.Duration `hcl:"-"`
+	IntervalRaw interface{}   `hcl:"interval"`
+	Timeout     time.Duration `hcl:"-"`
+	TimeoutRaw  interface{}   `hcl:"timeout"`
+}
+
 // Vault contains configuration for connecting to Vault servers
 type Vault struct {
 	Address          string      `hcl:"address"`
@@ -81,6 +95,12 @@ type Vault struct {
 	TLSServerName    string      `hcl:"tls_server_name"`
 	Namespace        string      `hcl:"namespace"`
 	Retry            *Retry      `hcl:"retry"`
+
+	// Addresses are the addresses of Vault servers in order of preference,
+	// which requests fail over between based on their health. Address is
+	// set to the first address.
+	Addresses   []string     `hcl:"addresses"`
+	HealthCheck *HealthCheck `hcl:"health_check"`
 }
 
 // transportDialer is an interface that allows passing a custom dialer function
@@ -112,6 +132,11 @@ type Cache struct {
 	StaticSecretTokenCapabilityRefreshIntervalRaw interface{}                     `hcl:"static_secret_token_capability_refresh_interval"`
 	StaticSecretTokenCapabilityRefreshInterval    time.Duration                   `hcl:"-"`
 	StaticSecretTokenCapabilityRefreshBehaviour   string                          `hcl:"static_secret_token_capability_refresh_behavior"`
+	StaticSecretStaleWhileRevalidateRaw           interface{}                     `hcl:"static_secret_stale_while_revalidate"`
+	StaticSecretStaleWhileRevalidate              time.Duration                   `hcl:"-"`
+	StaticSecretOfflineMode                       bool                            `hcl:"static_secret_offline_mode"`
+	StaticSecretOfflineMaxStalenessRaw            interface{}                     `hcl:"static_secret_offline_max_staleness"`
+	StaticSecretOfflineMaxStaleness               time.Duration                   `hcl:"-"`
 }
 
 // AutoAuth is the configured authentication method and sinks
@@ -281,6 +306,19 @@ func (c *Config) ValidateConfig() error {
 		}
 	}
 
+	if c.Cache != nil && !c.Cache.CacheStaticSecrets {
+		if c.Cache.StaticSecretStaleWhileRevalidate != 0 {
+			return fmt.Errorf("cache.static_secret_stale_while_revalidate requires cache.cache_static_secrets=true")
+		}
+		if c.Cache.StaticSecretOfflineMode {
+			return fmt.Errorf("cache.static_secret_offline_mode requires cache.cache_static_secrets=true")
+		}
+	}
+
+	if c.Cache != nil && c.Cache.StaticSecretOfflineMaxStaleness != 0 && !c.Cache.StaticSecretOfflineMode {
+		return fmt.Errorf("cache.static_secret_offline_max_staleness requires cache.static_secret_offline_mode=true")
+	}
+
 	return nil
 }
 
@@ -551,6 +589,19 @@ func parseVault(result *Config, list *ast.ObjectList) error {
 		v.Address = configutil.NormalizeAddr(v.Address)
 	}
 
+	if len(v.Addresses) > 0 {
+		if v.Address != "" {
+			return fmt.Errorf("only one of 'address' and 'addresses' may be set")
+		}
+		for i, address := range v.Addresses {
+			if address == "" {
+				return fmt.Errorf("'addresses' must not contain empty addresses")
+			}
+			v.Addresses[i] = configutil.NormalizeAddr(address)
+		}
+		v.Address = v.Addresses[0]
+	}
+
 	if v.TLSSkipVerifyRaw != nil {
 		v.TLSSkipVerify, err = parseutil.ParseBool(v.TLSSkipVerifyRaw)
 		if err != nil {
@@ -569,6 +620,10 @@ func parseVault(result *Config, list *ast.ObjectList) error {
 		return fmt.Errorf("error parsing 'retry': %w", err)
 	}
 
+	if err := parseHealthCheck(result, subs.List); err != nil {
+		return fmt.Errorf("error parsing 'health_check': %w", err)
+	}
+
 	return nil
 }
 
@@ -597,6 +652,45 @@ func parseRetry(result *Config, list *ast.ObjectList) error {
 	return nil
 }
 
+func parseHealthCheck(result *Config, list *ast.ObjectList) error {
+	name := "health_check"
+
+	healthCheckList := list.Filter(name)
+	if len(healthCheckList.Items) == 0 {
+		return nil
+	}
+
+	if len(healthCheckList.Items) > 1 {
+		return fmt.Errorf("one and only one %q block is required", name)
+	}
+
+	item := healthCheckList.Items[0]
+
+	var h HealthCheck
+	err := hcl.DecodeObject(&h, item.Val)
+	if err != nil {
+		return err
+	}
+
+	if h.IntervalRaw != nil {
+		if h.Interval, err = parseutil.ParseDurationSecond(h.IntervalRaw); err != nil {
+			return err
+		}
+		h.IntervalRaw = nil
+	}
+
+	if h.TimeoutRaw != nil {
+		if h.Timeout, err = parseutil.ParseDurationSecond(h.TimeoutRaw); err != nil {
+			return err
+		}
+		h.TimeoutRaw = nil
+	}
+
+	result.Vault.HealthCheck = &h
+
+	return nil
+}
+
 func parseAPIProxy(result *Config, list *ast.ObjectList) error {
 	name := "api_proxy"
 
@@ -680,6 +774,32 @@ func parseCache(result *Config, list *ast.ObjectList) error {
 		result.Cache.StaticSecretTokenCapabilityRefreshIntervalRaw = nil
 	}
 
+	if result.Cache.StaticSecretStaleWhileRevalidateRaw != nil {
+		var err error
+		if result.Cache.StaticSecretStaleWhileRevalidate, err = parseutil.ParseDurationSecond(result.Cache.StaticSecretStaleWhileRevalidateRaw); err != nil {
+			return fmt.Errorf("error parsing static_secret_stale_while_revalidate, must be provided as a duration string: %w", err)
+		}
+		if result.Cache.StaticSecretStaleWhileRevalidate < 0 {
+			return errors.New("static_secret_stale_while_revalidate must not be negative")
+		}
+		result.Cache.StaticSecretStaleWhileRevalidateRaw = nil
+	}
+
+	if result.Cache.StaticSecretOfflineMaxStalenessRaw != nil {
+		var err error
+		if result.Cache.StaticSecretOfflineMaxStaleness, err = parseutil.ParseDurationSecond(result.Cache.StaticSecretOfflineMaxStalenessRaw); err != nil {
+			return fmt.Errorf("error parsing static_secret_offline_max_staleness, must be provided as a duration string: %w", err)
+		}
+		if result.Cache.StaticSecretOfflineMaxStaleness < 0 {
+			return errors.New("static_secret_offline_max_staleness must not be negative")
+		}
+		result.Cache.StaticSecretOfflineMaxStalenessRaw = nil
+	}
+
+	if result.Cache.StaticSecretOfflineMode && result.Cache.StaticSecretOfflineMaxStaleness == 0 {
+		result.Cache.StaticSecretOfflineMaxStaleness = DefaultStaticSecretOfflineMaxStaleness
+	}
+
 	return nil
 }
 
@@ -205,6 +205,47 @@ func TestLoadConfigFile_ProxyCacheStaticSecrets(t *testing.T) {
 	}
 }
 
+// TestLoadConfigFile_ProxyCacheStaticSecretsOffline tests loading a config file
+// with static secret caching, stale-while-revalidate and offline mode enabled
+func TestLoadConfigFile_ProxyCacheStaticSecretsOffline(t *testing.T) {
+	config, err := LoadConfigFile("./test-fixtures/config-cache-static-secret-offline.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := config.ValidateConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &Cache{
+		CacheStaticSecrets:               true,
+		StaticSecretStaleWhileRevalidate: 30 * time.Second,
+		StaticSecretOfflineMode:          true,
+		StaticSecretOfflineMaxStaleness:  12 * time.Hour,
+	}
+	if diff := deep.Equal(config.Cache, expected); diff != nil {
+		t.Fatal(diff)
+	}
+}
+
+// TestLoadConfigFile_StaticSecretOfflineModeWithoutStaticSecrets tests that
+// offline mode can't be enabled without static secret caching.
+func TestLoadConfigFile_StaticSecretOfflineModeWithoutStaticSecrets(t *testing.T) {
+	cfg, err := LoadConfigFile("./test-fixtures/config-cache-offline-no-static-secrets.hcl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Offline mode defaults the max staleness
+	if cfg.Cache.StaticSecretOfflineMaxStaleness != DefaultStaticSecretOfflineMaxStaleness {
+		t.Fatalf("expected default max staleness, got %s", cfg.Cache.StaticSecretOfflineMaxStaleness)
+	}
+
+	if err := cfg.ValidateConfig(); err == nil {
+		t.Fatalf("expected error, as offline mode requires static secret caching")
+	}
+}
+
 // Test_LoadConfigFile_AutoAuth_AddrConformance verifies basic config file
 // loading in addition to RFC-5942 §4 normalization of auto-auth methods.
 // See: https://rfc-editor.org/rfc/rfc5952.html
@@ -327,3 +368,26 @@ func Test_LoadConfigFile_AutoAuth_AddrConformance(t *testing.T) {
 		})
 	}
 }
+
+// TestLoadConfigFile_Vault_Addresses tests loading a config file with
+// multiple Vault addresses and a health check configuration
+func TestLoadConfigFile_Vault_Addresses(t *testing.T) {
+	config, err := LoadConfigFile("./test-fixtures/config-vault-addresses.hcl")
+	require.NoError(t, err)
+
+	expected := &Vault{
+		Address:   "http://127.0.0.1:1111",
+		Addresses: []string{"http://127.0.0.1:1111", "http://127.0.0.1:2222"},
+		HealthCheck: &HealthCheck{
+			Interval: 30 * time.Second,
+			Timeout:  2 * time.Second,
+		},
+		Retry: &Retry{
+			NumRetries: 12,
+		},
+	}
+	require.Equal(t, expected, config.Vault)
+
+	_, err = LoadConfigFile("./test-fixtures/bad-config-vault-address-and-addresses.hcl")
+	require.Error(t, err)
+}
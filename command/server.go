@@ -540,6 +540,11 @@ func (c *ServerCommand) runRecoveryMode() int {
 		c.UI.Error(fmt.Sprintf("Error initializing storage of type %s: %s", config.Storage.Type, err))
 		return 1
 	}
+	backend, err = setupStorageEntryCodec(c, config, backend)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
 
 	infoKeys := make([]string, 0, 10)
 	info := make(map[string]string)
@@ -594,6 +599,8 @@ func (c *ServerCommand) runRecoveryMode() int {
 		DisableMlock: config.DisableMlock,
 		RecoveryMode: c.flagRecovery,
 		ClusterAddr:  config.ClusterAddr,
+
+		StorageEntryCompression: config.Storage.EntryCompression,
 	}
 
 	core, newCoreError := vault.NewCore(coreConfig)
@@ -827,6 +834,33 @@ func (c *ServerCommand) setupStorage(config *server.Config) (physical.Backend, e
 	return backend, nil
 }
 
+// setupStorageEntryCodec wraps the storage backend to chunk its entries, if
+// the storage stanza configures it, or if entries were chunked while it did.
+func setupStorageEntryCodec(c *ServerCommand, config *server.Config, backend physical.Backend) (physical.Backend, error) {
+	if config.Storage.EntryChunkSize == 0 {
+		// Raft chunks entries itself
+		if config.Storage.Type == storageTypeRaft {
+			return backend, nil
+		}
+		hasChunks, err := physical.HasEntryChunks(context.Background(), backend)
+		if err != nil {
+			return nil, fmt.Errorf("Error checking storage for chunked entries: %w", err)
+		}
+		if !hasChunks {
+			return backend, nil
+		}
+		c.logger.Warn("storage contains entries split into chunks, which are read and removed as they're overwritten although entry_chunk_size is not set")
+	}
+
+	codec, err := physical.NewEntryCodec(backend, &physical.EntryCodecConfig{
+		ChunkSize: config.Storage.EntryChunkSize,
+	}, c.logger.Named("storage.codec"))
+	if err != nil {
+		return nil, fmt.Errorf("Error configuring storage entry chunking: %w", err)
+	}
+	return codec, nil
+}
+
 func beginServiceRegistration(c *ServerCommand, config *server.Config) (sr.ServiceRegistration, error) {
 	sdFactory, ok := c.ServiceRegistrations[config.ServiceRegistration.Type]
 	if !ok {
@@ -1256,6 +1290,11 @@ func (c *ServerCommand) Run(args []string) int {
 		if c.storageMigrationActive(backend) {
 			return 1
 		}
+		backend, err = c.followStorageCutover(config, backend)
+		if err != nil {
+			c.UI.Error(err.Error())
+			return 1
+		}
 	}
 
 	clusterName := config.ClusterName
@@ -1363,6 +1402,14 @@ func (c *ServerCommand) Run(args []string) int {
 		return 1
 	}
 
+	// Compress and chunk entries if configured, once the HA backend and the
+	// redirect address have been determined from the unwrapped backend
+	coreConfig.Physical, err = setupStorageEntryCodec(c, config, coreConfig.Physical)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
 	// Override the UI enabling config by the environment variable
 	if enableUI := os.Getenv("VAULT_UI"); enableUI != "" {
 		var err error
@@ -2433,6 +2480,36 @@ func (c *ServerCommand) storageMigrationActive(backend physical.Backend) bool {
 	}
 }
 
+// followStorageCutover returns the storage which an online storage migration
+// cut the configured storage over to, replacing the storage configuration
+// with the new storage's, or the configured storage if it was never cut over.
+func (c *ServerCommand) followStorageCutover(config *server.Config, backend physical.Backend) (physical.Backend, error) {
+	cutover, err := vault.ReadStorageCutover(context.Background(), backend)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for storage cutover: %w", err)
+	}
+	if cutover == nil {
+		return backend, nil
+	}
+
+	c.logger.Info("storage was cut over by an online storage migration, using the new storage",
+		"id", cutover.ID, "type", cutover.Type, "time", cutover.Time.Format(time.RFC3339))
+	if closer, ok := backend.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			c.logger.Warn("failed to close the old storage", "error", err)
+		}
+	}
+	config.Storage = &server.Storage{
+		Type:              cutover.Type,
+		RedirectAddr:      config.Storage.RedirectAddr,
+		ClusterAddr:       config.Storage.ClusterAddr,
+		DisableClustering: config.Storage.DisableClustering,
+		EntryCompression:  config.Storage.EntryCompression,
+		Config:            cutover.Config,
+	}
+	return c.setupStorage(config)
+}
+
 type StorageMigrationStatus struct {
 	Start time.Time `json:"start"`
 }
@@ -2957,6 +3034,8 @@ func createCoreConfig(c *ServerCommand, config *server.Config, backend physical.
 		ServiceRegistration:             configSR,
 		Seal:                            barrierSeal,
 		UnwrapSeal:                      unwrapSeal,
+		PhysicalBackends:                c.PhysicalBackends,
+		SeparateHAStorage:               config.HAStorage != nil,
 		AuditBackends:                   c.AuditBackends,
 		CredentialBackends:              c.CredentialBackends,
 		LogicalBackends:                 c.LogicalBackends,
@@ -2964,8 +3043,10 @@ func createCoreConfig(c *ServerCommand, config *server.Config, backend physical.
 		Logger:                          c.logger,
 		DetectDeadlocks:                 config.DetectDeadlocks,
 		ImpreciseLeaseRoleTracking:      config.ImpreciseLeaseRoleTracking,
+		StorageUsageAccounting:          config.EnableStorageUsageAccounting,
 		DisableSentinelTrace:            config.DisableSentinelTrace,
 		DisableCache:                    config.DisableCache,
+		StorageEntryCompression:         config.Storage.EntryCompression,
 		DisableMlock:                    config.DisableMlock,
 		MaxLeaseTTL:                     config.MaxLeaseTTL,
 		DefaultLeaseTTL:                 config.DefaultLeaseTTL,
@@ -126,6 +126,8 @@ type Config struct {
 
 	ImpreciseLeaseRoleTracking bool `hcl:"imprecise_lease_role_tracking"`
 
+	EnableStorageUsageAccounting bool `hcl:"enable_storage_usage_accounting"`
+
 	EnableResponseHeaderRaftNodeID    bool        `hcl:"-"`
 	EnableResponseHeaderRaftNodeIDRaw interface{} `hcl:"enable_response_header_raft_node_id"`
 
@@ -261,6 +263,8 @@ type Storage struct {
 	RedirectAddr      string
 	ClusterAddr       string
 	DisableClustering bool
+	EntryCompression  string
+	EntryChunkSize    int
 	Config            map[string]string
 }
 
@@ -511,6 +515,11 @@ func (c *Config) Merge(c2 *Config) *Config {
 		result.ImpreciseLeaseRoleTracking = c2.ImpreciseLeaseRoleTracking
 	}
 
+	result.EnableStorageUsageAccounting = c.EnableStorageUsageAccounting
+	if c2.EnableStorageUsageAccounting {
+		result.EnableStorageUsageAccounting = c2.EnableStorageUsageAccounting
+	}
+
 	result.EnableResponseHeaderRaftNodeID = c.EnableResponseHeaderRaftNodeID
 	if c2.EnableResponseHeaderRaftNodeID {
 		result.EnableResponseHeaderRaftNodeID = c2.EnableResponseHeaderRaftNodeID
@@ -1172,6 +1181,37 @@ func ParseStorage(result *Config, list *ast.ObjectList, name string) error {
 		delete(m, "disable_clustering")
 	}
 
+	// Pull out the compression and chunking of entries. Entries are
+	// compressed by the barrier before they're encrypted, and chunked by
+	// Vault for any backend other than raft, which chunks entries itself.
+	//
+	// Compression has two risks. As entries are compressed before they're
+	// encrypted, the size of an entry stored by a mount reveals how well its
+	// plaintext compresses, which can leak secrets stored alongside data an
+	// attacker controls, as in the CRIME attack. And compressed entries can't
+	// be read by versions of Vault before 2.2.0, so they're only written once
+	// every node has upgraded, after which downgrading to an older version
+	// isn't possible.
+	var entryCompression string
+	if v, ok := m["entry_compression"]; ok {
+		entryCompression = strings.ToLower(v)
+		delete(m, "entry_compression")
+	}
+
+	var entryChunkSize int
+	if v, ok := m["entry_chunk_size"]; ok {
+		size, err := parseutil.ParseCapacityString(v)
+		if err != nil {
+			return multierror.Prefix(fmt.Errorf("invalid entry_chunk_size: %w", err), fmt.Sprintf("%s.%s:", name, key))
+		}
+		entryChunkSize = int(size)
+		delete(m, "entry_chunk_size")
+	}
+
+	if strings.ToLower(key) == "raft" && entryChunkSize > 0 {
+		return fmt.Errorf("%s.%s: entry_chunk_size is not supported by raft storage", name, key)
+	}
+
 	// Override with top-level values if they are set
 	if result.APIAddr != "" {
 		redirectAddr = configutil.NormalizeAddr(result.APIAddr)
@@ -1189,6 +1229,8 @@ func ParseStorage(result *Config, list *ast.ObjectList, name string) error {
 		RedirectAddr:      redirectAddr,
 		ClusterAddr:       clusterAddr,
 		DisableClustering: disableClustering,
+		EntryCompression:  entryCompression,
+		EntryChunkSize:    entryChunkSize,
 		Type:              strings.ToLower(key),
 		Config:            m,
 	}
@@ -1227,6 +1269,7 @@ var storageAddressKeys = map[string][]string{
 	"raft":                   {}, // retry_join is handled separately in normalizeRaftRetryJoin()
 	"s3":                     {"endpoint"},
 	"spanner":                {},
+	"sqlite":                 {},
 	"swift":                  {"auth_url", "storage_url"},
 	"zookeeper":              {"address"},
 }
@@ -1523,6 +1566,8 @@ func (c *Config) Sanitized() map[string]interface{} {
 
 		"imprecise_lease_role_tracking": c.ImpreciseLeaseRoleTracking,
 
+		"enable_storage_usage_accounting": c.EnableStorageUsageAccounting,
+
 		"enable_post_unseal_trace":    c.EnablePostUnsealTrace,
 		"post_unseal_trace_directory": c.PostUnsealTraceDir,
 
@@ -65,6 +65,46 @@ func TestParseStorage(t *testing.T) {
 	testParseStorageTemplate(t)
 }
 
+// TestParseStorageEntryCodec tests that the entry compression and chunking
+// settings are pulled out of the storage stanza's config
+func TestParseStorageEntryCodec(t *testing.T) {
+	config, err := ParseConfig(`
+storage "dynamodb" {
+	table             = "vault"
+	entry_compression = "Gzip"
+	entry_chunk_size  = "350KiB"
+}
+`, "")
+	require.NoError(t, err)
+	require.Equal(t, "gzip", config.Storage.EntryCompression)
+	require.Equal(t, 350*1024, config.Storage.EntryChunkSize)
+	require.Equal(t, map[string]string{"table": "vault"}, config.Storage.Config)
+
+	_, err = ParseConfig(`
+storage "dynamodb" {
+	entry_chunk_size = "lots"
+}
+`, "")
+	require.ErrorContains(t, err, "entry_chunk_size")
+
+	_, err = ParseConfig(`
+storage "raft" {
+	path             = "/tmp/raft"
+	entry_chunk_size = 4096
+}
+`, "")
+	require.ErrorContains(t, err, "not supported by raft storage")
+
+	config, err = ParseConfig(`
+storage "raft" {
+	path              = "/tmp/raft"
+	entry_compression = "snappy"
+}
+`, "")
+	require.NoError(t, err)
+	require.Equal(t, "snappy", config.Storage.EntryCompression)
+}
+
 // TestParseStorageURLConformance tests that all config attrs whose values can be
 // URLs, IP addresses, or host:port addresses, when configured with an IPv6
 // address, the normalized to be conformant with RFC-5942 §4
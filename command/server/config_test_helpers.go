@@ -953,16 +953,17 @@ func testConfig_Sanitized(t *testing.T) {
 			"add_lease_metrics_namespace_labels":     false,
 			"add_mount_point_rollback_metrics":       false,
 		},
-		"administrative_namespace_path":  "admin/",
-		"operator_namespace_path":        "",
-		"imprecise_lease_role_tracking":  false,
-		"enable_post_unseal_trace":       true,
-		"post_unseal_trace_directory":    "/tmp",
-		"remove_irrevocable_lease_after": (30 * 24 * time.Hour) / time.Second,
-		"allow_audit_log_prefixing":      false,
-		"enable_unauthenticated_access":  []string(nil),
-		"deny_slash_in_templated_paths":  false,
-		"disable_goroutine_trace_dump":   false,
+		"administrative_namespace_path":   "admin/",
+		"operator_namespace_path":         "",
+		"imprecise_lease_role_tracking":   false,
+		"enable_storage_usage_accounting": false,
+		"enable_post_unseal_trace":        true,
+		"post_unseal_trace_directory":     "/tmp",
+		"remove_irrevocable_lease_after":  (30 * 24 * time.Hour) / time.Second,
+		"allow_audit_log_prefixing":       false,
+		"enable_unauthenticated_access":   []string(nil),
+		"deny_slash_in_templated_paths":   false,
+		"disable_goroutine_trace_dump":    false,
 	}
 
 	addExpectedEntSanitizedConfig(expected, []string{"http"})
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*TokenListCommand)(nil)
+	_ cli.CommandAutocomplete = (*TokenListCommand)(nil)
+)
+
+type TokenListCommand struct {
+	*BaseCommand
+
+	flagEntity string
+}
+
+func (c *TokenListCommand) Synopsis() string {
+	return "List token accessors"
+}
+
+func (c *TokenListCommand) Help() string {
+	helpText := `
+Usage: vault token list [options]
+
+  Lists the accessors of tokens. By default the accessors of all tokens are
+  listed, which requires sudo permission on the /auth/token/accessors
+  endpoint.
+
+  List the accessors of all tokens:
+
+      $ vault token list
+
+  List the accessors of the tokens issued to an entity, along with when they
+  were created and the mount they were issued by:
+
+      $ vault token list -entity=7d2e3179-f69b-450c-7179-ac8ee8bd8ca9 -detailed
+
+  For a full list of examples, please see the documentation.
+
+` + c.Flags().Help()
+
+	return strings.TrimSpace(helpText)
+}
+
+func (c *TokenListCommand) Flags() *FlagSets {
+	set := c.flagSet(FlagSetHTTP | FlagSetOutputFormat | FlagSetOutputDetailed)
+
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&StringVar{
+		Name:       "entity",
+		Target:     &c.flagEntity,
+		Default:    "",
+		EnvVar:     "",
+		Completion: complete.PredictAnything,
+		Usage: "ID of an identity entity. If set, only the tokens issued to " +
+			"the entity, or to entities merged into it, are listed.",
+	})
+
+	return set
+}
+
+func (c *TokenListCommand) AutocompleteArgs() complete.Predictor {
+	return nil
+}
+
+func (c *TokenListCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *TokenListCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = f.Args()
+	if len(args) > 0 {
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected 0, got %d)", len(args)))
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 2
+	}
+
+	path := "auth/token/accessors"
+	if c.flagEntity != "" {
+		path = fmt.Sprintf("identity/entity/id/%s/tokens", url.PathEscape(c.flagEntity))
+	}
+
+	secret, err := client.Logical().List(path)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error listing tokens: %s", err))
+		return 2
+	}
+
+	_, ok := extractListData(secret)
+	if Format(c.UI) != "table" {
+		if secret == nil || secret.Data == nil || !ok {
+			OutputData(c.UI, map[string]interface{}{})
+			return 2
+		}
+	}
+
+	if secret == nil || secret.Data == nil || !ok {
+		c.UI.Error("No tokens found")
+		return 2
+	}
+
+	return OutputList(c.UI, secret)
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/cli"
+)
+
+func testTokenListCommand(tb testing.TB) (*cli.MockUi, *TokenListCommand) {
+	tb.Helper()
+
+	ui := cli.NewMockUi()
+	return ui, &TokenListCommand{
+		BaseCommand: &BaseCommand{
+			UI: ui,
+		},
+	}
+}
+
+func TestTokenListCommand_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("too_many_args", func(t *testing.T) {
+		t.Parallel()
+
+		client, closer := testVaultServer(t)
+		defer closer()
+
+		ui, cmd := testTokenListCommand(t)
+		cmd.client = client
+
+		code := cmd.Run([]string{"abcd1234"})
+		if exp := 1; code != exp {
+			t.Errorf("expected %d to be %d", code, exp)
+		}
+
+		expected := "Too many arguments"
+		combined := ui.OutputWriter.String() + ui.ErrorWriter.String()
+		if !strings.Contains(combined, expected) {
+			t.Errorf("expected %q to contain %q", combined, expected)
+		}
+	})
+
+	t.Run("accessors", func(t *testing.T) {
+		t.Parallel()
+
+		client, closer := testVaultServer(t)
+		defer closer()
+
+		_, accessor := testTokenAndAccessor(t, client)
+
+		ui, cmd := testTokenListCommand(t)
+		cmd.client = client
+
+		code := cmd.Run([]string{})
+		if exp := 0; code != exp {
+			t.Errorf("expected %d to be %d: %s", code, exp, ui.ErrorWriter.String())
+		}
+
+		combined := ui.OutputWriter.String() + ui.ErrorWriter.String()
+		if !strings.Contains(combined, accessor) {
+			t.Errorf("expected %q to contain %q", combined, accessor)
+		}
+	})
+
+	t.Run("entity", func(t *testing.T) {
+		t.Parallel()
+
+		client, closer := testVaultServer(t)
+		defer closer()
+
+		secret, err := client.Logical().Write("identity/entity", map[string]interface{}{
+			"name": "test",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		entityID := secret.Data["id"].(string)
+
+		// The token created by the helper is not issued to the entity
+		testTokenAndAccessor(t, client)
+
+		ui, cmd := testTokenListCommand(t)
+		cmd.client = client
+
+		code := cmd.Run([]string{"-entity", entityID})
+		if exp := 2; code != exp {
+			t.Errorf("expected %d to be %d", code, exp)
+		}
+
+		expected := "No tokens found"
+		combined := ui.OutputWriter.String() + ui.ErrorWriter.String()
+		if !strings.Contains(combined, expected) {
+			t.Errorf("expected %q to contain %q", combined, expected)
+		}
+	})
+
+	t.Run("no_tabs", func(t *testing.T) {
+		t.Parallel()
+
+		_, cmd := testTokenListCommand(t)
+		assertNoTabs(t, cmd)
+	})
+}
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package pgpkeys
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"filippo.io/age"
+	"filippo.io/age/agessh"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	ageRecipientPrefix = "age1"
+	ageIdentityPrefix  = "AGE-SECRET-KEY-1"
+	sshKeyPrefix       = "ssh-"
+)
+
+// isAgeRecipient returns whether the key is an age recipient, either a native
+// X25519 recipient or an SSH public key, rather than a base64-encoded PGP key,
+// which never starts with either prefix.
+func isAgeRecipient(key string) bool {
+	return strings.HasPrefix(key, ageRecipientPrefix) || strings.HasPrefix(key, sshKeyPrefix)
+}
+
+// parseAgeRecipient parses an age X25519 recipient, in the "age1..." format,
+// or an ssh-ed25519 public key, in the authorized_keys format. Along with the
+// recipient, it returns its fingerprint, which is the recipient itself for an
+// X25519 recipient and the SHA256 fingerprint of an SSH key, and the recipient
+// without any comment.
+func parseAgeRecipient(key string) (age.Recipient, string, string, error) {
+	key = strings.TrimSpace(key)
+
+	if strings.HasPrefix(key, sshKeyPrefix) {
+		pubKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+		if err != nil {
+			return nil, "", "", fmt.Errorf("error parsing SSH public key: %w", err)
+		}
+		if pubKey.Type() != ssh.KeyAlgoED25519 {
+			return nil, "", "", fmt.Errorf("unsupported SSH key type %q, only %q keys are supported", pubKey.Type(), ssh.KeyAlgoED25519)
+		}
+		recipient, err := agessh.NewEd25519Recipient(pubKey)
+		if err != nil {
+			return nil, "", "", fmt.Errorf("error parsing SSH public key: %w", err)
+		}
+		canonical := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pubKey)))
+		return recipient, ssh.FingerprintSHA256(pubKey), canonical, nil
+	}
+
+	recipient, err := age.ParseX25519Recipient(key)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("error parsing age recipient, only X25519 recipients are supported: %w", err)
+	}
+	return recipient, recipient.String(), recipient.String(), nil
+}
+
+// readAgeRecipient returns the age recipient in the contents of a recipients
+// file or an SSH public key file, or "" if it doesn't contain exactly one.
+func readAgeRecipient(data []byte) (string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if scanner.Err() != nil || len(lines) != 1 || !isAgeRecipient(lines[0]) {
+		return "", nil
+	}
+
+	_, _, canonical, err := parseAgeRecipient(lines[0])
+	if err != nil {
+		return "", err
+	}
+	return canonical, nil
+}
+
+// encryptAge encrypts the input to the age recipient, returning the
+// fingerprint of the recipient and the binary age file.
+func encryptAge(input []byte, key string) (string, []byte, error) {
+	recipient, fingerprint, _, err := parseAgeRecipient(key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	ctBuf := bytes.NewBuffer(nil)
+	pt, err := age.Encrypt(ctBuf, recipient)
+	if err != nil {
+		return "", nil, fmt.Errorf("error setting up encryption for age file: %w", err)
+	}
+	if _, err := pt.Write(input); err != nil {
+		return "", nil, fmt.Errorf("error encrypting age file: %w", err)
+	}
+	if err := pt.Close(); err != nil {
+		return "", nil, fmt.Errorf("error encrypting age file: %w", err)
+	}
+	return fingerprint, ctBuf.Bytes(), nil
+}
+
+// DecryptAgeBytes takes in base64-encoded encrypted bytes and either an age
+// X25519 identity, in the "AGE-SECRET-KEY-1..." format, or an unencrypted
+// OpenSSH ed25519 private key, and decrypts it as DecryptBytes does with a PGP
+// private key.
+func DecryptAgeBytes(encodedCrypt, identity string) (*bytes.Buffer, error) {
+	cryptBytes, err := base64.StdEncoding.DecodeString(encodedCrypt)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding base64 crypted bytes: %w", err)
+	}
+
+	var id age.Identity
+	if strings.HasPrefix(strings.TrimSpace(identity), ageIdentityPrefix) {
+		id, err = age.ParseX25519Identity(strings.TrimSpace(identity))
+	} else {
+		id, err = agessh.ParseIdentity([]byte(identity))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error parsing identity: %w", err)
+	}
+
+	pt, err := age.Decrypt(bytes.NewReader(cryptBytes), id)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting the age file: %w", err)
+	}
+
+	ptBuf := bytes.NewBuffer(nil)
+	if _, err := ptBuf.ReadFrom(pt); err != nil {
+		return nil, fmt.Errorf("error decrypting the age file: %w", err)
+	}
+	return ptBuf, nil
+}
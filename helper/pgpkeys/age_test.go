@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package pgpkeys
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testSSHFingerprint1 = "SHA256:yRnWyrIbEXsHMH09XHcwzBjB363whnH5ThUxItntGmE"
+
+// TestEncryptShares_Age encrypts shares to a mix of PGP keys and age
+// recipients, and checks that each can be decrypted with its own key.
+func TestEncryptShares_Age(t *testing.T) {
+	shares := [][]byte{[]byte("share-1"), []byte("share-2"), []byte("share-3")}
+	keys := []string{TestPubKey1, TestAgeRecipient1, TestSSHPubKey1 + " user@host"}
+
+	fingerprints, encrypted, err := EncryptShares(shares, keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := GetFingerprints(keys, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, fingerprints) {
+		t.Fatalf("bad fingerprints: expected %v, got %v", expected, fingerprints)
+	}
+	if fingerprints[1] != TestAgeRecipient1 {
+		t.Fatalf("bad age fingerprint: %s", fingerprints[1])
+	}
+	if fingerprints[2] != testSSHFingerprint1 {
+		t.Fatalf("bad SSH fingerprint: %s", fingerprints[2])
+	}
+
+	for i, privKey := range []string{TestPrivKey1, TestAgeIdentity1, TestSSHPrivKey1} {
+		encoded := base64.StdEncoding.EncodeToString(encrypted[i])
+		decrypt := DecryptAgeBytes
+		if i == 0 {
+			decrypt = DecryptBytes
+		}
+		ptBuf, err := decrypt(encoded, privKey)
+		if err != nil {
+			t.Fatalf("error decrypting share %d: %v", i, err)
+		}
+		if ptBuf.String() != string(shares[i]) {
+			t.Fatalf("bad share %d: %s", i, ptBuf.String())
+		}
+	}
+
+	// Only the intended identity can decrypt a share
+	if _, err := DecryptAgeBytes(base64.StdEncoding.EncodeToString(encrypted[2]), TestAgeIdentity1); err == nil {
+		t.Fatal("expected an error decrypting with the wrong identity")
+	}
+}
+
+func TestParsePGPKeys_Age(t *testing.T) {
+	tempDir := t.TempDir()
+	recipientsFile := filepath.Join(tempDir, "recipients.txt")
+	if err := os.WriteFile(recipientsFile, []byte("# created: 2025-01-01\n"+TestAgeRecipient1+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	sshFile := filepath.Join(tempDir, "id_ed25519.pub")
+	if err := os.WriteFile(sshFile, []byte(TestSSHPubKey1+" user@host\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	rsaFile := filepath.Join(tempDir, "id_rsa.pub")
+	if err := os.WriteFile(rsaFile, []byte("ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAAAgQDGgFHzSA5LAbO9uQIa76QPbCbBWc8RR7Ecf9nBxbyP0enJ7uBGdVO8/CJRMgmJWE9/wP2bGG8ATgi6nSqgpDH9/H7EIeP9mMkgdqS9gcJPK4jg9JmEwM+W3R9SQ8uVVZgpx/PrRUL86UbEuZnjRkuW7QDvNdM4EJ7qxhSTCM7YJQ==\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := ParsePGPKeys([]string{TestAgeRecipient1, " " + TestSSHPubKey1 + " user@host", recipientsFile, "@" + sshFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{TestAgeRecipient1, TestSSHPubKey1, TestAgeRecipient1, TestSSHPubKey1}
+	if !reflect.DeepEqual(expected, keys) {
+		t.Fatalf("bad keys: expected %v, got %v", expected, keys)
+	}
+
+	for _, keyfile := range []string{rsaFile, "age1invalid", "ssh-ed25519 invalid"} {
+		if _, err := ParsePGPKeys([]string{keyfile}); err == nil {
+			t.Fatalf("expected an error parsing %q", keyfile)
+		}
+	}
+
+	var flag PubKeyFilesFlag
+	if err := flag.Set(strings.Join([]string{TestAgeRecipient1, sshFile}, ",")); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual([]string(flag), []string{TestAgeRecipient1, TestSSHPubKey1}) {
+		t.Fatalf("bad flag value: %v", flag)
+	}
+}
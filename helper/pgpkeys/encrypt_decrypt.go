@@ -13,52 +13,78 @@ import (
 )
 
 // EncryptShares takes an ordered set of byte slices to encrypt and the
-// corresponding base64-encoded public keys to encrypt them with, encrypts each
-// byte slice with the corresponding public key.
-//
-// Note: There is no corresponding test function; this functionality is
-// thoroughly tested in the init and rekey command unit tests
+// corresponding keys to encrypt them with, encrypts each byte slice with the
+// corresponding key. Each key is either a base64-encoded PGP public key or an
+// age recipient, which is an X25519 recipient or an ssh-ed25519 public key.
 func EncryptShares(input [][]byte, pgpKeys []string) ([]string, [][]byte, error) {
 	if len(input) != len(pgpKeys) {
 		return nil, nil, fmt.Errorf("mismatch between number items to encrypt and number of PGP keys")
 	}
+	fingerprints := make([]string, 0, len(pgpKeys))
 	encryptedShares := make([][]byte, 0, len(pgpKeys))
-	entities, err := GetEntities(pgpKeys)
-	if err != nil {
-		return nil, nil, err
-	}
-	for i, entity := range entities {
-		ctBuf := bytes.NewBuffer(nil)
-		pt, err := openpgp.Encrypt(ctBuf, []*openpgp.Entity{entity}, nil, nil, nil)
-		if err != nil {
-			return nil, nil, fmt.Errorf("error setting up encryption for PGP message: %w", err)
+	for i, key := range pgpKeys {
+		var fingerprint string
+		var ct []byte
+		var err error
+		if isAgeRecipient(key) {
+			fingerprint, ct, err = encryptAge(input[i], key)
+		} else {
+			fingerprint, ct, err = encryptPGP(input[i], key)
 		}
-		_, err = pt.Write(input[i])
 		if err != nil {
-			return nil, nil, fmt.Errorf("error encrypting PGP message: %w", err)
+			return nil, nil, err
 		}
-		pt.Close()
-		encryptedShares = append(encryptedShares, ctBuf.Bytes())
+		fingerprints = append(fingerprints, fingerprint)
+		encryptedShares = append(encryptedShares, ct)
 	}
 
-	fingerprints, err := GetFingerprints(nil, entities)
+	return fingerprints, encryptedShares, nil
+}
+
+// encryptPGP encrypts the input with the base64-encoded PGP public key,
+// returning the fingerprint of the key and the binary PGP message.
+func encryptPGP(input []byte, pgpKey string) (string, []byte, error) {
+	entities, err := GetEntities([]string{pgpKey})
 	if err != nil {
-		return nil, nil, err
+		return "", nil, err
 	}
+	ctBuf := bytes.NewBuffer(nil)
+	pt, err := openpgp.Encrypt(ctBuf, entities, nil, nil, nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("error setting up encryption for PGP message: %w", err)
+	}
+	_, err = pt.Write(input)
+	if err != nil {
+		return "", nil, fmt.Errorf("error encrypting PGP message: %w", err)
+	}
+	pt.Close()
 
-	return fingerprints, encryptedShares, nil
+	return fmt.Sprintf("%x", entities[0].PrimaryKey.Fingerprint), ctBuf.Bytes(), nil
 }
 
 // GetFingerprints takes in a list of openpgp Entities and returns the
-// fingerprints. If entities is nil, it will instead parse both entities and
-// fingerprints from the pgpKeys string slice.
+// fingerprints. If entities is nil, it will instead parse the fingerprints from
+// the pgpKeys string slice, which may also contain age recipients.
 func GetFingerprints(pgpKeys []string, entities []*openpgp.Entity) ([]string, error) {
 	if entities == nil {
-		var err error
-		entities, err = GetEntities(pgpKeys)
-		if err != nil {
-			return nil, err
+		ret := make([]string, 0, len(pgpKeys))
+		for _, key := range pgpKeys {
+			if isAgeRecipient(key) {
+				_, fingerprint, _, err := parseAgeRecipient(key)
+				if err != nil {
+					return nil, err
+				}
+				ret = append(ret, fingerprint)
+				continue
+			}
+
+			entities, err := GetEntities([]string{key})
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, fmt.Sprintf("%x", entities[0].PrimaryKey.Fingerprint))
 		}
+		return ret, nil
 	}
 	ret := make([]string, 0, len(entities))
 	for _, entity := range entities {
@@ -15,7 +15,7 @@ import (
 )
 
 // PubKeyFileFlag implements flag.Value and command.Example to receive exactly
-// one PGP or keybase key via a flag.
+// one PGP, keybase or age key via a flag.
 type PubKeyFileFlag string
 
 func (p *PubKeyFileFlag) String() string { return string(*p) }
@@ -66,7 +66,8 @@ func (p *PubKeyFilesFlag) Example() string { return "keybase:user1, keybase:user
 
 // ParsePGPKeys takes a list of PGP keys and parses them either using keybase
 // or reading them from disk and returns the "expanded" list of pgp keys in
-// the same order.
+// the same order. Age X25519 recipients and ssh-ed25519 public keys may also
+// be given directly, or read from a recipients or public key file on disk.
 func ParsePGPKeys(keyfiles []string) ([]string, error) {
 	keys := make([]string, len(keyfiles))
 
@@ -87,6 +88,16 @@ func ParsePGPKeys(keyfiles []string) ([]string, error) {
 			continue
 		}
 
+		// A path to a file which happens to start like a recipient is read
+		if _, statErr := os.Stat(keyfile); statErr != nil && isAgeRecipient(keyfile) {
+			_, _, recipient, err := parseAgeRecipient(keyfile)
+			if err != nil {
+				return nil, err
+			}
+			keys[i] = recipient
+			continue
+		}
+
 		pgpStr, err := ReadPGPFile(keyfile)
 		if err != nil {
 			return nil, err
@@ -97,7 +108,9 @@ func ParsePGPKeys(keyfiles []string) ([]string, error) {
 	return keys, nil
 }
 
-// ReadPGPFile reads the given PGP file from disk.
+// ReadPGPFile reads the given PGP file from disk. If the file contains an age
+// recipient or an ssh-ed25519 public key instead, it's returned without any
+// comment.
 func ReadPGPFile(path string) (string, error) {
 	if len(path) <= 0 {
 		return "", errors.New("empty path")
@@ -116,6 +129,14 @@ func ReadPGPFile(path string) (string, error) {
 		return "", err
 	}
 
+	recipient, err := readAgeRecipient(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("error reading age recipient from file %q: %w", path, err)
+	}
+	if recipient != "" {
+		return recipient, nil
+	}
+
 	// First parse as an armored keyring file, if that doesn't work, treat it as a straight binary/b64 string
 	keyReader := bytes.NewReader(buf.Bytes())
 	entityList, err := openpgp.ReadArmoredKeyRing(keyReader)
@@ -78,6 +78,13 @@ const (
 	VaultInconsistentForward    = "forward-active-node"
 	VaultInconsistentFail       = "fail"
 
+	// VaultMaxStalenessHeaderName is the header set on reads that may be
+	// served by a standby whose data is at most this far behind the active
+	// node. VaultStalenessHeaderName is set on the response to the bound of
+	// the data the read was served from.
+	VaultMaxStalenessHeaderName = "X-Vault-Max-Staleness"
+	VaultStalenessHeaderName    = "X-Vault-Staleness"
+
 	// DefaultMaxRequestSize is the default maximum accepted request size. This
 	// is to prevent a denial of service attack where no Content-Length is
 	// provided and the server is fed ever more data until it exhausts memory.
@@ -1215,6 +1222,23 @@ func forwardBasedOnHeaders(core *vault.Core, r *http.Request) (bool, error) {
 	return core.MissingRequiredState(r.Header.Values(VaultIndexHeaderName), core.PerfStandby()), nil
 }
 
+// maxStalenessFromHeader returns the staleness a read tolerates, if the client
+// set one. It's ignored for requests other than reads.
+func maxStalenessFromHeader(r *http.Request) (time.Duration, bool, error) {
+	raw := r.Header.Get(VaultMaxStalenessHeaderName)
+	if raw == "" || (r.Method != http.MethodGet && r.Method != "LIST") {
+		return 0, false, nil
+	}
+	maxStaleness, err := parseutil.ParseDurationSecond(raw)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s header: %w", VaultMaxStalenessHeaderName, err)
+	}
+	if maxStaleness < 0 {
+		return 0, false, fmt.Errorf("invalid %s header: staleness must not be negative", VaultMaxStalenessHeaderName)
+	}
+	return maxStaleness, true, nil
+}
+
 // handleRequestForwarding determines whether to forward a request or not,
 // falling back on the older behavior of redirecting the client
 func handleRequestForwarding(core *vault.Core, handler http.Handler) http.Handler {
@@ -1230,6 +1254,29 @@ func handleRequestForwarding(core *vault.Core, handler http.Handler) http.Handle
 		// node
 		shouldForward = shouldForward || requiresSnapshot(r)
 
+		// Read replicas serve reads that tolerate some staleness locally if
+		// their data is recent enough, and forward everything else
+		if core.RaftReadReplica() {
+			if !shouldForward {
+				maxStaleness, ok, err := maxStalenessFromHeader(r)
+				if err != nil {
+					respondError(w, http.StatusBadRequest, err)
+					return
+				}
+				if ok {
+					staleness, serve := core.ServeStaleRead(maxStaleness)
+					if serve {
+						w.Header().Set(VaultStalenessHeaderName, staleness.String())
+						handler.ServeHTTP(w, r)
+						return
+					}
+					core.Logger().Trace("request will be forwarded as local data may be too stale",
+						"max_staleness", maxStaleness, "staleness", staleness)
+				}
+			}
+			shouldForward = true
+		}
+
 		// If we are a performance standby we can maybe handle the request.
 		if core.PerfStandby() && !shouldForward {
 			ns, err := namespace.FromContext(r.Context())
@@ -1273,6 +1320,9 @@ func handleRequestForwarding(core *vault.Core, handler http.Handler) http.Handle
 }
 
 func forwardRequest(core *vault.Core, w http.ResponseWriter, r *http.Request) {
+	// The request may have been tried locally as a stale read first
+	w.Header().Del(VaultStalenessHeaderName)
+
 	if r.Header.Get(vault.IntNoForwardingHeaderName) != "" {
 		respondStandby(core, w, r)
 		return
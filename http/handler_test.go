@@ -18,6 +18,7 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-test/deep"
 	"github.com/hashicorp/go-cleanhttp"
@@ -491,7 +492,7 @@ func TestSysMounts_headerAuth(t *testing.T) {
 					"max_lease_ttl":               json.Number("0"),
 					"force_no_cache":              false,
 					"passthrough_request_headers": []interface{}{"Authorization"},
-					"allowed_response_headers":    []interface{}{"Location"},
+					"allowed_response_headers":    []interface{}{"Location", "ETag"},
 				},
 				"local":                  false,
 				"seal_wrap":              false,
@@ -593,7 +594,7 @@ func TestSysMounts_headerAuth(t *testing.T) {
 				"max_lease_ttl":               json.Number("0"),
 				"force_no_cache":              false,
 				"passthrough_request_headers": []interface{}{"Authorization"},
-				"allowed_response_headers":    []interface{}{"Location"},
+				"allowed_response_headers":    []interface{}{"Location", "ETag"},
 			},
 			"local":                  false,
 			"seal_wrap":              false,
@@ -1231,3 +1232,41 @@ func TestHandler_JSONLimitQuotaWrappers(t *testing.T) {
 		})
 	}
 }
+
+// TestHandler_maxStalenessFromHeader verifies that the max staleness header is
+// only honored for reads, and that invalid values are rejected.
+func TestHandler_maxStalenessFromHeader(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		method     string
+		header     string
+		expected   time.Duration
+		expectedOk bool
+		expectErr  bool
+	}{
+		"no header":      {method: http.MethodGet},
+		"get seconds":    {method: http.MethodGet, header: "30", expected: 30 * time.Second, expectedOk: true},
+		"list duration":  {method: "LIST", header: "1m", expected: time.Minute, expectedOk: true},
+		"zero":           {method: http.MethodGet, header: "0s", expectedOk: true},
+		"write ignored":  {method: http.MethodPost, header: "30s"},
+		"invalid":        {method: http.MethodGet, header: "soon", expectErr: true},
+		"negative value": {method: http.MethodGet, header: "-5s", expectErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, "/v1/secret/foo", nil)
+			if tc.header != "" {
+				req.Header.Set(VaultMaxStalenessHeaderName, tc.header)
+			}
+			maxStaleness, ok, err := maxStalenessFromHeader(req)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedOk, ok)
+			require.Equal(t, tc.expected, maxStaleness)
+		})
+	}
+}
@@ -95,7 +95,7 @@ func TestSysMounts(t *testing.T) {
 					"max_lease_ttl":               json.Number("0"),
 					"force_no_cache":              false,
 					"passthrough_request_headers": []interface{}{"Authorization"},
-					"allowed_response_headers":    []interface{}{"Location"},
+					"allowed_response_headers":    []interface{}{"Location", "ETag"},
 				},
 				"local":                  false,
 				"seal_wrap":              false,
@@ -180,7 +180,7 @@ func TestSysMounts(t *testing.T) {
 				"max_lease_ttl":               json.Number("0"),
 				"force_no_cache":              false,
 				"passthrough_request_headers": []interface{}{"Authorization"},
-				"allowed_response_headers":    []interface{}{"Location"},
+				"allowed_response_headers":    []interface{}{"Location", "ETag"},
 			},
 			"local":                  false,
 			"seal_wrap":              false,
@@ -329,7 +329,7 @@ func TestSysMount(t *testing.T) {
 					"max_lease_ttl":               json.Number("0"),
 					"force_no_cache":              false,
 					"passthrough_request_headers": []interface{}{"Authorization"},
-					"allowed_response_headers":    []interface{}{"Location"},
+					"allowed_response_headers":    []interface{}{"Location", "ETag"},
 				},
 				"local":                  false,
 				"seal_wrap":              false,
@@ -430,7 +430,7 @@ func TestSysMount(t *testing.T) {
 				"max_lease_ttl":               json.Number("0"),
 				"force_no_cache":              false,
 				"passthrough_request_headers": []interface{}{"Authorization"},
-				"allowed_response_headers":    []interface{}{"Location"},
+				"allowed_response_headers":    []interface{}{"Location", "ETag"},
 			},
 			"local":                  false,
 			"seal_wrap":              false,
@@ -680,7 +680,7 @@ func TestSysRemount(t *testing.T) {
 					"max_lease_ttl":               json.Number("0"),
 					"force_no_cache":              false,
 					"passthrough_request_headers": []interface{}{"Authorization"},
-					"allowed_response_headers":    []interface{}{"Location"},
+					"allowed_response_headers":    []interface{}{"Location", "ETag"},
 				},
 				"local":                  false,
 				"seal_wrap":              false,
@@ -781,7 +781,7 @@ func TestSysRemount(t *testing.T) {
 				"max_lease_ttl":               json.Number("0"),
 				"force_no_cache":              false,
 				"passthrough_request_headers": []interface{}{"Authorization"},
-				"allowed_response_headers":    []interface{}{"Location"},
+				"allowed_response_headers":    []interface{}{"Location", "ETag"},
 			},
 			"local":                  false,
 			"seal_wrap":              false,
@@ -914,7 +914,7 @@ func TestSysUnmount(t *testing.T) {
 					"max_lease_ttl":               json.Number("0"),
 					"force_no_cache":              false,
 					"passthrough_request_headers": []interface{}{"Authorization"},
-					"allowed_response_headers":    []interface{}{"Location"},
+					"allowed_response_headers":    []interface{}{"Location", "ETag"},
 				},
 				"local":                  false,
 				"seal_wrap":              false,
@@ -999,7 +999,7 @@ func TestSysUnmount(t *testing.T) {
 				"max_lease_ttl":               json.Number("0"),
 				"force_no_cache":              false,
 				"passthrough_request_headers": []interface{}{"Authorization"},
-				"allowed_response_headers":    []interface{}{"Location"},
+				"allowed_response_headers":    []interface{}{"Location", "ETag"},
 			},
 			"local":                  false,
 			"seal_wrap":              false,
@@ -1241,7 +1241,7 @@ func TestSysTuneMount(t *testing.T) {
 					"max_lease_ttl":               json.Number("0"),
 					"force_no_cache":              false,
 					"passthrough_request_headers": []interface{}{"Authorization"},
-					"allowed_response_headers":    []interface{}{"Location"},
+					"allowed_response_headers":    []interface{}{"Location", "ETag"},
 				},
 				"local":                  false,
 				"seal_wrap":              false,
@@ -1342,7 +1342,7 @@ func TestSysTuneMount(t *testing.T) {
 				"max_lease_ttl":               json.Number("0"),
 				"force_no_cache":              false,
 				"passthrough_request_headers": []interface{}{"Authorization"},
-				"allowed_response_headers":    []interface{}{"Location"},
+				"allowed_response_headers":    []interface{}{"Location", "ETag"},
 			},
 			"local":                  false,
 			"seal_wrap":              false,
@@ -1517,7 +1517,7 @@ func TestSysTuneMount(t *testing.T) {
 					"max_lease_ttl":               json.Number("0"),
 					"force_no_cache":              false,
 					"passthrough_request_headers": []interface{}{"Authorization"},
-					"allowed_response_headers":    []interface{}{"Location"},
+					"allowed_response_headers":    []interface{}{"Location", "ETag"},
 				},
 				"local":                  false,
 				"seal_wrap":              false,
@@ -1618,7 +1618,7 @@ func TestSysTuneMount(t *testing.T) {
 				"max_lease_ttl":               json.Number("0"),
 				"force_no_cache":              false,
 				"passthrough_request_headers": []interface{}{"Authorization"},
-				"allowed_response_headers":    []interface{}{"Location"},
+				"allowed_response_headers":    []interface{}{"Location", "ETag"},
 			},
 			"local":                  false,
 			"seal_wrap":              false,
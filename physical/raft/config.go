@@ -33,6 +33,7 @@ type RaftBackendConfig struct {
 	AutopilotReconcileInterval  time.Duration
 	AutopilotUpdateInterval     time.Duration
 	RetryJoin                   string
+	ServeStaleReads             bool
 
 	// Enterprise only
 	RaftNonVoter                       bool
@@ -127,6 +128,14 @@ func parseRaftBackendConfig(conf map[string]string, logger log.Logger) (*RaftBac
 		}
 	}
 
+	if staleReadsRaw, ok := conf["serve_stale_reads"]; ok {
+		serveStaleReads, err := strconv.ParseBool(staleReadsRaw)
+		if err != nil {
+			return nil, fmt.Errorf("serve_stale_reads does not parse as a boolean: %w", err)
+		}
+		c.ServeStaleReads = serveStaleReads
+	}
+
 	if delayRaw, ok := conf["snapshot_delay"]; ok {
 		delay, err := parseutil.ParseDurationSecond(delayRaw)
 		if err != nil {
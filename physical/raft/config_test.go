@@ -323,6 +323,24 @@ func TestRaft_ParseConfig(t *testing.T) {
 			wantWarns: ceOnlyWarnings("configuration for a Vault Enterprise feature has been ignored: field=retry_join_as_non_voter"),
 		},
 
+		// Stale reads -----------------------------------------------------------
+		{
+			name: "serve stale reads",
+			conf: map[string]string{
+				"serve_stale_reads": "true",
+			},
+			wantMutation: func(cfg *RaftBackendConfig) {
+				cfg.ServeStaleReads = true
+			},
+		},
+		{
+			name: "serve stale reads junk",
+			conf: map[string]string{
+				"serve_stale_reads": "notabooleanlol",
+			},
+			wantErr: "serve_stale_reads does not parse as a boolean",
+		},
+
 		// Entry Size Limits -----------------------------------------------------
 		{
 			name: "entry size, happy path",
@@ -40,6 +40,7 @@ const (
 	restoreCallbackOp
 	getOp
 	verifierCheckpointOp
+	compactOp
 
 	chunkingPrefix   = "raftchunking/"
 	databaseFilename = "vault.db"
@@ -176,8 +177,15 @@ type FSM struct {
 	// retoreCb is called after we've restored a snapshot
 	restoreCb restoreCallback
 
+	// invalidateCb is called with the keys written by each applied batch, and
+	// with nil once a snapshot has been installed
+	invalidateCb func(keys []string)
+
 	chunker *logVerificationChunkingShim
 
+	// compaction tracks the last compaction of the database
+	compaction compactionTracker
+
 	localID         string
 	desiredSuffrage string
 	// metricSuffix should contain a dash, since it will be appended directly to the end of the key string.
@@ -253,6 +261,17 @@ func (r *RaftBackend) SetFSMApplyCallback(f func()) {
 	r.fsm.l.Unlock()
 }
 
+// SetFSMInvalidateCallback sets a function that is called with the keys
+// written by every batch applied to the FSM, before the batch's index is
+// reported as applied. It is called with nil keys after a snapshot has been
+// installed, in which case any key may have changed. The callback must not
+// write to the backend.
+func (r *RaftBackend) SetFSMInvalidateCallback(f func(keys []string)) {
+	r.fsm.l.Lock()
+	r.fsm.invalidateCb = f
+	r.fsm.l.Unlock()
+}
+
 func (f *FSM) openDBFile(dbPath string) error {
 	if len(dbPath) == 0 {
 		return errors.New("can not open empty filename")
@@ -743,13 +762,17 @@ func (f *FSM) ApplyBatch(logs []*raft.Log) []interface{} {
 		}
 	}
 
+	f.compactIfRequested(logs, commands, latestIndex.Index)
+
 	f.l.RLock()
-	defer f.l.RUnlock()
 
 	if f.applyCallback != nil {
 		f.applyCallback()
 	}
 
+	invalidateCb := f.invalidateCb
+	var invalidated []string
+
 	err = f.db.Update(func(tx *bolt.Tx) error {
 		b := tx.Bucket(dataBucketName)
 		for _, commandRaw := range commands {
@@ -762,8 +785,14 @@ func (f *FSM) ApplyBatch(logs []*raft.Log) []interface{} {
 					switch op.OpType {
 					case putOp:
 						err = b.Put([]byte(op.Key), op.Value)
+						if invalidateCb != nil {
+							invalidated = append(invalidated, op.Key)
+						}
 					case deleteOp:
 						err = b.Delete([]byte(op.Key))
+						if invalidateCb != nil {
+							invalidated = append(invalidated, op.Key)
+						}
 					case getOp:
 						fsmEntry := &FSMEntry{
 							Key: op.Key,
@@ -780,6 +809,9 @@ func (f *FSM) ApplyBatch(logs []*raft.Log) []interface{} {
 							// Kick off the restore callback function in a go routine
 							go f.restoreCb(context.Background())
 						}
+					case compactOp:
+						// Handled by compactIfRequested before the batch is
+						// applied
 					default:
 						return fmt.Errorf("%d is not a supported transaction operation", op.OpType)
 					}
@@ -812,11 +844,20 @@ func (f *FSM) ApplyBatch(logs []*raft.Log) []interface{} {
 
 		return nil
 	})
+	f.l.RUnlock()
 	if err != nil {
 		f.logger.Error("failed to store data", "error", err)
 		panic("failed to store data")
 	}
 
+	// Invalidate anything cached from the written keys before the batch is
+	// reported as applied, so that reads bounded by the applied index don't
+	// see older cached values. This is done without holding the lock, as the
+	// callback may read from the FSM.
+	if len(invalidated) > 0 {
+		invalidateCb(invalidated)
+	}
+
 	// If we advanced the latest value, update the in-memory representation too.
 	if len(logIndex) > 0 {
 		atomic.StoreUint64(f.latestTerm, lastLog.Term)
@@ -939,6 +980,17 @@ func (f *FSM) Restore(r io.ReadCloser) error {
 		}
 	}
 
+	// Everything may have changed once the snapshot is installed. This is
+	// deferred before the lock is taken so that it runs once it's released.
+	defer func() {
+		f.l.RLock()
+		invalidateCb := f.invalidateCb
+		f.l.RUnlock()
+		if invalidateCb != nil {
+			invalidateCb(nil)
+		}
+	}()
+
 	f.l.Lock()
 	defer f.l.Unlock()
 
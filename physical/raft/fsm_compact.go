@@ -0,0 +1,381 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package raft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	metrics "github.com/hashicorp/go-metrics/compat"
+	"github.com/hashicorp/raft"
+	"github.com/hashicorp/vault/sdk/helper/jsonutil"
+	"github.com/rboyer/safeio"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	compactFilenameSuffix = ".compact"
+
+	// compactTxMaxSize is the amount of data copied into the compacted
+	// database in each transaction.
+	compactTxMaxSize = 64 * 1024 * 1024
+
+	// compactProgressInterval is how often progress is logged while
+	// compacting.
+	compactProgressInterval = 10 * time.Second
+
+	CompactionStateRunning   = "running"
+	CompactionStateCompleted = "completed"
+	CompactionStateFailed    = "failed"
+)
+
+// CompactionStatus is the state of the last compaction of the FSM's database
+// on this node.
+type CompactionStatus struct {
+	RequestID  string    `json:"request_id"`
+	State      string    `json:"state"`
+	Error      string    `json:"error,omitempty"`
+	StartTime  time.Time `json:"start_time"`
+	EndTime    time.Time `json:"end_time,omitempty"`
+	KeysCopied uint64    `json:"keys_copied"`
+	KeysTotal  uint64    `json:"keys_total"`
+	SizeBefore int64     `json:"size_before"`
+	SizeAfter  int64     `json:"size_after,omitempty"`
+}
+
+// compactionTracker holds the status of the last compaction, which is updated
+// while the FSM is locked.
+type compactionTracker struct {
+	l      sync.Mutex
+	status *CompactionStatus
+}
+
+func (t *compactionTracker) update(fn func(*CompactionStatus)) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	fn(t.status)
+}
+
+// get returns a copy of the last compaction's status, or nil if there hasn't
+// been one.
+func (t *compactionTracker) get() *CompactionStatus {
+	t.l.Lock()
+	defer t.l.Unlock()
+	if t.status == nil {
+		return nil
+	}
+	status := *t.status
+	return &status
+}
+
+// Compact rewrites the FSM's database into a new file, releasing the space
+// of free pages left behind by deleted data, which bolt never returns to the
+// filesystem. The FSM is locked while compacting, so nothing can be applied
+// to it or read from it; compaction should only be done on followers.
+func (f *FSM) Compact(requestID string) error {
+	defer metrics.MeasureSince([]string{"raft_storage", "fsm", "compact"}, time.Now())
+
+	f.compaction.l.Lock()
+	f.compaction.status = &CompactionStatus{
+		RequestID: requestID,
+		State:     CompactionStateRunning,
+		StartTime: time.Now().UTC(),
+	}
+	f.compaction.l.Unlock()
+
+	err := f.compact()
+
+	f.compaction.update(func(s *CompactionStatus) {
+		s.EndTime = time.Now().UTC()
+		if err != nil {
+			s.State = CompactionStateFailed
+			s.Error = err.Error()
+			return
+		}
+		s.State = CompactionStateCompleted
+	})
+	if err != nil {
+		f.logger.Error("failed to compact database", "request_id", requestID, "error", err)
+		return err
+	}
+
+	status := f.compaction.get()
+	f.logger.Info("compacted database", "request_id", requestID,
+		"size_before", status.SizeBefore, "size_after", status.SizeAfter,
+		"elapsed", status.EndTime.Sub(status.StartTime))
+	return nil
+}
+
+// CompactionStatus returns the status of the last compaction of the FSM's
+// database, or nil if it hasn't been compacted since the node started.
+func (f *FSM) CompactionStatus() *CompactionStatus {
+	return f.compaction.get()
+}
+
+func (f *FSM) compact() error {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	dbPath := filepath.Join(f.path, databaseFilename)
+	compactPath := dbPath + compactFilenameSuffix
+
+	st, err := os.Stat(dbPath)
+	if err != nil {
+		return err
+	}
+
+	var total uint64
+	err = f.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(_ []byte, b *bolt.Bucket) error {
+			total += uint64(b.Stats().KeyN)
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	f.compaction.update(func(s *CompactionStatus) {
+		s.SizeBefore = st.Size()
+		s.KeysTotal = total
+	})
+
+	// Remove anything left behind by a compaction that didn't finish
+	if err := os.Remove(compactPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	f.logger.Info("compacting database", "path", dbPath, "size", st.Size(), "keys", total)
+	compacted, err := bolt.Open(compactPath, 0o600, boltOptions(compactPath))
+	if err != nil {
+		return fmt.Errorf("failed to create compacted database: %w", err)
+	}
+	lastLog := time.Now()
+	err = compactBolt(compacted, f.db, func(copied uint64) {
+		f.compaction.update(func(s *CompactionStatus) {
+			s.KeysCopied = copied
+		})
+		if time.Since(lastLog) > compactProgressInterval {
+			lastLog = time.Now()
+			f.logger.Info("compacting database", "keys_copied", copied, "keys_total", total)
+		}
+	})
+	if closeErr := compacted.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(compactPath)
+		return fmt.Errorf("failed to compact database: %w", err)
+	}
+
+	if err := f.db.Close(); err != nil {
+		os.Remove(compactPath)
+		return fmt.Errorf("failed to close database: %w", err)
+	}
+
+	// Install the compacted file. We want to open the database regardless of
+	// whether this worked, in which case it's the original file.
+	var retErr error
+	if runtime.GOOS != "windows" {
+		retErr = safeio.Rename(compactPath, dbPath)
+	} else {
+		retErr = os.Rename(compactPath, dbPath)
+	}
+	if retErr != nil {
+		os.Remove(compactPath)
+		retErr = fmt.Errorf("failed to install compacted database: %w", retErr)
+	}
+	if err := f.openDBFile(dbPath); err != nil {
+		return errors.Join(retErr, fmt.Errorf("failed to open database: %w", err))
+	}
+	if retErr != nil {
+		return retErr
+	}
+
+	st, err = os.Stat(dbPath)
+	if err != nil {
+		return err
+	}
+	f.compaction.update(func(s *CompactionStatus) {
+		s.SizeAfter = st.Size()
+	})
+	return nil
+}
+
+// compactBolt copies every bucket of src into dst, which should be empty,
+// calling progress with the number of keys copied after each transaction.
+// Unlike bolt.Compact, pages are only filled to the default fill percent, as
+// the database continues to be written to afterwards.
+func compactBolt(dst, src *bolt.DB, progress func(uint64)) error {
+	tx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tx.Rollback()
+	}()
+
+	var size int64
+	var copied uint64
+	// gen is incremented by each commit, after which the buckets being
+	// copied into must be looked up again in the new transaction.
+	var gen int
+	lookup := func(path [][]byte) *bolt.Bucket {
+		b := tx.Bucket(path[0])
+		for _, name := range path[1:] {
+			b = b.Bucket(name)
+		}
+		return b
+	}
+
+	var copyBucket func(srcBucket *bolt.Bucket, path [][]byte) error
+	copyBucket = func(srcBucket *bolt.Bucket, path [][]byte) error {
+		dstBucket, dstGen := lookup(path), gen
+		if err := dstBucket.SetSequence(srcBucket.Sequence()); err != nil {
+			return err
+		}
+		return srcBucket.ForEach(func(k, v []byte) error {
+			sz := int64(len(k) + len(v))
+			if size+sz > compactTxMaxSize {
+				if err := tx.Commit(); err != nil {
+					return err
+				}
+				progress(copied)
+				tx, err = dst.Begin(true)
+				if err != nil {
+					return err
+				}
+				size = 0
+				gen++
+			}
+			if dstGen != gen {
+				dstBucket, dstGen = lookup(path), gen
+			}
+			size += sz
+
+			if v == nil {
+				if _, err := dstBucket.CreateBucket(k); err != nil {
+					return err
+				}
+				childPath := append(append([][]byte{}, path...), k)
+				return copyBucket(srcBucket.Bucket(k), childPath)
+			}
+			copied++
+			return dstBucket.Put(k, v)
+		})
+	}
+
+	err = src.View(func(srcTx *bolt.Tx) error {
+		return srcTx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			if _, err := tx.CreateBucket(name); err != nil {
+				return err
+			}
+			return copyBucket(b, [][]byte{name})
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	progress(copied)
+	return nil
+}
+
+// compactionRequest is the value of a compactOp, which is applied through
+// raft so that followers compact their database in between applying logs.
+type compactionRequest struct {
+	ID string `json:"id"`
+}
+
+// compactIfRequested compacts the FSM's database if any of the logs request
+// it for this node, before they're applied. This means the applied index
+// reported to the leader doesn't reach the request until the compaction is
+// done. Logs which were already applied, and are being replayed on startup,
+// are ignored.
+func (f *FSM) compactIfRequested(logs []*raft.Log, commands []interface{}, latestIndex uint64) {
+	for i, commandRaw := range commands {
+		command, ok := commandRaw.(*LogData)
+		if !ok || logs[i].Index <= latestIndex {
+			continue
+		}
+		for _, op := range command.Operations {
+			if op.OpType != compactOp || op.Key != f.localID {
+				continue
+			}
+			var req compactionRequest
+			if err := jsonutil.DecodeJSON(op.Value, &req); err != nil {
+				f.logger.Error("failed to decode compaction request", "error", err)
+				continue
+			}
+			// Failures are recorded in the compaction status, and the
+			// original database is kept.
+			f.Compact(req.ID)
+		}
+	}
+}
+
+// RequestCompaction asks the node with the given ID to compact its FSM
+// database, which it does when the request is applied to its FSM. It returns
+// the index of the request; the node has finished compacting once it has
+// applied it. The request must not be for the leader, as it can't apply logs
+// while compacting.
+func (b *RaftBackend) RequestCompaction(ctx context.Context, nodeID, requestID string) (uint64, error) {
+	b.l.RLock()
+	defer b.l.RUnlock()
+
+	if b.raft == nil {
+		return 0, errors.New("raft storage is not initialized")
+	}
+	if nodeID == b.localID {
+		return 0, errors.New("the leader can't compact its database, it must step down first")
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	value, err := jsonutil.EncodeJSON(&compactionRequest{ID: requestID})
+	if err != nil {
+		return 0, err
+	}
+	commandBytes, err := proto.Marshal(&LogData{
+		Operations: []*LogOperation{
+			{
+				OpType: compactOp,
+				Key:    nodeID,
+				Value:  value,
+			},
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	applyFuture := b.raft.Apply(commandBytes, 0)
+	if err := applyFuture.Error(); err != nil {
+		return 0, err
+	}
+	return applyFuture.Index(), nil
+}
+
+// CompactLocal compacts the FSM database of this node in place, which blocks
+// applying logs until it's done. This is only meant for single node clusters,
+// where there's no follower to take over leadership.
+func (b *RaftBackend) CompactLocal(requestID string) error {
+	b.l.RLock()
+	fsm := b.fsm
+	b.l.RUnlock()
+
+	if fsm == nil {
+		return errors.New("raft storage is not initialized")
+	}
+	return fsm.Compact(requestID)
+}
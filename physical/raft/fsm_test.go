@@ -14,6 +14,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/raft"
+	"github.com/hashicorp/vault/sdk/helper/jsonutil"
 	"github.com/hashicorp/vault/sdk/physical"
 	"github.com/stretchr/testify/require"
 )
@@ -194,3 +195,92 @@ func TestFSM_UnknownOperation(t *testing.T) {
 
 	require.Fail(t, "failed to panic")
 }
+
+// TestFSM_Compact verifies that a compaction requested through a log shrinks
+// the database of the node it's for, without losing any data, and that it
+// isn't repeated when the log is replayed.
+func TestFSM_Compact(t *testing.T) {
+	raftDir := t.TempDir()
+	fsm, err := NewFSM(raftDir, "node1", hclog.NewNullLogger())
+	require.NoError(t, err)
+	defer fsm.Close()
+	ctx := context.Background()
+
+	// Bolt grows its file in 16MB increments, so write enough data to see
+	// it shrink
+	value := make([]byte, 16*1024)
+	for i := 0; i < 4000; i++ {
+		require.NoError(t, fsm.Put(ctx, &physical.Entry{Key: fmt.Sprintf("key-%d", i), Value: value}))
+	}
+	for i := 0; i < 3900; i++ {
+		require.NoError(t, fsm.Delete(ctx, fmt.Sprintf("key-%d", i)))
+	}
+
+	applyCompaction := func(index uint64, nodeID, requestID string) {
+		value, err := jsonutil.EncodeJSON(&compactionRequest{ID: requestID})
+		require.NoError(t, err)
+		commandBytes, err := proto.Marshal(&LogData{
+			Operations: []*LogOperation{{OpType: compactOp, Key: nodeID, Value: value}},
+		})
+		require.NoError(t, err)
+		fsm.ApplyBatch([]*raft.Log{{Index: index, Term: 1, Type: raft.LogCommand, Data: commandBytes}})
+	}
+
+	// Compactions for other nodes are ignored
+	applyCompaction(1, "node2", "other")
+	require.Nil(t, fsm.CompactionStatus())
+
+	applyCompaction(2, "node1", "first")
+	status := fsm.CompactionStatus()
+	require.NotNil(t, status)
+	require.Equal(t, CompactionStateCompleted, status.State, status.Error)
+	require.Equal(t, "first", status.RequestID)
+	require.Less(t, status.SizeAfter, status.SizeBefore)
+	require.Equal(t, status.KeysTotal, status.KeysCopied)
+
+	keys, err := fsm.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, keys, 100)
+	entry, err := fsm.Get(ctx, "key-3999")
+	require.NoError(t, err)
+	require.Equal(t, value, entry.Value)
+	latest, _ := fsm.LatestState()
+	require.Equal(t, uint64(2), latest.Index)
+
+	// Replayed logs don't compact again
+	applyCompaction(2, "node1", "replayed")
+	require.Equal(t, "first", fsm.CompactionStatus().RequestID)
+}
+
+// TestFSM_InvalidateCallback verifies that the invalidate callback is called
+// with the keys written by an applied batch, before the batch's index is
+// reported as applied, and that it can read from the FSM.
+func TestFSM_InvalidateCallback(t *testing.T) {
+	fsm := getFSM(t)
+	ctx := context.Background()
+
+	var invalidated []string
+	fsm.invalidateCb = func(keys []string) {
+		latest, _ := fsm.LatestState()
+		require.Equal(t, uint64(0), latest.Index)
+		entry, err := fsm.Get(ctx, "key-1")
+		require.NoError(t, err)
+		require.NotNil(t, entry)
+		invalidated = append(invalidated, keys...)
+	}
+
+	commandBytes, err := proto.Marshal(&LogData{
+		Operations: []*LogOperation{
+			{OpType: putOp, Key: "key-1", Value: []byte("value-1")},
+			{OpType: putOp, Key: "key-2", Value: []byte("value-2")},
+			{OpType: deleteOp, Key: "key-3"},
+			{OpType: getOp, Key: "key-1"},
+		},
+	})
+	require.NoError(t, err)
+	fsm.ApplyBatch([]*raft.Log{{Index: 1, Term: 1, Type: raft.LogCommand, Data: commandBytes}})
+
+	require.Equal(t, []string{"key-1", "key-2", "key-3"}, invalidated)
+	latest, _ := fsm.LatestState()
+	require.Equal(t, uint64(1), latest.Index)
+}
@@ -0,0 +1,354 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package raft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	metrics "github.com/hashicorp/go-metrics/compat"
+	"github.com/hashicorp/raft"
+	raftboltdb "github.com/hashicorp/raft-boltdb/v2"
+	raftwal "github.com/hashicorp/raft-wal"
+	walmetrics "github.com/hashicorp/raft-wal/metrics"
+	"github.com/hashicorp/raft-wal/migrate"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// LogStoreBoltDB is the log store which keeps raft's logs in a single
+	// BoltDB file.
+	LogStoreBoltDB = "boltdb"
+
+	// LogStoreWAL is the log store which keeps raft's logs in a segmented
+	// write-ahead log, using raft-wal.
+	LogStoreWAL = "wal"
+
+	raftDBFilename = "raft.db"
+
+	logStoreMigrateSuffix = ".migrate"
+	logStoreBackupSuffix  = ".bak"
+
+	// logStoreMigrateBatchBytes is the amount of log data written to the new
+	// log store in each batch when migrating.
+	logStoreMigrateBatchBytes = 16 * 1024 * 1024
+)
+
+// logStore is implemented by the log stores raft can be configured with, each
+// of which also serves as raft's stable store.
+type logStore interface {
+	raft.LogStore
+	raft.StableStore
+	io.Closer
+}
+
+// logStorePath returns the path of the file or directory of the given type
+// of log store within the raft directory.
+func logStorePath(raftBasePath, storeType string) string {
+	if storeType == LogStoreWAL {
+		return filepath.Join(raftBasePath, raftWalDir)
+	}
+	return filepath.Join(raftBasePath, raftDBFilename)
+}
+
+// detectLogStore returns the type of the log store found in the raft
+// directory, or an empty string if there isn't one yet. A BoltDB store takes
+// precedence, as raft-wal was only ever used when it didn't exist.
+func detectLogStore(raftBasePath string) (string, error) {
+	raftDbExists, err := fileExists(logStorePath(raftBasePath, LogStoreBoltDB))
+	if err != nil {
+		return "", fmt.Errorf("failed to check if raft.db already exists: %w", err)
+	}
+	if raftDbExists {
+		return LogStoreBoltDB, nil
+	}
+
+	entries, err := os.ReadDir(logStorePath(raftBasePath, LogStoreWAL))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf("failed to check if raft-wal already exists: %w", err)
+	case len(entries) > 0:
+		return LogStoreWAL, nil
+	}
+	return "", nil
+}
+
+// openLogStore opens, or creates, the log store of the given type at path.
+func openLogStore(storeType, path string, logger log.Logger) (logStore, error) {
+	switch storeType {
+	case LogStoreWAL:
+		if err := EnsurePath(path, true); err != nil {
+			return nil, err
+		}
+
+		mc := walmetrics.NewGoMetricsCollector([]string{"raft", "wal"}, nil, nil)
+		wal, err := raftwal.Open(path, raftwal.WithMetricsCollector(mc))
+		if err != nil {
+			return nil, fmt.Errorf("fail to open write-ahead-log: %w", err)
+		}
+		return wal, nil
+
+	case LogStoreBoltDB:
+		raftDbExists, err := fileExists(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if raft.db already exists: %w", err)
+		}
+
+		opts := boltOptions(path)
+		if runtime.GOOS == "linux" && raftDbExists && !usingMapPopulate(opts.MmapFlags) {
+			logger.Warn("the MAP_POPULATE mmap flag has not been set before opening the log store database. This may be due to the database file being larger than the available memory on the system, or due to the VAULT_RAFT_DISABLE_MAP_POPULATE environment variable being set. As a result, Vault may be slower to start up.")
+		}
+
+		return raftboltdb.New(raftboltdb.Options{
+			Path:                    path,
+			BoltOptions:             opts,
+			MsgpackUseNewTimeFormat: true,
+		})
+
+	default:
+		return nil, fmt.Errorf("unknown log store type %q", storeType)
+	}
+}
+
+// instrumentedLogStore measures how long it takes to append logs to the log
+// store, labelled with the type of store, so that the latency of the log
+// stores can be compared across the nodes of a cluster.
+type instrumentedLogStore struct {
+	raft.LogStore
+	labels []metrics.Label
+}
+
+var (
+	_ raft.MonotonicLogStore = (*instrumentedLogStore)(nil)
+	_ io.Closer              = (*instrumentedLogStore)(nil)
+)
+
+func newInstrumentedLogStore(store raft.LogStore, storeType string) *instrumentedLogStore {
+	return &instrumentedLogStore{
+		LogStore: store,
+		labels:   []metrics.Label{{Name: "store", Value: storeType}},
+	}
+}
+
+func (s *instrumentedLogStore) StoreLog(l *raft.Log) error {
+	return s.StoreLogs([]*raft.Log{l})
+}
+
+func (s *instrumentedLogStore) StoreLogs(logs []*raft.Log) error {
+	defer metrics.MeasureSinceWithLabels([]string{"raft_storage", "logstore", "append"}, time.Now(), s.labels)
+	metrics.IncrCounterWithLabels([]string{"raft_storage", "logstore", "append_entries"}, float32(len(logs)), s.labels)
+	return s.LogStore.StoreLogs(logs)
+}
+
+// IsMonotonic implements raft.MonotonicLogStore, which raft-wal relies on
+// when restoring snapshots.
+func (s *instrumentedLogStore) IsMonotonic() bool {
+	if store, ok := s.LogStore.(raft.MonotonicLogStore); ok {
+		return store.IsMonotonic()
+	}
+	return false
+}
+
+func (s *instrumentedLogStore) Close() error {
+	if closer, ok := s.LogStore.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// LogStoreMigration is the result of migrating a node's log store.
+type LogStoreMigration struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	FirstIndex uint64 `json:"first_index"`
+	LastIndex  uint64 `json:"last_index"`
+	BackupPath string `json:"backup_path"`
+}
+
+// MigrateLogStore converts the log store of the raft node whose data is in
+// path to the given type, copying its logs and stable store values. The node
+// must not be running. The new store is only put in place once it's complete,
+// and the original store is kept next to it with a ".bak" suffix. As the
+// existing store is always used on startup, the node will use the new store
+// regardless of its raft_wal setting, though it should be updated to match.
+func MigrateLogStore(ctx context.Context, path, to string, logger log.Logger) (*LogStoreMigration, error) {
+	if to != LogStoreBoltDB && to != LogStoreWAL {
+		return nil, fmt.Errorf("unknown log store type %q", to)
+	}
+
+	raftBasePath := filepath.Join(path, raftState)
+	from, err := detectLogStore(raftBasePath)
+	if err != nil {
+		return nil, err
+	}
+	switch from {
+	case "":
+		return nil, fmt.Errorf("no raft log store found in %q", raftBasePath)
+	case to:
+		return nil, fmt.Errorf("the log store is already %s", to)
+	}
+
+	if err := ensureNodeStopped(path); err != nil {
+		return nil, err
+	}
+
+	srcPath := logStorePath(raftBasePath, from)
+	dstPath := logStorePath(raftBasePath, to)
+	tmpPath := dstPath + logStoreMigrateSuffix
+	backupPath := srcPath + logStoreBackupSuffix
+
+	backupExists, err := fileExists(backupPath)
+	if err != nil {
+		return nil, err
+	}
+	if backupExists {
+		return nil, fmt.Errorf("a backup of a previous migration already exists at %q, it must be removed first", backupPath)
+	}
+
+	// Remove anything left behind by a migration that didn't finish
+	if err := os.RemoveAll(tmpPath); err != nil {
+		return nil, err
+	}
+
+	src, err := openLogStore(from, srcPath, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s log store: %w", from, err)
+	}
+	dst, err := openLogStore(to, tmpPath, logger)
+	if err != nil {
+		src.Close()
+		os.RemoveAll(tmpPath)
+		return nil, fmt.Errorf("failed to create %s log store: %w", to, err)
+	}
+
+	result := &LogStoreMigration{
+		From:       from,
+		To:         to,
+		BackupPath: backupPath,
+	}
+	err = copyLogStore(ctx, dst, src, result, logger)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := src.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.RemoveAll(tmpPath)
+		return nil, err
+	}
+
+	// Install the new store before moving the original one aside, so that
+	// there's always a complete store to be found on startup.
+	if err := os.Rename(tmpPath, dstPath); err != nil {
+		os.RemoveAll(tmpPath)
+		return nil, fmt.Errorf("failed to install %s log store: %w", to, err)
+	}
+	if err := os.Rename(srcPath, backupPath); err != nil {
+		return nil, fmt.Errorf("failed to move %s log store aside: %w", from, err)
+	}
+
+	logger.Info("migrated log store", "from", from, "to", to,
+		"first_index", result.FirstIndex, "last_index", result.LastIndex, "backup", backupPath)
+	return result, nil
+}
+
+// copyLogStore copies the logs and the stable store values used by raft and
+// Vault from src into the empty dst, and verifies the logs were copied.
+func copyLogStore(ctx context.Context, dst, src logStore, result *LogStoreMigration, logger log.Logger) error {
+	var err error
+	result.FirstIndex, err = src.FirstIndex()
+	if err != nil {
+		return fmt.Errorf("failed to read first index: %w", err)
+	}
+	result.LastIndex, err = src.LastIndex()
+	if err != nil {
+		return fmt.Errorf("failed to read last index: %w", err)
+	}
+
+	if result.LastIndex > 0 {
+		progress := make(chan string, 64)
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			for msg := range progress {
+				logger.Info(msg)
+			}
+		}()
+		err = migrate.CopyLogs(ctx, dst, src, logStoreMigrateBatchBytes, progress)
+		<-doneCh
+		if err != nil {
+			return fmt.Errorf("failed to copy logs: %w", err)
+		}
+	}
+
+	// raft's own keys, as well as whether this node was removed from the
+	// cluster. Keys which were never set aren't copied.
+	for _, key := range [][]byte{[]byte("CurrentTerm"), []byte("LastVoteTerm"), removedKey} {
+		val, err := src.GetUint64(key)
+		switch {
+		case errors.Is(err, raftboltdb.ErrKeyNotFound) || (err == nil && val == 0):
+			continue
+		case err != nil:
+			return fmt.Errorf("failed to read %s: %w", key, err)
+		}
+		if err := dst.SetUint64(key, val); err != nil {
+			return fmt.Errorf("failed to write %s: %w", key, err)
+		}
+	}
+	for _, key := range [][]byte{[]byte("LastVoteCand")} {
+		val, err := src.Get(key)
+		switch {
+		case errors.Is(err, raftboltdb.ErrKeyNotFound) || (err == nil && len(val) == 0):
+			continue
+		case err != nil:
+			return fmt.Errorf("failed to read %s: %w", key, err)
+		}
+		if err := dst.Set(key, val); err != nil {
+			return fmt.Errorf("failed to write %s: %w", key, err)
+		}
+	}
+
+	first, err := dst.FirstIndex()
+	if err != nil {
+		return err
+	}
+	last, err := dst.LastIndex()
+	if err != nil {
+		return err
+	}
+	if first != result.FirstIndex || last != result.LastIndex {
+		return fmt.Errorf("copied logs [%d, %d] don't match the original logs [%d, %d]", first, last, result.FirstIndex, result.LastIndex)
+	}
+	return nil
+}
+
+// ensureNodeStopped checks that the FSM database isn't locked by a running
+// node. raft-wal waits for its lock forever, rather than timing out like the
+// BoltDB log store does.
+func ensureNodeStopped(path string) error {
+	dbPath := filepath.Join(path, databaseFilename)
+	exists, err := fileExists(dbPath)
+	if err != nil || !exists {
+		return err
+	}
+
+	db, err := bolt.Open(dbPath, 0o600, &bolt.Options{Timeout: time.Second, ReadOnly: true})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return errors.New("the raft database is locked, the node must be stopped first")
+		}
+		return err
+	}
+	return db.Close()
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package raft
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/hashicorp/vault/sdk/physical"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRaft_MigrateLogStore migrates the log store of a node to the other type
+// of store, and checks that the node starts back up using the migrated store,
+// regardless of its raft_wal setting.
+func TestRaft_MigrateLogStore(t *testing.T) {
+	testBothRaftBackends(t, func(t *testing.T, useRaftWal string) {
+		from, to := LogStoreBoltDB, LogStoreWAL
+		if useRaftWal == "true" {
+			from, to = to, from
+		}
+
+		conf := map[string]string{
+			"trailing_logs": "100",
+			"raft_wal":      useRaftWal,
+		}
+		b, dir := GetRaftWithConfig(t, true, true, conf)
+		ctx := context.Background()
+		for i := 0; i < 50; i++ {
+			require.NoError(t, b.Put(ctx, &physical.Entry{
+				Key:   fmt.Sprintf("key-%d", i),
+				Value: []byte(fmt.Sprintf("value-%d", i)),
+			}))
+		}
+
+		// The node must be stopped first
+		_, err := MigrateLogStore(ctx, dir, to, b.logger)
+		require.ErrorContains(t, err, "locked")
+
+		lastIndex, err := b.logStore.LastIndex()
+		require.NoError(t, err)
+		var lastLog raft.Log
+		require.NoError(t, b.logStore.GetLog(lastIndex, &lastLog))
+		term, err := b.stableStore.GetUint64([]byte("CurrentTerm"))
+		require.NoError(t, err)
+
+		require.NoError(t, b.TeardownCluster(nil))
+		require.NoError(t, b.Close())
+
+		// raft-wal doesn't release the lock on its metadata database when it's
+		// closed, so the node's data is copied before it's opened again.
+		dir = copyRaftDir(t, dir)
+
+		_, err = MigrateLogStore(ctx, dir, from, b.logger)
+		require.ErrorContains(t, err, "already")
+
+		result, err := MigrateLogStore(ctx, dir, to, b.logger)
+		require.NoError(t, err)
+		require.Equal(t, from, result.From)
+		require.Equal(t, to, result.To)
+		require.Equal(t, lastIndex, result.LastIndex)
+		require.Equal(t, logStorePath(filepath.Join(dir, raftState), from)+logStoreBackupSuffix, result.BackupPath)
+
+		storeType, err := detectLogStore(filepath.Join(dir, raftState))
+		require.NoError(t, err)
+		require.Equal(t, to, storeType)
+
+		// Start back up with the original config
+		conf = maps.Clone(b.conf)
+		conf["path"] = copyRaftDir(t, dir)
+		backendRaw, err := NewRaftBackend(conf, b.logger)
+		require.NoError(t, err)
+		b = backendRaw.(*RaftBackend)
+		t.Cleanup(func() {
+			b.TeardownCluster(nil)
+			b.Close()
+		})
+
+		migratedTerm, err := b.stableStore.GetUint64([]byte("CurrentTerm"))
+		require.NoError(t, err)
+		require.Equal(t, term, migratedTerm)
+		var migratedLog raft.Log
+		require.NoError(t, b.logStore.GetLog(lastIndex, &migratedLog))
+		require.Equal(t, lastLog.Term, migratedLog.Term)
+		require.Equal(t, lastLog.Data, migratedLog.Data)
+
+		require.NoError(t, b.SetupCluster(ctx, SetupOpts{}))
+
+		// The node has to elect itself again after starting back up, which
+		// takes up to twice the heartbeat timeout, and longer on a busy
+		// machine
+		deadline := time.Now().Add(time.Minute)
+		for {
+			err = b.Put(ctx, &physical.Entry{Key: "after", Value: []byte("migration")})
+			if err == nil {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("no leader after starting back up: state=%s, last error: %v", b.raft.State(), err)
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		entry, err := b.Get(ctx, "key-49")
+		require.NoError(t, err)
+		require.Equal(t, []byte("value-49"), entry.Value)
+	})
+}
+
+// copyRaftDir copies the node's data in dir into a new directory.
+func copyRaftDir(t *testing.T, dir string) string {
+	t.Helper()
+
+	to := t.TempDir()
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return os.MkdirAll(filepath.Join(to, rel), 0o700)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(to, rel), data, 0o600)
+	})
+	require.NoError(t, err)
+	return to
+}
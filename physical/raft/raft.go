@@ -15,7 +15,6 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
-	"runtime"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -32,7 +31,6 @@ import (
 	autopilot "github.com/hashicorp/raft-autopilot"
 	raftboltdb "github.com/hashicorp/raft-boltdb/v2"
 	snapshot "github.com/hashicorp/raft-snapshot"
-	raftwal "github.com/hashicorp/raft-wal"
 	walmetrics "github.com/hashicorp/raft-wal/metrics"
 	"github.com/hashicorp/raft-wal/verifier"
 	"github.com/hashicorp/vault/helper/metricsutil"
@@ -246,6 +244,10 @@ type RaftBackend struct {
 	// replicated to and can serve reads, but do not take part in leader elections.
 	nonVoter bool
 
+	// serveStaleReads specifies whether the node serves reads from its FSM
+	// while it is a standby, for requests that tolerate some staleness.
+	serveStaleReads bool
+
 	effectiveSDKVersion string
 	failGetInTxn        *uint32
 
@@ -518,60 +520,36 @@ func NewRaftBackend(conf map[string]string, logger log.Logger) (physical.Backend
 		if err := EnsurePath(raftBasePath, true); err != nil {
 			return nil, err
 		}
-		dbPath := filepath.Join(raftBasePath, "raft.db")
 
-		// If the existing raft db exists from a previous use of BoltDB, warn about this and continue to use BoltDB
-		raftDbExists, err := fileExists(dbPath)
+		// The log store already in use is always used, so that a node doesn't
+		// lose its logs if raft_wal is changed, or after it was migrated with
+		// `vault operator raft migrate-logstore`.
+		storeType, err := detectLogStore(raftBasePath)
 		if err != nil {
-			return nil, fmt.Errorf("failed to check if raft.db already exists: %w", err)
+			return nil, err
 		}
-
-		if backendConfig.RaftWal && raftDbExists {
+		switch {
+		case storeType == LogStoreBoltDB && backendConfig.RaftWal:
 			logger.Warn("raft is configured to use raft-wal for storage but existing raft.db detected. raft-wal config will be ignored.")
-			backendConfig.RaftWal = false
+		case storeType == LogStoreWAL && !backendConfig.RaftWal:
+			logger.Warn("raft is configured to use boltdb for storage but existing raft-wal detected. raft-wal will continue to be used.")
+		case storeType == "" && backendConfig.RaftWal:
+			storeType = LogStoreWAL
+		case storeType == "":
+			storeType = LogStoreBoltDB
 		}
+		backendConfig.RaftWal = storeType == LogStoreWAL
 
-		if backendConfig.RaftWal {
-			raftWalPath := filepath.Join(raftBasePath, raftWalDir)
-			if err := EnsurePath(raftWalPath, true); err != nil {
-				return nil, err
-			}
-
-			mc := walmetrics.NewGoMetricsCollector([]string{"raft", "wal"}, nil, nil)
-			wal, err := raftwal.Open(raftWalPath, raftwal.WithMetricsCollector(mc))
-			if err != nil {
-				return nil, fmt.Errorf("fail to open write-ahead-log: %w", err)
-			}
-			// We need to Close the store but don't register it in closers yet because
-			// if we are going to wrap it with a verifier we need to close through
-			// that instead.
-
-			stableStore = wal
-			logStore = wal
-		} else {
-			// use the traditional BoltDB setup
-			opts := boltOptions(dbPath)
-			raftOptions := raftboltdb.Options{
-				Path:                    dbPath,
-				BoltOptions:             opts,
-				MsgpackUseNewTimeFormat: true,
-			}
-
-			if runtime.GOOS == "linux" && raftDbExists && !usingMapPopulate(opts.MmapFlags) {
-				logger.Warn("the MAP_POPULATE mmap flag has not been set before opening the log store database. This may be due to the database file being larger than the available memory on the system, or due to the VAULT_RAFT_DISABLE_MAP_POPULATE environment variable being set. As a result, Vault may be slower to start up.")
-			}
-
-			store, err := raftboltdb.New(raftOptions)
-			if err != nil {
-				return nil, err
-			}
-			// We need to Close the store but don't register it in closers yet because
-			// if we are going to wrap it with a verifier we need to close through
-			// that instead.
-
-			stableStore = store
-			logStore = store
+		store, err := openLogStore(storeType, logStorePath(raftBasePath, storeType), logger)
+		if err != nil {
+			return nil, err
 		}
+		// We need to Close the store but don't register it in closers yet because
+		// if we are going to wrap it with a verifier we need to close through
+		// that instead.
+
+		stableStore = store
+		logStore = newInstrumentedLogStore(store, storeType)
 
 		// Create the snapshot store.
 		snapshots, err := NewBoltSnapshotStore(raftBasePath, logger.Named("snapshot"), fsm)
@@ -644,6 +622,7 @@ func NewRaftBackend(conf map[string]string, logger log.Logger) (physical.Backend
 		autopilotUpdateInterval:       backendConfig.AutopilotUpdateInterval,
 		redundancyZone:                backendConfig.AutopilotRedundancyZone,
 		nonVoter:                      backendConfig.RaftNonVoter,
+		serveStaleReads:               backendConfig.ServeStaleReads,
 		upgradeVersion:                backendConfig.AutopilotUpgradeVersion,
 		failGetInTxn:                  new(uint32),
 		raftLogVerifierEnabled:        backendConfig.RaftLogVerifierEnabled,
@@ -742,6 +721,15 @@ func (b *RaftBackend) NonVoter() bool {
 	return b.nonVoter
 }
 
+// ServeStaleReads returns whether the node was configured to serve reads that
+// tolerate some staleness from its FSM while it is a standby.
+func (b *RaftBackend) ServeStaleReads() bool {
+	b.l.RLock()
+	defer b.l.RUnlock()
+
+	return b.serveStaleReads
+}
+
 // UpgradeVersion returns the string that should be used by autopilot during automated upgrades. We return the
 // specified upgradeVersion if it's present. If it's not, we fall back to effectiveSDKVersion, which is
 // Vault's binary version (though that can be overridden for tests).
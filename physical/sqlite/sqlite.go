@@ -0,0 +1,494 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+	log "github.com/hashicorp/go-hclog"
+	metrics "github.com/hashicorp/go-metrics/compat"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/hashicorp/go-secure-stdlib/permitpool"
+	"github.com/hashicorp/vault/sdk/physical"
+	_ "modernc.org/sqlite"
+)
+
+const (
+	// DefaultBusyTimeout is how long a connection waits for another
+	// connection, which may belong to another process, to release its lock on
+	// the database before failing with SQLITE_BUSY.
+	DefaultBusyTimeout = 5 * time.Second
+
+	// SQLiteLockRetryInterval is the amount of time to wait if the HA lock is
+	// held by another process before trying again.
+	SQLiteLockRetryInterval = time.Second
+
+	// The limits of a transaction. SQLite itself has no practical limit, so
+	// these only bound how long a transaction holds the write lock.
+	sqliteTransactionMaxEntries = 1024
+	sqliteTransactionMaxSize    = 8 * 1024 * 1024
+)
+
+// Verify SQLiteBackend satisfies the correct interfaces
+var (
+	_ physical.Backend             = (*SQLiteBackend)(nil)
+	_ physical.Transactional       = (*SQLiteBackend)(nil)
+	_ physical.TransactionalLimits = (*SQLiteBackend)(nil)
+	_ physical.HABackend           = (*SQLiteBackend)(nil)
+	_ physical.Lock                = (*SQLiteLock)(nil)
+)
+
+// SQLiteBackend is a physical backend that stores data in a single SQLite
+// database file, for single node deployments. The database is opened in WAL
+// mode, so reads aren't blocked by writes.
+type SQLiteBackend struct {
+	path   string
+	client *sql.DB
+
+	haEnabled  bool
+	logger     log.Logger
+	permitPool *permitpool.Pool
+}
+
+// SQLiteLock implements a lock using a file lock next to the database, so
+// it's only shared by Vault processes on the same host. The value of the lock
+// is stored in the database, for the nodes which don't hold it to read.
+type SQLiteLock struct {
+	backend    *SQLiteBackend
+	key, value string
+
+	l        sync.Mutex
+	flock    *flock.Flock
+	leaderCh chan struct{}
+}
+
+// NewSQLiteBackend constructs a SQLite backend using the database file at the
+// configured path, which is created if it doesn't exist.
+func NewSQLiteBackend(conf map[string]string, logger log.Logger) (physical.Backend, error) {
+	path, ok := conf["path"]
+	if !ok || path == "" {
+		return nil, fmt.Errorf("'path' must be set")
+	}
+
+	busyTimeout := DefaultBusyTimeout
+	if busyTimeoutStr, ok := conf["busy_timeout"]; ok {
+		d, err := parseutil.ParseDurationSecond(busyTimeoutStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing busy_timeout parameter: %w", err)
+		}
+		busyTimeout = d
+		if logger.IsDebug() {
+			logger.Debug("busy_timeout set", "busy_timeout", busyTimeout)
+		}
+	}
+
+	maxParInt := physical.DefaultParallelOperations
+	if maxParStr, ok := conf["max_parallel"]; ok {
+		var err error
+		maxParInt, err = strconv.Atoi(maxParStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing max_parallel parameter: %w", err)
+		}
+		if logger.IsDebug() {
+			logger.Debug("max_parallel set", "max_parallel", maxParInt)
+		}
+	}
+
+	var haEnabled bool
+	if haEnabledStr, ok := conf["ha_enabled"]; ok {
+		var err error
+		haEnabled, err = strconv.ParseBool(haEnabledStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing ha_enabled parameter: %w", err)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create database directory: %w", err)
+	}
+	// Create the database file so that only Vault can read it, as SQLite
+	// would create it with the default permissions. SQLite gives its WAL and
+	// shared memory files the same permissions.
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create database file: %w", err)
+	}
+	f.Close()
+
+	db, err := sql.Open("sqlite", dsn(path, busyTimeout))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	db.SetMaxOpenConns(maxParInt)
+
+	if err := createTables(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &SQLiteBackend{
+		path:       path,
+		client:     db,
+		haEnabled:  haEnabled,
+		logger:     logger,
+		permitPool: permitpool.New(maxParInt),
+	}, nil
+}
+
+// dsn returns the data source name used to open the database at the path.
+// Every connection uses WAL mode and waits for the busy timeout when the
+// database is locked. Transactions take the write lock when they begin rather
+// than on their first write, which could otherwise fail without waiting if
+// another connection wrote in the meantime.
+func dsn(path string, busyTimeout time.Duration) string {
+	params := url.Values{}
+	params.Add("_pragma", "journal_mode(WAL)")
+	params.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeout.Milliseconds()))
+	params.Add("_pragma", "synchronous(FULL)")
+	params.Set("_txlock", "immediate")
+	return "file:" + path + "?" + params.Encode()
+}
+
+func createTables(db *sql.DB) error {
+	if _, err := db.Exec("CREATE TABLE IF NOT EXISTS vault_kv_store (key TEXT NOT NULL PRIMARY KEY, value BLOB) WITHOUT ROWID"); err != nil {
+		return fmt.Errorf("failed to create table: %w", err)
+	}
+	if _, err := db.Exec("CREATE TABLE IF NOT EXISTS vault_ha_locks (ha_key TEXT NOT NULL PRIMARY KEY, ha_value TEXT) WITHOUT ROWID"); err != nil {
+		return fmt.Errorf("failed to create HA table: %w", err)
+	}
+	return nil
+}
+
+// Put is used to insert or update an entry.
+func (s *SQLiteBackend) Put(ctx context.Context, entry *physical.Entry) error {
+	defer metrics.MeasureSince([]string{"sqlite", "put"}, time.Now())
+
+	if err := s.permitPool.Acquire(ctx); err != nil {
+		return err
+	}
+	defer s.permitPool.Release()
+
+	return put(ctx, s.client, entry)
+}
+
+// Get is used to fetch an entry.
+func (s *SQLiteBackend) Get(ctx context.Context, key string) (*physical.Entry, error) {
+	defer metrics.MeasureSince([]string{"sqlite", "get"}, time.Now())
+
+	if err := s.permitPool.Acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer s.permitPool.Release()
+
+	return get(ctx, s.client, key)
+}
+
+// Delete is used to permanently delete an entry
+func (s *SQLiteBackend) Delete(ctx context.Context, key string) error {
+	defer metrics.MeasureSince([]string{"sqlite", "delete"}, time.Now())
+
+	if err := s.permitPool.Acquire(ctx); err != nil {
+		return err
+	}
+	defer s.permitPool.Release()
+
+	return del(ctx, s.client, key)
+}
+
+// List is used to list all the keys under a given prefix, up to the next
+// prefix. Once a key under a sub-prefix is found, the rest of the keys under
+// it are skipped, so listing a prefix doesn't read every key beneath it.
+func (s *SQLiteBackend) List(ctx context.Context, prefix string) ([]string, error) {
+	defer metrics.MeasureSince([]string{"sqlite", "list"}, time.Now())
+
+	if err := s.permitPool.Acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer s.permitPool.Release()
+
+	end := prefixEnd(prefix)
+	var keys []string
+	start := prefix
+	for {
+		var rows *sql.Rows
+		var err error
+		if end == "" {
+			rows, err = s.client.QueryContext(ctx, "SELECT key FROM vault_kv_store WHERE key >= ? ORDER BY key", start)
+		} else {
+			rows, err = s.client.QueryContext(ctx, "SELECT key FROM vault_kv_store WHERE key >= ? AND key < ? ORDER BY key", start, end)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var next string
+		for rows.Next() {
+			var key string
+			if err := rows.Scan(&key); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("failed to scan rows: %w", err)
+			}
+
+			key = strings.TrimPrefix(key, prefix)
+			if i := strings.Index(key, "/"); i >= 0 {
+				// Continue from the first key after those under the
+				// sub-prefix, as '0' is the character after '/'
+				keys = append(keys, key[:i+1])
+				next = prefix + key[:i] + "0"
+				break
+			}
+			if key != "" {
+				keys = append(keys, key)
+			}
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+		if next == "" {
+			return keys, nil
+		}
+		start = next
+	}
+}
+
+// prefixEnd returns the first key after all of those starting with the
+// prefix, or "" if there isn't one.
+func prefixEnd(prefix string) string {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return string(end[:i+1])
+		}
+	}
+	return ""
+}
+
+// Transaction applies the entries atomically. Get operations are applied
+// first, so they return the values from before the transaction, as with the
+// other transactional backends.
+func (s *SQLiteBackend) Transaction(ctx context.Context, txns []*physical.TxnEntry) (retErr error) {
+	defer metrics.MeasureSince([]string{"sqlite", "transaction"}, time.Now())
+	if len(txns) == 0 {
+		return nil
+	}
+
+	if err := s.permitPool.Acquire(ctx); err != nil {
+		return err
+	}
+	defer s.permitPool.Release()
+
+	tx, err := s.client.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if retErr != nil {
+			tx.Rollback()
+		}
+	}()
+
+	for _, txn := range txns {
+		if txn.Operation != physical.GetOperation {
+			continue
+		}
+		entry, err := get(ctx, tx, txn.Entry.Key)
+		if err != nil {
+			return err
+		}
+		if entry != nil {
+			txn.Entry.Value = entry.Value
+		}
+	}
+
+	for _, txn := range txns {
+		switch txn.Operation {
+		case physical.PutOperation:
+			err = put(ctx, tx, txn.Entry)
+		case physical.DeleteOperation:
+			err = del(ctx, tx, txn.Entry.Key)
+		case physical.GetOperation:
+		default:
+			err = fmt.Errorf("%q is not a supported transaction operation", txn.Operation)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// TransactionLimits implements physical.TransactionalLimits
+func (s *SQLiteBackend) TransactionLimits() (int, int) {
+	return sqliteTransactionMaxEntries, sqliteTransactionMaxSize
+}
+
+// execer is implemented by both the database and its transactions.
+type execer interface {
+	ExecContext(context.Context, string, ...any) (sql.Result, error)
+	QueryRowContext(context.Context, string, ...any) *sql.Row
+}
+
+func put(ctx context.Context, db execer, entry *physical.Entry) error {
+	_, err := db.ExecContext(ctx, "INSERT INTO vault_kv_store (key, value) VALUES (?, ?) ON CONFLICT (key) DO UPDATE SET value = excluded.value", entry.Key, entry.Value)
+	return err
+}
+
+func get(ctx context.Context, db execer, key string) (*physical.Entry, error) {
+	var value []byte
+	err := db.QueryRowContext(ctx, "SELECT value FROM vault_kv_store WHERE key = ?", key).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &physical.Entry{
+		Key:   key,
+		Value: value,
+	}, nil
+}
+
+func del(ctx context.Context, db execer, key string) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM vault_kv_store WHERE key = ?", key)
+	return err
+}
+
+// LockWith is used for mutual exclusion based on the given key.
+func (s *SQLiteBackend) LockWith(key, value string) (physical.Lock, error) {
+	return &SQLiteLock{
+		backend: s,
+		key:     key,
+		value:   value,
+	}, nil
+}
+
+func (s *SQLiteBackend) HAEnabled() bool {
+	return s.haEnabled
+}
+
+// lockPath returns the path of the file locked to hold the lock with the key.
+func (s *SQLiteBackend) lockPath(key string) string {
+	return s.path + "-" + strings.ReplaceAll(key, "/", "-") + ".lock"
+}
+
+// Lock blocks until the file lock can be taken or the stop channel is closed.
+// The file lock is held until it's unlocked or the process exits, so the
+// returned channel is only closed once it's unlocked.
+func (l *SQLiteLock) Lock(stopCh <-chan struct{}) (<-chan struct{}, error) {
+	l.l.Lock()
+	defer l.l.Unlock()
+	if l.leaderCh != nil {
+		return nil, fmt.Errorf("lock already held")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	fl := flock.New(l.backend.lockPath(l.key))
+	locked, err := fl.TryLockContext(ctx, SQLiteLockRetryInterval)
+	if !locked {
+		if ctx.Err() != nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to lock %q: %w", fl.Path(), err)
+	}
+
+	s := l.backend
+	if err := s.permitPool.Acquire(ctx); err != nil {
+		fl.Unlock()
+		return nil, err
+	}
+	defer s.permitPool.Release()
+
+	_, err = s.client.Exec("INSERT INTO vault_ha_locks (ha_key, ha_value) VALUES (?, ?) ON CONFLICT (ha_key) DO UPDATE SET ha_value = excluded.ha_value", l.key, l.value)
+	if err != nil {
+		fl.Unlock()
+		return nil, fmt.Errorf("failed to write lock value: %w", err)
+	}
+
+	l.flock = fl
+	l.leaderCh = make(chan struct{})
+	return l.leaderCh, nil
+}
+
+// Unlock removes the value of the lock and releases the file lock.
+func (l *SQLiteLock) Unlock() error {
+	l.l.Lock()
+	defer l.l.Unlock()
+	if l.leaderCh == nil {
+		return nil
+	}
+
+	s := l.backend
+	if err := s.permitPool.Acquire(context.Background()); err != nil {
+		return err
+	}
+	defer s.permitPool.Release()
+
+	if _, err := s.client.Exec("DELETE FROM vault_ha_locks WHERE ha_key = ?", l.key); err != nil {
+		return err
+	}
+	if err := l.flock.Unlock(); err != nil {
+		return err
+	}
+
+	close(l.leaderCh)
+	l.leaderCh = nil
+	l.flock = nil
+	return nil
+}
+
+// Value checks whether or not the lock is held by any process, including
+// this one, and returns its current value. The value may be left behind by a
+// process which exited while holding the lock, so the lock is only held if a
+// shared lock on the file can't be taken.
+func (l *SQLiteLock) Value() (bool, string, error) {
+	fl := flock.New(l.backend.lockPath(l.key))
+	unlocked, err := fl.TryRLock()
+	if err != nil {
+		return false, "", err
+	}
+	if unlocked {
+		fl.Unlock()
+		return false, "", nil
+	}
+
+	s := l.backend
+	if err := s.permitPool.Acquire(context.Background()); err != nil {
+		return false, "", err
+	}
+	defer s.permitPool.Release()
+
+	var value string
+	err = s.client.QueryRow("SELECT ha_value FROM vault_ha_locks WHERE ha_key = ?", l.key).Scan(&value)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, "", err
+	}
+	return true, value, nil
+}
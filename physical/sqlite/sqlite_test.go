@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package sqlite
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/sdk/helper/logging"
+	"github.com/hashicorp/vault/sdk/physical"
+	"github.com/stretchr/testify/require"
+)
+
+func testSQLiteBackend(t *testing.T, path string) *SQLiteBackend {
+	t.Helper()
+
+	logger := logging.NewVaultLogger(log.Debug)
+	b, err := NewSQLiteBackend(map[string]string{
+		"path":       path,
+		"ha_enabled": "true",
+	}, logger)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		b.(*SQLiteBackend).client.Close()
+	})
+	return b.(*SQLiteBackend)
+}
+
+func TestSQLiteBackend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vault.db")
+	b := testSQLiteBackend(t, path)
+
+	physical.ExerciseBackend(t, b)
+	physical.ExerciseBackend_ListPrefix(t, b)
+	physical.ExerciseTransactionalBackend(t, b)
+
+	// The database is only readable by Vault
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestSQLiteBackend_HA(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vault.db")
+	b1 := testSQLiteBackend(t, path)
+	b2 := testSQLiteBackend(t, path)
+
+	physical.ExerciseHABackend(t, b1, b2)
+}
+
+// TestSQLiteBackend_StaleLockValue checks that the value left behind by a
+// lock which wasn't unlocked isn't reported as held once the file lock is
+// released.
+func TestSQLiteBackend_StaleLockValue(t *testing.T) {
+	b := testSQLiteBackend(t, filepath.Join(t.TempDir(), "vault.db"))
+
+	lock, err := b.LockWith("core/lock", "foo")
+	require.NoError(t, err)
+	leaderCh, err := lock.Lock(nil)
+	require.NoError(t, err)
+	require.NotNil(t, leaderCh)
+
+	held, value, err := lock.Value()
+	require.NoError(t, err)
+	require.True(t, held)
+	require.Equal(t, "foo", value)
+
+	// Release the file lock as if the process had exited
+	require.NoError(t, lock.(*SQLiteLock).flock.Unlock())
+
+	held, _, err = lock.Value()
+	require.NoError(t, err)
+	require.False(t, held)
+}
+
+func TestSQLiteBackend_ListSkipsPrefixes(t *testing.T) {
+	b := testSQLiteBackend(t, filepath.Join(t.TempDir(), "vault.db"))
+	ctx := context.Background()
+
+	for _, key := range []string{"a", "b/1", "b/2/3", "b0", "b.c", "c/d"} {
+		require.NoError(t, b.Put(ctx, &physical.Entry{Key: key, Value: []byte(key)}))
+	}
+
+	keys, err := b.List(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b.c", "b/", "b0", "c/"}, keys)
+
+	keys, err = b.List(ctx, "b/")
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2/"}, keys)
+}
+
+func TestPrefixEnd(t *testing.T) {
+	require.Equal(t, "", prefixEnd(""))
+	require.Equal(t, "foo0", prefixEnd("foo/"))
+	require.Equal(t, "b", prefixEnd("a\xff"))
+	require.Equal(t, "", prefixEnd("\xff\xff"))
+}
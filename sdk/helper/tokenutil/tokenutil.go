@@ -19,6 +19,10 @@ import (
 // TokenParams contains a set of common parameters that auth plugins can use
 // for setting token behavior
 type TokenParams struct {
+	// If set, tokens generated using this role will be bound to the client
+	// certificate presented during login
+	TokenBindClientCert bool `json:"token_bind_client_cert" mapstructure:"token_bind_client_cert"`
+
 	// The set of CIDRs that tokens generated using this role will be bound to
 	TokenBoundCIDRs []*sockaddr.SockAddrMarshaler `json:"token_bound_cidrs"`
 
@@ -77,6 +81,15 @@ func AddTokenFieldsWithAllowList(m map[string]*framework.FieldSchema, allowed []
 // TokenFields provides a set of field schemas for the parameters
 func TokenFields() map[string]*framework.FieldSchema {
 	return map[string]*framework.FieldSchema{
+		"token_bind_client_cert": {
+			Type:        framework.TypeBool,
+			Description: tokenBindClientCertHelp,
+			DisplayAttrs: &framework.DisplayAttributes{
+				Name:  "Bind Generated Tokens To Client Certificate",
+				Group: "Tokens",
+			},
+		},
+
 		"token_bound_cidrs": {
 			Type:        framework.TypeCommaStringSlice,
 			Description: `Comma separated string or JSON list of CIDR blocks. If set, specifies the blocks of IP addresses which are allowed to use the generated token.`,
@@ -174,6 +187,10 @@ func TokenFields() map[string]*framework.FieldSchema {
 
 // ParseTokenFields provides common field parsing functionality into a TokenFields struct
 func (t *TokenParams) ParseTokenFields(req *logical.Request, d *framework.FieldData) error {
+	if bindClientCertRaw, ok := d.GetOk("token_bind_client_cert"); ok {
+		t.TokenBindClientCert = bindClientCertRaw.(bool)
+	}
+
 	if boundCIDRsRaw, ok := d.GetOk("token_bound_cidrs"); ok {
 		boundCIDRs, err := parseutil.ParseAddrs(boundCIDRsRaw.([]string))
 		if err != nil {
@@ -260,6 +277,7 @@ func (t *TokenParams) ParseTokenFields(req *logical.Request, d *framework.FieldD
 
 // PopulateTokenData adds information from TokenParams into the map
 func (t *TokenParams) PopulateTokenData(m map[string]interface{}) {
+	m["token_bind_client_cert"] = t.TokenBindClientCert
 	m["token_bound_cidrs"] = t.TokenBoundCIDRs
 	m["token_explicit_max_ttl"] = int64(t.TokenExplicitMaxTTL.Seconds())
 	m["token_max_ttl"] = int64(t.TokenMaxTTL.Seconds())
@@ -286,6 +304,7 @@ func (t *TokenParams) PopulateTokenData(m map[string]interface{}) {
 
 // PopulateTokenAuth populates Auth with parameters
 func (t *TokenParams) PopulateTokenAuth(auth *logical.Auth) {
+	auth.BindClientCert = t.TokenBindClientCert
 	auth.BoundCIDRs = t.TokenBoundCIDRs
 	auth.ExplicitMaxTTL = t.TokenExplicitMaxTTL
 	auth.MaxTTL = t.TokenMaxTTL
@@ -453,4 +472,9 @@ the current maximum TTL values of the role
 and the mount are not checked for changes,
 and any updates to these values will have
 no effect on the token being renewed.`
+	tokenBindClientCertHelp = `If set, tokens created via this role
+are bound to the TLS client certificate
+presented during login, and can only be
+used over connections presenting the
+same certificate.`
 )
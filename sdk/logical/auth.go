@@ -99,6 +99,15 @@ type Auth struct {
 	// The set of CIDRs that this token can be used with
 	BoundCIDRs []*sockaddr.SockAddrMarshaler `json:"bound_cidrs"`
 
+	// BindClientCert is set by the backend to request that the issued token
+	// be bound to the TLS client certificate presented during login.
+	BindClientCert bool `json:"bind_client_cert"`
+
+	// BoundCertThumbprint is the base64url-encoded SHA-256 thumbprint of the
+	// client certificate the issued token is bound to. It is set by core
+	// when BindClientCert is requested.
+	BoundCertThumbprint string `json:"bound_cert_thumbprint"`
+
 	// CreationPath is a path that the backend can return to use in the lease.
 	// This is currently only supported for the token store where roles may
 	// change the perceived path of the lease, even though they don't change
@@ -102,6 +102,10 @@ type TokenEntry struct {
 	// InlinePolicy specifies ACL rules to be applied to this token entry.
 	InlinePolicy string `json:"inline_policy" mapstructure:"inline_policy" structs:"inline_policy"`
 
+	// Scope restricts the token to the given capabilities on the given path
+	// globs, regardless of what its policies grant
+	Scope map[string][]string `json:"scope,omitempty" mapstructure:"scope" structs:"scope"`
+
 	// Used for audit trails, this is something like "auth/user/login"
 	Path string `json:"path" mapstructure:"path" structs:"path"`
 
@@ -156,6 +160,10 @@ type TokenEntry struct {
 	// The set of CIDRs that this token can be used with
 	BoundCIDRs []*sockaddr.SockAddrMarshaler `json:"bound_cidrs" sentinel:""`
 
+	// BoundCertThumbprint is the base64url-encoded SHA-256 thumbprint of the
+	// TLS client certificate this token is bound to, if any
+	BoundCertThumbprint string `json:"bound_cert_thumbprint" mapstructure:"bound_cert_thumbprint" structs:"bound_cert_thumbprint" sentinel:""`
+
 	// NamespaceID is the identifier of the namespace to which this token is
 	// confined to. Do not return this value over the API when the token is
 	// being looked up.
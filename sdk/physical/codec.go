@@ -0,0 +1,458 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package physical
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/vault/sdk/helper/locksutil"
+)
+
+const (
+	// EntryChunkPrefix is the prefix under which the chunks of entries that
+	// were too large for the backend are stored. It's hidden from listings.
+	EntryChunkPrefix = "_chunks/"
+
+	// MinEntryChunkSize is the smallest chunk size which can be configured.
+	MinEntryChunkSize = 1024
+
+	// DefaultMaxEntryChunks is the largest number of chunks an entry is split
+	// into, unless the backend's transaction limits require fewer.
+	DefaultMaxEntryChunks = 32
+
+	entryCodecRaw      byte = 'r'
+	entryCodecManifest byte = 'm'
+)
+
+// entryCodecMagic prefixes the values written by EntryCodec which it needs to
+// decode. No value Vault stores starts with 0xff: encrypted values start with
+// the big-endian key term.
+var entryCodecMagic = []byte("\xffvault-codec:")
+
+var ErrEntryChunkMissing = errors.New("chunk of storage entry is missing")
+
+// EntryCodecConfig configures how EntryCodec encodes entries.
+type EntryCodecConfig struct {
+	// ChunkSize is the largest value written to the backend, above which a
+	// value is split into chunks. Zero disables chunking, in which case
+	// entries which were split into chunks are still read, and their chunks
+	// removed when they're overwritten or deleted.
+	ChunkSize int
+}
+
+// entryManifest replaces the value of an entry which was split into chunks.
+type entryManifest struct {
+	ID     string `json:"id"`
+	Chunks int    `json:"chunks"`
+	Size   int    `json:"size"`
+	SHA256 []byte `json:"sha256"`
+}
+
+// EntryCodec is used to wrap an underlying physical backend, splitting values
+// too large for the backend into chunks. The chunks are written under
+// EntryChunkPrefix before the entry's manifest is written in place of its
+// value, so readers either see the previous value or the new one in full.
+// Writes to the same key are serialized, so that the chunks of the previous
+// value are always the ones removed. Values are expected to be encrypted, and so incompressible; compression is
+// done by the barrier before values are encrypted.
+type EntryCodec struct {
+	backend   Backend
+	chunkSize int
+	maxChunks int
+	logger    log.Logger
+
+	// locks are held while an entry's previous manifest is read, the entry is
+	// written and the previous chunks are removed
+	locks []*locksutil.LockEntry
+}
+
+// TransactionalEntryCodec is the transactional version of EntryCodec
+type TransactionalEntryCodec struct {
+	*EntryCodec
+	Transactional
+}
+
+// Verify EntryCodec satisfies the correct interfaces
+var (
+	_ Backend             = (*EntryCodec)(nil)
+	_ Transactional       = (*TransactionalEntryCodec)(nil)
+	_ TransactionalLimits = (*TransactionalEntryCodec)(nil)
+)
+
+// NewEntryCodec returns a wrapped physical backend which chunks entries as
+// configured.
+func NewEntryCodec(b Backend, conf *EntryCodecConfig, logger log.Logger) (Backend, error) {
+	if conf == nil {
+		return nil, errors.New("entry codec config is nil")
+	}
+	if conf.ChunkSize != 0 && conf.ChunkSize < MinEntryChunkSize {
+		return nil, fmt.Errorf("chunk size must be at least %d bytes", MinEntryChunkSize)
+	}
+
+	c := &EntryCodec{
+		backend:   b,
+		chunkSize: conf.ChunkSize,
+		maxChunks: DefaultMaxEntryChunks,
+		logger:    logger,
+		locks:     locksutil.CreateLocks(),
+	}
+
+	// A transaction writing an entry also writes its chunks and removes the
+	// chunks of its previous value, which must fit in a single transaction
+	if tl, ok := b.(TransactionalLimits); ok {
+		if maxEntries, _ := tl.TransactionLimits(); maxEntries > 0 {
+			c.maxChunks = max(min(c.maxChunks, (maxEntries-1)/2), 1)
+		}
+	}
+
+	if bTxn, ok := b.(Transactional); ok {
+		return &TransactionalEntryCodec{
+			EntryCodec:    c,
+			Transactional: bTxn,
+		}, nil
+	}
+
+	return c, nil
+}
+
+// HasEntryChunks returns whether the backend holds any chunks written by an
+// EntryCodec, which means it must be read through one.
+func HasEntryChunks(ctx context.Context, b Backend) (bool, error) {
+	keys, err := b.List(ctx, EntryChunkPrefix)
+	if err != nil {
+		return false, err
+	}
+	return len(keys) > 0, nil
+}
+
+// Put writes the entry, splitting it into chunks as needed. Chunks of the
+// entry's previous value are removed afterwards.
+func (c *EntryCodec) Put(ctx context.Context, entry *Entry) error {
+	lock := locksutil.LockForKey(c.locks, entry.Key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	old, err := c.previousManifest(ctx, entry.Key)
+	if err != nil {
+		return err
+	}
+
+	manifest, entries, err := c.encode(entry)
+	if err != nil {
+		return err
+	}
+	// The entry itself is last, so it's only replaced once its chunks exist
+	for _, e := range entries {
+		if err := c.backend.Put(ctx, e); err != nil {
+			// The chunks written so far aren't referenced by anything
+			c.deleteChunks(ctx, manifest)
+			return err
+		}
+	}
+
+	c.deleteChunks(ctx, old)
+	return nil
+}
+
+// Get reads the entry, reassembling and decompressing it as needed.
+func (c *EntryCodec) Get(ctx context.Context, key string) (*Entry, error) {
+	entry, err := c.backend.Get(ctx, key)
+	if err != nil || entry == nil {
+		return entry, err
+	}
+
+	value, err := c.decode(ctx, entry.Value)
+	if errors.Is(err, ErrEntryChunkMissing) {
+		// The entry may have been overwritten while its chunks were being
+		// read, so try again with the new value.
+		entry, err = c.backend.Get(ctx, key)
+		if err != nil || entry == nil {
+			return entry, err
+		}
+		value, err = c.decode(ctx, entry.Value)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %q: %w", key, err)
+	}
+
+	entry.Value = value
+	return entry, nil
+}
+
+// Delete removes the entry, followed by its chunks.
+func (c *EntryCodec) Delete(ctx context.Context, key string) error {
+	lock := locksutil.LockForKey(c.locks, key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	old, err := c.previousManifest(ctx, key)
+	if err != nil {
+		return err
+	}
+	if err := c.backend.Delete(ctx, key); err != nil {
+		return err
+	}
+	c.deleteChunks(ctx, old)
+	return nil
+}
+
+// List lists the keys with the given prefix, hiding the chunks.
+func (c *EntryCodec) List(ctx context.Context, prefix string) ([]string, error) {
+	keys, err := c.backend.List(ctx, prefix)
+	if err != nil || prefix != "" {
+		return keys, err
+	}
+
+	filtered := keys[:0]
+	for _, key := range keys {
+		if key != EntryChunkPrefix {
+			filtered = append(filtered, key)
+		}
+	}
+	return filtered, nil
+}
+
+func (c *TransactionalEntryCodec) Transaction(ctx context.Context, txns []*TxnEntry) error {
+	var keys []string
+	for _, txn := range txns {
+		if txn.Operation == PutOperation || txn.Operation == DeleteOperation {
+			keys = append(keys, txn.Entry.Key)
+		}
+	}
+	for _, lock := range locksutil.LocksForKeys(c.locks, keys) {
+		lock.Lock()
+		defer lock.Unlock()
+	}
+
+	encoded := make([]*TxnEntry, 0, len(txns))
+	var deletes []*TxnEntry
+	for _, txn := range txns {
+		switch txn.Operation {
+		case PutOperation, DeleteOperation:
+			old, err := c.previousManifest(ctx, txn.Entry.Key)
+			if err != nil {
+				return err
+			}
+			for i := 0; old != nil && i < old.Chunks; i++ {
+				deletes = append(deletes, &TxnEntry{
+					Operation: DeleteOperation,
+					Entry:     &Entry{Key: entryChunkKey(old.ID, i)},
+				})
+			}
+		}
+
+		if txn.Operation != PutOperation {
+			encoded = append(encoded, txn)
+			continue
+		}
+		_, entries, err := c.encode(txn.Entry)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			encoded = append(encoded, &TxnEntry{Operation: PutOperation, Entry: e})
+		}
+	}
+
+	if err := c.Transactional.Transaction(ctx, append(encoded, deletes...)); err != nil {
+		return err
+	}
+
+	// Get operations are filled in with the value from the backend, which
+	// needs decoding.
+	for _, txn := range txns {
+		if txn.Operation != GetOperation || len(txn.Entry.Value) == 0 {
+			continue
+		}
+		value, err := c.decode(ctx, txn.Entry.Value)
+		if err != nil {
+			return fmt.Errorf("failed to decode %q: %w", txn.Entry.Key, err)
+		}
+		txn.Entry.Value = value
+	}
+	return nil
+}
+
+// TransactionLimits implements physical.TransactionalLimits. Each operation
+// of a transaction may become the puts of an entry's chunks and manifest, and
+// the deletes of the chunks of its previous value, so the backend's limit on
+// the number of entries is divided by the most entries an operation can
+// become.
+func (c *TransactionalEntryCodec) TransactionLimits() (int, int) {
+	tl, ok := c.Transactional.(TransactionalLimits)
+	if !ok {
+		return 0, 0
+	}
+	maxEntries, maxSize := tl.TransactionLimits()
+	if maxEntries > 0 {
+		maxEntries = max(maxEntries/(2*c.maxChunks+1), 1)
+	}
+	return maxEntries, maxSize
+}
+
+// encode returns the entries to write for the given entry: any chunks,
+// followed by the entry itself. If the entry is split into chunks, their
+// manifest is returned too.
+func (c *EntryCodec) encode(entry *Entry) (*entryManifest, []*Entry, error) {
+	value := entry.Value
+	var encoded bool
+	if bytes.HasPrefix(value, entryCodecMagic) {
+		// Values which happen to start with the magic are marked as raw, so
+		// that they aren't mistaken for encoded values.
+		value, encoded = entryCodecValue(entryCodecRaw, value), true
+	}
+
+	if c.chunkSize == 0 || len(value) <= c.chunkSize {
+		if !encoded {
+			return nil, []*Entry{entry}, nil
+		}
+		return nil, []*Entry{c.entryWithValue(entry, value)}, nil
+	}
+	if chunks := (len(value) + c.chunkSize - 1) / c.chunkSize; chunks > c.maxChunks {
+		return nil, nil, fmt.Errorf("entry %q of %d bytes needs %d chunks, more than the maximum of %d", entry.Key, len(entry.Value), chunks, c.maxChunks)
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, nil, err
+	}
+	sum := sha256.Sum256(value)
+	manifest := &entryManifest{
+		ID:     id,
+		Size:   len(value),
+		SHA256: sum[:],
+	}
+
+	var entries []*Entry
+	for start := 0; start < len(value); start += c.chunkSize {
+		end := min(start+c.chunkSize, len(value))
+		entries = append(entries, &Entry{
+			Key:      entryChunkKey(id, manifest.Chunks),
+			Value:    value[start:end],
+			SealWrap: entry.SealWrap,
+		})
+		manifest.Chunks++
+	}
+
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, nil, err
+	}
+	return manifest, append(entries, c.entryWithValue(entry, entryCodecValue(entryCodecManifest, manifestBytes))), nil
+}
+
+// decode returns the original value of an entry read from the backend.
+func (c *EntryCodec) decode(ctx context.Context, value []byte) ([]byte, error) {
+	if !bytes.HasPrefix(value, entryCodecMagic) || len(value) == len(entryCodecMagic) {
+		return value, nil
+	}
+
+	kind, data := value[len(entryCodecMagic)], value[len(entryCodecMagic)+1:]
+	switch kind {
+	case entryCodecRaw:
+		return data, nil
+
+	case entryCodecManifest:
+		var manifest entryManifest
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return nil, fmt.Errorf("failed to decode manifest: %w", err)
+		}
+		payload := make([]byte, 0, manifest.Size)
+		for i := 0; i < manifest.Chunks; i++ {
+			chunk, err := c.backend.Get(ctx, entryChunkKey(manifest.ID, i))
+			if err != nil {
+				return nil, err
+			}
+			if chunk == nil {
+				return nil, fmt.Errorf("%w: %s", ErrEntryChunkMissing, entryChunkKey(manifest.ID, i))
+			}
+			payload = append(payload, chunk.Value...)
+		}
+		if sum := sha256.Sum256(payload); !bytes.Equal(sum[:], manifest.SHA256) {
+			return nil, errors.New("checksum of chunks does not match manifest")
+		}
+		if bytes.HasPrefix(payload, entryCodecMagic) && len(payload) > len(entryCodecMagic) &&
+			payload[len(entryCodecMagic)] == entryCodecManifest {
+			return nil, errors.New("chunks contain a nested manifest")
+		}
+		return c.decode(ctx, payload)
+
+	default:
+		return nil, fmt.Errorf("unknown encoding %q", kind)
+	}
+}
+
+// previousManifest returns the manifest of the entry currently stored at key,
+// if it was split into chunks. This is looked up even if chunking is
+// disabled, so that the chunks of entries written while it was enabled are
+// removed.
+func (c *EntryCodec) previousManifest(ctx context.Context, key string) (*entryManifest, error) {
+	entry, err := c.backend.Get(ctx, key)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	prefix := entryCodecValue(entryCodecManifest, nil)
+	if !bytes.HasPrefix(entry.Value, prefix) {
+		return nil, nil
+	}
+	var manifest entryManifest
+	if err := json.Unmarshal(entry.Value[len(prefix):], &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest of %q: %w", key, err)
+	}
+	return &manifest, nil
+}
+
+// deleteChunks removes the chunks of a value which was replaced, or failed to
+// be written. Failures are only logged, as the chunks are no longer
+// referenced.
+func (c *EntryCodec) deleteChunks(ctx context.Context, manifest *entryManifest) {
+	if manifest == nil {
+		return
+	}
+	for i := 0; i < manifest.Chunks; i++ {
+		if err := c.backend.Delete(ctx, entryChunkKey(manifest.ID, i)); err != nil {
+			c.logger.Warn("failed to delete unreferenced chunk", "key", entryChunkKey(manifest.ID, i), "error", err)
+		}
+	}
+}
+
+func (c *EntryCodec) entryWithValue(entry *Entry, value []byte) *Entry {
+	return &Entry{
+		Key:       entry.Key,
+		Value:     value,
+		SealWrap:  entry.SealWrap,
+		ValueHash: entry.ValueHash,
+	}
+}
+
+func entryCodecValue(kind byte, data []byte) []byte {
+	value := make([]byte, 0, len(entryCodecMagic)+1+len(data))
+	value = append(value, entryCodecMagic...)
+	value = append(value, kind)
+	return append(value, data...)
+}
+
+func entryChunkKey(id string, index int) string {
+	return EntryChunkPrefix + id + "/" + strconv.Itoa(index)
+}
+
+func (c *EntryCodec) Purge(ctx context.Context) {
+	if purgeable, ok := c.backend.(ToggleablePurgemonster); ok {
+		purgeable.Purge(ctx)
+	}
+}
+
+func (c *EntryCodec) SetEnabled(enabled bool) {
+	if purgeable, ok := c.backend.(ToggleablePurgemonster); ok {
+		purgeable.SetEnabled(enabled)
+	}
+}
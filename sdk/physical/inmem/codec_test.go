@@ -0,0 +1,273 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package inmem
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/sdk/helper/logging"
+	"github.com/hashicorp/vault/sdk/physical"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntryCodec(t *testing.T) {
+	logger := logging.NewVaultLogger(log.Debug)
+
+	inm, err := NewInmem(map[string]string{"max_value_size": "2048"}, logger)
+	require.NoError(t, err)
+	codec, err := physical.NewEntryCodec(inm, &physical.EntryCodecConfig{ChunkSize: 2048}, logger)
+	require.NoError(t, err)
+	physical.ExerciseBackend(t, codec)
+	physical.ExerciseBackend_ListPrefix(t, codec)
+
+	inmTxn, err := NewTransactionalInmem(map[string]string{"max_value_size": "2048"}, logger)
+	require.NoError(t, err)
+	codec, err = physical.NewEntryCodec(inmTxn, &physical.EntryCodecConfig{ChunkSize: 2048}, logger)
+	require.NoError(t, err)
+	physical.ExerciseBackend(t, codec)
+	physical.ExerciseBackend_ListPrefix(t, codec)
+	physical.ExerciseTransactionalBackend(t, codec)
+}
+
+func TestEntryCodec_Config(t *testing.T) {
+	logger := logging.NewVaultLogger(log.Debug)
+	inm, err := NewInmem(nil, logger)
+	require.NoError(t, err)
+
+	_, err = physical.NewEntryCodec(inm, &physical.EntryCodecConfig{ChunkSize: 100}, logger)
+	require.Error(t, err)
+}
+
+// TestEntryCodec_TransactionLimits verifies that the backend's limit on the
+// number of entries in a transaction is divided by the most entries an
+// operation can become, and that entries are limited to as many chunks as fit
+// in a transaction.
+func TestEntryCodec_TransactionLimits(t *testing.T) {
+	logger := logging.NewVaultLogger(log.Debug)
+
+	backend := &physical.TestTransactionalLimitBackend{MaxEntries: 63, MaxSize: 128 * 1024}
+	codec, err := physical.NewEntryCodec(backend, &physical.EntryCodecConfig{ChunkSize: 1024}, logger)
+	require.NoError(t, err)
+	maxEntries, maxSize := codec.(physical.TransactionalLimits).TransactionLimits()
+	require.Equal(t, 1, maxEntries)
+	require.Equal(t, 128*1024, maxSize)
+
+	// An entry can't be split into more chunks than fit in a transaction,
+	// along with its manifest and the chunks of its previous value
+	require.Error(t, codec.Put(context.Background(), &physical.Entry{Key: "foo", Value: make([]byte, 32*1024)}))
+	require.NoError(t, codec.Put(context.Background(), &physical.Entry{Key: "foo", Value: make([]byte, 31*1024)}))
+
+	backend = &physical.TestTransactionalLimitBackend{MaxEntries: 1000}
+	codec, err = physical.NewEntryCodec(backend, &physical.EntryCodecConfig{ChunkSize: 1024}, logger)
+	require.NoError(t, err)
+	maxEntries, _ = codec.(physical.TransactionalLimits).TransactionLimits()
+	require.Equal(t, 1000/(2*physical.DefaultMaxEntryChunks+1), maxEntries)
+
+	backend = &physical.TestTransactionalLimitBackend{}
+	codec, err = physical.NewEntryCodec(backend, &physical.EntryCodecConfig{ChunkSize: 1024}, logger)
+	require.NoError(t, err)
+	maxEntries, maxSize = codec.(physical.TransactionalLimits).TransactionLimits()
+	require.Zero(t, maxEntries)
+	require.Zero(t, maxSize)
+}
+
+// TestEntryCodec_ChunkingDisabled verifies that entries split into chunks are
+// still read once chunking is disabled, and that their chunks are removed
+// when they're overwritten or deleted.
+func TestEntryCodec_ChunkingDisabled(t *testing.T) {
+	logger := logging.NewVaultLogger(log.Debug)
+	ctx := context.Background()
+
+	inm, err := NewInmem(nil, logger)
+	require.NoError(t, err)
+	hasChunks, err := physical.HasEntryChunks(ctx, inm)
+	require.NoError(t, err)
+	require.False(t, hasChunks)
+
+	chunking, err := physical.NewEntryCodec(inm, &physical.EntryCodecConfig{ChunkSize: 1024}, logger)
+	require.NoError(t, err)
+	large := make([]byte, 5000)
+	_, err = rand.Read(large)
+	require.NoError(t, err)
+	require.NoError(t, chunking.Put(ctx, &physical.Entry{Key: "foo", Value: large}))
+	require.NoError(t, chunking.Put(ctx, &physical.Entry{Key: "bar", Value: large}))
+
+	hasChunks, err = physical.HasEntryChunks(ctx, inm)
+	require.NoError(t, err)
+	require.True(t, hasChunks)
+
+	codec, err := physical.NewEntryCodec(inm, &physical.EntryCodecConfig{}, logger)
+	require.NoError(t, err)
+	entry, err := codec.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.Equal(t, large, entry.Value)
+
+	// Large entries are no longer chunked
+	require.NoError(t, codec.Put(ctx, &physical.Entry{Key: "foo", Value: large}))
+	raw, err := inm.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.Equal(t, large, raw.Value)
+	require.NoError(t, codec.Delete(ctx, "bar"))
+
+	hasChunks, err = physical.HasEntryChunks(ctx, inm)
+	require.NoError(t, err)
+	require.False(t, hasChunks)
+}
+
+func TestEntryCodec_Chunking(t *testing.T) {
+	logger := logging.NewVaultLogger(log.Debug)
+	ctx := context.Background()
+
+	inm, err := NewTransactionalInmem(map[string]string{"max_value_size": "1024"}, logger)
+	require.NoError(t, err)
+	codecRaw, err := physical.NewEntryCodec(inm, &physical.EntryCodecConfig{ChunkSize: 1024}, logger)
+	require.NoError(t, err)
+	codec := codecRaw.(physical.TransactionalBackend)
+
+	large := make([]byte, 5000)
+	_, err = rand.Read(large)
+	require.NoError(t, err)
+	require.Error(t, inm.Put(ctx, &physical.Entry{Key: "foo", Value: large}))
+	require.NoError(t, codec.Put(ctx, &physical.Entry{Key: "foo", Value: large}))
+
+	entry, err := codec.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.Equal(t, large, entry.Value)
+
+	chunks, err := inm.List(ctx, physical.EntryChunkPrefix)
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	chunks, err = inm.List(ctx, physical.EntryChunkPrefix+chunks[0])
+	require.NoError(t, err)
+	require.Len(t, chunks, 5)
+
+	// Chunks are hidden from listings
+	keys, err := inm.List(ctx, "")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"foo", physical.EntryChunkPrefix}, keys)
+	keys, err = codec.List(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"foo"}, keys)
+
+	// Overwriting the entry removes its chunks
+	require.NoError(t, codec.Put(ctx, &physical.Entry{Key: "foo", Value: []byte("bar")}))
+	chunks, err = inm.List(ctx, physical.EntryChunkPrefix)
+	require.NoError(t, err)
+	require.Empty(t, chunks)
+
+	// Values which fit aren't encoded
+	small := make([]byte, 1024)
+	require.NoError(t, codec.Put(ctx, &physical.Entry{Key: "small", Value: small}))
+	raw, err := inm.Get(ctx, "small")
+	require.NoError(t, err)
+	require.Equal(t, small, raw.Value)
+
+	// Values which look like encoded values are stored as they are
+	magic := append([]byte("\xffvault-codec:m"), []byte("{}")...)
+	require.NoError(t, codec.Put(ctx, &physical.Entry{Key: "magic", Value: magic}))
+	entry, err = codec.Get(ctx, "magic")
+	require.NoError(t, err)
+	require.Equal(t, magic, entry.Value)
+
+	// Chunks are written and removed in transactions
+	require.NoError(t, codec.Transaction(ctx, []*physical.TxnEntry{
+		{Operation: physical.PutOperation, Entry: &physical.Entry{Key: "txn", Value: large}},
+		{Operation: physical.DeleteOperation, Entry: &physical.Entry{Key: "small"}},
+	}))
+	entry, err = codec.Get(ctx, "txn")
+	require.NoError(t, err)
+	require.Equal(t, large, entry.Value)
+	require.NoError(t, codec.Transaction(ctx, []*physical.TxnEntry{
+		{Operation: physical.DeleteOperation, Entry: &physical.Entry{Key: "txn"}},
+	}))
+	chunks, err = inm.List(ctx, physical.EntryChunkPrefix)
+	require.NoError(t, err)
+	require.Empty(t, chunks)
+
+	// A missing chunk is an error, rather than a truncated value
+	require.NoError(t, codec.Put(ctx, &physical.Entry{Key: "foo", Value: large}))
+	chunks, err = inm.List(ctx, physical.EntryChunkPrefix)
+	require.NoError(t, err)
+	require.NoError(t, inm.Delete(ctx, physical.EntryChunkPrefix+chunks[0]+"2"))
+	_, err = codec.Get(ctx, "foo")
+	require.ErrorIs(t, err, physical.ErrEntryChunkMissing)
+
+	require.NoError(t, codec.Delete(ctx, "foo"))
+	raw, err = inm.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.Nil(t, raw)
+}
+
+// slowGetBackend delays returning what it read, so that concurrent writes
+// through an EntryCodec read the same previous manifest of an entry.
+type slowGetBackend struct {
+	physical.TransactionalBackend
+}
+
+func (b *slowGetBackend) Get(ctx context.Context, key string) (*physical.Entry, error) {
+	entry, err := b.TransactionalBackend.Get(ctx, key)
+	time.Sleep(time.Millisecond)
+	return entry, err
+}
+
+// TestEntryCodec_ConcurrentPuts verifies that concurrent writes to the same key
+// don't leave behind chunks which no manifest references.
+func TestEntryCodec_ConcurrentPuts(t *testing.T) {
+	logger := logging.NewVaultLogger(log.Debug)
+	ctx := context.Background()
+
+	inm, err := NewTransactionalInmem(map[string]string{"max_value_size": "1024"}, logger)
+	require.NoError(t, err)
+	codecRaw, err := physical.NewEntryCodec(&slowGetBackend{inm.(physical.TransactionalBackend)}, &physical.EntryCodecConfig{ChunkSize: 1024}, logger)
+	require.NoError(t, err)
+	codec := codecRaw.(physical.TransactionalBackend)
+
+	large := make([]byte, 5000)
+	_, err = rand.Read(large)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, 20*10)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				entry := &physical.Entry{Key: "foo", Value: large}
+				if i%2 == 0 {
+					errCh <- codec.Put(ctx, entry)
+				} else {
+					errCh <- codec.Transaction(ctx, []*physical.TxnEntry{{Operation: physical.PutOperation, Entry: entry}})
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		require.NoError(t, err)
+	}
+
+	// Only the chunks of the last value written remain
+	chunks, err := inm.List(ctx, physical.EntryChunkPrefix)
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	chunks, err = inm.List(ctx, physical.EntryChunkPrefix+chunks[0])
+	require.NoError(t, err)
+	require.Len(t, chunks, 5)
+
+	entry, err := codec.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.Equal(t, large, entry.Value)
+
+	require.NoError(t, codec.Delete(ctx, "foo"))
+	chunks, err = inm.List(ctx, physical.EntryChunkPrefix)
+	require.NoError(t, err)
+	require.Empty(t, chunks)
+}
@@ -8,6 +8,7 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"errors"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -573,6 +574,7 @@ func LogicalAuthToProtoAuth(a *logical.Auth) (*Auth, error) {
 		GroupAliases:     a.GroupAliases,
 		BoundCIDRs:       boundCIDRs,
 		ExplicitMaxTTL:   int64(a.ExplicitMaxTTL),
+		BindClientCert:   a.BindClientCert,
 	}, nil
 }
 
@@ -621,6 +623,7 @@ func ProtoAuthToLogicalAuth(a *Auth) (*logical.Auth, error) {
 		GroupAliases:     a.GroupAliases,
 		BoundCIDRs:       boundCIDRs,
 		ExplicitMaxTTL:   time.Duration(a.ExplicitMaxTTL),
+		BindClientCert:   a.BindClientCert,
 	}, nil
 }
 
@@ -635,28 +638,30 @@ func LogicalTokenEntryToProtoTokenEntry(t *logical.TokenEntry) *TokenEntry {
 	}
 
 	return &TokenEntry{
-		ID:                 t.ID,
-		Accessor:           t.Accessor,
-		Parent:             t.Parent,
-		Policies:           t.Policies,
-		InlinePolicy:       t.InlinePolicy,
-		Path:               t.Path,
-		Meta:               t.Meta,
-		InternalMeta:       t.InternalMeta,
-		DisplayName:        t.DisplayName,
-		NumUses:            int64(t.NumUses),
-		CreationTime:       t.CreationTime,
-		TTL:                int64(t.TTL),
-		ExplicitMaxTTL:     int64(t.ExplicitMaxTTL),
-		Role:               t.Role,
-		Period:             int64(t.Period),
-		EntityID:           t.EntityID,
-		NoIdentityPolicies: t.NoIdentityPolicies,
-		BoundCIDRs:         boundCIDRs,
-		NamespaceID:        t.NamespaceID,
-		CubbyholeID:        t.CubbyholeID,
-		Type:               uint32(t.Type),
-		ExternalID:         t.ExternalID,
+		ID:                  t.ID,
+		Accessor:            t.Accessor,
+		Parent:              t.Parent,
+		Policies:            t.Policies,
+		InlinePolicy:        t.InlinePolicy,
+		Path:                t.Path,
+		Meta:                t.Meta,
+		InternalMeta:        t.InternalMeta,
+		DisplayName:         t.DisplayName,
+		NumUses:             int64(t.NumUses),
+		CreationTime:        t.CreationTime,
+		TTL:                 int64(t.TTL),
+		ExplicitMaxTTL:      int64(t.ExplicitMaxTTL),
+		Role:                t.Role,
+		Period:              int64(t.Period),
+		EntityID:            t.EntityID,
+		NoIdentityPolicies:  t.NoIdentityPolicies,
+		BoundCIDRs:          boundCIDRs,
+		NamespaceID:         t.NamespaceID,
+		CubbyholeID:         t.CubbyholeID,
+		Type:                uint32(t.Type),
+		ExternalID:          t.ExternalID,
+		BoundCertThumbprint: t.BoundCertThumbprint,
+		Scope:               LogicalTokenScopeToProtoTokenScope(t.Scope),
 	}
 }
 
@@ -676,31 +681,66 @@ func ProtoTokenEntryToLogicalTokenEntry(t *TokenEntry) (*logical.TokenEntry, err
 	}
 
 	return &logical.TokenEntry{
-		ID:                 t.ID,
-		Accessor:           t.Accessor,
-		Parent:             t.Parent,
-		Policies:           t.Policies,
-		InlinePolicy:       t.InlinePolicy,
-		Path:               t.Path,
-		Meta:               t.Meta,
-		InternalMeta:       t.InternalMeta,
-		DisplayName:        t.DisplayName,
-		NumUses:            int(t.NumUses),
-		CreationTime:       t.CreationTime,
-		TTL:                time.Duration(t.TTL),
-		ExplicitMaxTTL:     time.Duration(t.ExplicitMaxTTL),
-		Role:               t.Role,
-		Period:             time.Duration(t.Period),
-		EntityID:           t.EntityID,
-		NoIdentityPolicies: t.NoIdentityPolicies,
-		BoundCIDRs:         boundCIDRs,
-		NamespaceID:        t.NamespaceID,
-		CubbyholeID:        t.CubbyholeID,
-		Type:               logical.TokenType(t.Type),
-		ExternalID:         t.ExternalID,
+		ID:                  t.ID,
+		Accessor:            t.Accessor,
+		Parent:              t.Parent,
+		Policies:            t.Policies,
+		InlinePolicy:        t.InlinePolicy,
+		Path:                t.Path,
+		Meta:                t.Meta,
+		InternalMeta:        t.InternalMeta,
+		DisplayName:         t.DisplayName,
+		NumUses:             int(t.NumUses),
+		CreationTime:        t.CreationTime,
+		TTL:                 time.Duration(t.TTL),
+		ExplicitMaxTTL:      time.Duration(t.ExplicitMaxTTL),
+		Role:                t.Role,
+		Period:              time.Duration(t.Period),
+		EntityID:            t.EntityID,
+		NoIdentityPolicies:  t.NoIdentityPolicies,
+		BoundCIDRs:          boundCIDRs,
+		NamespaceID:         t.NamespaceID,
+		CubbyholeID:         t.CubbyholeID,
+		Type:                logical.TokenType(t.Type),
+		ExternalID:          t.ExternalID,
+		BoundCertThumbprint: t.BoundCertThumbprint,
+		Scope:               ProtoTokenScopeToLogicalTokenScope(t.Scope),
 	}, nil
 }
 
+func LogicalTokenScopeToProtoTokenScope(scope map[string][]string) []*TokenScopePath {
+	if len(scope) == 0 {
+		return nil
+	}
+
+	paths := make([]string, 0, len(scope))
+	for path := range scope {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	ret := make([]*TokenScopePath, 0, len(paths))
+	for _, path := range paths {
+		ret = append(ret, &TokenScopePath{
+			Path:         path,
+			Capabilities: scope[path],
+		})
+	}
+	return ret
+}
+
+func ProtoTokenScopeToLogicalTokenScope(scope []*TokenScopePath) map[string][]string {
+	if len(scope) == 0 {
+		return nil
+	}
+
+	ret := make(map[string][]string, len(scope))
+	for _, p := range scope {
+		ret[p.Path] = p.Capabilities
+	}
+	return ret
+}
+
 func TLSConnectionStateToProtoConnectionState(connState *tls.ConnectionState) *ConnectionState {
 	if connState == nil {
 		return nil
@@ -60,6 +60,33 @@ func TestTranslation_StorageEntry(t *testing.T) {
 	}
 }
 
+func TestTranslation_TokenEntry(t *testing.T) {
+	tCases := []*logical.TokenEntry{
+		nil,
+		{ID: "id", Accessor: "accessor", Policies: []string{"default"}, Type: logical.TokenTypeBatch},
+		{ID: "id", BoundCertThumbprint: "thumbprint"},
+		{
+			ID: "id",
+			Scope: map[string][]string{
+				"secret/data/app/ci/*": {"read"},
+				"secret/metadata/app/": {"list", "read"},
+			},
+		},
+	}
+
+	for _, c := range tCases {
+		p := LogicalTokenEntryToProtoTokenEntry(c)
+		e, err := ProtoTokenEntryToLogicalTokenEntry(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(c, e) {
+			t.Fatalf("Entries did not match: %#v, %#v", e, c)
+		}
+	}
+}
+
 func TestTranslation_Request(t *testing.T) {
 	certs, err := peerCertificates()
 	if err != nil {
@@ -41,6 +41,10 @@ type ACL struct {
 
 	// Stores policies that are actually RGPs for later fetching
 	rgpPolicies []*Policy
+
+	// scope, if set, restricts the results of this ACL to those also granted
+	// by the scope. It is used for tokens created with a capability scope.
+	scope *ACL
 }
 
 type PolicyCheckOpts struct {
@@ -362,7 +366,58 @@ func (a *ACL) Capabilities(ctx context.Context, path string) []string {
 }
 
 // AllowOperation is used to check if the given operation is permitted.
-func (a *ACL) AllowOperation(ctx context.Context, req *logical.Request, capCheckOnly bool) (ret *ACLResults) {
+func (a *ACL) AllowOperation(ctx context.Context, req *logical.Request, capCheckOnly bool) *ACLResults {
+	ret := a.allowOperation(ctx, req, capCheckOnly)
+	if a.scope == nil {
+		return ret
+	}
+
+	return restrictACLResults(ret, a.scope.allowOperation(ctx, req, capCheckOnly))
+}
+
+// restrictToScope restricts the ACL so that an operation is only permitted
+// if it is permitted by both the ACL and the given scope.
+func (a *ACL) restrictToScope(scope *ACL) {
+	a.scope = scope
+}
+
+// restrictACLResults returns the intersection of the results of an ACL check
+// and the results of the same check against the scope the ACL is restricted
+// to.
+func restrictACLResults(ret, scope *ACLResults) *ACLResults {
+	if ret.IsRoot {
+		// Root grants everything, so the scope alone determines what is
+		// permitted.
+		ret.IsRoot = false
+		ret.Allowed = scope.Allowed
+		ret.RootPrivs = scope.RootPrivs
+		ret.CapabilitiesBitmap = scope.CapabilitiesBitmap
+		ret.SubscribeEventTypes = scope.SubscribeEventTypes
+		return ret
+	}
+
+	ret.Allowed = ret.Allowed && scope.Allowed
+	ret.RootPrivs = ret.RootPrivs && scope.RootPrivs
+	ret.CapabilitiesBitmap &= scope.CapabilitiesBitmap
+
+	switch {
+	case slices.Contains(scope.SubscribeEventTypes, "*"):
+	case slices.Contains(ret.SubscribeEventTypes, "*"):
+		ret.SubscribeEventTypes = scope.SubscribeEventTypes
+	default:
+		var eventTypes []string
+		for _, eventType := range ret.SubscribeEventTypes {
+			if slices.Contains(scope.SubscribeEventTypes, eventType) {
+				eventTypes = append(eventTypes, eventType)
+			}
+		}
+		ret.SubscribeEventTypes = eventTypes
+	}
+
+	return ret
+}
+
+func (a *ACL) allowOperation(ctx context.Context, req *logical.Request, capCheckOnly bool) (ret *ACLResults) {
 	ret = a.performEnterpriseAclChecks(ctx, req, capCheckOnly)
 	if ret != nil {
 		return ret
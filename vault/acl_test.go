@@ -186,6 +186,91 @@ func testACLRoot(t *testing.T, ns *namespace.Namespace) {
 	}
 }
 
+func TestACL_TokenScope(t *testing.T) {
+	ns := namespace.RootNamespace
+	ctx := namespace.RootContext(context.Background())
+
+	scopePolicy, err := tokenScopePolicy(ns, map[string][]string{
+		"dev/*":       {"read"},
+		"stage/aws/*": {"read", "list", "sudo"},
+	})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	scope, err := NewACL(ctx, []*Policy{scopePolicy})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	policy, err := ParseACLPolicy(ns, aclPolicy)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	acl, err := NewACL(ctx, []*Policy{policy})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	acl.restrictToScope(scope)
+
+	rootACL, err := NewACL(ctx, []*Policy{{Name: "root"}})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	rootACL.restrictToScope(scope)
+
+	type tcase struct {
+		acl       *ACL
+		op        logical.Operation
+		path      string
+		allowed   bool
+		rootPrivs bool
+	}
+	tcases := []tcase{
+		{acl, logical.HelpOperation, "root", true, false},
+
+		{acl, logical.ReadOperation, "dev/foo", true, false},
+		{acl, logical.UpdateOperation, "dev/foo", false, false},
+		{acl, logical.ListOperation, "stage/aws/foo", true, true},
+		{acl, logical.UpdateOperation, "stage/aws/foo", false, true},
+		{acl, logical.DeleteOperation, "stage/foo", false, false},
+		{acl, logical.ReadOperation, "prod/foo", false, false},
+
+		{rootACL, logical.ReadOperation, "dev/foo", true, false},
+		{rootACL, logical.UpdateOperation, "dev/foo", false, false},
+		{rootACL, logical.ListOperation, "stage/aws/foo", true, true},
+		{rootACL, logical.UpdateOperation, "sys/mounts/foo", false, false},
+	}
+
+	for _, tc := range tcases {
+		request := new(logical.Request)
+		request.Operation = tc.op
+		request.Path = tc.path
+
+		authResults := tc.acl.AllowOperation(ctx, request, false)
+		if authResults.Allowed != tc.allowed {
+			t.Fatalf("bad: case %s %s: %v, %v", tc.op, tc.path, authResults.Allowed, authResults.RootPrivs)
+		}
+		if authResults.RootPrivs != tc.rootPrivs {
+			t.Fatalf("bad: case %s %s: %v, %v", tc.op, tc.path, authResults.Allowed, authResults.RootPrivs)
+		}
+		if authResults.IsRoot {
+			t.Fatalf("bad: case %s %s: scoped ACL reported as root", tc.op, tc.path)
+		}
+	}
+
+	actual := rootACL.Capabilities(ctx, "dev/foo")
+	expected := []string{"read"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: got\n%#v\nexpected\n%#v\n", actual, expected)
+	}
+
+	actual = acl.Capabilities(ctx, "stage/aws/foo")
+	expected = []string{"sudo", "read", "list"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: got\n%#v\nexpected\n%#v\n", actual, expected)
+	}
+}
+
 func TestACL_Single(t *testing.T) {
 	t.Run("root-ns", func(t *testing.T) {
 		t.Parallel()
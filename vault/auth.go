@@ -169,6 +169,7 @@ func (c *Core) enableCredentialWithRequestInternal(ctx context.Context, entry *M
 
 	viewPath := entry.ViewPath()
 	view := NewBarrierView(c.barrier, viewPath)
+	c.trackStorageUsage(entry, true)
 
 	// Singleton mounts cannot be filtered on a per-secondary basis
 	// from replication
@@ -412,6 +413,7 @@ func (c *Core) disableCredentialWithRequestInternal(ctx context.Context, path st
 	}
 
 	removePathCheckers(c, entry, viewPath)
+	c.untrackStorageUsage(entry)
 
 	if !c.IsPerfSecondary() {
 		if c.quotaManager != nil {
@@ -856,6 +858,7 @@ func (c *Core) setupCredentials(ctx context.Context) error {
 		}
 
 		view := NewBarrierView(c.barrier, viewPath)
+		c.trackStorageUsage(entry, false)
 
 		// Determining the replicated state of the mount
 		nilMount, err := preprocessMount(c, entry, view)
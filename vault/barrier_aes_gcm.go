@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"github.com/hashicorp/vault/helper/locking"
+	"github.com/hashicorp/vault/sdk/helper/compressutil"
 	"github.com/hashicorp/vault/sdk/helper/jsonutil"
 	"github.com/hashicorp/vault/sdk/logical"
 	"github.com/hashicorp/vault/sdk/physical"
@@ -44,12 +45,21 @@ const (
 	// The keyring is persisted before the root key.
 	defaultKeyringTimeout            = 1 * time.Second
 	bestEffortKeyringTimeoutOverride = "VAULT_ENCRYPTION_COUNT_PERSIST_TIMEOUT"
+
+	// minCompressedEntrySize is the size below which entries aren't
+	// compressed, as there is little to gain.
+	minCompressedEntrySize = 1024
 )
 
 // Versions of the AESGCM storage methodology
 const (
 	AESGCMVersion1 = 0x1
 	AESGCMVersion2 = 0x2
+	// AESGCMVersion3 is AESGCMVersion2 with the plaintext compressed before
+	// it's encrypted. The version byte is authenticated along with the path.
+	// Versions of Vault before barrierCompressionMinimumVersion can't decrypt
+	// it.
+	AESGCMVersion3 = 0x3
 )
 
 // barrierInit is the JSON encoded value stored
@@ -90,6 +100,13 @@ type AESGCMBarrier struct {
 	// of const to allow for testing
 	currentAESGCMVersionByte byte
 
+	// compression is the compressutil type entries are compressed with
+	// before they're encrypted, if any
+	compression string
+	// compressionAllowed is set once every node of the cluster can read
+	// compressed entries, until which they're written uncompressed
+	compressionAllowed atomic.Bool
+
 	initialized atomic.Bool
 
 	UnaccountedEncryptions *atomic.Int64
@@ -149,6 +166,28 @@ func NewAESGCMBarrier(physical physical.Backend, detectDeadlocks bool) (*AESGCMB
 	return b, nil
 }
 
+// SetCompression sets the compressutil type used to compress entries before
+// they're encrypted, or disables compression if empty. Entries are only stored
+// compressed if that makes them smaller, and once compression has been allowed
+// with SetCompressionAllowed. Compressed entries are read regardless of this
+// setting. It must be called before the barrier is used.
+func (b *AESGCMBarrier) SetCompression(compressionType string) error {
+	switch compressionType {
+	case "", compressutil.CompressionTypeGzip, compressutil.CompressionTypeLZW,
+		compressutil.CompressionTypeSnappy, compressutil.CompressionTypeLZ4:
+	default:
+		return fmt.Errorf("unsupported compression type %q", compressionType)
+	}
+	b.compression = compressionType
+	return nil
+}
+
+// SetCompressionAllowed sets whether entries may be written compressed, which
+// must only be allowed once every node of the cluster can read them.
+func (b *AESGCMBarrier) SetCompressionAllowed(allowed bool) {
+	b.compressionAllowed.Store(allowed)
+}
+
 func (b *AESGCMBarrier) DetectDeadlocks() bool {
 	if _, ok := b.l.(*locking.DeadlockRWMutex); ok {
 		return true
@@ -862,7 +901,7 @@ func (b *AESGCMBarrier) Put(ctx context.Context, entry *logical.StorageEntry) er
 }
 
 func (b *AESGCMBarrier) putInternal(ctx context.Context, term uint32, primary cipher.AEAD, entry *logical.StorageEntry) error {
-	value, err := b.encryptTracked(entry.Key, term, primary, entry.Value)
+	value, err := b.encryptEntry(entry.Key, term, primary, entry.Value)
 	if err != nil {
 		return err
 	}
@@ -1024,6 +1063,35 @@ func (b *AESGCMBarrier) aeadFromKey(key []byte) (cipher.AEAD, error) {
 
 // encrypt is used to encrypt a value
 func (b *AESGCMBarrier) encrypt(path string, term uint32, gcm cipher.AEAD, plain []byte) ([]byte, error) {
+	return b.encryptVersion(path, term, gcm, plain, b.currentAESGCMVersionByte)
+}
+
+// encryptEntry is used to encrypt the value of an entry written to storage,
+// compressing it first if the barrier is configured to
+func (b *AESGCMBarrier) encryptEntry(path string, term uint32, gcm cipher.AEAD, plain []byte) ([]byte, error) {
+	if b.compression == "" || !b.compressionAllowed.Load() || len(plain) < minCompressedEntrySize || b.currentAESGCMVersionByte != AESGCMVersion2 {
+		return b.encryptTracked(path, term, gcm, plain)
+	}
+
+	compressed, err := compressutil.Compress(plain, &compressutil.CompressionConfig{Type: b.compression})
+	if err != nil {
+		return nil, fmt.Errorf("failed to compress entry: %w", err)
+	}
+	if len(compressed) >= len(plain) {
+		return b.encryptTracked(path, term, gcm, plain)
+	}
+
+	ct, err := b.encryptVersion(path, term, gcm, compressed, AESGCMVersion3)
+	if err != nil {
+		return nil, err
+	}
+	b.trackEncryption(term)
+	return ct, nil
+}
+
+// encryptVersion is used to encrypt a value using the given version of the
+// storage methodology
+func (b *AESGCMBarrier) encryptVersion(path string, term uint32, gcm cipher.AEAD, plain []byte, version byte) ([]byte, error) {
 	// Allocate the output buffer with room for term, version byte,
 	// nonce, GCM tag and the plaintext
 
@@ -1040,7 +1108,7 @@ func (b *AESGCMBarrier) encrypt(path string, term uint32, gcm cipher.AEAD, plain
 	binary.BigEndian.PutUint32(out[:4], term)
 
 	// Set the version byte
-	out[4] = b.currentAESGCMVersionByte
+	out[4] = version
 
 	// Generate a random nonce
 	nonce := out[5 : 5+gcm.NonceSize()]
@@ -1053,7 +1121,7 @@ func (b *AESGCMBarrier) encrypt(path string, term uint32, gcm cipher.AEAD, plain
 	}
 
 	// Seal the output
-	switch b.currentAESGCMVersionByte {
+	switch version {
 	case AESGCMVersion1:
 		out = gcm.Seal(out, nonce, plain, nil)
 	case AESGCMVersion2:
@@ -1062,6 +1130,8 @@ func (b *AESGCMBarrier) encrypt(path string, term uint32, gcm cipher.AEAD, plain
 			aad = []byte(path)
 		}
 		out = gcm.Seal(out, nonce, plain, aad)
+	case AESGCMVersion3:
+		out = gcm.Seal(out, nonce, plain, aesGCMVersion3AAD(path))
 	default:
 		panic("Unknown AESGCM version")
 	}
@@ -1098,11 +1168,33 @@ func (b *AESGCMBarrier) decrypt(path string, gcm cipher.AEAD, cipher []byte) ([]
 			aad = []byte(path)
 		}
 		return gcm.Open(out, nonce, raw, aad)
+	case AESGCMVersion3:
+		compressed, err := gcm.Open(out, nonce, raw, aesGCMVersion3AAD(path))
+		if err != nil {
+			return nil, err
+		}
+		plain, notCompressed, err := compressutil.Decompress(compressed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress: %w", err)
+		}
+		if notCompressed {
+			return nil, errors.New("compressed value is missing compression canary")
+		}
+		return plain, nil
 	default:
 		return nil, fmt.Errorf("version bytes mis-match")
 	}
 }
 
+// aesGCMVersion3AAD returns the additional data authenticated with values
+// encrypted with AESGCMVersion3, which includes the version so that it can't
+// be changed to have a value decompressed or not.
+func aesGCMVersion3AAD(path string) []byte {
+	aad := make([]byte, 0, 1+len(path))
+	aad = append(aad, AESGCMVersion3)
+	return append(aad, path...)
+}
+
 // Encrypt is used to encrypt in-memory for the BarrierEncryptor interface
 func (b *AESGCMBarrier) Encrypt(ctx context.Context, key string, plaintext []byte) ([]byte, error) {
 	b.l.RLock()
@@ -1206,12 +1298,15 @@ func (b *AESGCMBarrier) encryptTracked(path string, term uint32, gcm cipher.AEAD
 	if err != nil {
 		return nil, err
 	}
-	// Increment the local encryption count, and track metrics
+	b.trackEncryption(term)
+	return ct, nil
+}
+
+// trackEncryption increments the local encryption count, and tracks metrics
+func (b *AESGCMBarrier) trackEncryption(term uint32) {
 	b.UnaccountedEncryptions.Add(1)
 	b.totalLocalEncryptions.Add(1)
 	metrics.IncrCounterWithLabels(barrierEncryptsMetric, 1, termLabel(term))
-
-	return ct, nil
 }
 
 // UnaccountedEncryptions returns the number of encryptions made on the local instance only for the current key term
@@ -395,6 +395,64 @@ func TestAESGCMBarrier_MoveIntegrityV2(t *testing.T) {
 	}
 }
 
+// TestAESGCMBarrier_Compression verifies that entries are compressed before
+// they're encrypted when configured, that compressed entries are read without
+// it, and that the version byte of a compressed entry can't be changed.
+func TestAESGCMBarrier_Compression(t *testing.T) {
+	ctx := context.Background()
+	inm, err := inmem.NewInmem(nil, logger)
+	require.NoError(t, err)
+	b, err := NewAESGCMBarrier(inm, false)
+	require.NoError(t, err)
+	require.Error(t, b.SetCompression("bogus"))
+	require.NoError(t, b.SetCompression("snappy"))
+
+	key, _ := b.GenerateKey(rand.Reader)
+	require.NoError(t, b.Initialize(ctx, key, nil, rand.Reader))
+	require.NoError(t, b.Unseal(ctx, key))
+
+	// Entries aren't compressed until every node can read them
+	value := bytes.Repeat([]byte("compressible "), 1000)
+	require.NoError(t, b.Put(ctx, &logical.StorageEntry{Key: "test", Value: value}))
+	pe, err := inm.Get(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, byte(AESGCMVersion2), pe.Value[4])
+
+	b.SetCompressionAllowed(true)
+	require.NoError(t, b.Put(ctx, &logical.StorageEntry{Key: "test", Value: value}))
+	pe, err = inm.Get(ctx, "test")
+	require.NoError(t, err)
+	require.Less(t, len(pe.Value), len(value)/2)
+	require.Equal(t, byte(AESGCMVersion3), pe.Value[4])
+
+	// Small and incompressible entries aren't compressed
+	require.NoError(t, b.Put(ctx, &logical.StorageEntry{Key: "small", Value: []byte("test")}))
+	pe, err = inm.Get(ctx, "small")
+	require.NoError(t, err)
+	require.Equal(t, byte(AESGCMVersion2), pe.Value[4])
+	random := make([]byte, 4096)
+	_, err = rand.Read(random)
+	require.NoError(t, err)
+	require.NoError(t, b.Put(ctx, &logical.StorageEntry{Key: "random", Value: random}))
+	pe, err = inm.Get(ctx, "random")
+	require.NoError(t, err)
+	require.Equal(t, byte(AESGCMVersion2), pe.Value[4])
+
+	// Compressed entries are read once compression is disabled
+	require.NoError(t, b.SetCompression(""))
+	entry, err := b.Get(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, value, entry.Value)
+
+	// The version byte is authenticated
+	pe, err = inm.Get(ctx, "test")
+	require.NoError(t, err)
+	pe.Value[4] = AESGCMVersion2
+	require.NoError(t, inm.Put(ctx, pe))
+	_, err = b.Get(ctx, "test")
+	require.Error(t, err)
+}
+
 func TestAESGCMBarrier_UpgradeV1toV2(t *testing.T) {
 	inm, err := inmem.NewInmem(nil, logger)
 	if err != nil {
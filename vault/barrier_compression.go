@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	// barrierCompressionMinimumVersion is the first version able to read the
+	// entries the barrier compresses, which it only writes once every node
+	// of the cluster has upgraded.
+	barrierCompressionMinimumVersion = "2.2.0"
+
+	barrierCompressionFeature = "write compressed storage entries"
+)
+
+// barrierCompressionCheckInterval is how often the active node checks whether
+// every node can read compressed entries. The first check is made after an
+// interval too, so that the standbys have reported their versions.
+var barrierCompressionCheckInterval = time.Minute
+
+// startBarrierCompressionChecker allows the barrier to compress entries once
+// every node of the cluster can read them, checking periodically on the
+// active node until the active context is canceled.
+func (c *Core) startBarrierCompressionChecker(ctx context.Context) {
+	barrier, ok := c.barrier.(*AESGCMBarrier)
+	if !ok || barrier.compression == "" {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(barrierCompressionCheckInterval)
+		defer ticker.Stop()
+		defer barrier.SetCompressionAllowed(false)
+
+		var lastErr string
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			err := c.checkBarrierCompressionVersions(ctx)
+			switch {
+			case err == nil && !barrier.compressionAllowed.Load():
+				c.logger.Info("every node can read compressed storage entries, compressing entries", "compression", barrier.compression)
+				lastErr = ""
+			case err != nil && ctx.Err() == nil && err.Error() != lastErr:
+				c.logger.Warn("not compressing storage entries", "error", err)
+				lastErr = err.Error()
+			}
+			barrier.SetCompressionAllowed(err == nil)
+		}
+	}()
+}
+
+// checkBarrierCompressionVersions checks that every node of the cluster is
+// running at least barrierCompressionMinimumVersion. With raft storage the
+// versions are reported by autopilot, and otherwise by the standbys'
+// heartbeats, so standbys which aren't running aren't checked.
+func (c *Core) checkBarrierCompressionVersions(ctx context.Context) error {
+	if raftBackend := c.getRaftBackend(); raftBackend != nil {
+		config, err := raftBackend.GetConfiguration(ctx)
+		if err != nil {
+			return err
+		}
+		if len(config.Servers) == 1 && config.Servers[0].NodeID == raftBackend.NodeID() {
+			return nil
+		}
+
+		state, err := raftBackend.GetAutopilotServerState(ctx)
+		if err != nil {
+			return err
+		}
+		if state == nil {
+			return errors.New("autopilot is required to check the versions of the nodes")
+		}
+		return checkRaftNodeVersions(config, state, barrierCompressionMinimumVersion, barrierCompressionFeature)
+	}
+
+	for _, node := range c.GetHAPeerNodesCached() {
+		if err := checkNodeVersion(node.ClusterAddress, node.Version, barrierCompressionMinimumVersion, barrierCompressionFeature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestBarrierCompression_Allowed verifies that the active node only allows the
+// barrier to compress entries once it has checked the versions of the nodes.
+func TestBarrierCompression_Allowed(t *testing.T) {
+	oldInterval := barrierCompressionCheckInterval
+	barrierCompressionCheckInterval = 100 * time.Millisecond
+	t.Cleanup(func() { barrierCompressionCheckInterval = oldInterval })
+
+	c, _, root := TestCoreUnsealedWithConfig(t, &CoreConfig{StorageEntryCompression: "snappy"})
+	barrier := c.barrier.(*AESGCMBarrier)
+	require.Eventually(t, barrier.compressionAllowed.Load, 10*time.Second, 10*time.Millisecond)
+
+	// Compression is disallowed again once the node is no longer active
+	require.NoError(t, c.Seal(root))
+	require.Eventually(t, func() bool { return !barrier.compressionAllowed.Load() }, 10*time.Second, 10*time.Millisecond)
+}
@@ -95,6 +95,9 @@ func (c *Core) CapabilitiesAndSubscribeEventTypes(ctx context.Context, token, pa
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := c.restrictACLToTokenScope(ctx, tokenNS, acl, te, entity); err != nil {
+		return nil, nil, err
+	}
 
 	capabilities, eventTypes := acl.CapabilitiesAndSubscribeEventTypes(ctx, path)
 	sort.Strings(capabilities)
@@ -682,6 +682,32 @@ type Core struct {
 	pendingRaftPeersLock sync.RWMutex
 	// Limits the number of concurrent retrying raft join background workers.
 	raftJoinRetryLimiter chan struct{}
+	// Takes the automated snapshots configured on the active node
+	raftSnapshotScheduler *raftSnapshotScheduler
+	// Runs the rolling compaction of the raft cluster on the active node
+	raftCompactor *raftCompactor
+
+	// physicalBackends are the storage backends which can be migrated to
+	physicalBackends map[string]physical.Factory
+	// storageMirror mirrors writes to the secondary storage of an online
+	// storage migration
+	storageMirror *storageMirror
+	// Runs the online storage migration on the active node
+	storageMigrator *storageMigrator
+	// separateHAStorage is set if the HA backend isn't the storage backend
+	separateHAStorage bool
+	// storageSwitching is set while switching to the storage which an online
+	// storage migration cut over to
+	storageSwitching atomic.Bool
+	// storageSwitchLock is the HA lock of the new storage, taken while
+	// switching to it, with which the node becomes active
+	storageSwitchLock atomic.Pointer[storageSwitchLock]
+	// storageUsage tracks the storage used by each mount, which is nil
+	// unless storage usage accounting is enabled
+	storageUsage *storageUsageTracker
+	// Tracks the raft snapshot loaded for reads and recovery, which is nil
+	// if raft storage isn't in use
+	snapshotManager *snapshotManager
 
 	// rawConfig stores the config as-is from the provided server configuration.
 	rawConfig *atomic.Value
@@ -789,6 +815,13 @@ type Core struct {
 	activeNodeClockSkewMillis     *uberAtomic.Int64
 	periodicLeaderRefreshInterval time.Duration
 
+	// staleReads bounds the staleness of reads served by this node while it's
+	// a standby, from the echo replies of the active node.
+	staleReads *staleReadTracker
+	// readReplica serves stale reads from the raft FSM while this node is a
+	// standby configured with serve_stale_reads.
+	readReplica *readReplica
+
 	clusterAddrBridge *raft.ClusterAddrBridge
 
 	censusManager *CensusManager
@@ -868,11 +901,20 @@ type CoreConfig struct {
 
 	Physical physical.Backend
 
+	// PhysicalBackends are the storage backends which can be migrated to
+	// while online
+	PhysicalBackends map[string]physical.Factory
+
 	StorageType string
 
 	// May be nil, which disables HA operations
 	HAPhysical physical.HABackend
 
+	// SeparateHAStorage is set if HAPhysical isn't the storage backend, in
+	// which case it's kept when an online storage migration switches the
+	// storage
+	SeparateHAStorage bool
+
 	ServiceRegistration sr.ServiceRegistration
 
 	// Seal is the configured seal, or if none is configured explicitly, a
@@ -895,12 +937,23 @@ type CoreConfig struct {
 	// If any role based quota (LCQ or RLQ) is enabled, don't track lease counts by role
 	ImpreciseLeaseRoleTracking bool
 
+	// StorageUsageAccounting tracks the storage used by each mount, at the
+	// cost of reading the existing entry before each write
+	StorageUsageAccounting bool
+
 	// Disables the trace display for Sentinel checks
 	DisableSentinelTrace bool
 
 	// Disables the LRU cache on the physical backend
 	DisableCache bool
 
+	// StorageEntryCompression is the compressutil type entries are compressed
+	// with before they're encrypted, if any. The ciphertext size of an entry
+	// then reveals how compressible its plaintext is, and older versions of
+	// Vault can't read compressed entries, so they're only written once every
+	// node has upgraded.
+	StorageEntryCompression string
+
 	// Disables mlock syscall
 	DisableMlock bool
 
@@ -1154,6 +1207,8 @@ func CreateCore(conf *CoreConfig) (*Core, error) {
 		physical:             conf.Physical,
 		serviceRegistration:  conf.GetServiceRegistration(),
 		underlyingPhysical:   conf.Physical,
+		physicalBackends:     conf.PhysicalBackends,
+		separateHAStorage:    conf.SeparateHAStorage,
 		storageType:          conf.StorageType,
 		redirectAddr:         conf.RedirectAddr,
 		clusterAddr:          new(atomic.Value),
@@ -1230,6 +1285,8 @@ func CreateCore(conf *CoreConfig) (*Core, error) {
 		detectDeadlocks:                 detectDeadlocks,
 		echoDuration:                    uberAtomic.NewDuration(0),
 		activeNodeClockSkewMillis:       uberAtomic.NewInt64(0),
+		staleReads:                      &staleReadTracker{},
+		readReplica:                     newReadReplica(),
 		periodicLeaderRefreshInterval:   conf.PeriodicLeaderRefreshInterval,
 		rpcLastSuccessfulHeartbeat:      new(atomic.Value),
 		reportingScanDirectory:          conf.ReportingScanDirectory,
@@ -1358,10 +1415,14 @@ func NewCore(conf *CoreConfig) (*Core, error) {
 	if detectDeadlocks {
 		c.Logger().Debug("enabling deadlock detection for the barrier")
 	}
-	c.barrier, err = NewAESGCMBarrier(c.physical, detectDeadlocks)
+	barrier, err := NewAESGCMBarrier(c.physical, detectDeadlocks)
 	if err != nil {
 		return nil, fmt.Errorf("barrier setup failed: %w", err)
 	}
+	if err := barrier.SetCompression(conf.StorageEntryCompression); err != nil {
+		return nil, fmt.Errorf("barrier setup failed: %w", err)
+	}
+	c.barrier = barrier
 
 	err = c.entCheckStoredLicense(conf)
 	if err != nil {
@@ -1521,8 +1582,7 @@ func NewCore(conf *CoreConfig) (*Core, error) {
 	c.events = events
 	c.events.Start()
 
-	// Create the snapshot manager if we're on enterprise and running raft
-	// storage backend.
+	// Create the snapshot manager if we're running raft storage backend.
 	c.createSnapshotManager()
 
 	observationsLogger := conf.Logger.Named("observations")
@@ -2735,6 +2795,11 @@ func (s standardUnsealStrategy) unseal(ctx context.Context, logger log.Logger, c
 		return err
 	}
 
+	// Storage which was cut over to another backend must not be used again
+	if err := c.checkStorageCutover(ctx); err != nil {
+		return err
+	}
+
 	if err := c.entPostUnseal(false); err != nil {
 		return err
 	}
@@ -2816,6 +2881,12 @@ func (s standardUnsealStrategy) unseal(ctx context.Context, logger log.Logger, c
 		}
 	}
 
+	if err := c.startStorageMigrator(c.activeContext); err != nil {
+		c.logger.Error("failed to resume storage migration", "error", err)
+	}
+	c.startStorageUsageReconciler(c.activeContext)
+	c.startBarrierCompressionChecker(c.activeContext)
+
 	if c.getClusterListener() != nil && (c.ha != nil || shouldStartClusterListener(c)) {
 		if err := c.setupRaftActiveNode(ctx); err != nil {
 			return err
@@ -2936,6 +3007,12 @@ func buildUnsealSetupFunctionSlice(c *Core, isActive bool) []func(context.Contex
 		setupFunctions = append(setupFunctions, func(_ context.Context) error {
 			return c.setupExpiration(expireLeaseStrategyFairsharing)
 		})
+		setupFunctions = append(setupFunctions, func(_ context.Context) error {
+			if isActive {
+				c.tokenStore.startEntityReindex()
+			}
+			return nil
+		})
 		setupFunctions = append(setupFunctions, func(ctx context.Context) error {
 			return c.setupOAuthTokenDenylist(ctx)
 		})
@@ -3314,6 +3391,8 @@ func (c *Core) preSeal() error {
 		result = multierror.Append(result, fmt.Errorf("error tearing down login MFA, error: %w", err))
 	}
 
+	c.stopStorageMigrator()
+
 	preSealPhysical(c)
 
 	c.logger.Info("pre-seal teardown complete")
@@ -356,6 +356,20 @@ func (c *Core) emitMetricsActiveNode(stopCh chan struct{}) {
 			"",
 			false,
 		},
+		{
+			[]string{"storage", "mount", "keys"},
+			[]metrics.Label{{"gauge", "storage_keys_by_mount"}},
+			c.storageUsageKeysGaugeCollector,
+			"VAULT_DISABLE_STORAGE_USAGE_GAUGE",
+			false,
+		},
+		{
+			[]string{"storage", "mount", "bytes"},
+			[]metrics.Label{{"gauge", "storage_bytes_by_mount"}},
+			c.storageUsageBytesGaugeCollector,
+			"VAULT_DISABLE_STORAGE_USAGE_GAUGE",
+			false,
+		},
 		{
 			[]string{"policy", "configured", "count"},
 			[]metrics.Label{{"gauge", "number_policies_by_type"}},
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/vault/helper/activationflags"
 	"github.com/hashicorp/vault/helper/namespace"
 	"github.com/hashicorp/vault/limits"
+	"github.com/hashicorp/vault/physical/raft"
 	"github.com/hashicorp/vault/sdk/helper/license"
 	"github.com/hashicorp/vault/sdk/logical"
 	"github.com/hashicorp/vault/sdk/physical"
@@ -42,7 +43,10 @@ type LicensingConfig struct {
 }
 
 func coreInit(c *Core, conf *CoreConfig) error {
-	phys := conf.Physical
+	storageMirrorLogger := conf.Logger.Named("storage.mirror")
+	c.allLoggers = append(c.allLoggers, storageMirrorLogger)
+	var phys physical.Backend
+	c.storageMirror, phys = newStorageMirror(conf.Physical, storageMirrorLogger)
 	_, txnOK := phys.(physical.Transactional)
 	sealUnwrapperLogger := conf.Logger.Named("storage.sealunwrapper")
 	c.allLoggers = append(c.allLoggers, sealUnwrapperLogger)
@@ -62,6 +66,12 @@ func coreInit(c *Core, conf *CoreConfig) error {
 		c.physical = physical.NewStorageEncoding(c.physical)
 	}
 
+	// Account for the storage used by each mount above the cache, so that
+	// reading the size of the entry being replaced is usually served by it
+	if conf.StorageUsageAccounting {
+		c.storageUsage, c.physical = newStorageUsageTracker(c.physical)
+	}
+
 	c.FeatureActivationFlags = activationflags.NewFeatureActivationFlags()
 
 	return nil
@@ -232,8 +242,13 @@ func (c *Core) GetRequestLimiter(key string) *limits.RequestLimiter {
 // ReloadRequestLimiter is a no-op on CE.
 func (c *Core) ReloadRequestLimiter() {}
 
-// createSnapshotManager is a no-op on CE.
-func (c *Core) createSnapshotManager() {}
+// createSnapshotManager creates the manager of loaded snapshots when raft
+// storage is in use.
+func (c *Core) createSnapshotManager() {
+	if _, ok := c.underlyingPhysical.(*raft.RaftBackend); ok {
+		c.snapshotManager = newSnapshotManager(c)
+	}
+}
 
 func (c *Core) GetConfigurableRNG(source string, defaultSource io.Reader) (io.Reader, error) {
 	var rng io.Reader
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package rafttests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/helper/testhelpers"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRaft_Compact verifies that a rolling compaction compacts every node,
+// with the active node stepping down to be compacted last, and that the data
+// is intact afterwards.
+func TestRaft_Compact(t *testing.T) {
+	t.Parallel()
+	cluster, _ := raftCluster(t, &RaftClusterOpts{
+		NumCores:        3,
+		InmemCluster:    true,
+		EnableAutopilot: true,
+	})
+	testhelpers.WaitForActiveNodeAndStandbys(t, cluster)
+	leaderCore := testhelpers.DeriveActiveCore(t, cluster)
+	client := leaderCore.Client
+
+	require.NoError(t, client.Sys().Mount("secret", &api.MountInput{Type: "kv"}))
+	for i := 0; i < 100; i++ {
+		_, err := client.Logical().Write(fmt.Sprintf("secret/%d", i), map[string]interface{}{"value": i})
+		require.NoError(t, err)
+	}
+	for i := 0; i < 90; i++ {
+		_, err := client.Logical().Delete(fmt.Sprintf("secret/%d", i))
+		require.NoError(t, err)
+	}
+
+	// Followers must be known to autopilot to be compacted
+	require.Eventually(t, func() bool {
+		state, err := client.Sys().RaftAutopilotState()
+		return err == nil && state != nil && state.Healthy && len(state.Servers) == 3
+	}, 60*time.Second, 500*time.Millisecond)
+
+	_, err := client.Sys().RaftCompact(&api.RaftCompactRequest{NodeIDs: []string{"unknown"}})
+	require.Error(t, err)
+
+	status, err := client.Sys().RaftCompact(nil)
+	require.NoError(t, err)
+	require.Equal(t, "running", status.State)
+	require.Len(t, status.Nodes, 3)
+	require.Equal(t, leaderCore.NodeID, status.Nodes[2].NodeID)
+
+	require.Eventually(t, func() bool {
+		status, err = client.Sys().RaftCompactionStatus()
+		return err == nil && status != nil && status.State != "running"
+	}, 2*time.Minute, time.Second)
+	require.Equal(t, "completed", status.State, status.Error)
+	require.Equal(t, 3, status.NodesCompleted)
+	for _, node := range status.Nodes {
+		require.Equal(t, "completed", node.State, node.NodeID)
+	}
+
+	// The original active node stepped down to be compacted
+	testhelpers.WaitForActiveNode(t, cluster)
+	require.NotEqual(t, leaderCore.NodeID, testhelpers.DeriveActiveCore(t, cluster).NodeID)
+
+	secret, err := client.Logical().Read("secret/99")
+	require.NoError(t, err)
+	require.NotNil(t, secret)
+	secret, err = client.Logical().Read("secret/0")
+	require.NoError(t, err)
+	require.Nil(t, secret)
+}
+
+// TestRaft_Compact_OldVersion verifies that followers aren't asked to compact
+// while any node runs a version that can't apply the request.
+func TestRaft_Compact_OldVersion(t *testing.T) {
+	t.Parallel()
+	cluster, _ := raftCluster(t, &RaftClusterOpts{
+		NumCores:        3,
+		InmemCluster:    true,
+		EnableAutopilot: true,
+		EffectiveSDKVersionMap: map[int]string{
+			0: "2.2.0",
+			1: "2.2.0",
+			2: "2.1.0",
+		},
+	})
+	testhelpers.WaitForActiveNodeAndStandbys(t, cluster)
+	client := testhelpers.DeriveActiveCore(t, cluster).Client
+
+	require.Eventually(t, func() bool {
+		state, err := client.Sys().RaftAutopilotState()
+		return err == nil && state != nil && state.Healthy && len(state.Servers) == 3
+	}, 60*time.Second, 500*time.Millisecond)
+
+	_, err := client.Sys().RaftCompact(nil)
+	require.ErrorContains(t, err, "must be running at least version 2.2.0")
+
+	status, err := client.Sys().RaftCompactionStatus()
+	require.NoError(t, err)
+	require.Nil(t, status)
+}
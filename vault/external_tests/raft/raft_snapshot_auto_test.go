@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package rafttests
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestRaft_SnapshotAuto_Local verifies that automated snapshots are taken at
+// the configured interval, written to a local directory, and pruned to the
+// retention count.
+func TestRaft_SnapshotAuto_Local(t *testing.T) {
+	t.Parallel()
+	cluster, _ := raftCluster(t, &RaftClusterOpts{NumCores: 1, InmemCluster: true})
+	client := cluster.Cores[0].Client
+
+	dir := t.TempDir()
+	_, err := client.Logical().Write("sys/storage/raft/snapshot-auto/config/hourly", map[string]interface{}{
+		"interval":     "1s",
+		"retain":       2,
+		"storage_type": "local",
+		"path_prefix":  dir,
+		"file_prefix":  "test",
+	})
+	require.NoError(t, err)
+
+	secret, err := client.Logical().List("sys/storage/raft/snapshot-auto/config")
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"hourly"}, secret.Data["keys"])
+
+	var firstURL string
+	require.Eventually(t, func() bool {
+		secret, err := client.Logical().Read("sys/storage/raft/snapshot-auto/status/hourly")
+		if err != nil || secret == nil {
+			return false
+		}
+		firstURL, _ = secret.Data["last_snapshot_url"].(string)
+		return firstURL != ""
+	}, 30*time.Second, 100*time.Millisecond)
+
+	// Wait for at least two more snapshots, so the first one is pruned
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(firstURL)
+		return os.IsNotExist(err)
+	}, 30*time.Second, 100*time.Millisecond)
+
+	matches, err := filepath.Glob(filepath.Join(dir, "test-*.snap"))
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+
+	secret, err = client.Logical().Read("sys/storage/raft/snapshot-auto/status/hourly")
+	require.NoError(t, err)
+	require.Equal(t, "", secret.Data["last_snapshot_error"])
+	require.NotEmpty(t, secret.Data["next_snapshot_start"])
+
+	// The snapshots can be restored
+	f, err := os.Open(matches[len(matches)-1])
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, client.Sys().RaftSnapshotRestore(f, false))
+
+	_, err = client.Logical().Delete("sys/storage/raft/snapshot-auto/config/hourly")
+	require.NoError(t, err)
+	secret, err = client.Logical().Read("sys/storage/raft/snapshot-auto/config/hourly")
+	require.NoError(t, err)
+	require.Nil(t, secret)
+}
+
+// TestRaft_SnapshotAuto_Config verifies that invalid automated snapshot
+// configurations are rejected, and that credentials aren't returned.
+func TestRaft_SnapshotAuto_Config(t *testing.T) {
+	t.Parallel()
+	cluster, _ := raftCluster(t, &RaftClusterOpts{NumCores: 1, InmemCluster: true})
+	client := cluster.Cores[0].Client
+
+	for name, data := range map[string]map[string]interface{}{
+		"no interval":      {"storage_type": "local", "path_prefix": t.TempDir()},
+		"no storage type":  {"interval": "1h"},
+		"no path":          {"interval": "1h", "storage_type": "local"},
+		"no bucket":        {"interval": "1h", "storage_type": "aws-s3"},
+		"bad compression":  {"interval": "1h", "storage_type": "local", "path_prefix": t.TempDir(), "compression": "zstd"},
+		"bad retain":       {"interval": "1h", "storage_type": "local", "path_prefix": t.TempDir(), "retain": 0},
+		"bad file prefix":  {"interval": "1h", "storage_type": "local", "path_prefix": t.TempDir(), "file_prefix": "../up"},
+		"bad storage type": {"interval": "1h", "storage_type": "gcs"},
+	} {
+		_, err := client.Logical().Write("sys/storage/raft/snapshot-auto/config/bad", data)
+		require.Error(t, err, name)
+	}
+
+	_, err := client.Logical().Write("sys/storage/raft/snapshot-auto/config/s3", map[string]interface{}{
+		"interval":              "24h",
+		"storage_type":          "aws-s3",
+		"path_prefix":           "vault/",
+		"aws_s3_bucket":         "snapshots",
+		"aws_s3_endpoint":       "http://127.0.0.1:1",
+		"aws_access_key_id":     "access",
+		"aws_secret_access_key": "secret",
+	})
+	require.NoError(t, err)
+
+	// Updates only change the given fields
+	_, err = client.Logical().Write("sys/storage/raft/snapshot-auto/config/s3", map[string]interface{}{
+		"retain": 5,
+	})
+	require.NoError(t, err)
+
+	secret, err := client.Logical().Read("sys/storage/raft/snapshot-auto/config/s3")
+	require.NoError(t, err)
+	require.Equal(t, "aws-s3", secret.Data["storage_type"])
+	require.Equal(t, "snapshots", secret.Data["aws_s3_bucket"])
+	require.Equal(t, "access", secret.Data["aws_access_key_id"])
+	require.NotContains(t, secret.Data, "aws_secret_access_key")
+	require.Equal(t, json.Number("86400"), secret.Data["interval"])
+	require.Equal(t, json.Number("5"), secret.Data["retain"])
+	require.Equal(t, "gzip", secret.Data["compression"])
+}
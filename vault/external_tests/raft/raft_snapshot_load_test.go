@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package rafttests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRaft_SnapshotLoad_Recover verifies that data deleted since a snapshot
+// was taken can be read from the loaded snapshot and selectively recovered,
+// without restoring the whole snapshot.
+func TestRaft_SnapshotLoad_Recover(t *testing.T) {
+	t.Parallel()
+	cluster, _ := raftCluster(t, &RaftClusterOpts{NumCores: 1, InmemCluster: true})
+	client := cluster.Cores[0].Client
+	ctx := context.Background()
+
+	require.NoError(t, client.Sys().EnableAuthWithOptions("userpass", &api.EnableAuthOptions{Type: "userpass"}))
+	_, err := client.Logical().Write("auth/userpass/users/alice", map[string]interface{}{
+		"password": "secret",
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Sys().PutPolicy("foo", `path "secret/*" { capabilities = ["read"] }`))
+
+	secret, err := client.Logical().Write("identity/entity", map[string]interface{}{
+		"name":     "bob",
+		"policies": []string{"foo"},
+	})
+	require.NoError(t, err)
+	entityID := secret.Data["id"].(string)
+	secret, err = client.Logical().Write("identity/group", map[string]interface{}{
+		"name":              "team",
+		"member_entity_ids": []string{entityID},
+	})
+	require.NoError(t, err)
+	groupID := secret.Data["id"].(string)
+
+	var snap bytes.Buffer
+	require.NoError(t, client.Sys().RaftSnapshot(&snap))
+
+	// Delete everything that was snapshotted
+	_, err = client.Logical().Delete("auth/userpass/users/alice")
+	require.NoError(t, err)
+	require.NoError(t, client.Sys().DeletePolicy("foo"))
+	_, err = client.Logical().Delete("identity/group/id/" + groupID)
+	require.NoError(t, err)
+	_, err = client.Logical().Delete("identity/entity/id/" + entityID)
+	require.NoError(t, err)
+
+	secret, err = client.Sys().RaftLoadLocalSnapshot(bytes.NewReader(snap.Bytes()))
+	require.NoError(t, err)
+	snapshotID := secret.Data["snapshot_id"].(string)
+	require.NotEmpty(t, snapshotID)
+
+	require.Eventually(t, func() bool {
+		secret, err := client.Logical().Read("sys/storage/raft/snapshot-load/" + snapshotID)
+		return err == nil && secret != nil && secret.Data["status"] == "loaded"
+	}, 30*time.Second, 100*time.Millisecond)
+
+	// Only one snapshot can be loaded at a time
+	_, err = client.Sys().RaftLoadLocalSnapshot(bytes.NewReader(snap.Bytes()))
+	require.Error(t, err)
+
+	secret, err = client.Logical().List("sys/storage/raft/snapshot-load")
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{snapshotID}, secret.Data["keys"])
+
+	// Policies are read from the snapshot, without affecting the live data
+	secret, err = client.Logical().ReadWithData("sys/policies/acl/foo", map[string][]string{
+		"read_snapshot_id": {snapshotID},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, secret)
+	require.Contains(t, secret.Data["policy"], "secret/*")
+	policy, err := client.Sys().GetPolicy("foo")
+	require.NoError(t, err)
+	require.Empty(t, policy)
+
+	// Paths which don't support snapshot reads are rejected
+	_, err = client.Logical().ReadWithData("auth/userpass/users/alice", map[string][]string{
+		"read_snapshot_id": {snapshotID},
+	})
+	require.Error(t, err)
+
+	_, err = client.Logical().Recover(ctx, "sys/policies/acl/foo", snapshotID)
+	require.NoError(t, err)
+	policy, err = client.Sys().GetPolicy("foo")
+	require.NoError(t, err)
+	require.Contains(t, policy, "secret/*")
+
+	secret, err = client.Logical().ReadWithData("identity/entity/id/"+entityID, map[string][]string{
+		"read_snapshot_id": {snapshotID},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, secret)
+	require.Equal(t, "bob", secret.Data["name"])
+
+	_, err = client.Logical().Recover(ctx, "identity/entity/id/"+entityID, snapshotID)
+	require.NoError(t, err)
+	_, err = client.Logical().Recover(ctx, "identity/group/id/"+groupID, snapshotID)
+	require.NoError(t, err)
+
+	secret, err = client.Logical().Read("identity/entity/id/" + entityID)
+	require.NoError(t, err)
+	require.NotNil(t, secret)
+	require.Equal(t, "bob", secret.Data["name"])
+	require.Equal(t, []interface{}{groupID}, secret.Data["direct_group_ids"])
+
+	secret, err = client.Logical().Write("sys/storage/raft/snapshot-load/"+snapshotID+"/recover-mount", map[string]interface{}{
+		"mount": "auth/userpass",
+	})
+	require.NoError(t, err)
+	recovered, err := secret.Data["keys_recovered"].(json.Number).Int64()
+	require.NoError(t, err)
+	require.Positive(t, recovered)
+
+	secret, err = client.Logical().Read("auth/userpass/users/alice")
+	require.NoError(t, err)
+	require.NotNil(t, secret)
+	_, err = client.Logical().Write("auth/userpass/login/alice", map[string]interface{}{
+		"password": "secret",
+	})
+	require.NoError(t, err)
+
+	// Singleton mounts can't be recovered wholesale
+	_, err = client.Logical().Write("sys/storage/raft/snapshot-load/"+snapshotID+"/recover-mount", map[string]interface{}{
+		"mount": "identity",
+	})
+	require.Error(t, err)
+
+	_, err = client.Sys().RaftUnloadSnapshot(snapshotID)
+	require.NoError(t, err)
+	secret, err = client.Logical().Read("sys/storage/raft/snapshot-load/" + snapshotID)
+	require.NoError(t, err)
+	require.Nil(t, secret)
+	_, err = client.Logical().ReadWithData("sys/policies/acl/foo", map[string][]string{
+		"read_snapshot_id": {snapshotID},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package rafttests
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/helper/testhelpers"
+	vaulthttp "github.com/hashicorp/vault/http"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRaft_StaleReads_ReadReplica verifies that a standby configured with
+// serve_stale_reads answers kv reads that tolerate some staleness from its own
+// FSM, keeps up with writes and mount changes on the active node, and forwards
+// everything else.
+func TestRaft_StaleReads_ReadReplica(t *testing.T) {
+	t.Parallel()
+
+	cluster, _ := raftCluster(t, &RaftClusterOpts{
+		InmemCluster: true,
+		NumCores:     3,
+		PerNodePhysicalFactoryConfig: map[int]map[string]interface{}{
+			1: {"serve_stale_reads": "true"},
+		},
+	})
+	defer cluster.Cleanup()
+
+	leaderClient := cluster.Cores[0].Client
+	require.NoError(t, leaderClient.Sys().Mount("secret", &api.MountInput{Type: "kv"}))
+	_, err := leaderClient.Logical().Write("secret/foo", map[string]interface{}{"value": "bar"})
+	require.NoError(t, err)
+
+	replica := cluster.Cores[1]
+	waitForReplica := func() {
+		t.Helper()
+		testhelpers.RetryUntil(t, 30*time.Second, func() error {
+			if _, ok := replica.ServeStaleRead(time.Minute); !ok {
+				return errors.New("not serving stale reads yet")
+			}
+			return nil
+		})
+	}
+	waitForReplica()
+	require.False(t, cluster.Cores[2].RaftReadReplica())
+
+	client, err := replica.Client.Clone()
+	require.NoError(t, err)
+	client.SetToken(cluster.RootToken)
+	client.AddHeader(vaulthttp.VaultMaxStalenessHeaderName, "1m")
+
+	// readLocal reads a kv value, and returns an error unless the replica
+	// answered the read itself
+	readLocal := func(path string) (string, error) {
+		resp, err := client.Logical().ReadRaw(path)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		if resp.Header.Get(vaulthttp.VaultStalenessHeaderName) == "" {
+			return "", fmt.Errorf("read of %q was forwarded", path)
+		}
+		secret, err := api.ParseSecret(resp.Body)
+		if err != nil {
+			return "", err
+		}
+		return secret.Data["value"].(string), nil
+	}
+
+	// waitForValue waits for the replica to have applied a kv value, since
+	// writes on the active node may be committed before the replica applies
+	// them
+	waitForValue := func(path, want string) {
+		t.Helper()
+		testhelpers.RetryUntil(t, 30*time.Second, func() error {
+			value, err := readLocal(path)
+			if err != nil {
+				return err
+			}
+			if value != want {
+				return fmt.Errorf("got %q", value)
+			}
+			return nil
+		})
+	}
+	waitForValue("secret/foo", "bar")
+
+	// Writes on the active node are seen by the replica
+	_, err = leaderClient.Logical().Write("secret/foo", map[string]interface{}{"value": "baz"})
+	require.NoError(t, err)
+	waitForValue("secret/foo", "baz")
+
+	// Writes and reads of other mounts are forwarded, even with the header
+	resp, err := client.Logical().WriteRaw("secret/written", []byte(`{"value":"replica"}`))
+	require.NoError(t, err)
+	require.Empty(t, resp.Header.Get(vaulthttp.VaultStalenessHeaderName))
+	resp.Body.Close()
+	secret, err := leaderClient.Logical().Read("secret/written")
+	require.NoError(t, err)
+	require.Equal(t, "replica", secret.Data["value"])
+
+	resp, err = client.Logical().ReadRaw("sys/mounts")
+	require.NoError(t, err)
+	require.Empty(t, resp.Header.Get(vaulthttp.VaultStalenessHeaderName))
+	resp.Body.Close()
+
+	// Mounts enabled on the active node are served once the replica has
+	// reloaded its mount table
+	require.NoError(t, leaderClient.Sys().Mount("other", &api.MountInput{Type: "kv"}))
+	_, err = leaderClient.Logical().Write("other/foo", map[string]interface{}{"value": "qux"})
+	require.NoError(t, err)
+	waitForValue("other/foo", "qux")
+
+	// Reads without the header are forwarded
+	plainClient, err := replica.Client.Clone()
+	require.NoError(t, err)
+	plainClient.SetToken(cluster.RootToken)
+	resp, err = plainClient.Logical().ReadRaw("secret/foo")
+	require.NoError(t, err)
+	require.Empty(t, resp.Header.Get(vaulthttp.VaultStalenessHeaderName))
+	resp.Body.Close()
+
+	// After the active node goes away, the replica either becomes active, in
+	// which case it's torn down, or serves stale reads again once it's caught
+	// up with the new active node
+	testhelpers.EnsureCoreSealed(t, cluster.Cores[0])
+	active := testhelpers.WaitForActiveNode(t, cluster)
+	_, err = active.Client.Logical().Write("secret/foo", map[string]interface{}{"value": "failover"})
+	require.NoError(t, err)
+	if active == replica {
+		require.False(t, replica.RaftReadReplica())
+		return
+	}
+	waitForReplica()
+	waitForValue("secret/foo", "failover")
+}
@@ -317,3 +317,46 @@ func testCore_GenerateRoot_Update_PGP_Common(t *testing.T, c *Core, keys [][]byt
 		t.Fatalf("bad: %#v", *te)
 	}
 }
+
+// TestCore_GenerateRoot_Update_Age checks that the generated root token can
+// be encrypted to an age recipient instead of a PGP key.
+func TestCore_GenerateRoot_Update_Age(t *testing.T) {
+	c, keys, _ := TestCoreUnsealed(t)
+
+	err := c.GenerateRootInit("", pgpkeys.TestAgeRecipient1, GenerateStandardRootTokenStrategy, true)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	rkconf, err := c.GenerateRootConfiguration(true)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if rkconf.PGPFingerprint != pgpkeys.TestAgeRecipient1 {
+		t.Fatalf("bad fingerprint: %s", rkconf.PGPFingerprint)
+	}
+
+	var result *GenerateRootResult
+	for _, key := range keys {
+		result, err = c.GenerateRootUpdate(namespace.RootContext(nil), key, rkconf.Nonce, GenerateStandardRootTokenStrategy, true)
+		if err != nil {
+			t.Fatalf("err: %v", err)
+		}
+		if result.EncodedToken != "" {
+			break
+		}
+	}
+
+	ptBuf, err := pgpkeys.DecryptAgeBytes(result.EncodedToken, pgpkeys.TestAgeIdentity1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	te, err := c.tokenStore.Lookup(namespace.RootContext(nil), ptBuf.String())
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if te == nil || len(te.Policies) != 1 || te.Policies[0] != "root" {
+		t.Fatalf("bad: %#v", te)
+	}
+}
@@ -492,6 +492,18 @@ func (c *Core) runStandby(doneCh, manualStepDownCh, stopCh chan struct{}) {
 			c.logger.Debug("shutting down periodic metrics")
 		})
 	}
+	if c.serveStaleReads() {
+		// Serve stale reads from the raft FSM
+		readReplicaStop := make(chan struct{})
+
+		g.Add(func() error {
+			c.runReadReplica(readReplicaStop, stopCh)
+			return nil
+		}, func(error) {
+			close(readReplicaStop)
+			c.logger.Debug("shutting down read replica")
+		})
+	}
 	{
 		// Wait for leadership
 		leaderStopCh := make(chan struct{})
@@ -535,20 +547,30 @@ func (c *Core) waitForLeadership(newLeaderCh chan func(), manualStepDownCh, stop
 		}
 		firstIteration = false
 
+		// Allow the read replica to be set up while we're a standby
+		c.resumeReadReplica()
+
 		// Create a lock
 		uuid, err := uuid.GenerateUUID()
 		if err != nil {
 			c.logger.Error("failed to generate uuid", "error", err)
 			continue
 		}
-		lock, err := c.ha.LockWith(CoreLockPath, uuid)
-		if err != nil {
-			c.logger.Error("failed to create lock", "error", err)
-			continue
-		}
+		var lock physical.Lock
+		var leaderLostCh <-chan struct{}
+		if held := c.storageSwitchLock.Swap(nil); held != nil {
+			// Use the lock taken when switching storage
+			uuid, lock, leaderLostCh = held.uuid, held.lock, held.leaderLostCh
+		} else {
+			lock, err = c.ha.LockWith(CoreLockPath, uuid)
+			if err != nil {
+				c.logger.Error("failed to create lock", "error", err)
+				continue
+			}
 
-		// Attempt the acquisition
-		leaderLostCh := c.acquireLock(lock, stopCh)
+			// Attempt the acquisition
+			leaderLostCh = c.acquireLock(lock, stopCh)
+		}
 
 		// Bail if we are being shutdown
 		if leaderLostCh == nil {
@@ -583,6 +605,7 @@ func (c *Core) waitForLeadership(newLeaderCh chan func(), manualStepDownCh, stop
 		activeTime := time.Now()
 
 		continueCh := interruptPerfStandby(newLeaderCh, stopCh)
+		c.pauseReadReplica(stopCh)
 
 		// Grab the statelock or stop
 		l := newLockGrabber(c.stateLock.Lock, c.stateLock.Unlock, stopCh)
@@ -70,6 +70,7 @@ func (i *IdentityStore) resetDB() error {
 
 func (i *IdentityStore) Cleanup(context.Context) {
 	i.oidcAuthCodeCache.c.Stop()
+	i.oidcDeviceCodeCache.c.Stop()
 }
 
 func NewIdentityStore(ctx context.Context, core *Core, config *logical.BackendConfig, logger log.Logger) (*IdentityStore, error) {
@@ -93,6 +94,7 @@ func NewIdentityStore(ctx context.Context, core *Core, config *logical.BackendCo
 		activationManager:               core.FeatureActivationFlags,
 		activationErrorHandler:          core,
 	}
+	iStore.backchannelLogoutCtx, iStore.backchannelLogoutCancel = context.WithCancel(context.Background())
 
 	// Create a memdb instance, which by default, operates on lower cased
 	// identity names
@@ -144,6 +146,8 @@ func NewIdentityStore(ctx context.Context, core *Core, config *logical.BackendCo
 		"oidc/+/.well-known/*",
 		"oidc/provider/+/.well-known/*",
 		"oidc/provider/+/token",
+		"oidc/provider/+/device",
+		"oidc/provider/+/revoke",
 	}
 	unauthenticatedPaths = append(unauthenticatedPaths, identityStoreLoginMFAEntUnauthedPaths()...)
 	unauthenticatedPaths = append(unauthenticatedPaths, identityStoreSCIMUnauthedPaths()...)
@@ -151,6 +155,7 @@ func NewIdentityStore(ctx context.Context, core *Core, config *logical.BackendCo
 		BackendType:    logical.TypeLogical,
 		Paths:          iStore.paths(),
 		Invalidate:     iStore.Invalidate,
+		Clean:          iStore.cleanup,
 		InitializeFunc: iStore.initialize,
 		ActivationFunc: iStore.activate,
 		PathsSpecial: &logical.Paths{
@@ -163,6 +168,10 @@ func NewIdentityStore(ctx context.Context, core *Core, config *logical.BackendCo
 			LocalStorage: []string{
 				localAliasesBucketsPrefix,
 			},
+			AllowSnapshotRead: []string{
+				"entity/id/+",
+				"group/id/+",
+			},
 		},
 		PeriodicFunc: func(ctx context.Context, req *logical.Request) error {
 			iStore.oidcPeriodicFunc(ctx, req.Storage)
@@ -174,6 +183,7 @@ func NewIdentityStore(ctx context.Context, core *Core, config *logical.BackendCo
 
 	iStore.oidcCache = newOIDCCache(ctx, ttlcache.NoTTL, core.synctest)
 	iStore.oidcAuthCodeCache = newOIDCCache(ctx, 5*time.Minute, core.synctest)
+	iStore.oidcDeviceCodeCache = newOIDCCache(ctx, deviceCodeTTL, core.synctest)
 
 	err = iStore.Setup(ctx, config)
 	if err != nil {
@@ -701,6 +711,13 @@ func (i *IdentityStore) initialize(ctx context.Context, req *logical.Initializat
 	return nil
 }
 
+// cleanup cancels in-flight back-channel logout requests and waits for them
+// to return, so that they don't outlive the backend.
+func (i *IdentityStore) cleanup(_ context.Context) {
+	i.backchannelLogoutCancel()
+	i.backchannelLogoutWG.Wait()
+}
+
 // Invalidate is a callback wherein the backend is informed that the value at
 // the given key is updated. In identity store's case, it would be the entity
 // storage entries that get updated. The value needs to be read and MemDB needs
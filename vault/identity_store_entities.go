@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	hclog "github.com/hashicorp/go-hclog"
@@ -152,11 +153,66 @@ func entityPaths(i *IdentityStore) []*framework.Path {
 						OperationVerb: "delete",
 					},
 				},
+				logical.RecoverOperation: &framework.PathOperation{
+					Callback: i.pathEntityIDRecover(),
+				},
 			},
 
 			HelpSynopsis:    strings.TrimSpace(entityHelp["entity-id"][0]),
 			HelpDescription: strings.TrimSpace(entityHelp["entity-id"][1]),
 		},
+		{
+			Pattern: "entity/id/" + framework.GenericNameRegex("id") + "/tokens/?$",
+
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "entity",
+				OperationSuffix: "tokens-by-id",
+			},
+
+			Fields: map[string]*framework.FieldSchema{
+				"id": {
+					Type:        framework.TypeString,
+					Description: "ID of the entity.",
+				},
+			},
+
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ListOperation: &framework.PathOperation{
+					Callback: i.pathEntityIDTokensList(),
+					DisplayAttrs: &framework.DisplayAttributes{
+						OperationVerb: "list",
+					},
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(entityHelp["entity-id-tokens"][0]),
+			HelpDescription: strings.TrimSpace(entityHelp["entity-id-tokens"][1]),
+		},
+		{
+			Pattern: "entity/id/" + framework.GenericNameRegex("id") + "/revoke-tokens$",
+
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "entity",
+				OperationVerb:   "revoke-tokens",
+				OperationSuffix: "by-id",
+			},
+
+			Fields: map[string]*framework.FieldSchema{
+				"id": {
+					Type:        framework.TypeString,
+					Description: "ID of the entity.",
+				},
+			},
+
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: i.pathEntityIDRevokeTokens(),
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(entityHelp["entity-id-revoke-tokens"][0]),
+			HelpDescription: strings.TrimSpace(entityHelp["entity-id-revoke-tokens"][1]),
+		},
 		{
 			Pattern: "entity/batch-delete",
 
@@ -399,6 +455,22 @@ func (i *IdentityStore) pathEntityIDRead() framework.OperationFunc {
 			return logical.ErrorResponse("missing entity id"), nil
 		}
 
+		if snapshotID, _ := logical.ContextSnapshotIDValue(ctx); snapshotID != "" {
+			entity, err := i.snapshotEntityByID(ctx, entityID)
+			if err != nil || entity == nil {
+				return nil, err
+			}
+			resp, err := i.handleEntityReadCommon(ctx, entity)
+			if err != nil || resp == nil {
+				return resp, err
+			}
+			// Group memberships are only tracked in memory for the live data
+			delete(resp.Data, "direct_group_ids")
+			delete(resp.Data, "inherited_group_ids")
+			delete(resp.Data, "group_ids")
+			return resp, nil
+		}
+
 		entity, err := i.MemDBEntityByID(entityID, false)
 		if err != nil {
 			return nil, err
@@ -495,6 +567,92 @@ func (i *IdentityStore) handleEntityReadCommon(ctx context.Context, entity *iden
 	}, nil
 }
 
+// pathEntityIDTokensList lists the accessors of the tokens issued to the
+// entity with the given ID, along with some information about each token
+func (i *IdentityStore) pathEntityIDTokensList() framework.OperationFunc {
+	return func(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+		entity, err := i.entityByIDInNamespace(ctx, d.Get("id").(string))
+		if err != nil {
+			return nil, err
+		}
+		if entity == nil {
+			return nil, nil
+		}
+
+		tokens, err := i.tokenStorer.EntityTokens(ctx, entity)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make([]string, 0, len(tokens))
+		keyInfo := make(map[string]interface{}, len(tokens))
+		for _, te := range tokens {
+			keys = append(keys, te.Accessor)
+			keyInfo[te.Accessor] = map[string]interface{}{
+				"creation_time": time.Unix(te.CreationTime, 0).UTC().Format(time.RFC3339),
+				"display_name":  te.DisplayName,
+				"entity_id":     te.EntityID,
+				"mount_path":    i.router.MatchingMount(ctx, te.Path),
+				"policies":      te.Policies,
+				"type":          te.Type.String(),
+			}
+		}
+
+		return logical.ListResponseWithInfo(keys, keyInfo), nil
+	}
+}
+
+// pathEntityIDRevokeTokens revokes all the tokens issued to the entity with
+// the given ID
+func (i *IdentityStore) pathEntityIDRevokeTokens() framework.OperationFunc {
+	return func(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+		entity, err := i.entityByIDInNamespace(ctx, d.Get("id").(string))
+		if err != nil {
+			return nil, err
+		}
+		if entity == nil {
+			return logical.ErrorResponse("entity not found"), logical.ErrInvalidRequest
+		}
+
+		accessors, err := i.tokenStorer.RevokeEntityTokens(ctx, entity)
+		if err != nil {
+			return nil, err
+		}
+		if accessors == nil {
+			accessors = []string{}
+		}
+
+		return &logical.Response{
+			Data: map[string]interface{}{
+				"revoked_accessors": accessors,
+			},
+		}, nil
+	}
+}
+
+// entityByIDInNamespace returns the entity with the given ID if it belongs
+// to the namespace of the request
+func (i *IdentityStore) entityByIDInNamespace(ctx context.Context, entityID string) (*identity.Entity, error) {
+	if entityID == "" {
+		return nil, errors.New("missing entity id")
+	}
+
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := i.MemDBEntityByID(entityID, false)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil || entity.NamespaceID != ns.ID {
+		return nil, nil
+	}
+
+	return entity, nil
+}
+
 // pathEntityIDDelete deletes the entity for a given entity ID
 func (i *IdentityStore) pathEntityIDDelete() framework.OperationFunc {
 	return func(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
@@ -1520,6 +1678,21 @@ var entityHelp = map[string][2]string{
 		"Update, read or delete an entity using entity ID",
 		"",
 	},
+	"entity-id-tokens": {
+		"List the tokens issued to an entity using entity ID",
+		`Lists the accessors of the tokens issued to the entity, including tokens
+issued to entities that were merged into it. Batch tokens are not listed as
+they are not persisted. Tokens are found through an index by entity; tokens
+created before upgrading to a version with the index are added to it in the
+background after the active node unseals, and every token is scanned until
+that has finished.`,
+	},
+	"entity-id-revoke-tokens": {
+		"Revoke the tokens issued to an entity using entity ID",
+		`Revokes the tokens issued to the entity, including tokens issued to
+entities that were merged into it, along with their child tokens and leases.
+The accessors of the revoked tokens are returned.`,
+	},
 	"entity-name": {
 		"Update, read or delete an entity using entity name",
 		"",
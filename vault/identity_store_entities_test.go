@@ -485,6 +485,96 @@ func TestIdentityStore_EntityByName_DoesNotAllowExternalIDRetarget(t *testing.T)
 	}
 }
 
+// TestIdentityStore_EntityTokens verifies that the tokens issued to an entity
+// can be listed and revoked without affecting the tokens of other entities.
+func TestIdentityStore_EntityTokens(t *testing.T) {
+	ctx := namespace.RootContext(nil)
+	is, _, c := testIdentityStoreWithGithubAuth(ctx, t)
+	ts := c.tokenStore
+
+	entityIDs := make([]string, 2)
+	for idx := range entityIDs {
+		resp, err := is.HandleRequest(ctx, &logical.Request{
+			Path:      "entity",
+			Operation: logical.UpdateOperation,
+			Data: map[string]interface{}{
+				"name": fmt.Sprintf("entity-%d", idx),
+			},
+		})
+		if err != nil || (resp != nil && resp.IsError()) {
+			t.Fatalf("bad: resp: %#v\nerr: %v", resp, err)
+		}
+		entityIDs[idx] = resp.Data["id"].(string)
+	}
+
+	first := &logical.TokenEntry{
+		Path:     "auth/github/login",
+		Policies: []string{"default", "foo"},
+		EntityID: entityIDs[0],
+		TTL:      time.Hour,
+	}
+	testMakeTokenDirectly(t, ts, first)
+	second := &logical.TokenEntry{
+		Path:     "auth/token/create",
+		Policies: []string{"default"},
+		EntityID: entityIDs[0],
+		TTL:      time.Hour,
+	}
+	testMakeTokenDirectly(t, ts, second)
+	other := &logical.TokenEntry{
+		Path:     "auth/github/login",
+		Policies: []string{"default"},
+		EntityID: entityIDs[1],
+		TTL:      time.Hour,
+	}
+	testMakeTokenDirectly(t, ts, other)
+
+	resp, err := is.HandleRequest(ctx, &logical.Request{
+		Path:      "entity/id/" + entityIDs[0] + "/tokens",
+		Operation: logical.ListOperation,
+	})
+	require.NoError(t, err)
+	require.False(t, resp.IsError())
+	require.ElementsMatch(t, []string{first.Accessor, second.Accessor}, resp.Data["keys"])
+
+	keyInfo := resp.Data["key_info"].(map[string]interface{})
+	firstInfo := keyInfo[first.Accessor].(map[string]interface{})
+	require.Equal(t, "auth/github/", firstInfo["mount_path"])
+	require.Equal(t, []string{"default", "foo"}, firstInfo["policies"])
+	require.Equal(t, entityIDs[0], firstInfo["entity_id"])
+	require.Equal(t, "service", firstInfo["type"])
+	require.Equal(t, time.Unix(first.CreationTime, 0).UTC().Format(time.RFC3339), firstInfo["creation_time"])
+
+	resp, err = is.HandleRequest(ctx, &logical.Request{
+		Path:      "entity/id/" + entityIDs[0] + "/revoke-tokens",
+		Operation: logical.UpdateOperation,
+	})
+	require.NoError(t, err)
+	require.False(t, resp.IsError())
+	require.ElementsMatch(t, []string{first.Accessor, second.Accessor}, resp.Data["revoked_accessors"])
+
+	for _, te := range []*logical.TokenEntry{first, second} {
+		out, err := ts.Lookup(ctx, te.ID)
+		require.NoError(t, err)
+		require.Nil(t, out)
+	}
+	out, err := ts.Lookup(ctx, other.ID)
+	require.NoError(t, err)
+	require.NotNil(t, out)
+
+	// Revoking the tokens clears their entity index entries
+	keys, err := ts.entityView(namespace.RootNamespace).List(ctx, entityIDs[0]+"/")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	resp, err = is.HandleRequest(ctx, &logical.Request{
+		Path:      "entity/id/" + entityIDs[0] + "/tokens",
+		Operation: logical.ListOperation,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Data["keys"])
+}
+
 func TestIdentityStore_EntityReadGroupIDs(t *testing.T) {
 	var err error
 	var resp *logical.Response
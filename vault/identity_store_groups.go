@@ -118,6 +118,11 @@ func groupPaths(i *IdentityStore) []*framework.Path {
 					ForwardPerformanceStandby:   true,
 					ForwardPerformanceSecondary: true,
 				},
+				logical.RecoverOperation: &framework.PathOperation{
+					Callback:                    i.pathGroupIDRecover(),
+					ForwardPerformanceStandby:   true,
+					ForwardPerformanceSecondary: true,
+				},
 			},
 
 			HelpSynopsis:    strings.TrimSpace(groupHelp["group-by-id"][0]),
@@ -413,6 +418,20 @@ func (i *IdentityStore) pathGroupIDRead() framework.OperationFunc {
 			return logical.ErrorResponse("empty group id"), nil
 		}
 
+		if snapshotID, _ := logical.ContextSnapshotIDValue(ctx); snapshotID != "" {
+			group, err := i.snapshotGroupByID(ctx, groupID)
+			if err != nil || group == nil {
+				return nil, err
+			}
+			resp, err := i.handleGroupReadCommon(ctx, group)
+			if err != nil || resp == nil {
+				return resp, err
+			}
+			// Member groups are only tracked in memory for the live data
+			delete(resp.Data, "member_group_ids")
+			return resp, nil
+		}
+
 		group, err := i.MemDBGroupByID(groupID, false)
 		if err != nil {
 			return nil, err
@@ -105,6 +105,7 @@ type idToken struct {
 	AuthTime        int64  `json:"auth_time"` // AuthTime given in OIDC authentication requests
 	AccessTokenHash string `json:"at_hash"`   // Access token hash value
 	CodeHash        string `json:"c_hash"`    // Authorization code hash value
+	SessionID       string `json:"sid"`       // Provider session ID
 }
 
 // discovery contains a subset of the required elements of OIDC discovery needed
@@ -130,7 +131,7 @@ var (
 	reservedClaims = []string{
 		"iat", "aud", "exp", "iss",
 		"sub", "namespace", "nonce",
-		"auth_time", "at_hash", "c_hash",
+		"auth_time", "at_hash", "c_hash", "sid",
 	}
 	supportedAlgs = []string{
 		string(jose.RS256),
@@ -1182,6 +1183,9 @@ func (tok *idToken) generatePayload(logger hclog.Logger, templates ...string) ([
 	if len(tok.CodeHash) > 0 {
 		output["c_hash"] = tok.CodeHash
 	}
+	if len(tok.SessionID) > 0 {
+		output["sid"] = tok.SessionID
+	}
 
 	// Merge each of the populated JSON templates into output
 	err := mergeJSONTemplates(logger, output, templates...)
@@ -2200,6 +2204,14 @@ func (i *IdentityStore) oidcPeriodicFunc(ctx context.Context, s logical.Storage)
 		i.Logger().Warn("error expiring OIDC public keys", "err", err)
 	}
 
+	if err := i.expireOIDCRefreshTokens(ctx, s); err != nil {
+		i.Logger().Warn("error expiring OIDC refresh tokens", "err", err)
+	}
+
+	if err := i.expireOIDCSessions(ctx, s); err != nil {
+		i.Logger().Warn("error expiring OIDC provider sessions", "err", err)
+	}
+
 	if err := i.oidcCache.Flush(ns); err != nil {
 		i.Logger().Error("error flushing oidc cache", "err", err)
 	}
@@ -33,6 +33,7 @@ const (
 	scopesDelimiter          = " "
 	accessTokenScopesMeta    = "scopes"
 	accessTokenClientIDMeta  = "client_id"
+	accessTokenSessionIDMeta = "session_id"
 	clientIDLength           = 32
 	clientSecretLength       = 64
 	clientSecretPrefix       = "hvo_secret_"
@@ -42,6 +43,18 @@ const (
 	defaultKeyName           = "default"
 	allowAllAssignmentName   = "allow_all"
 
+	// Grant types supported by the token endpoint
+	grantTypeAuthorizationCode = "authorization_code"
+	grantTypeClientCredentials = "client_credentials"
+	grantTypeRefreshToken      = "refresh_token"
+	grantTypeDeviceCode        = "urn:ietf:params:oauth:grant-type:device_code"
+	grantTypeTokenExchange     = "urn:ietf:params:oauth:grant-type:token-exchange"
+
+	// Token type identifiers used in the token exchange grant. See details at
+	// https://datatracker.ietf.org/doc/html/rfc8693#section-3.
+	tokenTypeAccessToken = "urn:ietf:params:oauth:token-type:access_token"
+	tokenTypeJWT         = "urn:ietf:params:oauth:token-type:jwt"
+
 	// Storage path constants
 	oidcProviderPrefix = "oidc_provider/"
 	assignmentPath     = oidcProviderPrefix + "assignment/"
@@ -49,6 +62,12 @@ const (
 	clientPath         = oidcProviderPrefix + "client/"
 	providerPath       = oidcProviderPrefix + "provider/"
 
+	refreshTokenPath       = oidcProviderPrefix + "refresh_token/"
+	refreshTokenFamilyPath = oidcProviderPrefix + "refresh_token_family/"
+	revokedAccessTokenPath = oidcProviderPrefix + "revoked_access_token/"
+	sessionPath            = oidcProviderPrefix + "session/"
+	sessionTokenPath       = oidcProviderPrefix + "session_token/"
+
 	// Error constants used in the Authorization Endpoint. See details at
 	// https://openid.net/specs/openid-connect-core-1_0.html#AuthError.
 	ErrAuthUnsupportedResponseType = "unsupported_response_type"
@@ -65,8 +84,18 @@ const (
 	ErrTokenInvalidClient        = "invalid_client"
 	ErrTokenInvalidGrant         = "invalid_grant"
 	ErrTokenUnsupportedGrantType = "unsupported_grant_type"
+	ErrTokenUnauthorizedClient   = "unauthorized_client"
+	ErrTokenInvalidScope         = "invalid_scope"
+	ErrTokenInvalidTarget        = "invalid_target"
 	ErrTokenServerError          = "server_error"
 
+	// Error constants used in the Device Access Token Response. See details at
+	// https://datatracker.ietf.org/doc/html/rfc8628#section-3.5
+	ErrTokenAuthorizationPending = "authorization_pending"
+	ErrTokenSlowDown             = "slow_down"
+	ErrTokenAccessDenied         = "access_denied"
+	ErrTokenExpiredToken         = "expired_token"
+
 	// Error constants used in the UserInfo Endpoint. See details at
 	// https://openid.net/specs/openid-connect-core-1_0.html#UserInfoError
 	ErrUserInfoServerError    = "server_error"
@@ -105,11 +134,45 @@ type client struct {
 	AccessTokenTTL time.Duration `json:"access_token_ttl"`
 	Type           clientType    `json:"type"`
 
+	GrantTypes         []string      `json:"grant_types"`
+	RefreshTokenTTL    time.Duration `json:"refresh_token_ttl"`
+	RefreshTokenMaxTTL time.Duration `json:"refresh_token_max_ttl"`
+	AllowedAudiences   []string      `json:"allowed_audiences"`
+
+	PostLogoutRedirectURIs []string `json:"post_logout_redirect_uris"`
+	BackchannelLogoutURI   string   `json:"backchannel_logout_uri"`
+
 	// Generated values that are used in OIDC endpoints
 	ClientID     string `json:"client_id"`
 	ClientSecret string `json:"client_secret"`
 }
 
+// supportedGrantTypes are the grant types that clients may be configured
+// to use at the token endpoint.
+var supportedGrantTypes = []string{
+	grantTypeAuthorizationCode,
+	grantTypeClientCredentials,
+	grantTypeRefreshToken,
+	grantTypeDeviceCode,
+	grantTypeTokenExchange,
+}
+
+// effectiveGrantTypes returns the grant types that the client is permitted
+// to use. Clients that were created before grant types were configurable
+// are only permitted to use the authorization code grant.
+func (c *client) effectiveGrantTypes() []string {
+	if len(c.GrantTypes) == 0 {
+		return []string{grantTypeAuthorizationCode}
+	}
+	return c.GrantTypes
+}
+
+// allowedGrantType returns true if the client is permitted to use the
+// given grant type.
+func (c *client) allowedGrantType(grantType string) bool {
+	return strutil.StrListContains(c.effectiveGrantTypes(), grantType)
+}
+
 //go:generate enumer -type=clientType -trimprefix=clientType -transform=snake
 type clientType int
 
@@ -147,6 +210,9 @@ type providerDiscovery struct {
 	AuthorizationEndpoint string   `json:"authorization_endpoint"`
 	TokenEndpoint         string   `json:"token_endpoint"`
 	UserinfoEndpoint      string   `json:"userinfo_endpoint"`
+	DeviceEndpoint        string   `json:"device_authorization_endpoint"`
+	RevocationEndpoint    string   `json:"revocation_endpoint"`
+	EndSessionEndpoint    string   `json:"end_session_endpoint"`
 	RequestParameter      bool     `json:"request_parameter_supported"`
 	RequestURIParameter   bool     `json:"request_uri_parameter_supported"`
 	IDTokenAlgs           []string `json:"id_token_signing_alg_values_supported"`
@@ -157,6 +223,9 @@ type providerDiscovery struct {
 	GrantTypes            []string `json:"grant_types_supported"`
 	AuthMethods           []string `json:"token_endpoint_auth_methods_supported"`
 	CodeChallengeMethods  []string `json:"code_challenge_methods_supported"`
+
+	BackchannelLogout        bool `json:"backchannel_logout_supported"`
+	BackchannelLogoutSession bool `json:"backchannel_logout_session_supported"`
 }
 
 type authCodeCacheEntry struct {
@@ -169,6 +238,7 @@ type authCodeCacheEntry struct {
 	authTime            time.Time
 	codeChallenge       string
 	codeChallengeMethod string
+	sessionID           string
 }
 
 func oidcProviderPaths(i *IdentityStore) []*framework.Path {
@@ -316,6 +386,33 @@ func oidcProviderPaths(i *IdentityStore) []*framework.Path {
 					Description: "The client type based on its ability to maintain confidentiality of credentials. The following client types are supported: 'confidential', 'public'. Defaults to 'confidential'.",
 					Default:     "confidential",
 				},
+				"grant_types": {
+					Type:        framework.TypeCommaStringSlice,
+					Description: "Comma separated string or array of grant types the client may use at the token endpoint. The following grant types are supported: 'authorization_code', 'client_credentials', 'refresh_token', 'urn:ietf:params:oauth:grant-type:device_code', 'urn:ietf:params:oauth:grant-type:token-exchange'. The 'client_credentials' grant type is only allowed for confidential clients. Defaults to 'authorization_code'.",
+					Default:     grantTypeAuthorizationCode,
+				},
+				"refresh_token_ttl": {
+					Type:        framework.TypeDurationSecond,
+					Description: "The time-to-live for refresh tokens obtained by the client. Each use of a refresh token issues a new refresh token with this time-to-live.",
+					Default:     "720h",
+				},
+				"refresh_token_max_ttl": {
+					Type:        framework.TypeDurationSecond,
+					Description: "The maximum time refresh tokens can be obtained for with the refresh token grant after the original grant. Refresh tokens never expire later than this, however often they are used. Must be at least refresh_token_ttl.",
+					Default:     "2160h",
+				},
+				"allowed_audiences": {
+					Type:        framework.TypeCommaStringSlice,
+					Description: "Comma separated string or array of audiences that the client may request with the token exchange grant. If not set, tokens obtained by token exchange can only have the client ID as their audience.",
+				},
+				"post_logout_redirect_uris": {
+					Type:        framework.TypeCommaStringSlice,
+					Description: "Comma separated string or array of URIs that the end-user may be redirected to after logging out. One of these values must exactly match the post_logout_redirect_uri parameter value used in each logout request.",
+				},
+				"backchannel_logout_uri": {
+					Type:        framework.TypeString,
+					Description: "The URI that the provider sends a logout token to when the end-user's session ends. If not set, the client isn't notified of logouts.",
+				},
 			},
 			Operations: map[logical.Operation]framework.OperationHandler{
 				logical.UpdateOperation: &framework.PathOperation{
@@ -550,23 +647,49 @@ func oidcProviderPaths(i *IdentityStore) []*framework.Path {
 				},
 				"code": {
 					Type:        framework.TypeString,
-					Description: "The authorization code received from the provider's authorization endpoint.",
-					Required:    true,
+					Description: "The authorization code received from the provider's authorization endpoint. Required for the 'authorization_code' grant type.",
 				},
 				"grant_type": {
 					Type:        framework.TypeString,
-					Description: "The authorization grant type. The following grant types are supported: 'authorization_code'.",
+					Description: "The authorization grant type. The following grant types are supported: 'authorization_code', 'client_credentials', 'refresh_token', 'urn:ietf:params:oauth:grant-type:device_code', 'urn:ietf:params:oauth:grant-type:token-exchange'.",
 					Required:    true,
 				},
 				"redirect_uri": {
 					Type:        framework.TypeString,
-					Description: "The callback location where the authentication response was sent.",
-					Required:    true,
+					Description: "The callback location where the authentication response was sent. Required for the 'authorization_code' grant type.",
 				},
 				"code_verifier": {
 					Type:        framework.TypeString,
 					Description: "The code verifier associated with the authorization code.",
 				},
+				"refresh_token": {
+					Type:        framework.TypeString,
+					Description: "The refresh token issued to the client. Required for the 'refresh_token' grant type.",
+				},
+				"device_code": {
+					Type:        framework.TypeString,
+					Description: "The device code received from the provider's device authorization endpoint. Required for the 'urn:ietf:params:oauth:grant-type:device_code' grant type.",
+				},
+				"scope": {
+					Type:        framework.TypeString,
+					Description: "A space-delimited, case-sensitive list of scopes to be requested. Used by the 'client_credentials', 'refresh_token', and 'urn:ietf:params:oauth:grant-type:token-exchange' grant types.",
+				},
+				"subject_token": {
+					Type:        framework.TypeString,
+					Description: "The Vault token that represents the identity of the party on behalf of whom the token is requested. Required for the 'urn:ietf:params:oauth:grant-type:token-exchange' grant type.",
+				},
+				"subject_token_type": {
+					Type:        framework.TypeString,
+					Description: "The type of the subject_token. The following token types are supported: 'urn:ietf:params:oauth:token-type:access_token'.",
+				},
+				"requested_token_type": {
+					Type:        framework.TypeString,
+					Description: "The type of the requested token. The following token types are supported: 'urn:ietf:params:oauth:token-type:jwt'.",
+				},
+				"audience": {
+					Type:        framework.TypeString,
+					Description: "The audience of the requested token. Must be one of the client's allowed_audiences. Defaults to the client ID.",
+				},
 				// For confidential clients, the client_id and client_secret are provided to
 				// the token endpoint via the 'client_secret_basic' or 'client_secret_post'
 				// authentication methods. See the OIDC spec for details at:
@@ -595,6 +718,178 @@ func oidcProviderPaths(i *IdentityStore) []*framework.Path {
 			HelpSynopsis:    "Provides the OIDC Token Endpoint.",
 			HelpDescription: "The OIDC Token Endpoint allows a client to exchange its Authorization Grant for an Access Token and ID Token.",
 		},
+		{
+			Pattern: "oidc/provider/" + framework.GenericNameRegex("name") + "/device",
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "oidc-provider",
+				OperationVerb:   "device-authorization",
+			},
+			Fields: map[string]*framework.FieldSchema{
+				"name": {
+					Type:        framework.TypeString,
+					Description: "Name of the provider",
+				},
+				"scope": {
+					Type:        framework.TypeString,
+					Description: "A space-delimited, case-sensitive list of scopes to be requested. The 'openid' scope is required.",
+					Required:    true,
+				},
+				"client_id": {
+					Type:        framework.TypeString,
+					Description: "The ID of the requesting client.",
+				},
+				"client_secret": {
+					Type:        framework.TypeString,
+					Description: "The secret of the requesting client.",
+				},
+			},
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback:                    i.pathOIDCDeviceAuthorization,
+					ForwardPerformanceStandby:   true,
+					ForwardPerformanceSecondary: false,
+				},
+			},
+			HelpSynopsis:    "Provides the OAuth 2.0 Device Authorization Endpoint.",
+			HelpDescription: "The Device Authorization Endpoint issues a device code and an end-user verification code to input-constrained devices using the device authorization grant.",
+		},
+		{
+			Pattern: "oidc/provider/" + framework.GenericNameRegex("name") + "/device/verify",
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "oidc-provider",
+			},
+			Fields: map[string]*framework.FieldSchema{
+				"name": {
+					Type:        framework.TypeString,
+					Description: "Name of the provider",
+				},
+				"user_code": {
+					Type:        framework.TypeString,
+					Description: "The end-user verification code displayed on the device.",
+					Required:    true,
+					Query:       true,
+				},
+				"approve": {
+					Type:        framework.TypeBool,
+					Description: "Whether the end-user approves the device authorization request. Defaults to true.",
+					Default:     true,
+				},
+			},
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: i.pathOIDCReadDeviceVerification,
+					DisplayAttrs: &framework.DisplayAttributes{
+						OperationVerb:   "read",
+						OperationSuffix: "device-verification",
+					},
+					ForwardPerformanceStandby:   true,
+					ForwardPerformanceSecondary: false,
+				},
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: i.pathOIDCDeviceVerify,
+					DisplayAttrs: &framework.DisplayAttributes{
+						OperationVerb:   "verify",
+						OperationSuffix: "device",
+					},
+					ForwardPerformanceStandby:   true,
+					ForwardPerformanceSecondary: false,
+				},
+			},
+			HelpSynopsis:    "Approve or deny a device authorization request.",
+			HelpDescription: "Read the device authorization request identified by the end-user verification code, or approve or deny it on behalf of the identity entity associated with the request.",
+		},
+		{
+			Pattern: "oidc/provider/" + framework.GenericNameRegex("name") + "/revoke",
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "oidc-provider",
+				OperationVerb:   "revoke",
+			},
+			Fields: map[string]*framework.FieldSchema{
+				"name": {
+					Type:        framework.TypeString,
+					Description: "Name of the provider",
+				},
+				"token": {
+					Type:        framework.TypeString,
+					Description: "The access token or refresh token to revoke.",
+					Required:    true,
+				},
+				"token_type_hint": {
+					Type:        framework.TypeString,
+					Description: "A hint about the type of the token to revoke. The following hints are supported: 'access_token', 'refresh_token'.",
+				},
+				"client_id": {
+					Type:        framework.TypeString,
+					Description: "The ID of the requesting client.",
+				},
+				"client_secret": {
+					Type:        framework.TypeString,
+					Description: "The secret of the requesting client.",
+				},
+			},
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback:                    i.pathOIDCRevoke,
+					ForwardPerformanceStandby:   true,
+					ForwardPerformanceSecondary: false,
+				},
+			},
+			HelpSynopsis:    "Provides the OAuth 2.0 Token Revocation Endpoint.",
+			HelpDescription: "The Token Revocation Endpoint allows a client to notify the provider that an access token or refresh token it obtained is no longer needed.",
+		},
+		{
+			Pattern: "oidc/provider/" + framework.GenericNameRegex("name") + "/logout",
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "oidc-provider",
+			},
+			Fields: map[string]*framework.FieldSchema{
+				"name": {
+					Type:        framework.TypeString,
+					Description: "Name of the provider",
+				},
+				"id_token_hint": {
+					Type:        framework.TypeString,
+					Description: "An ID token previously issued by the provider to the client.",
+					Query:       true,
+				},
+				"client_id": {
+					Type:        framework.TypeString,
+					Description: "The ID of the client requesting the logout. Required with post_logout_redirect_uri if id_token_hint is not provided.",
+					Query:       true,
+				},
+				"post_logout_redirect_uri": {
+					Type:        framework.TypeString,
+					Description: "The URI that the end-user is redirected to after logging out. Must be one of the client's post_logout_redirect_uris.",
+					Query:       true,
+				},
+				"state": {
+					Type:        framework.TypeString,
+					Description: "The value used to maintain state between the logout request and the post-logout redirect.",
+					Query:       true,
+				},
+			},
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: i.pathOIDCLogout,
+					DisplayAttrs: &framework.DisplayAttributes{
+						OperationVerb: "logout",
+					},
+					ForwardPerformanceStandby:   true,
+					ForwardPerformanceSecondary: false,
+				},
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: i.pathOIDCLogout,
+					DisplayAttrs: &framework.DisplayAttributes{
+						OperationVerb:   "logout",
+						OperationSuffix: "with-parameters",
+					},
+					ForwardPerformanceStandby:   true,
+					ForwardPerformanceSecondary: false,
+				},
+			},
+			HelpSynopsis:    "Provides the OIDC End Session Endpoint.",
+			HelpDescription: "The OIDC End Session Endpoint ends the end-user's session with the provider and notifies the clients that participated in the session.",
+		},
 		{
 			Pattern: "oidc/provider/" + framework.GenericNameRegex("name") + "/userinfo",
 			DisplayAttrs: &framework.DisplayAttributes{
@@ -1158,6 +1453,66 @@ func (i *IdentityStore) pathOIDCCreateUpdateClient(ctx context.Context, req *log
 		}
 	}
 
+	if grantTypesRaw, ok := d.GetOk("grant_types"); ok {
+		client.GrantTypes = grantTypesRaw.([]string)
+	} else if req.Operation == logical.CreateOperation {
+		client.GrantTypes = d.Get("grant_types").([]string)
+	}
+	client.GrantTypes = strutil.RemoveDuplicates(client.GrantTypes, false)
+	if len(client.GrantTypes) == 0 {
+		client.GrantTypes = []string{grantTypeAuthorizationCode}
+	}
+	for _, grantType := range client.GrantTypes {
+		if !strutil.StrListContains(supportedGrantTypes, grantType) {
+			return logical.ErrorResponse("invalid grant type %q", grantType), nil
+		}
+	}
+	for _, grantType := range []string{grantTypeClientCredentials, grantTypeTokenExchange} {
+		if client.Type == public && client.allowedGrantType(grantType) {
+			return logical.ErrorResponse("the %q grant type is only allowed for confidential clients", grantType), nil
+		}
+	}
+
+	if allowedAudiencesRaw, ok := d.GetOk("allowed_audiences"); ok {
+		client.AllowedAudiences = allowedAudiencesRaw.([]string)
+	} else if req.Operation == logical.CreateOperation {
+		client.AllowedAudiences = d.Get("allowed_audiences").([]string)
+	}
+	client.AllowedAudiences = strutil.RemoveDuplicates(client.AllowedAudiences, false)
+
+	if postLogoutRedirectURIsRaw, ok := d.GetOk("post_logout_redirect_uris"); ok {
+		client.PostLogoutRedirectURIs = postLogoutRedirectURIsRaw.([]string)
+	} else if req.Operation == logical.CreateOperation {
+		client.PostLogoutRedirectURIs = d.Get("post_logout_redirect_uris").([]string)
+	}
+	client.PostLogoutRedirectURIs = strutil.RemoveDuplicates(client.PostLogoutRedirectURIs, false)
+
+	if backchannelLogoutURIRaw, ok := d.GetOk("backchannel_logout_uri"); ok {
+		client.BackchannelLogoutURI = backchannelLogoutURIRaw.(string)
+	}
+	if client.BackchannelLogoutURI != "" {
+		u, err := url.Parse(client.BackchannelLogoutURI)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return logical.ErrorResponse("backchannel_logout_uri must be an absolute http or https URI"), nil
+		}
+	}
+
+	if refreshTokenTTLRaw, ok := d.GetOk("refresh_token_ttl"); ok {
+		client.RefreshTokenTTL = time.Duration(refreshTokenTTLRaw.(int)) * time.Second
+	} else if req.Operation == logical.CreateOperation || client.RefreshTokenTTL == 0 {
+		client.RefreshTokenTTL = time.Duration(d.Get("refresh_token_ttl").(int)) * time.Second
+	}
+
+	if refreshTokenMaxTTLRaw, ok := d.GetOk("refresh_token_max_ttl"); ok {
+		client.RefreshTokenMaxTTL = time.Duration(refreshTokenMaxTTLRaw.(int)) * time.Second
+	} else if req.Operation == logical.CreateOperation || client.RefreshTokenMaxTTL == 0 {
+		client.RefreshTokenMaxTTL = time.Duration(d.Get("refresh_token_max_ttl").(int)) * time.Second
+	}
+
+	if client.RefreshTokenMaxTTL < client.RefreshTokenTTL {
+		return logical.ErrorResponse("refresh_token_max_ttl must be greater than or equal to refresh_token_ttl"), nil
+	}
+
 	if client.ClientID == "" {
 		// generate client_id
 		clientID, err := base62.Random(clientIDLength)
@@ -1206,13 +1561,20 @@ func (i *IdentityStore) pathOIDCListClient(ctx context.Context, req *logical.Req
 	for _, client := range clients {
 		keys = append(keys, client.Name)
 		keyInfo[client.Name] = map[string]interface{}{
-			"redirect_uris":    client.RedirectURIs,
-			"assignments":      client.Assignments,
-			"key":              client.Key,
-			"id_token_ttl":     int64(client.IDTokenTTL.Seconds()),
-			"access_token_ttl": int64(client.AccessTokenTTL.Seconds()),
-			"client_type":      client.Type.String(),
-			"client_id":        client.ClientID,
+			"redirect_uris":         client.RedirectURIs,
+			"assignments":           client.Assignments,
+			"key":                   client.Key,
+			"id_token_ttl":          int64(client.IDTokenTTL.Seconds()),
+			"access_token_ttl":      int64(client.AccessTokenTTL.Seconds()),
+			"client_type":           client.Type.String(),
+			"client_id":             client.ClientID,
+			"grant_types":           client.effectiveGrantTypes(),
+			"refresh_token_ttl":     int64(client.RefreshTokenTTL.Seconds()),
+			"refresh_token_max_ttl": int64(client.RefreshTokenMaxTTL.Seconds()),
+			"allowed_audiences":     client.AllowedAudiences,
+
+			"post_logout_redirect_uris": client.PostLogoutRedirectURIs,
+			"backchannel_logout_uri":    client.BackchannelLogoutURI,
 			// client_secret is intentionally omitted
 		}
 	}
@@ -1234,13 +1596,20 @@ func (i *IdentityStore) pathOIDCReadClient(ctx context.Context, req *logical.Req
 
 	resp := &logical.Response{
 		Data: map[string]interface{}{
-			"redirect_uris":    client.RedirectURIs,
-			"assignments":      client.Assignments,
-			"key":              client.Key,
-			"id_token_ttl":     int64(client.IDTokenTTL.Seconds()),
-			"access_token_ttl": int64(client.AccessTokenTTL.Seconds()),
-			"client_id":        client.ClientID,
-			"client_type":      client.Type.String(),
+			"redirect_uris":         client.RedirectURIs,
+			"assignments":           client.Assignments,
+			"key":                   client.Key,
+			"id_token_ttl":          int64(client.IDTokenTTL.Seconds()),
+			"access_token_ttl":      int64(client.AccessTokenTTL.Seconds()),
+			"client_id":             client.ClientID,
+			"client_type":           client.Type.String(),
+			"grant_types":           client.effectiveGrantTypes(),
+			"refresh_token_ttl":     int64(client.RefreshTokenTTL.Seconds()),
+			"refresh_token_max_ttl": int64(client.RefreshTokenMaxTTL.Seconds()),
+			"allowed_audiences":     client.AllowedAudiences,
+
+			"post_logout_redirect_uris": client.PostLogoutRedirectURIs,
+			"backchannel_logout_uri":    client.BackchannelLogoutURI,
 		},
 	}
 
@@ -1258,6 +1627,18 @@ func (i *IdentityStore) pathOIDCDeleteClient(ctx context.Context, req *logical.R
 	i.oidcLock.Lock()
 	defer i.oidcLock.Unlock()
 
+	client, err := i.clientByName(ctx, req.Storage, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revoke the refresh tokens that were issued to the client
+	if client != nil {
+		if err := i.revokeOIDCRefreshTokens(ctx, req.Storage, client.ClientID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Delete the client from memdb
 	if err := i.memDBDeleteClientByName(ctx, name); err != nil {
 		return nil, err
@@ -1549,6 +1930,9 @@ func (i *IdentityStore) pathOIDCProviderDiscovery(ctx context.Context, req *logi
 		AuthorizationEndpoint: strings.Replace(p.effectiveIssuer, "/v1/", "/ui/vault/", 1) + "/authorize",
 		TokenEndpoint:         p.effectiveIssuer + "/token",
 		UserinfoEndpoint:      p.effectiveIssuer + "/userinfo",
+		DeviceEndpoint:        p.effectiveIssuer + "/device",
+		RevocationEndpoint:    p.effectiveIssuer + "/revoke",
+		EndSessionEndpoint:    strings.Replace(p.effectiveIssuer, "/v1/", "/ui/vault/", 1) + "/logout",
 		IDTokenAlgs:           supportedAlgs,
 		Scopes:                scopes,
 		Claims:                []string{},
@@ -1556,7 +1940,7 @@ func (i *IdentityStore) pathOIDCProviderDiscovery(ctx context.Context, req *logi
 		RequestURIParameter:   false,
 		ResponseTypes:         []string{"code"},
 		Subjects:              []string{"public"},
-		GrantTypes:            []string{"authorization_code"},
+		GrantTypes:            supportedGrantTypes,
 		AuthMethods: []string{
 			// PKCE is required for auth method "none"
 			"none",
@@ -1567,6 +1951,8 @@ func (i *IdentityStore) pathOIDCProviderDiscovery(ctx context.Context, req *logi
 			codeChallengeMethodPlain,
 			codeChallengeMethodS256,
 		},
+		BackchannelLogout:        true,
+		BackchannelLogoutSession: true,
 	}
 
 	data, err := json.Marshal(disc)
@@ -1862,6 +2248,17 @@ func (i *IdentityStore) pathOIDCAuthorize(ctx context.Context, req *logical.Requ
 		authCodeEntry.authTime = lastAuthTime
 	}
 
+	// Tie the tokens issued for the authorization code to the end-user's
+	// session with the provider, which is tracked by the Vault token that
+	// authorized the client
+	if req.ClientToken != "" {
+		sessionID, err := i.startOIDCSession(ctx, req.Storage, name, entity.GetID(), req.ClientToken, clientID)
+		if err != nil {
+			return authResponse("", state, ErrAuthServerError, err.Error())
+		}
+		authCodeEntry.sessionID = sessionID
+	}
+
 	// Generate the authorization code
 	code, err := base62.Random(32)
 	if err != nil {
@@ -1938,32 +2335,12 @@ func (i *IdentityStore) pathOIDCToken(ctx context.Context, req *logical.Request,
 		return tokenResponse(nil, ErrTokenInvalidRequest, "provider not found")
 	}
 
-	// client_secret_basic - Check for client credentials in the Authorization header
-	clientID, clientSecret, okBasicAuth := basicAuth(req)
-	if !okBasicAuth {
-		// client_secret_post - Check for client credentials in the request body
-		clientID = d.Get("client_id").(string)
-		if clientID == "" {
-			return tokenResponse(nil, ErrTokenInvalidRequest, "client_id parameter is required")
-		}
-		clientSecret = d.Get("client_secret").(string)
-	}
-	client, err := i.clientByID(ctx, req.Storage, clientID)
-	if err != nil {
-		return tokenResponse(nil, ErrTokenServerError, err.Error())
-	}
-	if client == nil {
-		i.Logger().Debug("client failed to authenticate with client not found", "client_id", clientID)
-		return tokenResponse(nil, ErrTokenInvalidClient, "client failed to authenticate")
-	}
-
-	// Authenticate the client if it's a confidential client type.
-	// Details at https://openid.net/specs/openid-connect-core-1_0.html#ClientAuthentication
-	if client.Type == confidential &&
-		subtle.ConstantTimeCompare([]byte(client.ClientSecret), []byte(clientSecret)) == 0 {
-		i.Logger().Debug("client failed to authenticate with invalid client secret", "client_id", clientID)
-		return tokenResponse(nil, ErrTokenInvalidClient, "client failed to authenticate")
+	// Authenticate the client
+	client, errResp, err := i.authenticateTokenClient(ctx, req, d)
+	if errResp != nil || err != nil {
+		return errResp, err
 	}
+	clientID := client.ClientID
 
 	// Validate that the client is authorized to use the provider
 	if !provider.allowedClientID(clientID) {
@@ -1990,9 +2367,23 @@ func (i *IdentityStore) pathOIDCToken(ctx context.Context, req *logical.Request,
 	if grantType == "" {
 		return tokenResponse(nil, ErrTokenInvalidRequest, "grant_type parameter is required")
 	}
-	if grantType != "authorization_code" {
+	if !strutil.StrListContains(supportedGrantTypes, grantType) {
 		return tokenResponse(nil, ErrTokenUnsupportedGrantType, "unsupported grant_type value")
 	}
+	if !client.allowedGrantType(grantType) {
+		return tokenResponse(nil, ErrTokenUnauthorizedClient, "client is not authorized to use the grant_type")
+	}
+
+	switch grantType {
+	case grantTypeClientCredentials:
+		return i.clientCredentialsGrant(ctx, d, ns, provider, client, key)
+	case grantTypeRefreshToken:
+		return i.refreshTokenGrant(ctx, req, d, ns, name, provider, client, key)
+	case grantTypeDeviceCode:
+		return i.deviceCodeGrant(ctx, req, d, ns, name, provider, client, key)
+	case grantTypeTokenExchange:
+		return i.tokenExchangeGrant(ctx, req, d, ns, name, provider, client, key)
+	}
 
 	// Validate the authorization code
 	code := d.Get("code").(string)
@@ -2074,6 +2465,58 @@ func (i *IdentityStore) pathOIDCToken(ctx context.Context, req *logical.Request,
 		}
 	}
 
+	return i.issueOIDCTokens(ctx, req, ns, name, provider, client, key, entity, &tokenGrant{
+		entityID:  authCodeEntry.entityID,
+		nonce:     authCodeEntry.nonce,
+		scopes:    authCodeEntry.scopes,
+		authTime:  authCodeEntry.authTime,
+		sessionID: authCodeEntry.sessionID,
+		code:      code,
+	})
+}
+
+// authenticateTokenClient authenticates the client making a request to the
+// token or device authorization endpoint. A token error response is returned
+// if the client fails to authenticate.
+func (i *IdentityStore) authenticateTokenClient(ctx context.Context, req *logical.Request, d *framework.FieldData) (*client, *logical.Response, error) {
+	// client_secret_basic - Check for client credentials in the Authorization header
+	clientID, clientSecret, okBasicAuth := basicAuth(req)
+	if !okBasicAuth {
+		// client_secret_post - Check for client credentials in the request body
+		clientID = d.Get("client_id").(string)
+		if clientID == "" {
+			resp, err := tokenResponse(nil, ErrTokenInvalidRequest, "client_id parameter is required")
+			return nil, resp, err
+		}
+		clientSecret = d.Get("client_secret").(string)
+	}
+	client, err := i.clientByID(ctx, req.Storage, clientID)
+	if err != nil {
+		resp, err := tokenResponse(nil, ErrTokenServerError, err.Error())
+		return nil, resp, err
+	}
+	if client == nil {
+		i.Logger().Debug("client failed to authenticate with client not found", "client_id", clientID)
+		resp, err := tokenResponse(nil, ErrTokenInvalidClient, "client failed to authenticate")
+		return nil, resp, err
+	}
+
+	// Authenticate the client if it's a confidential client type.
+	// Details at https://openid.net/specs/openid-connect-core-1_0.html#ClientAuthentication
+	if client.Type == confidential &&
+		subtle.ConstantTimeCompare([]byte(client.ClientSecret), []byte(clientSecret)) == 0 {
+		i.Logger().Debug("client failed to authenticate with invalid client secret", "client_id", clientID)
+		resp, err := tokenResponse(nil, ErrTokenInvalidClient, "client failed to authenticate")
+		return nil, resp, err
+	}
+
+	return client, nil, nil
+}
+
+// issueOIDCTokens issues an access token and ID token to the client for the
+// given entity once a grant has been validated. A refresh token is also issued
+// if the client is permitted to use the refresh token grant.
+func (i *IdentityStore) issueOIDCTokens(ctx context.Context, req *logical.Request, ns *namespace.Namespace, name string, provider *provider, client *client, key *namedKey, entity *identity.Entity, grant *tokenGrant) (*logical.Response, error) {
 	// The access token is a Vault batch token with a policy that only
 	// provides access to the issuing provider's userinfo endpoint.
 	accessTokenIssuedAt := time.Now()
@@ -2091,7 +2534,7 @@ func (i *IdentityStore) pathOIDCToken(ctx context.Context, req *logical.Request,
 		},
 		InternalMeta: map[string]string{
 			accessTokenClientIDMeta: client.ClientID,
-			accessTokenScopesMeta:   strings.Join(authCodeEntry.scopes, scopesDelimiter),
+			accessTokenScopesMeta:   strings.Join(grant.scopes, scopesDelimiter),
 		},
 		InlinePolicy: fmt.Sprintf(`
 			path "identity/oidc/provider/%s/userinfo" {
@@ -2099,7 +2542,10 @@ func (i *IdentityStore) pathOIDCToken(ctx context.Context, req *logical.Request,
 			}
 		`, name),
 	}
-	err = i.tokenStorer.CreateToken(ctx, accessToken)
+	if grant.sessionID != "" {
+		accessToken.InternalMeta[accessTokenSessionIDMeta] = grant.sessionID
+	}
+	err := i.tokenStorer.CreateToken(ctx, accessToken)
 	if err != nil {
 		return tokenResponse(nil, ErrTokenServerError, err.Error())
 	}
@@ -2111,9 +2557,12 @@ func (i *IdentityStore) pathOIDCToken(ctx context.Context, req *logical.Request,
 	}
 
 	// Compute the authorization code hash claim (c_hash)
-	cHash, err := computeHashClaim(key.Algorithm, code)
-	if err != nil {
-		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	var cHash string
+	if grant.code != "" {
+		cHash, err = computeHashClaim(key.Algorithm, grant.code)
+		if err != nil {
+			return tokenResponse(nil, ErrTokenServerError, err.Error())
+		}
 	}
 
 	// Set the ID token claims
@@ -2122,22 +2571,23 @@ func (i *IdentityStore) pathOIDCToken(ctx context.Context, req *logical.Request,
 	idToken := idToken{
 		Namespace:       ns.ID,
 		Issuer:          provider.effectiveIssuer,
-		Subject:         authCodeEntry.entityID,
-		Audience:        authCodeEntry.clientID,
-		Nonce:           authCodeEntry.nonce,
+		Subject:         grant.entityID,
+		Audience:        client.ClientID,
+		Nonce:           grant.nonce,
 		Expiry:          idTokenExpiry.Unix(),
 		IssuedAt:        idTokenIssuedAt.Unix(),
 		AccessTokenHash: atHash,
 		CodeHash:        cHash,
+		SessionID:       grant.sessionID,
 	}
 
 	// Add the auth_time claim if it's not the zero time instant
-	if !authCodeEntry.authTime.IsZero() {
-		idToken.AuthTime = authCodeEntry.authTime.Unix()
+	if !grant.authTime.IsZero() {
+		idToken.AuthTime = grant.authTime.Unix()
 	}
 
 	// Populate each of the requested scope templates
-	templates, conflict, err := i.populateScopeTemplates(ctx, req.Storage, ns, entity, authCodeEntry.scopes...)
+	templates, conflict, err := i.populateScopeTemplates(ctx, req.Storage, ns, entity, grant.scopes...)
 	if !conflict && err != nil {
 		return tokenResponse(nil, ErrTokenServerError, err.Error())
 	}
@@ -2167,12 +2617,23 @@ func (i *IdentityStore) pathOIDCToken(ctx context.Context, req *logical.Request,
 		i.billingCounter.IncrementOidcTokenCount(validity, attr)
 	}
 
-	return tokenResponse(map[string]interface{}{
+	response := map[string]interface{}{
 		"token_type":   "Bearer",
 		"access_token": accessToken.ID,
 		"id_token":     signedIDToken,
 		"expires_in":   int64(accessTokenExpiry.Sub(accessTokenIssuedAt).Seconds()),
-	}, "", "")
+	}
+
+	// Issue a refresh token if the client is permitted to use it
+	if client.allowedGrantType(grantTypeRefreshToken) {
+		refreshToken, err := i.issueOIDCRefreshToken(ctx, req.Storage, name, client, grant)
+		if err != nil {
+			return tokenResponse(nil, ErrTokenServerError, err.Error())
+		}
+		response["refresh_token"] = refreshToken
+	}
+
+	return tokenResponse(response, "", "")
 }
 
 // getMaxTokenTTL returns the maximum of the given access token and ID token
@@ -2269,6 +2730,16 @@ func (i *IdentityStore) pathOIDCUserInfo(ctx context.Context, req *logical.Reque
 		return userInfoResponse(nil, ErrUserInfoInvalidToken, "access token is malformed or invalid")
 	}
 
+	// Validate that the access token hasn't been revoked and that the
+	// session it was issued in hasn't ended
+	active, err := i.oidcAccessTokenActive(ctx, req.Storage, req.ClientToken, te)
+	if err != nil {
+		return userInfoResponse(nil, ErrUserInfoServerError, err.Error())
+	}
+	if !active {
+		return userInfoResponse(nil, ErrUserInfoInvalidToken, "access token has been revoked")
+	}
+
 	// Get the client ID that originated the request from the token metadata
 	clientID, ok := te.InternalMeta[accessTokenClientIDMeta]
 	if !ok {
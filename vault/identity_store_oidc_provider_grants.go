@@ -0,0 +1,1005 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-secure-stdlib/base62"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/vault/helper/namespace"
+	"github.com/hashicorp/vault/sdk/framework"
+	"github.com/hashicorp/vault/sdk/logical"
+)
+
+const (
+	refreshTokenLength     = 64
+	refreshTokenPrefix     = "hvo_refresh_"
+	defaultRefreshTokenTTL = 720 * time.Hour
+
+	// defaultRefreshTokenMaxTTL is the maximum lifetime of a refresh token
+	// family of clients created before refresh_token_max_ttl existed.
+	defaultRefreshTokenMaxTTL = 2160 * time.Hour
+
+	// Device authorization grant constants. See details at
+	// https://datatracker.ietf.org/doc/html/rfc8628.
+	deviceCodeLength       = 32
+	deviceCodeTTL          = 10 * time.Minute
+	deviceCodePollInterval = 5 * time.Second
+	userCodeCachePrefix    = "user_code/"
+
+	// The user code character set excludes vowels to avoid generating
+	// words and is case-insensitive to ease entry by the end-user. See
+	// https://datatracker.ietf.org/doc/html/rfc8628#section-6.1.
+	userCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength  = 8
+)
+
+// tokenGrant holds the authorization details that each grant type validates
+// before tokens are issued from the token endpoint.
+type tokenGrant struct {
+	entityID string
+	nonce    string
+	scopes   []string
+	authTime time.Time
+
+	// sessionID is the ID of the provider session that the grant
+	// belongs to, if any.
+	sessionID string
+
+	// code is the authorization code. It's only set for the
+	// authorization code grant.
+	code string
+
+	// refreshFamily is the refresh token family being rotated. It's
+	// only set for the refresh token grant.
+	refreshFamily *refreshTokenFamily
+}
+
+// refreshToken is the storage entry for an issued refresh token. Entries
+// are keyed by the SHA-256 hash of the refresh token.
+type refreshToken struct {
+	ClientID   string    `json:"client_id"`
+	FamilyID   string    `json:"family_id"`
+	ExpireTime time.Time `json:"expire_time"`
+}
+
+// refreshTokenFamily tracks the chain of rotated refresh tokens that originate
+// from a single authorization grant. Only the most recently issued refresh
+// token in a family is valid. Presenting any other refresh token of the family
+// is treated as a replay of a stolen token and revokes the entire family. See
+// https://datatracker.ietf.org/doc/html/draft-ietf-oauth-security-topics#section-4.14.2.
+type refreshTokenFamily struct {
+	ID               string    `json:"id"`
+	Provider         string    `json:"provider"`
+	ClientID         string    `json:"client_id"`
+	EntityID         string    `json:"entity_id"`
+	Scopes           []string  `json:"scopes"`
+	AuthTime         time.Time `json:"auth_time"`
+	SessionID        string    `json:"session_id"`
+	CurrentTokenHash string    `json:"current_token_hash"`
+	ExpireTime       time.Time `json:"expire_time"`
+
+	// MaxExpireTime is when the family expires, however often its refresh
+	// tokens are rotated.
+	MaxExpireTime time.Time `json:"max_expire_time"`
+}
+
+type deviceCodeStatus int
+
+const (
+	deviceCodePending deviceCodeStatus = iota
+	deviceCodeApproved
+	deviceCodeDenied
+	deviceCodeRedeemed
+)
+
+type deviceCodeCacheEntry struct {
+	provider  string
+	clientID  string
+	userCode  string
+	scopes    []string
+	expiresAt time.Time
+
+	// l protects the fields below, which change as the end-user
+	// verifies the request and the device polls the token endpoint.
+	l          sync.Mutex
+	interval   time.Duration
+	lastPolled time.Time
+	status     deviceCodeStatus
+	entityID   string
+	authTime   time.Time
+	sessionID  string
+}
+
+// clientCredentialsGrant implements the client credentials grant. The access
+// token is a JWT signed by the client's key that represents the client itself
+// rather than an end-user, so no ID token or refresh token is issued. See details at
+// https://datatracker.ietf.org/doc/html/rfc6749#section-4.4.
+func (i *IdentityStore) clientCredentialsGrant(ctx context.Context, d *framework.FieldData, ns *namespace.Namespace, provider *provider, client *client, key *namedKey) (*logical.Response, error) {
+	if client.Type != confidential {
+		return tokenResponse(nil, ErrTokenUnauthorizedClient, "client credentials grant is only allowed for confidential clients")
+	}
+
+	// Requested scopes must be supported by the provider
+	scopes := strutil.ParseDedupAndSortStrings(d.Get("scope").(string), scopesDelimiter)
+	for _, scope := range scopes {
+		if !strutil.StrListContains(provider.ScopesSupported, scope) {
+			return tokenResponse(nil, ErrTokenInvalidScope, fmt.Sprintf("scope %q is not supported by the provider", scope))
+		}
+	}
+
+	jti, err := uuid.GenerateUUID()
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+
+	issuedAt := time.Now()
+	claims := map[string]interface{}{
+		"iss":       provider.effectiveIssuer,
+		"namespace": ns.ID,
+		"sub":       client.ClientID,
+		"aud":       client.ClientID,
+		"client_id": client.ClientID,
+		"jti":       jti,
+		"iat":       issuedAt.Unix(),
+		"exp":       issuedAt.Add(client.AccessTokenTTL).Unix(),
+	}
+	if len(scopes) > 0 {
+		claims["scope"] = strings.Join(scopes, scopesDelimiter)
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+
+	// Sign the access token using the client's key
+	accessToken, err := key.signPayload(payload)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+
+	// Track OIDC token generated for billing
+	if i.billingCounter != nil {
+		validity := client.AccessTokenTTL.Seconds()
+		attr := i.oidcBillingAttribution(ctx, ns, validity)
+		i.billingCounter.IncrementOidcTokenCount(validity, attr)
+	}
+
+	response := map[string]interface{}{
+		"token_type":   "Bearer",
+		"access_token": accessToken,
+		"expires_in":   int64(client.AccessTokenTTL.Seconds()),
+	}
+	if len(scopes) > 0 {
+		response["scope"] = strings.Join(scopes, scopesDelimiter)
+	}
+
+	return tokenResponse(response, "", "")
+}
+
+// refreshTokenGrant implements the refresh token grant. Refresh tokens are
+// single use and each use issues a new refresh token of the same family. See
+// details at https://datatracker.ietf.org/doc/html/rfc6749#section-6.
+func (i *IdentityStore) refreshTokenGrant(ctx context.Context, req *logical.Request, d *framework.FieldData, ns *namespace.Namespace, name string, provider *provider, client *client, key *namedKey) (*logical.Response, error) {
+	token := d.Get("refresh_token").(string)
+	if token == "" {
+		return tokenResponse(nil, ErrTokenInvalidRequest, "refresh_token parameter is required")
+	}
+
+	i.oidcRefreshTokenLock.Lock()
+	defer i.oidcRefreshTokenLock.Unlock()
+
+	tokenHash := oidcTokenHash(token)
+	entry, err := i.getOIDCRefreshToken(ctx, req.Storage, tokenHash)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if entry == nil || time.Now().After(entry.ExpireTime) {
+		return tokenResponse(nil, ErrTokenInvalidGrant, "refresh token is invalid or expired")
+	}
+
+	// Ensure the refresh token was issued to the authenticated client
+	if entry.ClientID != client.ClientID {
+		return tokenResponse(nil, ErrTokenInvalidGrant, "refresh token was not issued to the client")
+	}
+
+	family, err := i.getOIDCRefreshTokenFamily(ctx, req.Storage, entry.ClientID, entry.FamilyID)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if family == nil {
+		return tokenResponse(nil, ErrTokenInvalidGrant, "refresh token has been revoked")
+	}
+
+	// A refresh token that is no longer the current token of its family has
+	// already been used. Revoke the family, since either the client or an
+	// attacker holds a token that was rotated out.
+	if subtle.ConstantTimeCompare([]byte(family.CurrentTokenHash), []byte(tokenHash)) == 0 {
+		i.Logger().Warn("refresh token reuse detected, revoking refresh token family",
+			"client_id", client.ClientID, "family_id", family.ID)
+		if err := req.Storage.Delete(ctx, refreshTokenFamilyPath+family.ClientID+"/"+family.ID); err != nil {
+			return tokenResponse(nil, ErrTokenServerError, err.Error())
+		}
+		return tokenResponse(nil, ErrTokenInvalidGrant, "refresh token has already been used")
+	}
+
+	// Ensure the refresh token was issued by the provider
+	if family.Provider != name {
+		return tokenResponse(nil, ErrTokenInvalidGrant, "refresh token was not issued by the provider")
+	}
+
+	// The requested scope must not include any scope not originally granted
+	scopes := family.Scopes
+	if scopeRaw, ok := d.GetOk("scope"); ok {
+		scopes = make([]string, 0)
+		for _, scope := range strutil.ParseDedupAndSortStrings(scopeRaw.(string), scopesDelimiter) {
+			if scope == openIDScope {
+				continue
+			}
+			if !strutil.StrListContains(family.Scopes, scope) {
+				return tokenResponse(nil, ErrTokenInvalidScope, fmt.Sprintf("scope %q was not originally granted", scope))
+			}
+			scopes = append(scopes, scope)
+		}
+	}
+
+	// Refresh tokens can't outlive the end-user's session with the provider
+	if family.SessionID != "" {
+		active, err := i.oidcSessionActive(ctx, req.Storage, family.SessionID)
+		if err != nil {
+			return tokenResponse(nil, ErrTokenServerError, err.Error())
+		}
+		if !active {
+			return tokenResponse(nil, ErrTokenInvalidGrant, "session associated with the refresh token has ended")
+		}
+	}
+
+	// Get the entity associated with the original authorization grant
+	entity, err := i.MemDBEntityByID(family.EntityID, true)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if entity == nil {
+		return tokenResponse(nil, ErrTokenInvalidGrant, "identity entity associated with the refresh token not found")
+	}
+
+	// Validate that the entity is still a member of the client's assignments
+	isMember, err := i.entityHasAssignment(ctx, req.Storage, entity, client.Assignments)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if !isMember {
+		return tokenResponse(nil, ErrTokenInvalidGrant, "identity entity not authorized by client assignment")
+	}
+
+	return i.issueOIDCTokens(ctx, req, ns, name, provider, client, key, entity, &tokenGrant{
+		entityID:      family.EntityID,
+		scopes:        scopes,
+		authTime:      family.AuthTime,
+		sessionID:     family.SessionID,
+		refreshFamily: family,
+	})
+}
+
+// issueOIDCRefreshToken issues a refresh token for the given grant. A new
+// family is started unless the grant rotates the token of an existing family.
+func (i *IdentityStore) issueOIDCRefreshToken(ctx context.Context, s logical.Storage, name string, client *client, grant *tokenGrant) (string, error) {
+	family := grant.refreshFamily
+	if family == nil {
+		familyID, err := uuid.GenerateUUID()
+		if err != nil {
+			return "", err
+		}
+		maxTTL := client.RefreshTokenMaxTTL
+		if maxTTL == 0 {
+			maxTTL = defaultRefreshTokenMaxTTL
+		}
+		family = &refreshTokenFamily{
+			ID:            familyID,
+			Provider:      name,
+			ClientID:      client.ClientID,
+			EntityID:      grant.entityID,
+			Scopes:        grant.scopes,
+			AuthTime:      grant.authTime,
+			SessionID:     grant.sessionID,
+			MaxExpireTime: time.Now().Add(maxTTL),
+		}
+	}
+
+	token, err := base62.Random(refreshTokenLength)
+	if err != nil {
+		return "", err
+	}
+	token = refreshTokenPrefix + token
+
+	ttl := client.RefreshTokenTTL
+	if ttl == 0 {
+		ttl = defaultRefreshTokenTTL
+	}
+	tokenHash := oidcTokenHash(token)
+	expireTime := time.Now().Add(ttl)
+	if !family.MaxExpireTime.IsZero() && expireTime.After(family.MaxExpireTime) {
+		expireTime = family.MaxExpireTime
+	}
+
+	entry, err := logical.StorageEntryJSON(refreshTokenPath+tokenHash, &refreshToken{
+		ClientID:   client.ClientID,
+		FamilyID:   family.ID,
+		ExpireTime: expireTime,
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := s.Put(ctx, entry); err != nil {
+		return "", err
+	}
+
+	family.CurrentTokenHash = tokenHash
+	family.ExpireTime = expireTime
+	entry, err = logical.StorageEntryJSON(refreshTokenFamilyPath+family.ClientID+"/"+family.ID, family)
+	if err != nil {
+		return "", err
+	}
+	if err := s.Put(ctx, entry); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (i *IdentityStore) getOIDCRefreshToken(ctx context.Context, s logical.Storage, tokenHash string) (*refreshToken, error) {
+	entry, err := s.Get(ctx, refreshTokenPath+tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	var token refreshToken
+	if err := entry.DecodeJSON(&token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (i *IdentityStore) getOIDCRefreshTokenFamily(ctx context.Context, s logical.Storage, clientID, familyID string) (*refreshTokenFamily, error) {
+	entry, err := s.Get(ctx, refreshTokenFamilyPath+clientID+"/"+familyID)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	var family refreshTokenFamily
+	if err := entry.DecodeJSON(&family); err != nil {
+		return nil, err
+	}
+
+	return &family, nil
+}
+
+// revokeOIDCRefreshTokens revokes all refresh tokens issued to the given
+// client. Orphaned refresh token entries are removed by expireOIDCRefreshTokens.
+func (i *IdentityStore) revokeOIDCRefreshTokens(ctx context.Context, s logical.Storage, clientID string) error {
+	i.oidcRefreshTokenLock.Lock()
+	defer i.oidcRefreshTokenLock.Unlock()
+
+	prefix := refreshTokenFamilyPath + clientID + "/"
+	familyIDs, err := s.List(ctx, prefix)
+	if err != nil {
+		return err
+	}
+	for _, familyID := range familyIDs {
+		if err := s.Delete(ctx, prefix+familyID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revokeOIDCRefreshToken revokes the given refresh token, along with every
+// other refresh token in its family, if it was issued to the given client.
+func (i *IdentityStore) revokeOIDCRefreshToken(ctx context.Context, s logical.Storage, client *client, token string) error {
+	i.oidcRefreshTokenLock.Lock()
+	defer i.oidcRefreshTokenLock.Unlock()
+
+	tokenHash := oidcTokenHash(token)
+	entry, err := i.getOIDCRefreshToken(ctx, s, tokenHash)
+	if err != nil {
+		return err
+	}
+	if entry == nil || entry.ClientID != client.ClientID {
+		return nil
+	}
+
+	if err := s.Delete(ctx, refreshTokenFamilyPath+entry.ClientID+"/"+entry.FamilyID); err != nil {
+		return err
+	}
+	return s.Delete(ctx, refreshTokenPath+tokenHash)
+}
+
+// expireOIDCRefreshTokens removes refresh tokens and refresh token families
+// that have expired, as well as refresh tokens whose family was revoked.
+func (i *IdentityStore) expireOIDCRefreshTokens(ctx context.Context, s logical.Storage) error {
+	i.oidcRefreshTokenLock.Lock()
+	defer i.oidcRefreshTokenLock.Unlock()
+
+	now := time.Now()
+
+	clientIDs, err := s.List(ctx, refreshTokenFamilyPath)
+	if err != nil {
+		return err
+	}
+	for _, clientID := range clientIDs {
+		clientID = strings.TrimSuffix(clientID, "/")
+		familyIDs, err := s.List(ctx, refreshTokenFamilyPath+clientID+"/")
+		if err != nil {
+			return err
+		}
+		for _, familyID := range familyIDs {
+			family, err := i.getOIDCRefreshTokenFamily(ctx, s, clientID, familyID)
+			if err != nil {
+				return err
+			}
+			if family != nil && now.After(family.ExpireTime) {
+				if err := s.Delete(ctx, refreshTokenFamilyPath+clientID+"/"+familyID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	tokenHashes, err := s.List(ctx, refreshTokenPath)
+	if err != nil {
+		return err
+	}
+	for _, tokenHash := range tokenHashes {
+		token, err := i.getOIDCRefreshToken(ctx, s, tokenHash)
+		if err != nil {
+			return err
+		}
+		if token == nil {
+			continue
+		}
+
+		expired := now.After(token.ExpireTime)
+		if !expired {
+			family, err := i.getOIDCRefreshTokenFamily(ctx, s, token.ClientID, token.FamilyID)
+			if err != nil {
+				return err
+			}
+			expired = family == nil
+		}
+		if expired {
+			if err := s.Delete(ctx, refreshTokenPath+tokenHash); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func oidcTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// pathOIDCDeviceAuthorization implements the device authorization endpoint.
+// Errors use the same format as the token endpoint. See details at
+// https://datatracker.ietf.org/doc/html/rfc8628#section-3.1.
+func (i *IdentityStore) pathOIDCDeviceAuthorization(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+
+	// Get the OIDC provider
+	name := d.Get("name").(string)
+	provider, err := i.getOIDCProvider(ctx, req.Storage, name)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if provider == nil {
+		return tokenResponse(nil, ErrTokenInvalidRequest, "provider not found")
+	}
+
+	client, errResp, err := i.authenticateTokenClient(ctx, req, d)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+	if !provider.allowedClientID(client.ClientID) {
+		return tokenResponse(nil, ErrTokenInvalidClient, "client is not authorized to use the provider")
+	}
+	if !client.allowedGrantType(grantTypeDeviceCode) {
+		return tokenResponse(nil, ErrTokenUnauthorizedClient, "client is not authorized to use the device authorization grant")
+	}
+
+	// Validate that the scope parameter contains the openid scope value
+	requestedScopes := strutil.ParseDedupAndSortStrings(d.Get("scope").(string), scopesDelimiter)
+	if !strutil.StrListContains(requestedScopes, openIDScope) {
+		return tokenResponse(nil, ErrTokenInvalidScope,
+			fmt.Sprintf("scope parameter must contain the %q value", openIDScope))
+	}
+
+	// Scope values that are not supported by the provider should be ignored
+	scopes := make([]string, 0)
+	for _, scope := range requestedScopes {
+		if strutil.StrListContains(provider.ScopesSupported, scope) && scope != openIDScope {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	deviceCode, err := base62.Random(deviceCodeLength)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+
+	// Generate a user code that isn't already pending verification
+	var userCode string
+	for attempt := 0; ; attempt++ {
+		userCode, err = generateUserCode()
+		if err != nil {
+			return tokenResponse(nil, ErrTokenServerError, err.Error())
+		}
+		_, exists, err := i.oidcDeviceCodeCache.Get(ns, userCodeCachePrefix+normalizeUserCode(userCode))
+		if err != nil {
+			return tokenResponse(nil, ErrTokenServerError, err.Error())
+		}
+		if !exists {
+			break
+		}
+		if attempt >= 10 {
+			return tokenResponse(nil, ErrTokenServerError, "failed to generate a unique user code")
+		}
+	}
+
+	entry := &deviceCodeCacheEntry{
+		provider:  name,
+		clientID:  client.ClientID,
+		userCode:  userCode,
+		scopes:    scopes,
+		expiresAt: time.Now().Add(deviceCodeTTL),
+		interval:  deviceCodePollInterval,
+	}
+	if err := i.oidcDeviceCodeCache.SetDefault(ns, deviceCode, entry); err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if err := i.oidcDeviceCodeCache.SetDefault(ns, userCodeCachePrefix+normalizeUserCode(userCode), deviceCode); err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+
+	verificationURI := strings.Replace(provider.effectiveIssuer, "/v1/", "/ui/vault/", 1) + "/device"
+	return tokenResponse(map[string]interface{}{
+		"device_code":               deviceCode,
+		"user_code":                 userCode,
+		"verification_uri":          verificationURI,
+		"verification_uri_complete": verificationURI + "?user_code=" + url.QueryEscape(userCode),
+		"expires_in":                int64(deviceCodeTTL.Seconds()),
+		"interval":                  int64(deviceCodePollInterval.Seconds()),
+	}, "", "")
+}
+
+// deviceCodeByUserCode returns the device code and cache entry of the pending
+// device authorization request identified by the given user code.
+func (i *IdentityStore) deviceCodeByUserCode(ns *namespace.Namespace, name, userCode string) (string, *deviceCodeCacheEntry, error) {
+	deviceCodeRaw, ok, err := i.oidcDeviceCodeCache.Get(ns, userCodeCachePrefix+normalizeUserCode(userCode))
+	if err != nil || !ok {
+		return "", nil, err
+	}
+	deviceCode := deviceCodeRaw.(string)
+
+	entryRaw, ok, err := i.oidcDeviceCodeCache.Get(ns, deviceCode)
+	if err != nil || !ok {
+		return "", nil, err
+	}
+	entry := entryRaw.(*deviceCodeCacheEntry)
+	if entry.provider != name || time.Now().After(entry.expiresAt) {
+		return "", nil, nil
+	}
+
+	return deviceCode, entry, nil
+}
+
+// pathOIDCReadDeviceVerification returns the details of a pending device
+// authorization request so that they can be presented to the end-user.
+func (i *IdentityStore) pathOIDCReadDeviceVerification(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, entry, err := i.deviceCodeByUserCode(ns, d.Get("name").(string), d.Get("user_code").(string))
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return logical.ErrorResponse("user code is invalid or expired"), nil
+	}
+
+	client, err := i.clientByID(ctx, req.Storage, entry.clientID)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return logical.ErrorResponse("client with client_id not found"), nil
+	}
+
+	return &logical.Response{
+		Data: map[string]interface{}{
+			"client_id":   client.ClientID,
+			"client_name": client.Name,
+			"scopes":      entry.scopes,
+			"expires_in":  int64(time.Until(entry.expiresAt).Seconds()),
+		},
+	}, nil
+}
+
+// pathOIDCDeviceVerify approves or denies a pending device authorization
+// request on behalf of the identity entity associated with the request.
+func (i *IdentityStore) pathOIDCDeviceVerify(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, entry, err := i.deviceCodeByUserCode(ns, d.Get("name").(string), d.Get("user_code").(string))
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return logical.ErrorResponse("user code is invalid or expired"), nil
+	}
+
+	// Validate that there is an identity entity associated with the request
+	if req.EntityID == "" {
+		return logical.ErrorResponse("identity entity must be associated with the request"), nil
+	}
+	entity, err := i.MemDBEntityByID(req.EntityID, false)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return logical.ErrorResponse("identity entity associated with the request not found"), nil
+	}
+
+	client, err := i.clientByID(ctx, req.Storage, entry.clientID)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return logical.ErrorResponse("client with client_id not found"), nil
+	}
+
+	// Validate that the entity is a member of the client's assignments
+	isMember, err := i.entityHasAssignment(ctx, req.Storage, entity, client.Assignments)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return logical.ErrorResponse("identity entity not authorized by client assignment"), nil
+	}
+
+	// Use the creation time of the token associated with the request
+	// for the auth_time claim
+	var authTime time.Time
+	te, err := i.tokenStorer.LookupToken(ctx, req.ClientToken)
+	if err != nil {
+		return nil, err
+	}
+	if te != nil {
+		authTime = time.Unix(te.CreationTime, 0).UTC()
+	}
+
+	entry.l.Lock()
+	defer entry.l.Unlock()
+
+	if entry.status != deviceCodePending {
+		return logical.ErrorResponse("device authorization request has already been completed"), nil
+	}
+
+	entry.status = deviceCodeDenied
+	if d.Get("approve").(bool) {
+		// Tie the tokens issued to the device to the end-user's session
+		sessionID, err := i.startOIDCSession(ctx, req.Storage, entry.provider, entity.ID, req.ClientToken, client.ClientID)
+		if err != nil {
+			entry.status = deviceCodePending
+			return nil, err
+		}
+
+		entry.status = deviceCodeApproved
+		entry.entityID = entity.ID
+		entry.authTime = authTime
+		entry.sessionID = sessionID
+	}
+
+	// The user code is single use
+	if err := i.oidcDeviceCodeCache.Delete(ns, userCodeCachePrefix+normalizeUserCode(entry.userCode)); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// deviceCodeGrant implements the token polling of the device authorization
+// grant. See details at https://datatracker.ietf.org/doc/html/rfc8628#section-3.4.
+func (i *IdentityStore) deviceCodeGrant(ctx context.Context, req *logical.Request, d *framework.FieldData, ns *namespace.Namespace, name string, provider *provider, client *client, key *namedKey) (*logical.Response, error) {
+	deviceCode := d.Get("device_code").(string)
+	if deviceCode == "" {
+		return tokenResponse(nil, ErrTokenInvalidRequest, "device_code parameter is required")
+	}
+
+	entryRaw, ok, err := i.oidcDeviceCodeCache.Get(ns, deviceCode)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if !ok {
+		return tokenResponse(nil, ErrTokenExpiredToken, "device code is invalid or expired")
+	}
+	entry, ok := entryRaw.(*deviceCodeCacheEntry)
+	if !ok {
+		return tokenResponse(nil, ErrTokenServerError, "device code is invalid or expired")
+	}
+
+	// Ensure the device code was issued to the authenticated client
+	if entry.clientID != client.ClientID {
+		return tokenResponse(nil, ErrTokenInvalidGrant, "device code was not issued to the client")
+	}
+
+	// Ensure the device code was issued by the provider
+	if entry.provider != name {
+		return tokenResponse(nil, ErrTokenInvalidGrant, "device code was not issued by the provider")
+	}
+
+	entry.l.Lock()
+	now := time.Now()
+	if now.After(entry.expiresAt) {
+		entry.l.Unlock()
+		i.deleteDeviceCode(ns, deviceCode, entry)
+		return tokenResponse(nil, ErrTokenExpiredToken, "device code is invalid or expired")
+	}
+
+	switch entry.status {
+	case deviceCodePending:
+		// Clients polling faster than the interval must slow down
+		// by increasing their interval by 5 seconds.
+		if !entry.lastPolled.IsZero() && now.Sub(entry.lastPolled) < entry.interval {
+			entry.interval += deviceCodePollInterval
+			entry.lastPolled = now
+			entry.l.Unlock()
+			return tokenResponse(nil, ErrTokenSlowDown, "polling too frequently")
+		}
+		entry.lastPolled = now
+		entry.l.Unlock()
+		return tokenResponse(nil, ErrTokenAuthorizationPending, "device authorization request is pending end-user verification")
+	case deviceCodeDenied:
+		entry.l.Unlock()
+		i.deleteDeviceCode(ns, deviceCode, entry)
+		return tokenResponse(nil, ErrTokenAccessDenied, "device authorization request was denied by the end-user")
+	case deviceCodeRedeemed:
+		entry.l.Unlock()
+		return tokenResponse(nil, ErrTokenInvalidGrant, "device code has already been used")
+	}
+
+	// The device code is single use
+	entry.status = deviceCodeRedeemed
+	entityID, authTime, sessionID := entry.entityID, entry.authTime, entry.sessionID
+	entry.l.Unlock()
+	i.deleteDeviceCode(ns, deviceCode, entry)
+
+	// Get the entity that approved the device authorization request
+	entity, err := i.MemDBEntityByID(entityID, true)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if entity == nil {
+		return tokenResponse(nil, ErrTokenInvalidRequest, "identity entity associated with the request not found")
+	}
+
+	// Validate that the entity is a member of the client's assignments
+	isMember, err := i.entityHasAssignment(ctx, req.Storage, entity, client.Assignments)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if !isMember {
+		return tokenResponse(nil, ErrTokenInvalidRequest, "identity entity not authorized by client assignment")
+	}
+
+	return i.issueOIDCTokens(ctx, req, ns, name, provider, client, key, entity, &tokenGrant{
+		entityID:  entityID,
+		scopes:    entry.scopes,
+		authTime:  authTime,
+		sessionID: sessionID,
+	})
+}
+
+// tokenExchangeGrant implements the token exchange grant. A Vault token
+// associated with an identity entity is exchanged for a JWT that is signed by
+// the client's key and scoped to the requested audience. See details at
+// https://datatracker.ietf.org/doc/html/rfc8693.
+func (i *IdentityStore) tokenExchangeGrant(ctx context.Context, req *logical.Request, d *framework.FieldData, ns *namespace.Namespace, name string, provider *provider, client *client, key *namedKey) (*logical.Response, error) {
+	if client.Type != confidential {
+		return tokenResponse(nil, ErrTokenUnauthorizedClient, "token exchange grant is only allowed for confidential clients")
+	}
+
+	subjectToken := d.Get("subject_token").(string)
+	if subjectToken == "" {
+		return tokenResponse(nil, ErrTokenInvalidRequest, "subject_token parameter is required")
+	}
+	if d.Get("subject_token_type").(string) != tokenTypeAccessToken {
+		return tokenResponse(nil, ErrTokenInvalidRequest,
+			fmt.Sprintf("subject_token_type parameter must be %q", tokenTypeAccessToken))
+	}
+	if requestedTokenType := d.Get("requested_token_type").(string); requestedTokenType != "" && requestedTokenType != tokenTypeJWT {
+		return tokenResponse(nil, ErrTokenInvalidRequest,
+			fmt.Sprintf("requested_token_type parameter must be %q", tokenTypeJWT))
+	}
+
+	// The audience must be allowed by the client
+	audience := d.Get("audience").(string)
+	switch {
+	case audience == "":
+		audience = client.ClientID
+	case audience != client.ClientID && !strutil.StrListContains(client.AllowedAudiences, audience):
+		return tokenResponse(nil, ErrTokenInvalidTarget, "audience is not allowed for the client")
+	}
+
+	// Scope values that are not supported by the provider should be ignored
+	scopes := make([]string, 0)
+	for _, scope := range strutil.ParseDedupAndSortStrings(d.Get("scope").(string), scopesDelimiter) {
+		if strutil.StrListContains(provider.ScopesSupported, scope) && scope != openIDScope {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	// Validate the subject token as if it were used to request an ID token
+	// from the authorization endpoint, so that its bound CIDRs, client
+	// certificate binding, scope and use count are all enforced
+	te, err := i.tokenStorer.UseSubjectToken(ctx, &logical.Request{
+		Operation:   logical.UpdateOperation,
+		Path:        "identity/oidc/provider/" + name + "/authorize",
+		ClientToken: subjectToken,
+		Connection:  req.Connection,
+	})
+	switch {
+	case errors.Is(err, logical.ErrPermissionDenied):
+		return tokenResponse(nil, ErrTokenInvalidGrant, "subject_token is invalid, expired or not permitted to use the provider")
+	case err != nil:
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	case te == nil:
+		return tokenResponse(nil, ErrTokenInvalidGrant, "subject_token is invalid or expired")
+	}
+
+	// Access tokens issued by the provider can't be exchanged, since they
+	// only grant access to the userinfo endpoint
+	if te.InternalMeta[accessTokenClientIDMeta] != "" {
+		return tokenResponse(nil, ErrTokenInvalidGrant, "subject_token must not be an access token issued by a provider")
+	}
+	if te.EntityID == "" {
+		return tokenResponse(nil, ErrTokenInvalidGrant, "subject_token is not associated with an identity entity")
+	}
+
+	entity, err := i.MemDBEntityByID(te.EntityID, true)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if entity == nil || entity.Disabled {
+		return tokenResponse(nil, ErrTokenInvalidGrant, "identity entity associated with the subject_token not found or disabled")
+	}
+
+	// Validate that the entity is a member of the client's assignments
+	isMember, err := i.entityHasAssignment(ctx, req.Storage, entity, client.Assignments)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if !isMember {
+		return tokenResponse(nil, ErrTokenInvalidGrant, "identity entity not authorized by client assignment")
+	}
+
+	issuedAt := time.Now()
+	token := idToken{
+		Namespace: ns.ID,
+		Issuer:    provider.effectiveIssuer,
+		Subject:   entity.ID,
+		Audience:  audience,
+		Expiry:    issuedAt.Add(client.AccessTokenTTL).Unix(),
+		IssuedAt:  issuedAt.Unix(),
+	}
+
+	// Populate each of the requested scope templates
+	templates, conflict, err := i.populateScopeTemplates(ctx, req.Storage, ns, entity, scopes...)
+	if !conflict && err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if conflict && err != nil {
+		return tokenResponse(nil, ErrTokenInvalidRequest, err.Error())
+	}
+
+	payload, err := token.generatePayload(i.Logger(), templates...)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+
+	// Sign the token using the client's key
+	signedToken, err := key.signPayload(payload)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+
+	// Track OIDC token generated for billing
+	if i.billingCounter != nil {
+		validity := client.AccessTokenTTL.Seconds()
+		attr := i.oidcBillingAttribution(ctx, ns, validity)
+		i.billingCounter.IncrementOidcTokenCount(validity, attr)
+	}
+
+	response := map[string]interface{}{
+		"access_token":      signedToken,
+		"issued_token_type": tokenTypeJWT,
+		"token_type":        "Bearer",
+		"expires_in":        int64(client.AccessTokenTTL.Seconds()),
+	}
+	if len(scopes) > 0 {
+		response["scope"] = strings.Join(scopes, scopesDelimiter)
+	}
+
+	return tokenResponse(response, "", "")
+}
+
+func (i *IdentityStore) deleteDeviceCode(ns *namespace.Namespace, deviceCode string, entry *deviceCodeCacheEntry) {
+	if err := i.oidcDeviceCodeCache.Delete(ns, deviceCode); err != nil {
+		i.Logger().Warn("failed to delete device code from cache", "err", err)
+	}
+	if err := i.oidcDeviceCodeCache.Delete(ns, userCodeCachePrefix+normalizeUserCode(entry.userCode)); err != nil {
+		i.Logger().Warn("failed to delete user code from cache", "err", err)
+	}
+}
+
+// generateUserCode returns a random user code formatted as XXXX-XXXX
+func generateUserCode() (string, error) {
+	var b strings.Builder
+	max := big.NewInt(int64(len(userCodeCharset)))
+	for n := 0; n < userCodeLength; n++ {
+		if n == userCodeLength/2 {
+			b.WriteByte('-')
+		}
+		idx, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(userCodeCharset[idx.Int64()])
+	}
+	return b.String(), nil
+}
+
+// normalizeUserCode removes the punctuation and whitespace that end-users
+// may include when entering a user code and ignores its case.
+func normalizeUserCode(userCode string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', ' ', '\t':
+			return -1
+		}
+		return r
+	}, strings.ToUpper(userCode))
+}
@@ -0,0 +1,561 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/vault/helper/namespace"
+	"github.com/hashicorp/vault/sdk/framework"
+	"github.com/hashicorp/vault/sdk/logical"
+)
+
+const (
+	// Back-channel logout constants. See details at
+	// https://openid.net/specs/openid-connect-backchannel-1_0.html.
+	backchannelLogoutEvent    = "http://schemas.openid.net/event/backchannel-logout"
+	backchannelLogoutTokenTTL = 2 * time.Minute
+	backchannelLogoutTimeout  = 10 * time.Second
+)
+
+// providerSession is an end-user session with a provider. A session is
+// started by the Vault token that authorizes a client, and it ends when that
+// token is no longer valid or when the end-user logs out. Access tokens and
+// refresh tokens issued in a session can't be used after the session ends.
+// The Vault token itself isn't stored; it's tracked by its accessor, or by its
+// expiration time if it's a batch token, which has no accessor.
+type providerSession struct {
+	ID              string    `json:"id"`
+	Provider        string    `json:"provider"`
+	EntityID        string    `json:"entity_id"`
+	TokenHash       string    `json:"token_hash"`
+	TokenAccessor   string    `json:"token_accessor"`
+	TokenExpireTime time.Time `json:"token_expire_time"`
+	ClientIDs       []string  `json:"client_ids"`
+	CreationTime    time.Time `json:"creation_time"`
+}
+
+// revokedAccessToken is the storage entry for a revoked access token. Access
+// tokens are batch tokens that can't be revoked, so revoked access tokens are
+// tracked until they expire. Entries are keyed by the SHA-256 hash of the token.
+type revokedAccessToken struct {
+	ExpireTime time.Time `json:"expire_time"`
+}
+
+type sessionTokenIndex struct {
+	SessionID string `json:"session_id"`
+}
+
+func sessionTokenKey(providerName, tokenHash string) string {
+	return sessionTokenPath + providerName + "/" + tokenHash
+}
+
+// startOIDCSession returns the ID of the provider session tied to the given
+// Vault token, starting a new session if one doesn't exist. The client is
+// recorded as a participant of the session so that it can be notified when
+// the session ends.
+func (i *IdentityStore) startOIDCSession(ctx context.Context, s logical.Storage, providerName, entityID, token, clientID string) (string, error) {
+	i.oidcSessionLock.Lock()
+	defer i.oidcSessionLock.Unlock()
+
+	session, err := i.oidcSessionByToken(ctx, s, providerName, token)
+	if err != nil {
+		return "", err
+	}
+	if session == nil || session.EntityID != entityID {
+		te, err := i.tokenStorer.LookupToken(ctx, token)
+		if err != nil {
+			return "", err
+		}
+		if te == nil {
+			return "", errors.New("token associated with the session not found")
+		}
+
+		sessionID, err := uuid.GenerateUUID()
+		if err != nil {
+			return "", err
+		}
+		session = &providerSession{
+			ID:            sessionID,
+			Provider:      providerName,
+			EntityID:      entityID,
+			TokenHash:     oidcTokenHash(token),
+			TokenAccessor: te.Accessor,
+			CreationTime:  time.Now(),
+		}
+		if te.Accessor == "" && te.TTL != 0 {
+			session.TokenExpireTime = time.Unix(te.CreationTime, 0).Add(te.TTL)
+		}
+
+		entry, err := logical.StorageEntryJSON(sessionTokenKey(providerName, session.TokenHash), &sessionTokenIndex{
+			SessionID: sessionID,
+		})
+		if err != nil {
+			return "", err
+		}
+		if err := s.Put(ctx, entry); err != nil {
+			return "", err
+		}
+	}
+
+	if strutil.StrListContains(session.ClientIDs, clientID) {
+		return session.ID, nil
+	}
+	session.ClientIDs = strutil.RemoveDuplicates(append(session.ClientIDs, clientID), false)
+
+	entry, err := logical.StorageEntryJSON(sessionPath+session.ID, session)
+	if err != nil {
+		return "", err
+	}
+	if err := s.Put(ctx, entry); err != nil {
+		return "", err
+	}
+
+	return session.ID, nil
+}
+
+func (i *IdentityStore) getOIDCSession(ctx context.Context, s logical.Storage, sessionID string) (*providerSession, error) {
+	entry, err := s.Get(ctx, sessionPath+sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	var session providerSession
+	if err := entry.DecodeJSON(&session); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (i *IdentityStore) oidcSessionByToken(ctx context.Context, s logical.Storage, providerName, token string) (*providerSession, error) {
+	entry, err := s.Get(ctx, sessionTokenKey(providerName, oidcTokenHash(token)))
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	var index sessionTokenIndex
+	if err := entry.DecodeJSON(&index); err != nil {
+		return nil, err
+	}
+
+	return i.getOIDCSession(ctx, s, index.SessionID)
+}
+
+// oidcSessionActive returns true if the given session exists and the Vault
+// token that started it is still valid. Sessions whose token is no longer
+// valid are ended by expireOIDCSessions.
+func (i *IdentityStore) oidcSessionActive(ctx context.Context, s logical.Storage, sessionID string) (bool, error) {
+	session, err := i.getOIDCSession(ctx, s, sessionID)
+	if err != nil || session == nil {
+		return false, err
+	}
+
+	return i.oidcSessionTokenValid(ctx, session)
+}
+
+// oidcSessionTokenValid returns true if the Vault token that started the
+// given session is still valid.
+func (i *IdentityStore) oidcSessionTokenValid(ctx context.Context, session *providerSession) (bool, error) {
+	if session.TokenAccessor == "" {
+		return session.TokenExpireTime.IsZero() || time.Now().Before(session.TokenExpireTime), nil
+	}
+
+	te, err := i.tokenStorer.LookupTokenByAccessor(ctx, session.TokenAccessor)
+	if err != nil {
+		return false, err
+	}
+
+	return te != nil, nil
+}
+
+// oidcAccessTokenActive returns true if the given access token hasn't been
+// revoked and the session it was issued in, if any, hasn't ended.
+func (i *IdentityStore) oidcAccessTokenActive(ctx context.Context, s logical.Storage, token string, te *logical.TokenEntry) (bool, error) {
+	entry, err := s.Get(ctx, revokedAccessTokenPath+oidcTokenHash(token))
+	if err != nil {
+		return false, err
+	}
+	if entry != nil {
+		return false, nil
+	}
+
+	sessionID := te.InternalMeta[accessTokenSessionIDMeta]
+	if sessionID == "" {
+		return true, nil
+	}
+
+	return i.oidcSessionActive(ctx, s, sessionID)
+}
+
+// endOIDCSession ends the given session and sends a logout token to the
+// back-channel logout URI of each client that participated in the session.
+func (i *IdentityStore) endOIDCSession(ctx context.Context, s logical.Storage, session *providerSession) error {
+	i.oidcSessionLock.Lock()
+	defer i.oidcSessionLock.Unlock()
+
+	if err := s.Delete(ctx, sessionTokenKey(session.Provider, session.TokenHash)); err != nil {
+		return err
+	}
+	if err := s.Delete(ctx, sessionPath+session.ID); err != nil {
+		return err
+	}
+
+	provider, err := i.getOIDCProvider(ctx, s, session.Provider)
+	if err != nil {
+		return err
+	}
+	if provider == nil {
+		return nil
+	}
+
+	for _, clientID := range session.ClientIDs {
+		if err := i.sendBackchannelLogout(ctx, s, provider, session, clientID); err != nil {
+			i.Logger().Warn("failed to send back-channel logout", "client_id", clientID, "err", err)
+		}
+	}
+
+	return nil
+}
+
+// sendBackchannelLogout sends a logout token for the given session to the
+// client's back-channel logout URI. The request is sent asynchronously so
+// that unresponsive clients don't delay the end of the session. See details at
+// https://openid.net/specs/openid-connect-backchannel-1_0.html#BCRequest.
+func (i *IdentityStore) sendBackchannelLogout(ctx context.Context, s logical.Storage, provider *provider, session *providerSession, clientID string) error {
+	client, err := i.clientByID(ctx, s, clientID)
+	if err != nil {
+		return err
+	}
+	if client == nil || client.BackchannelLogoutURI == "" {
+		return nil
+	}
+
+	key, err := i.getNamedKey(ctx, s, client.Key)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return fmt.Errorf("client key %q not found", client.Key)
+	}
+
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+	jti, err := uuid.GenerateUUID()
+	if err != nil {
+		return err
+	}
+
+	issuedAt := time.Now()
+	payload, err := json.Marshal(map[string]interface{}{
+		"iss":       provider.effectiveIssuer,
+		"namespace": ns.ID,
+		"sub":       session.EntityID,
+		"aud":       client.ClientID,
+		"iat":       issuedAt.Unix(),
+		"exp":       issuedAt.Add(backchannelLogoutTokenTTL).Unix(),
+		"jti":       jti,
+		"sid":       session.ID,
+		"events": map[string]interface{}{
+			backchannelLogoutEvent: map[string]interface{}{},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	logoutToken, err := key.signPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	i.backchannelLogoutWG.Add(1)
+	go func(uri string) {
+		defer i.backchannelLogoutWG.Done()
+
+		ctx, cancel := context.WithTimeout(i.backchannelLogoutCtx, backchannelLogoutTimeout)
+		defer cancel()
+
+		body := url.Values{"logout_token": {logoutToken}}.Encode()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, strings.NewReader(body))
+		if err != nil {
+			i.Logger().Warn("failed to create back-channel logout request", "client_id", clientID, "err", err)
+			return
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		resp, err := cleanhttp.DefaultClient().Do(req)
+		if err != nil {
+			i.Logger().Warn("failed to send back-channel logout request", "client_id", clientID, "err", err)
+			return
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+			i.Logger().Warn("client rejected back-channel logout request", "client_id", clientID, "status", resp.StatusCode)
+		}
+	}(client.BackchannelLogoutURI)
+
+	return nil
+}
+
+// expireOIDCSessions ends sessions whose Vault token is no longer valid and
+// removes revoked access tokens that have expired.
+func (i *IdentityStore) expireOIDCSessions(ctx context.Context, s logical.Storage) error {
+	sessionIDs, err := s.List(ctx, sessionPath)
+	if err != nil {
+		return err
+	}
+	for _, sessionID := range sessionIDs {
+		session, err := i.getOIDCSession(ctx, s, sessionID)
+		if err != nil {
+			return err
+		}
+		if session == nil {
+			continue
+		}
+
+		valid, err := i.oidcSessionTokenValid(ctx, session)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			if err := i.endOIDCSession(ctx, s, session); err != nil {
+				return err
+			}
+		}
+	}
+
+	now := time.Now()
+	tokenHashes, err := s.List(ctx, revokedAccessTokenPath)
+	if err != nil {
+		return err
+	}
+	for _, tokenHash := range tokenHashes {
+		entry, err := s.Get(ctx, revokedAccessTokenPath+tokenHash)
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			continue
+		}
+
+		var revoked revokedAccessToken
+		if err := entry.DecodeJSON(&revoked); err != nil {
+			return err
+		}
+		if now.After(revoked.ExpireTime) {
+			if err := s.Delete(ctx, revokedAccessTokenPath+tokenHash); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// pathOIDCRevoke implements the token revocation endpoint. Clients may only
+// revoke tokens that were issued to them. A successful response is returned
+// for tokens that are invalid or unknown. See details at
+// https://datatracker.ietf.org/doc/html/rfc7009#section-2.
+func (i *IdentityStore) pathOIDCRevoke(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	// Get the OIDC provider
+	name := d.Get("name").(string)
+	provider, err := i.getOIDCProvider(ctx, req.Storage, name)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if provider == nil {
+		return tokenResponse(nil, ErrTokenInvalidRequest, "provider not found")
+	}
+
+	client, errResp, err := i.authenticateTokenClient(ctx, req, d)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+	if !provider.allowedClientID(client.ClientID) {
+		return tokenResponse(nil, ErrTokenInvalidClient, "client is not authorized to use the provider")
+	}
+
+	token := d.Get("token").(string)
+	if token == "" {
+		return tokenResponse(nil, ErrTokenInvalidRequest, "token parameter is required")
+	}
+
+	// Refresh tokens are identified by their prefix, so the optional
+	// token_type_hint parameter isn't needed to locate the token.
+	if strings.HasPrefix(token, refreshTokenPrefix) {
+		if err := i.revokeOIDCRefreshToken(ctx, req.Storage, client, token); err != nil {
+			return tokenResponse(nil, ErrTokenServerError, err.Error())
+		}
+		return tokenResponse(map[string]interface{}{}, "", "")
+	}
+
+	te, err := i.tokenStorer.LookupToken(ctx, token)
+	if err != nil {
+		return tokenResponse(nil, ErrTokenServerError, err.Error())
+	}
+	if te != nil && te.InternalMeta[accessTokenClientIDMeta] == client.ClientID {
+		entry, err := logical.StorageEntryJSON(revokedAccessTokenPath+oidcTokenHash(token), &revokedAccessToken{
+			ExpireTime: time.Unix(te.CreationTime, 0).Add(te.TTL),
+		})
+		if err != nil {
+			return tokenResponse(nil, ErrTokenServerError, err.Error())
+		}
+		if err := req.Storage.Put(ctx, entry); err != nil {
+			return tokenResponse(nil, ErrTokenServerError, err.Error())
+		}
+	}
+
+	return tokenResponse(map[string]interface{}{}, "", "")
+}
+
+// pathOIDCLogout implements RP-initiated logout. It ends the end-user's
+// session with the provider that is tied to the Vault token of the request and
+// returns the validated post-logout redirect URI, if any. See details at
+// https://openid.net/specs/openid-connect-rpinitiated-1_0.html.
+func (i *IdentityStore) pathOIDCLogout(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	name := d.Get("name").(string)
+	provider, err := i.getOIDCProvider(ctx, req.Storage, name)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return logical.ErrorResponse("provider not found"), nil
+	}
+
+	clientID := d.Get("client_id").(string)
+
+	// Validate the ID token that was previously issued to the client
+	var hintSessionID string
+	if idTokenHint := d.Get("id_token_hint").(string); idTokenHint != "" {
+		claims, err := i.verifyIDTokenHint(ctx, req.Storage, provider, idTokenHint)
+		if err != nil {
+			return logical.ErrorResponse("invalid id_token_hint: %s", err), nil
+		}
+		if req.EntityID != "" && claims.Subject != req.EntityID {
+			return logical.ErrorResponse("id_token_hint was not issued to the identity entity associated with the request"), nil
+		}
+		if clientID != "" && !claims.Audience.Contains(clientID) {
+			return logical.ErrorResponse("client_id does not match the audience of the id_token_hint"), nil
+		}
+		if clientID == "" && len(claims.Audience) > 0 {
+			clientID = claims.Audience[0]
+		}
+		hintSessionID = claims.SessionID
+	}
+
+	// Validate the post-logout redirect URI
+	redirectURI := d.Get("post_logout_redirect_uri").(string)
+	if redirectURI != "" {
+		if clientID == "" {
+			return logical.ErrorResponse("client_id or id_token_hint is required with post_logout_redirect_uri"), nil
+		}
+		client, err := i.clientByID(ctx, req.Storage, clientID)
+		if err != nil {
+			return nil, err
+		}
+		if client == nil {
+			return logical.ErrorResponse("client with client_id not found"), nil
+		}
+		if !validRedirect(redirectURI, client.PostLogoutRedirectURIs) {
+			return logical.ErrorResponse("post_logout_redirect_uri is not allowed for the client"), nil
+		}
+
+		if state := d.Get("state").(string); state != "" {
+			u, err := url.Parse(redirectURI)
+			if err != nil {
+				return nil, err
+			}
+			query := u.Query()
+			query.Set("state", state)
+			u.RawQuery = query.Encode()
+			redirectURI = u.String()
+		}
+	}
+
+	// Find the session tied to the Vault token of the request. The session
+	// identified by the id_token_hint is used if the end-user has since
+	// authenticated to Vault with a different token.
+	session, err := i.oidcSessionByToken(ctx, req.Storage, name, req.ClientToken)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil && hintSessionID != "" {
+		session, err = i.getOIDCSession(ctx, req.Storage, hintSessionID)
+		if err != nil {
+			return nil, err
+		}
+		if session != nil && (session.Provider != name || session.EntityID != req.EntityID) {
+			session = nil
+		}
+	}
+	if session != nil {
+		if err := i.endOIDCSession(ctx, req.Storage, session); err != nil {
+			return nil, err
+		}
+	}
+
+	return &logical.Response{
+		Data: map[string]interface{}{
+			"redirect_uri": redirectURI,
+		},
+	}, nil
+}
+
+type idTokenHintClaims struct {
+	jwt.Claims
+	SessionID string `json:"sid"`
+}
+
+// verifyIDTokenHint verifies the signature and issuer of an ID token issued by
+// the provider. Expired ID tokens are accepted, since they're commonly used to
+// log out after the end-user's session with the client has expired.
+func (i *IdentityStore) verifyIDTokenHint(ctx context.Context, s logical.Storage, provider *provider, idTokenHint string) (*idTokenHintClaims, error) {
+	parsedJWT, err := jwt.ParseSigned(idTokenHint)
+	if err != nil {
+		return nil, err
+	}
+
+	jwks, err := i.generatePublicJWKS(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims idTokenHintClaims
+	var valid bool
+	for _, key := range jwks.Keys {
+		if err := parsedJWT.Claims(key, &claims); err == nil {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, fmt.Errorf("unable to validate the token signature")
+	}
+	if claims.Issuer != provider.effectiveIssuer {
+		return nil, fmt.Errorf("token was not issued by the provider")
+	}
+
+	return &claims, nil
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"sort"
 	"strings"
 	"testing"
@@ -1898,14 +1899,21 @@ func TestOIDC_Path_OIDC_ProviderClient(t *testing.T) {
 	})
 	expectSuccess(t, resp, err)
 	expected := map[string]interface{}{
-		"redirect_uris":    []string{},
-		"assignments":      []string{},
-		"key":              "test-key",
-		"id_token_ttl":     int64(60),
-		"access_token_ttl": int64(86400),
-		"client_id":        resp.Data["client_id"],
-		"client_secret":    resp.Data["client_secret"],
-		"client_type":      confidential.String(),
+		"redirect_uris":         []string{},
+		"assignments":           []string{},
+		"key":                   "test-key",
+		"id_token_ttl":          int64(60),
+		"access_token_ttl":      int64(86400),
+		"client_id":             resp.Data["client_id"],
+		"client_secret":         resp.Data["client_secret"],
+		"client_type":           confidential.String(),
+		"grant_types":           []string{"authorization_code"},
+		"refresh_token_ttl":     int64(2592000),
+		"refresh_token_max_ttl": int64(7776000),
+		"allowed_audiences":     []string{},
+
+		"post_logout_redirect_uris": []string{},
+		"backchannel_logout_uri":    "",
 	}
 	if diff := deep.Equal(expected, resp.Data); diff != nil {
 		t.Fatal(diff)
@@ -1953,14 +1961,21 @@ func TestOIDC_Path_OIDC_ProviderClient(t *testing.T) {
 	})
 	expectSuccess(t, resp, err)
 	expected = map[string]interface{}{
-		"redirect_uris":    []string{"http://localhost:3456/callback"},
-		"assignments":      []string{"my-assignment"},
-		"key":              "test-key",
-		"id_token_ttl":     int64(90),
-		"access_token_ttl": int64(60),
-		"client_id":        resp.Data["client_id"],
-		"client_secret":    resp.Data["client_secret"],
-		"client_type":      confidential.String(),
+		"redirect_uris":         []string{"http://localhost:3456/callback"},
+		"assignments":           []string{"my-assignment"},
+		"key":                   "test-key",
+		"id_token_ttl":          int64(90),
+		"access_token_ttl":      int64(60),
+		"client_id":             resp.Data["client_id"],
+		"client_secret":         resp.Data["client_secret"],
+		"client_type":           confidential.String(),
+		"grant_types":           []string{"authorization_code"},
+		"refresh_token_ttl":     int64(2592000),
+		"refresh_token_max_ttl": int64(7776000),
+		"allowed_audiences":     []string{},
+
+		"post_logout_redirect_uris": []string{},
+		"backchannel_logout_uri":    "",
 	}
 	if diff := deep.Equal(expected, resp.Data); diff != nil {
 		t.Fatal(diff)
@@ -2033,13 +2048,20 @@ func TestOIDC_Path_OIDC_ProviderClient_Deduplication(t *testing.T) {
 	})
 	expectSuccess(t, resp, err)
 	expected := map[string]interface{}{
-		"redirect_uris":    []string{"http://example.com", "http://notduplicate.com"},
-		"assignments":      []string{"test-assignment1"},
-		"key":              "test-key",
-		"id_token_ttl":     int64(60),
-		"access_token_ttl": int64(86400),
-		"client_id":        resp.Data["client_id"],
-		"client_type":      public.String(),
+		"redirect_uris":         []string{"http://example.com", "http://notduplicate.com"},
+		"assignments":           []string{"test-assignment1"},
+		"key":                   "test-key",
+		"id_token_ttl":          int64(60),
+		"access_token_ttl":      int64(86400),
+		"client_id":             resp.Data["client_id"],
+		"client_type":           public.String(),
+		"grant_types":           []string{"authorization_code"},
+		"refresh_token_ttl":     int64(2592000),
+		"refresh_token_max_ttl": int64(7776000),
+		"allowed_audiences":     []string{},
+
+		"post_logout_redirect_uris": []string{},
+		"backchannel_logout_uri":    "",
 	}
 	if diff := deep.Equal(expected, resp.Data); diff != nil {
 		t.Fatal(diff)
@@ -2094,14 +2116,21 @@ func TestOIDC_Path_OIDC_ProviderClient_Update(t *testing.T) {
 	})
 	expectSuccess(t, resp, err)
 	expected := map[string]interface{}{
-		"redirect_uris":    []string{"http://localhost:3456/callback"},
-		"assignments":      []string{"my-assignment"},
-		"key":              "test-key",
-		"id_token_ttl":     int64(120),
-		"access_token_ttl": int64(3600),
-		"client_id":        resp.Data["client_id"],
-		"client_secret":    resp.Data["client_secret"],
-		"client_type":      confidential.String(),
+		"redirect_uris":         []string{"http://localhost:3456/callback"},
+		"assignments":           []string{"my-assignment"},
+		"key":                   "test-key",
+		"id_token_ttl":          int64(120),
+		"access_token_ttl":      int64(3600),
+		"client_id":             resp.Data["client_id"],
+		"client_secret":         resp.Data["client_secret"],
+		"client_type":           confidential.String(),
+		"grant_types":           []string{"authorization_code"},
+		"refresh_token_ttl":     int64(2592000),
+		"refresh_token_max_ttl": int64(7776000),
+		"allowed_audiences":     []string{},
+
+		"post_logout_redirect_uris": []string{},
+		"backchannel_logout_uri":    "",
 	}
 	if diff := deep.Equal(expected, resp.Data); diff != nil {
 		t.Fatal(diff)
@@ -2128,14 +2157,21 @@ func TestOIDC_Path_OIDC_ProviderClient_Update(t *testing.T) {
 	})
 	expectSuccess(t, resp, err)
 	expected = map[string]interface{}{
-		"redirect_uris":    []string{"http://localhost:3456/callback2"},
-		"assignments":      []string{"my-assignment"},
-		"key":              "test-key",
-		"id_token_ttl":     int64(30),
-		"access_token_ttl": int64(60),
-		"client_id":        resp.Data["client_id"],
-		"client_secret":    resp.Data["client_secret"],
-		"client_type":      confidential.String(),
+		"redirect_uris":         []string{"http://localhost:3456/callback2"},
+		"assignments":           []string{"my-assignment"},
+		"key":                   "test-key",
+		"id_token_ttl":          int64(30),
+		"access_token_ttl":      int64(60),
+		"client_id":             resp.Data["client_id"],
+		"client_secret":         resp.Data["client_secret"],
+		"client_type":           confidential.String(),
+		"grant_types":           []string{"authorization_code"},
+		"refresh_token_ttl":     int64(2592000),
+		"refresh_token_max_ttl": int64(7776000),
+		"allowed_audiences":     []string{},
+
+		"post_logout_redirect_uris": []string{},
+		"backchannel_logout_uri":    "",
 	}
 	if diff := deep.Equal(expected, resp.Data); diff != nil {
 		t.Fatal(diff)
@@ -2396,6 +2432,10 @@ func TestOIDC_Path_OIDC_ProviderScope_TemplateValidation(t *testing.T) {
 			templ:         `{"c_hash": "hijklmn", "other": "test"}`,
 			restrictedKey: "c_hash",
 		},
+		{
+			templ:         `{"sid": "opqrstu", "other": "test"}`,
+			restrictedKey: "sid",
+		},
 	}
 	for _, tc := range testCases {
 		encodedTempl := base64.StdEncoding.EncodeToString([]byte(tc.templ))
@@ -2411,7 +2451,7 @@ func TestOIDC_Path_OIDC_ProviderScope_TemplateValidation(t *testing.T) {
 		})
 		expectError(t, resp, err)
 		errString := fmt.Sprintf(
-			"top level key %q not allowed. Restricted keys: iat, aud, exp, iss, sub, namespace, nonce, auth_time, at_hash, c_hash",
+			"top level key %q not allowed. Restricted keys: iat, aud, exp, iss, sub, namespace, nonce, auth_time, at_hash, c_hash, sid",
 			tc.restrictedKey,
 		)
 		// validate error message
@@ -3634,11 +3674,22 @@ func TestOIDC_Path_OpenIDProviderConfig(t *testing.T) {
 		AuthorizationEndpoint: "/ui/vault/identity/oidc/provider/test-provider/authorize",
 		TokenEndpoint:         basePath + "/token",
 		UserinfoEndpoint:      basePath + "/userinfo",
-		GrantTypes:            []string{"authorization_code"},
-		AuthMethods:           []string{"none", "client_secret_basic", "client_secret_post"},
-		RequestParameter:      false,
-		RequestURIParameter:   false,
-		CodeChallengeMethods:  []string{codeChallengeMethodPlain, codeChallengeMethodS256},
+		DeviceEndpoint:        basePath + "/device",
+		RevocationEndpoint:    basePath + "/revoke",
+		EndSessionEndpoint:    "/ui/vault/identity/oidc/provider/test-provider/logout",
+		GrantTypes: []string{
+			"authorization_code",
+			"client_credentials",
+			"refresh_token",
+			"urn:ietf:params:oauth:grant-type:device_code",
+			"urn:ietf:params:oauth:grant-type:token-exchange",
+		},
+		AuthMethods:              []string{"none", "client_secret_basic", "client_secret_post"},
+		RequestParameter:         false,
+		RequestURIParameter:      false,
+		CodeChallengeMethods:     []string{codeChallengeMethodPlain, codeChallengeMethodS256},
+		BackchannelLogout:        true,
+		BackchannelLogoutSession: true,
 	}
 	discoveryResp := &providerDiscovery{}
 	json.Unmarshal(resp.Data["http_raw_body"].([]byte), discoveryResp)
@@ -3691,11 +3742,22 @@ func TestOIDC_Path_OpenIDProviderConfig(t *testing.T) {
 		AuthorizationEndpoint: testIssuer + "/ui/vault/identity/oidc/provider/test-provider/authorize",
 		TokenEndpoint:         basePath + "/token",
 		UserinfoEndpoint:      basePath + "/userinfo",
-		GrantTypes:            []string{"authorization_code"},
-		AuthMethods:           []string{"none", "client_secret_basic", "client_secret_post"},
-		RequestParameter:      false,
-		RequestURIParameter:   false,
-		CodeChallengeMethods:  []string{codeChallengeMethodPlain, codeChallengeMethodS256},
+		DeviceEndpoint:        basePath + "/device",
+		RevocationEndpoint:    basePath + "/revoke",
+		EndSessionEndpoint:    testIssuer + "/ui/vault/identity/oidc/provider/test-provider/logout",
+		GrantTypes: []string{
+			"authorization_code",
+			"client_credentials",
+			"refresh_token",
+			"urn:ietf:params:oauth:grant-type:device_code",
+			"urn:ietf:params:oauth:grant-type:token-exchange",
+		},
+		AuthMethods:              []string{"none", "client_secret_basic", "client_secret_post"},
+		RequestParameter:         false,
+		RequestURIParameter:      false,
+		CodeChallengeMethods:     []string{codeChallengeMethodPlain, codeChallengeMethodS256},
+		BackchannelLogout:        true,
+		BackchannelLogoutSession: true,
 	}
 	discoveryResp = &providerDiscovery{}
 	json.Unmarshal(resp.Data["http_raw_body"].([]byte), discoveryResp)
@@ -3791,3 +3853,911 @@ func TestGetMaxTokenTTL(t *testing.T) {
 		})
 	}
 }
+
+// testTokenEndpointResponse parses the raw JSON body of a response from the
+// token or device authorization endpoint
+func testTokenEndpointResponse(t *testing.T, resp *logical.Response) map[string]interface{} {
+	t.Helper()
+
+	require.NotNil(t, resp)
+	require.Equal(t, "no-store", resp.Data[logical.HTTPCacheControlHeader])
+	body := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(resp.Data[logical.HTTPRawBody].([]byte), &body))
+	return body
+}
+
+// testJWTClaims returns the claims of the given signed JWT without
+// verifying its signature
+func testJWTClaims(t *testing.T, token string) map[string]interface{} {
+	t.Helper()
+
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	claims := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(payload, &claims))
+	return claims
+}
+
+func testClientGrantTypesReq(s logical.Storage, grantTypes ...string) *logical.Request {
+	return &logical.Request{
+		Storage:   s,
+		Path:      "oidc/client/test-client",
+		Operation: logical.UpdateOperation,
+		Data: map[string]interface{}{
+			"grant_types": grantTypes,
+		},
+	}
+}
+
+// TestOIDC_Path_OIDC_Client_GrantTypes tests the validation of the grant
+// types that a client may use
+func TestOIDC_Path_OIDC_Client_GrantTypes(t *testing.T) {
+	c, _, _ := TestCoreUnsealed(t)
+	ctx := namespace.RootContext(nil)
+	s := new(logical.InmemStorage)
+
+	setupOIDCCommon(t, c, s)
+
+	// Unsupported grant types are rejected
+	resp, err := c.identityStore.HandleRequest(ctx, testClientGrantTypesReq(s, "implicit"))
+	expectError(t, resp, err)
+
+	// The client credentials grant requires a confidential client
+	resp, err = c.identityStore.HandleRequest(ctx, &logical.Request{
+		Storage:   s,
+		Path:      "oidc/client/public-client",
+		Operation: logical.CreateOperation,
+		Data: map[string]interface{}{
+			"key":         "test-key",
+			"client_type": "public",
+			"grant_types": []string{"authorization_code", "client_credentials"},
+		},
+	})
+	expectError(t, resp, err)
+
+	resp, err = c.identityStore.HandleRequest(ctx, testClientGrantTypesReq(s,
+		"client_credentials", "refresh_token", "refresh_token"))
+	expectSuccess(t, resp, err)
+
+	resp, err = c.identityStore.HandleRequest(ctx, &logical.Request{
+		Storage:   s,
+		Path:      "oidc/client/test-client",
+		Operation: logical.ReadOperation,
+	})
+	expectSuccess(t, resp, err)
+	require.Equal(t, []string{"client_credentials", "refresh_token"}, resp.Data["grant_types"])
+	require.Equal(t, int64(2592000), resp.Data["refresh_token_ttl"])
+	require.Equal(t, int64(7776000), resp.Data["refresh_token_max_ttl"])
+}
+
+// TestOIDC_Path_OIDC_Token_ClientCredentials tests the client credentials
+// grant of the token endpoint
+func TestOIDC_Path_OIDC_Token_ClientCredentials(t *testing.T) {
+	c, _, _ := TestCoreUnsealed(t)
+	ctx := namespace.RootContext(nil)
+	s := new(logical.InmemStorage)
+
+	_, _, _, clientID, clientSecret := setupOIDCCommon(t, c, s)
+
+	tokenReq := func(scope string) *logical.Request {
+		req := testTokenReq(s, "", clientID, clientSecret)
+		req.Data = map[string]interface{}{
+			"grant_type": "client_credentials",
+			"scope":      scope,
+		}
+		return req
+	}
+
+	// The client isn't allowed to use the grant type by default
+	resp, err := c.identityStore.HandleRequest(ctx, tokenReq(""))
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenUnauthorizedClient, testTokenEndpointResponse(t, resp)["error"])
+
+	resp, err = c.identityStore.HandleRequest(ctx, testClientGrantTypesReq(s, "client_credentials"))
+	expectSuccess(t, resp, err)
+
+	// Scopes must be supported by the provider
+	resp, err = c.identityStore.HandleRequest(ctx, tokenReq("unknown-scope"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.Data[logical.HTTPStatusCode])
+	require.Equal(t, ErrTokenInvalidScope, testTokenEndpointResponse(t, resp)["error"])
+
+	resp, err = c.identityStore.HandleRequest(ctx, tokenReq("test-scope"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Data[logical.HTTPStatusCode])
+	body := testTokenEndpointResponse(t, resp)
+	require.Equal(t, "Bearer", body["token_type"])
+	require.Equal(t, "test-scope", body["scope"])
+	require.EqualValues(t, 86400, body["expires_in"])
+	require.NotContains(t, body, "id_token")
+	require.NotContains(t, body, "refresh_token")
+
+	claims := testJWTClaims(t, body["access_token"].(string))
+	require.Equal(t, clientID, claims["sub"])
+	require.Equal(t, clientID, claims["aud"])
+	require.Equal(t, clientID, claims["client_id"])
+	require.Equal(t, "test-scope", claims["scope"])
+	require.NotEmpty(t, claims["jti"])
+}
+
+// TestOIDC_Path_OIDC_Token_RefreshToken tests the issuance, rotation, reuse
+// detection, and revocation of refresh tokens
+func TestOIDC_Path_OIDC_Token_RefreshToken(t *testing.T) {
+	c, _, _ := TestCoreUnsealed(t)
+	ctx := namespace.RootContext(nil)
+	s := new(logical.InmemStorage)
+
+	entityID, _, _, clientID, clientSecret := setupOIDCCommon(t, c, s)
+
+	resp, err := c.identityStore.HandleRequest(ctx, testClientGrantTypesReq(s, "authorization_code", "refresh_token"))
+	expectSuccess(t, resp, err)
+
+	te := &logical.TokenEntry{
+		Path:         "test",
+		Policies:     []string{"default"},
+		TTL:          time.Hour * 24,
+		CreationTime: time.Now().Unix(),
+	}
+	testMakeTokenDirectly(t, c.tokenStore, te)
+
+	// authorize runs the authorization code flow and returns the refresh token
+	authorize := func() string {
+		t.Helper()
+
+		authorizeReq := testAuthorizeReq(s, clientID)
+		authorizeReq.EntityID = entityID
+		authorizeReq.ClientToken = te.ID
+		resp, err := c.identityStore.HandleRequest(ctx, authorizeReq)
+		expectSuccess(t, resp, err)
+		var authRes struct {
+			Code string `json:"code"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data[logical.HTTPRawBody].([]byte), &authRes))
+
+		resp, err = c.identityStore.HandleRequest(ctx, testTokenReq(s, authRes.Code, clientID, clientSecret))
+		require.NoError(t, err)
+		body := testTokenEndpointResponse(t, resp)
+		require.NotEmpty(t, body["id_token"])
+		require.NotEmpty(t, body["refresh_token"])
+		require.True(t, strings.HasPrefix(body["refresh_token"].(string), refreshTokenPrefix))
+		return body["refresh_token"].(string)
+	}
+
+	refreshReq := func(refreshToken string) *logical.Request {
+		req := testTokenReq(s, "", clientID, clientSecret)
+		req.Data = map[string]interface{}{
+			"grant_type":    "refresh_token",
+			"refresh_token": refreshToken,
+		}
+		return req
+	}
+
+	refreshToken1 := authorize()
+
+	// Using the refresh token rotates it
+	resp, err = c.identityStore.HandleRequest(ctx, refreshReq(refreshToken1))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Data[logical.HTTPStatusCode])
+	body := testTokenEndpointResponse(t, resp)
+	require.NotEmpty(t, body["access_token"])
+	refreshToken2 := body["refresh_token"].(string)
+	require.NotEmpty(t, refreshToken2)
+	require.NotEqual(t, refreshToken1, refreshToken2)
+
+	claims := testJWTClaims(t, body["id_token"].(string))
+	require.Equal(t, entityID, claims["sub"])
+	require.Equal(t, clientID, claims["aud"])
+	require.NotContains(t, claims, "c_hash")
+
+	// Reusing a rotated refresh token revokes the family
+	resp, err = c.identityStore.HandleRequest(ctx, refreshReq(refreshToken1))
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenInvalidGrant, testTokenEndpointResponse(t, resp)["error"])
+	resp, err = c.identityStore.HandleRequest(ctx, refreshReq(refreshToken2))
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenInvalidGrant, testTokenEndpointResponse(t, resp)["error"])
+
+	// Scopes that weren't originally granted can't be requested
+	refreshToken3 := authorize()
+	req := refreshReq(refreshToken3)
+	req.Data["scope"] = "openid test-scope"
+	resp, err = c.identityStore.HandleRequest(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenInvalidScope, testTokenEndpointResponse(t, resp)["error"])
+
+	// Rotating refresh tokens doesn't extend the family past its maximum
+	// lifetime
+	refreshToken4 := authorize()
+	entry, err := c.identityStore.getOIDCRefreshToken(ctx, s, oidcTokenHash(refreshToken4))
+	require.NoError(t, err)
+	family, err := c.identityStore.getOIDCRefreshTokenFamily(ctx, s, clientID, entry.FamilyID)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(90*24*time.Hour), family.MaxExpireTime, time.Minute)
+
+	family.MaxExpireTime = time.Now().Add(time.Minute).Truncate(time.Second)
+	familyEntry, err := logical.StorageEntryJSON(refreshTokenFamilyPath+clientID+"/"+family.ID, family)
+	require.NoError(t, err)
+	require.NoError(t, s.Put(ctx, familyEntry))
+
+	resp, err = c.identityStore.HandleRequest(ctx, refreshReq(refreshToken4))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Data[logical.HTTPStatusCode])
+	entry, err = c.identityStore.getOIDCRefreshToken(ctx, s, oidcTokenHash(testTokenEndpointResponse(t, resp)["refresh_token"].(string)))
+	require.NoError(t, err)
+	require.True(t, entry.ExpireTime.Equal(family.MaxExpireTime))
+
+	// The maximum lifetime can't be shorter than the lifetime of each token
+	resp, err = c.identityStore.HandleRequest(ctx, &logical.Request{
+		Storage:   s,
+		Path:      "oidc/client/test-client",
+		Operation: logical.UpdateOperation,
+		Data: map[string]interface{}{
+			"refresh_token_ttl":     "2h",
+			"refresh_token_max_ttl": "1h",
+		},
+	})
+	expectError(t, resp, err)
+
+	// Unknown refresh tokens are rejected
+	resp, err = c.identityStore.HandleRequest(ctx, refreshReq(refreshTokenPrefix+"unknown"))
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenInvalidGrant, testTokenEndpointResponse(t, resp)["error"])
+
+	// Deleting the client revokes its refresh tokens
+	resp, err = c.identityStore.HandleRequest(ctx, &logical.Request{
+		Storage:   s,
+		Path:      "oidc/client/test-client",
+		Operation: logical.DeleteOperation,
+	})
+	expectSuccess(t, resp, err)
+	families, err := s.List(ctx, refreshTokenFamilyPath+clientID+"/")
+	require.NoError(t, err)
+	require.Empty(t, families)
+
+	// Refresh tokens of revoked families are removed
+	require.NoError(t, c.identityStore.expireOIDCRefreshTokens(ctx, s))
+	tokens, err := s.List(ctx, refreshTokenPath)
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+}
+
+// TestOIDC_Path_OIDC_DeviceAuthorization tests the device authorization
+// grant, including end-user verification and token polling
+func TestOIDC_Path_OIDC_DeviceAuthorization(t *testing.T) {
+	c, _, _ := TestCoreUnsealed(t)
+	ctx := namespace.RootContext(nil)
+	s := new(logical.InmemStorage)
+
+	entityID, _, _, clientID, clientSecret := setupOIDCCommon(t, c, s)
+
+	te := &logical.TokenEntry{
+		Path:         "test",
+		Policies:     []string{"default"},
+		TTL:          time.Hour * 24,
+		CreationTime: time.Now().Unix(),
+	}
+	testMakeTokenDirectly(t, c.tokenStore, te)
+
+	deviceReq := func() *logical.Request {
+		return &logical.Request{
+			Storage:   s,
+			Path:      "oidc/provider/test-provider/device",
+			Operation: logical.UpdateOperation,
+			Headers: map[string][]string{
+				"Authorization": {basicAuthHeader(clientID, clientSecret)},
+			},
+			Data: map[string]interface{}{
+				"scope": "openid test-scope",
+			},
+		}
+	}
+	pollReq := func(deviceCode string) *logical.Request {
+		req := testTokenReq(s, "", clientID, clientSecret)
+		req.Data = map[string]interface{}{
+			"grant_type":  "urn:ietf:params:oauth:grant-type:device_code",
+			"device_code": deviceCode,
+		}
+		return req
+	}
+	verifyReq := func(userCode string, approve bool) *logical.Request {
+		return &logical.Request{
+			Storage:     s,
+			Path:        "oidc/provider/test-provider/device/verify",
+			Operation:   logical.UpdateOperation,
+			EntityID:    entityID,
+			ClientToken: te.ID,
+			Data: map[string]interface{}{
+				"user_code": userCode,
+				"approve":   approve,
+			},
+		}
+	}
+
+	// The client isn't allowed to use the grant type by default
+	resp, err := c.identityStore.HandleRequest(ctx, deviceReq())
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenUnauthorizedClient, testTokenEndpointResponse(t, resp)["error"])
+
+	resp, err = c.identityStore.HandleRequest(ctx, testClientGrantTypesReq(s,
+		"authorization_code", "urn:ietf:params:oauth:grant-type:device_code"))
+	expectSuccess(t, resp, err)
+
+	resp, err = c.identityStore.HandleRequest(ctx, deviceReq())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Data[logical.HTTPStatusCode])
+	body := testTokenEndpointResponse(t, resp)
+	deviceCode := body["device_code"].(string)
+	userCode := body["user_code"].(string)
+	require.NotEmpty(t, deviceCode)
+	require.Regexp(t, "^[BCDFGHJKLMNPQRSTVWXZ]{4}-[BCDFGHJKLMNPQRSTVWXZ]{4}$", userCode)
+	require.Equal(t, "/ui/vault/identity/oidc/provider/test-provider/device", body["verification_uri"])
+	require.Equal(t, body["verification_uri"].(string)+"?user_code="+userCode, body["verification_uri_complete"])
+	require.EqualValues(t, 600, body["expires_in"])
+	require.EqualValues(t, 5, body["interval"])
+
+	// Polling before verification is pending, and polling too fast slows down
+	resp, err = c.identityStore.HandleRequest(ctx, pollReq(deviceCode))
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenAuthorizationPending, testTokenEndpointResponse(t, resp)["error"])
+	resp, err = c.identityStore.HandleRequest(ctx, pollReq(deviceCode))
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenSlowDown, testTokenEndpointResponse(t, resp)["error"])
+
+	// The user code is case-insensitive and ignores the separator
+	resp, err = c.identityStore.HandleRequest(ctx, &logical.Request{
+		Storage:   s,
+		Path:      "oidc/provider/test-provider/device/verify",
+		Operation: logical.ReadOperation,
+		EntityID:  entityID,
+		Data: map[string]interface{}{
+			"user_code": strings.ToLower(strings.ReplaceAll(userCode, "-", "")),
+		},
+	})
+	expectSuccess(t, resp, err)
+	require.Equal(t, clientID, resp.Data["client_id"])
+	require.Equal(t, "test-client", resp.Data["client_name"])
+	require.Equal(t, []string{"test-scope"}, resp.Data["scopes"])
+
+	// Verification requires an entity
+	req := verifyReq(userCode, true)
+	req.EntityID = ""
+	resp, err = c.identityStore.HandleRequest(ctx, req)
+	expectError(t, resp, err)
+
+	resp, err = c.identityStore.HandleRequest(ctx, verifyReq(userCode, true))
+	expectSuccess(t, resp, err)
+
+	// The user code is single use
+	resp, err = c.identityStore.HandleRequest(ctx, verifyReq(userCode, true))
+	expectError(t, resp, err)
+
+	resp, err = c.identityStore.HandleRequest(ctx, pollReq(deviceCode))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Data[logical.HTTPStatusCode])
+	body = testTokenEndpointResponse(t, resp)
+	require.NotEmpty(t, body["access_token"])
+	require.NotContains(t, body, "refresh_token")
+	claims := testJWTClaims(t, body["id_token"].(string))
+	require.Equal(t, entityID, claims["sub"])
+	require.Equal(t, clientID, claims["aud"])
+	require.Equal(t, "test-entity", claims["name"])
+
+	// The device code is single use
+	resp, err = c.identityStore.HandleRequest(ctx, pollReq(deviceCode))
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenExpiredToken, testTokenEndpointResponse(t, resp)["error"])
+
+	// Denied requests don't issue tokens
+	resp, err = c.identityStore.HandleRequest(ctx, deviceReq())
+	require.NoError(t, err)
+	body = testTokenEndpointResponse(t, resp)
+	resp, err = c.identityStore.HandleRequest(ctx, verifyReq(body["user_code"].(string), false))
+	expectSuccess(t, resp, err)
+	resp, err = c.identityStore.HandleRequest(ctx, pollReq(body["device_code"].(string)))
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenAccessDenied, testTokenEndpointResponse(t, resp)["error"])
+}
+
+// TestOIDC_Path_OIDC_Token_TokenExchange tests the exchange of Vault tokens
+// for audience-scoped JWTs
+func TestOIDC_Path_OIDC_Token_TokenExchange(t *testing.T) {
+	c, _, _ := TestCoreUnsealed(t)
+	ctx := namespace.RootContext(nil)
+	s := new(logical.InmemStorage)
+
+	entityID, _, _, clientID, clientSecret := setupOIDCCommon(t, c, s)
+
+	subjectToken := &logical.TokenEntry{
+		Path:         "test",
+		Policies:     []string{"default"},
+		TTL:          time.Hour,
+		CreationTime: time.Now().Unix(),
+		EntityID:     entityID,
+	}
+	testMakeTokenDirectly(t, c.tokenStore, subjectToken)
+
+	noEntityToken := &logical.TokenEntry{
+		Path:         "test",
+		Policies:     []string{"default"},
+		TTL:          time.Hour,
+		CreationTime: time.Now().Unix(),
+	}
+	testMakeTokenDirectly(t, c.tokenStore, noEntityToken)
+
+	exchangeReq := func(subjectToken string, data map[string]interface{}) *logical.Request {
+		req := testTokenReq(s, "", clientID, clientSecret)
+		req.Data = map[string]interface{}{
+			"grant_type":         "urn:ietf:params:oauth:grant-type:token-exchange",
+			"subject_token":      subjectToken,
+			"subject_token_type": "urn:ietf:params:oauth:token-type:access_token",
+		}
+		for k, v := range data {
+			req.Data[k] = v
+		}
+		return req
+	}
+
+	// The client isn't allowed to use the grant type by default
+	resp, err := c.identityStore.HandleRequest(ctx, exchangeReq(subjectToken.ID, nil))
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenUnauthorizedClient, testTokenEndpointResponse(t, resp)["error"])
+
+	resp, err = c.identityStore.HandleRequest(ctx, &logical.Request{
+		Storage:   s,
+		Path:      "oidc/client/test-client",
+		Operation: logical.UpdateOperation,
+		Data: map[string]interface{}{
+			"grant_types":       []string{"urn:ietf:params:oauth:grant-type:token-exchange"},
+			"allowed_audiences": []string{"https://api.example.com"},
+			"access_token_ttl":  "5m",
+		},
+	})
+	expectSuccess(t, resp, err)
+
+	tests := []struct {
+		name         string
+		subjectToken string
+		data         map[string]interface{}
+		wantErr      string
+	}{
+		{
+			name:         "missing subject token",
+			subjectToken: "",
+			wantErr:      ErrTokenInvalidRequest,
+		},
+		{
+			name:         "unsupported subject token type",
+			subjectToken: subjectToken.ID,
+			data:         map[string]interface{}{"subject_token_type": "urn:ietf:params:oauth:token-type:id_token"},
+			wantErr:      ErrTokenInvalidRequest,
+		},
+		{
+			name:         "unsupported requested token type",
+			subjectToken: subjectToken.ID,
+			data:         map[string]interface{}{"requested_token_type": "urn:ietf:params:oauth:token-type:saml2"},
+			wantErr:      ErrTokenInvalidRequest,
+		},
+		{
+			name:         "audience not allowed",
+			subjectToken: subjectToken.ID,
+			data:         map[string]interface{}{"audience": "https://other.example.com"},
+			wantErr:      ErrTokenInvalidTarget,
+		},
+		{
+			name:         "invalid subject token",
+			subjectToken: "hvs.invalid",
+			wantErr:      ErrTokenInvalidGrant,
+		},
+		{
+			name:         "subject token without entity",
+			subjectToken: noEntityToken.ID,
+			wantErr:      ErrTokenInvalidGrant,
+		},
+		{
+			name:         "default audience",
+			subjectToken: subjectToken.ID,
+		},
+		{
+			name:         "allowed audience with scope",
+			subjectToken: subjectToken.ID,
+			data: map[string]interface{}{
+				"audience":             "https://api.example.com",
+				"requested_token_type": "urn:ietf:params:oauth:token-type:jwt",
+				"scope":                "test-scope",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := c.identityStore.HandleRequest(ctx, exchangeReq(tt.subjectToken, tt.data))
+			require.NoError(t, err)
+			body := testTokenEndpointResponse(t, resp)
+			if tt.wantErr != "" {
+				require.Equal(t, tt.wantErr, body["error"])
+				require.Equal(t, http.StatusBadRequest, resp.Data[logical.HTTPStatusCode])
+				return
+			}
+
+			require.Equal(t, http.StatusOK, resp.Data[logical.HTTPStatusCode])
+			require.Equal(t, "urn:ietf:params:oauth:token-type:jwt", body["issued_token_type"])
+			require.EqualValues(t, 300, body["expires_in"])
+
+			claims := testJWTClaims(t, body["access_token"].(string))
+			require.Equal(t, entityID, claims["sub"])
+			audience := clientID
+			if aud, ok := tt.data["audience"]; ok {
+				audience = aud.(string)
+			}
+			require.Equal(t, audience, claims["aud"])
+			if tt.data["scope"] == "test-scope" {
+				require.Equal(t, "test-entity", claims["name"])
+			} else {
+				require.NotContains(t, claims, "name")
+			}
+		})
+	}
+
+	// Subject tokens are subject to the same checks as tokens used to make
+	// requests
+	boundCIDRs, err := parseutil.ParseAddrs([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+	cidrToken := &logical.TokenEntry{
+		Path:         "test",
+		Policies:     []string{"default"},
+		TTL:          time.Hour,
+		CreationTime: time.Now().Unix(),
+		EntityID:     entityID,
+		BoundCIDRs:   boundCIDRs,
+	}
+	testMakeTokenDirectly(t, c.tokenStore, cidrToken)
+	req := exchangeReq(cidrToken.ID, nil)
+	req.Connection = &logical.Connection{RemoteAddr: "127.0.0.1"}
+	resp, err = c.identityStore.HandleRequest(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenInvalidGrant, testTokenEndpointResponse(t, resp)["error"])
+	req = exchangeReq(cidrToken.ID, nil)
+	req.Connection = &logical.Connection{RemoteAddr: "10.1.2.3"}
+	resp, err = c.identityStore.HandleRequest(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Data[logical.HTTPStatusCode])
+
+	certToken := &logical.TokenEntry{
+		Path:                "test",
+		Policies:            []string{"default"},
+		TTL:                 time.Hour,
+		CreationTime:        time.Now().Unix(),
+		EntityID:            entityID,
+		BoundCertThumbprint: "thumbprint",
+	}
+	testMakeTokenDirectly(t, c.tokenStore, certToken)
+	resp, err = c.identityStore.HandleRequest(ctx, exchangeReq(certToken.ID, nil))
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenInvalidGrant, testTokenEndpointResponse(t, resp)["error"])
+
+	scopedToken := &logical.TokenEntry{
+		Path:         "test",
+		Policies:     []string{"default"},
+		TTL:          time.Hour,
+		CreationTime: time.Now().Unix(),
+		EntityID:     entityID,
+		Scope:        map[string][]string{"secret/*": {"read"}},
+	}
+	testMakeTokenDirectly(t, c.tokenStore, scopedToken)
+	resp, err = c.identityStore.HandleRequest(ctx, exchangeReq(scopedToken.ID, nil))
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenInvalidGrant, testTokenEndpointResponse(t, resp)["error"])
+
+	singleUseToken := &logical.TokenEntry{
+		Path:         "test",
+		Policies:     []string{"default"},
+		TTL:          time.Hour,
+		CreationTime: time.Now().Unix(),
+		EntityID:     entityID,
+		NumUses:      1,
+	}
+	testMakeTokenDirectly(t, c.tokenStore, singleUseToken)
+	resp, err = c.identityStore.HandleRequest(ctx, exchangeReq(singleUseToken.ID, nil))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Data[logical.HTTPStatusCode])
+	resp, err = c.identityStore.HandleRequest(ctx, exchangeReq(singleUseToken.ID, nil))
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenInvalidGrant, testTokenEndpointResponse(t, resp)["error"])
+
+	// Public clients can't use the grant type
+	resp, err = c.identityStore.HandleRequest(ctx, &logical.Request{
+		Storage:   s,
+		Path:      "oidc/client/test-public-client",
+		Operation: logical.CreateOperation,
+		Data: map[string]interface{}{
+			"client_type": "public",
+			"grant_types": []string{"urn:ietf:params:oauth:grant-type:token-exchange"},
+		},
+	})
+	expectError(t, resp, err)
+
+	// Entities outside of the client's assignments can't exchange tokens
+	resp, err = c.identityStore.HandleRequest(ctx, &logical.Request{
+		Storage:   s,
+		Path:      "oidc/assignment/test-assignment",
+		Operation: logical.UpdateOperation,
+		Data: map[string]interface{}{
+			"entity_ids": []string{},
+			"group_ids":  []string{},
+		},
+	})
+	expectSuccess(t, resp, err)
+	resp, err = c.identityStore.HandleRequest(ctx, exchangeReq(subjectToken.ID, nil))
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenInvalidGrant, testTokenEndpointResponse(t, resp)["error"])
+}
+
+func testUserInfoReq(s logical.Storage, accessToken, entityID string) *logical.Request {
+	return &logical.Request{
+		Storage:           s,
+		Path:              "oidc/provider/test-provider/userinfo",
+		Operation:         logical.ReadOperation,
+		ClientToken:       accessToken,
+		ClientTokenSource: logical.ClientTokenFromAuthzHeader,
+		EntityID:          entityID,
+	}
+}
+
+// TestOIDC_Path_OIDC_Revoke tests the revocation of access tokens and
+// refresh tokens at the token revocation endpoint
+func TestOIDC_Path_OIDC_Revoke(t *testing.T) {
+	c, _, _ := TestCoreUnsealed(t)
+	ctx := namespace.RootContext(nil)
+	s := new(logical.InmemStorage)
+
+	entityID, _, _, clientID, clientSecret := setupOIDCCommon(t, c, s)
+
+	resp, err := c.identityStore.HandleRequest(ctx, testClientGrantTypesReq(s, "authorization_code", "refresh_token"))
+	expectSuccess(t, resp, err)
+
+	te := &logical.TokenEntry{
+		Path:         "test",
+		Policies:     []string{"default"},
+		TTL:          time.Hour * 24,
+		CreationTime: time.Now().Unix(),
+	}
+	testMakeTokenDirectly(t, c.tokenStore, te)
+
+	authorizeReq := testAuthorizeReq(s, clientID)
+	authorizeReq.EntityID = entityID
+	authorizeReq.ClientToken = te.ID
+	resp, err = c.identityStore.HandleRequest(ctx, authorizeReq)
+	expectSuccess(t, resp, err)
+	var authRes struct {
+		Code string `json:"code"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Data[logical.HTTPRawBody].([]byte), &authRes))
+
+	resp, err = c.identityStore.HandleRequest(ctx, testTokenReq(s, authRes.Code, clientID, clientSecret))
+	require.NoError(t, err)
+	body := testTokenEndpointResponse(t, resp)
+	accessToken := body["access_token"].(string)
+	refreshToken := body["refresh_token"].(string)
+
+	revokeReq := func(token string) *logical.Request {
+		return &logical.Request{
+			Storage:   s,
+			Path:      "oidc/provider/test-provider/revoke",
+			Operation: logical.UpdateOperation,
+			Headers: map[string][]string{
+				"Authorization": {basicAuthHeader(clientID, clientSecret)},
+			},
+			Data: map[string]interface{}{
+				"token": token,
+			},
+		}
+	}
+
+	// The access token can be used before it's revoked
+	resp, err = c.identityStore.HandleRequest(ctx, testUserInfoReq(s, accessToken, entityID))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Data[logical.HTTPStatusCode])
+
+	// Revoking the access token denies its use at the userinfo endpoint
+	resp, err = c.identityStore.HandleRequest(ctx, revokeReq(accessToken))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Data[logical.HTTPStatusCode])
+	resp, err = c.identityStore.HandleRequest(ctx, testUserInfoReq(s, accessToken, entityID))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, resp.Data[logical.HTTPStatusCode])
+
+	// Revoking the refresh token denies its use at the token endpoint
+	resp, err = c.identityStore.HandleRequest(ctx, revokeReq(refreshToken))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Data[logical.HTTPStatusCode])
+	refreshReq := testTokenReq(s, "", clientID, clientSecret)
+	refreshReq.Data = map[string]interface{}{
+		"grant_type":    "refresh_token",
+		"refresh_token": refreshToken,
+	}
+	resp, err = c.identityStore.HandleRequest(ctx, refreshReq)
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenInvalidGrant, testTokenEndpointResponse(t, resp)["error"])
+
+	// Revoking an unknown token succeeds
+	resp, err = c.identityStore.HandleRequest(ctx, revokeReq("unknown"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Data[logical.HTTPStatusCode])
+
+	// Clients must authenticate to revoke tokens
+	req := revokeReq(refreshToken)
+	req.Headers = map[string][]string{
+		"Authorization": {basicAuthHeader(clientID, "wrong-secret")},
+	}
+	resp, err = c.identityStore.HandleRequest(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenInvalidClient, testTokenEndpointResponse(t, resp)["error"])
+}
+
+// TestOIDC_Path_OIDC_Logout tests that RP-initiated logout ends the
+// end-user's session and notifies clients at their back-channel logout URI
+func TestOIDC_Path_OIDC_Logout(t *testing.T) {
+	c, _, _ := TestCoreUnsealed(t)
+	ctx := namespace.RootContext(nil)
+	s := new(logical.InmemStorage)
+
+	entityID, _, _, clientID, clientSecret := setupOIDCCommon(t, c, s)
+
+	logoutTokens := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		logoutTokens <- r.PostForm.Get("logout_token")
+	}))
+	defer srv.Close()
+
+	resp, err := c.identityStore.HandleRequest(ctx, &logical.Request{
+		Storage:   s,
+		Path:      "oidc/client/test-client",
+		Operation: logical.UpdateOperation,
+		Data: map[string]interface{}{
+			"grant_types":               []string{"authorization_code", "refresh_token"},
+			"post_logout_redirect_uris": []string{"https://localhost:8251/logged-out"},
+			"backchannel_logout_uri":    srv.URL,
+		},
+	})
+	expectSuccess(t, resp, err)
+
+	// Back-channel logout URIs must be absolute http or https URIs
+	resp, err = c.identityStore.HandleRequest(ctx, &logical.Request{
+		Storage:   s,
+		Path:      "oidc/client/test-client",
+		Operation: logical.UpdateOperation,
+		Data: map[string]interface{}{
+			"backchannel_logout_uri": "/logout",
+		},
+	})
+	expectError(t, resp, err)
+
+	te := &logical.TokenEntry{
+		Path:         "test",
+		Policies:     []string{"default"},
+		TTL:          time.Hour * 24,
+		CreationTime: time.Now().Unix(),
+	}
+	testMakeTokenDirectly(t, c.tokenStore, te)
+
+	authorizeReq := testAuthorizeReq(s, clientID)
+	authorizeReq.EntityID = entityID
+	authorizeReq.ClientToken = te.ID
+	resp, err = c.identityStore.HandleRequest(ctx, authorizeReq)
+	expectSuccess(t, resp, err)
+	var authRes struct {
+		Code string `json:"code"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Data[logical.HTTPRawBody].([]byte), &authRes))
+
+	resp, err = c.identityStore.HandleRequest(ctx, testTokenReq(s, authRes.Code, clientID, clientSecret))
+	require.NoError(t, err)
+	body := testTokenEndpointResponse(t, resp)
+	accessToken := body["access_token"].(string)
+	idToken := body["id_token"].(string)
+
+	// The ID token identifies the end-user's session with the provider
+	sessionID, ok := testJWTClaims(t, idToken)["sid"].(string)
+	require.True(t, ok)
+	require.NotEmpty(t, sessionID)
+
+	// The post-logout redirect URI must be registered by the client
+	logoutReq := func(redirectURI string) *logical.Request {
+		return &logical.Request{
+			Storage:     s,
+			Path:        "oidc/provider/test-provider/logout",
+			Operation:   logical.UpdateOperation,
+			ClientToken: te.ID,
+			EntityID:    entityID,
+			Data: map[string]interface{}{
+				"id_token_hint":            idToken,
+				"post_logout_redirect_uri": redirectURI,
+				"state":                    "abc123",
+			},
+		}
+	}
+	resp, err = c.identityStore.HandleRequest(ctx, logoutReq("https://localhost:8251/other"))
+	expectError(t, resp, err)
+
+	resp, err = c.identityStore.HandleRequest(ctx, logoutReq("https://localhost:8251/logged-out"))
+	expectSuccess(t, resp, err)
+	require.Equal(t, "https://localhost:8251/logged-out?state=abc123", resp.Data["redirect_uri"])
+
+	// The client is notified of the logout
+	select {
+	case logoutToken := <-logoutTokens:
+		claims := testJWTClaims(t, logoutToken)
+		require.Equal(t, clientID, claims["aud"])
+		require.Equal(t, entityID, claims["sub"])
+		require.Equal(t, sessionID, claims["sid"])
+		require.Contains(t, claims["events"], backchannelLogoutEvent)
+		require.NotContains(t, claims, "nonce")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for back-channel logout request")
+	}
+
+	// Tokens issued in the session can no longer be used
+	resp, err = c.identityStore.HandleRequest(ctx, testUserInfoReq(s, accessToken, entityID))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, resp.Data[logical.HTTPStatusCode])
+
+	refreshReq := testTokenReq(s, "", clientID, clientSecret)
+	refreshReq.Data = map[string]interface{}{
+		"grant_type":    "refresh_token",
+		"refresh_token": body["refresh_token"],
+	}
+	resp, err = c.identityStore.HandleRequest(ctx, refreshReq)
+	require.NoError(t, err)
+	require.Equal(t, ErrTokenInvalidGrant, testTokenEndpointResponse(t, resp)["error"])
+
+	sessions, err := s.List(ctx, sessionPath)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+// TestOIDC_ExpireOIDCSessions tests that sessions are ended when the
+// Vault token that started them is revoked
+func TestOIDC_ExpireOIDCSessions(t *testing.T) {
+	c, _, _ := TestCoreUnsealed(t)
+	ctx := namespace.RootContext(nil)
+	s := new(logical.InmemStorage)
+
+	entityID, _, _, clientID, _ := setupOIDCCommon(t, c, s)
+
+	te := &logical.TokenEntry{
+		Path:         "test",
+		Policies:     []string{"default"},
+		TTL:          time.Hour * 24,
+		CreationTime: time.Now().Unix(),
+	}
+	testMakeTokenDirectly(t, c.tokenStore, te)
+
+	sessionID, err := c.identityStore.startOIDCSession(ctx, s, "test-provider", entityID, te.ID, clientID)
+	require.NoError(t, err)
+
+	// The session tracks the Vault token by its accessor rather than storing it
+	entry, err := s.Get(ctx, sessionPath+sessionID)
+	require.NoError(t, err)
+	require.NotContains(t, string(entry.Value), te.ID)
+	session, err := c.identityStore.getOIDCSession(ctx, s, sessionID)
+	require.NoError(t, err)
+	require.Equal(t, te.Accessor, session.TokenAccessor)
+
+	// The same Vault token continues the session
+	sessionID2, err := c.identityStore.startOIDCSession(ctx, s, "test-provider", entityID, te.ID, clientID)
+	require.NoError(t, err)
+	require.Equal(t, sessionID, sessionID2)
+
+	require.NoError(t, c.identityStore.expireOIDCSessions(ctx, s))
+	active, err := c.identityStore.oidcSessionActive(ctx, s, sessionID)
+	require.NoError(t, err)
+	require.True(t, active)
+
+	require.NoError(t, c.tokenStore.revokeOrphan(ctx, te.ID))
+	require.NoError(t, c.identityStore.expireOIDCSessions(ctx, s))
+	session, err = c.identityStore.getOIDCSession(ctx, s, sessionID)
+	require.NoError(t, err)
+	require.Nil(t, session)
+}
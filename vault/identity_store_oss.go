@@ -25,7 +25,10 @@ func identityStoreLoginMFAEntUnauthedPaths() []string {
 }
 
 func identityStoreSCIMUnauthedPaths() []string {
-	return []string{}
+	return []string{
+		"scim/v2/ServiceProviderConfig",
+		"scim/v2/ResourceTypes",
+	}
 }
 
 func mfaLoginEnterprisePaths(i *IdentityStore) []*framework.Path {
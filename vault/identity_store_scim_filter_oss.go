@@ -0,0 +1,705 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !enterprise
+
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// scimError is an error that is returned to SCIM clients using the SCIM error
+// response format described in RFC 7644 section 3.12.
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func newSCIMError(status int, scimType, format string, args ...interface{}) *scimError {
+	return &scimError{
+		status:   status,
+		scimType: scimType,
+		detail:   fmt.Sprintf(format, args...),
+	}
+}
+
+// scimFilter is a parsed SCIM filter expression, as described in RFC 7644
+// section 3.4.2.2. Filters are evaluated against the JSON representation of a
+// resource.
+type scimFilter interface {
+	matches(resource map[string]interface{}) bool
+}
+
+type scimLogicalFilter struct {
+	and         bool
+	left, right scimFilter
+}
+
+func (f *scimLogicalFilter) matches(resource map[string]interface{}) bool {
+	if f.and {
+		return f.left.matches(resource) && f.right.matches(resource)
+	}
+	return f.left.matches(resource) || f.right.matches(resource)
+}
+
+type scimNotFilter struct {
+	filter scimFilter
+}
+
+func (f *scimNotFilter) matches(resource map[string]interface{}) bool {
+	return !f.filter.matches(resource)
+}
+
+type scimAttrFilter struct {
+	attr    string
+	subAttr string
+	op      string
+	value   interface{}
+}
+
+func (f *scimAttrFilter) matches(resource map[string]interface{}) bool {
+	values := scimAttrValues(resource, f.attr, f.subAttr)
+	if f.op == "pr" {
+		for _, v := range values {
+			if !scimValueEmpty(v) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, v := range values {
+		if scimCompare(v, f.op, f.value) {
+			return true
+		}
+	}
+
+	// A missing attribute is only equal to null
+	if len(values) == 0 {
+		switch f.op {
+		case "eq":
+			return f.value == nil
+		case "ne":
+			return f.value != nil
+		}
+	}
+
+	return false
+}
+
+// scimValuePathFilter matches resources with at least one value of a
+// multi-valued attribute matching the nested filter, e.g.
+// emails[type eq "work"].
+type scimValuePathFilter struct {
+	attr   string
+	filter scimFilter
+}
+
+func (f *scimValuePathFilter) matches(resource map[string]interface{}) bool {
+	for _, element := range scimElements(resource, f.attr) {
+		if f.filter.matches(element) {
+			return true
+		}
+	}
+	return false
+}
+
+// scimLookup returns the value of the attribute with the given name. SCIM
+// attribute names are case insensitive.
+func scimLookup(resource map[string]interface{}, name string) (string, interface{}, bool) {
+	if v, ok := resource[name]; ok {
+		return name, v, true
+	}
+	for k, v := range resource {
+		if strings.EqualFold(k, name) {
+			return k, v, true
+		}
+	}
+	return "", nil, false
+}
+
+// scimElements returns the complex values of a multi-valued attribute.
+func scimElements(resource map[string]interface{}, attr string) []map[string]interface{} {
+	_, raw, ok := scimLookup(resource, attr)
+	if !ok {
+		return nil
+	}
+
+	var elements []map[string]interface{}
+	switch v := raw.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if m, ok := item.(map[string]interface{}); ok {
+				elements = append(elements, m)
+			}
+		}
+	case map[string]interface{}:
+		elements = append(elements, v)
+	}
+	return elements
+}
+
+// scimAttrValues returns the values of the given attribute path. Comparing a
+// multi-valued attribute of complex values without a sub-attribute compares
+// against their "value" sub-attribute.
+func scimAttrValues(resource map[string]interface{}, attr, subAttr string) []interface{} {
+	_, raw, ok := scimLookup(resource, attr)
+	if !ok || raw == nil {
+		return nil
+	}
+
+	items, multi := raw.([]interface{})
+	if !multi {
+		items = []interface{}{raw}
+	}
+
+	var values []interface{}
+	for _, item := range items {
+		m, complexValue := item.(map[string]interface{})
+		switch {
+		case !complexValue && subAttr == "":
+			values = append(values, item)
+		case complexValue:
+			sub := subAttr
+			if sub == "" {
+				sub = "value"
+			}
+			if _, v, ok := scimLookup(m, sub); ok && v != nil {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+func scimValueEmpty(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case string:
+		return t == ""
+	case []interface{}:
+		return len(t) == 0
+	case map[string]interface{}:
+		return len(t) == 0
+	}
+	return false
+}
+
+func scimCompare(actual interface{}, op string, expected interface{}) bool {
+	switch a := actual.(type) {
+	case string:
+		e, ok := expected.(string)
+		if !ok {
+			return op == "ne"
+		}
+		a, e = strings.ToLower(a), strings.ToLower(e)
+		switch op {
+		case "eq":
+			return a == e
+		case "ne":
+			return a != e
+		case "co":
+			return strings.Contains(a, e)
+		case "sw":
+			return strings.HasPrefix(a, e)
+		case "ew":
+			return strings.HasSuffix(a, e)
+		case "gt":
+			return a > e
+		case "ge":
+			return a >= e
+		case "lt":
+			return a < e
+		case "le":
+			return a <= e
+		}
+	case bool:
+		e, ok := expected.(bool)
+		switch op {
+		case "eq":
+			return ok && a == e
+		case "ne":
+			return !ok || a != e
+		}
+	default:
+		af, aok := scimNumber(actual)
+		ef, eok := scimNumber(expected)
+		if !aok || !eok {
+			return op == "ne"
+		}
+		switch op {
+		case "eq":
+			return af == ef
+		case "ne":
+			return af != ef
+		case "gt":
+			return af > ef
+		case "ge":
+			return af >= ef
+		case "lt":
+			return af < ef
+		case "le":
+			return af <= ef
+		}
+	}
+	return false
+}
+
+func scimNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// scimAttrPath splits an attribute path into the attribute and the optional
+// sub-attribute, removing any schema URN prefix.
+func scimAttrPath(path string) (string, string) {
+	if idx := strings.LastIndex(path, ":"); idx >= 0 {
+		path = path[idx+1:]
+	}
+	attr, subAttr, _ := strings.Cut(path, ".")
+	return attr, subAttr
+}
+
+type scimFilterParser struct {
+	tokens []string
+	pos    int
+}
+
+// parseSCIMFilter parses a SCIM filter expression.
+func parseSCIMFilter(filter string) (scimFilter, error) {
+	tokens, err := scimTokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "empty filter")
+	}
+
+	p := &scimFilterParser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "unexpected %q in filter", p.tokens[p.pos])
+	}
+	return f, nil
+}
+
+func scimTokenize(s string) ([]string, error) {
+	var tokens []string
+	for idx := 0; idx < len(s); {
+		c := s[idx]
+		switch {
+		case c == ' ' || c == '\t':
+			idx++
+		case c == '(' || c == ')' || c == '[' || c == ']':
+			tokens = append(tokens, string(c))
+			idx++
+		case c == '"':
+			end := idx + 1
+			for ; end < len(s); end++ {
+				if s[end] == '\\' {
+					end++
+					continue
+				}
+				if s[end] == '"' {
+					break
+				}
+			}
+			if end >= len(s) {
+				return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "unterminated string in filter")
+			}
+			tokens = append(tokens, s[idx:end+1])
+			idx = end + 1
+		default:
+			end := idx
+			for end < len(s) && !strings.ContainsRune(" \t()[]\"", rune(s[end])) {
+				end++
+			}
+			tokens = append(tokens, s[idx:end])
+			idx = end
+		}
+	}
+	return tokens, nil
+}
+
+func (p *scimFilterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *scimFilterParser) next() string {
+	t := p.peek()
+	if t != "" {
+		p.pos++
+	}
+	return t
+}
+
+func (p *scimFilterParser) expect(token string) error {
+	if t := p.next(); t != token {
+		return newSCIMError(http.StatusBadRequest, "invalidFilter", "expected %q in filter, got %q", token, t)
+	}
+	return nil
+}
+
+func (p *scimFilterParser) parseOr() (scimFilter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &scimLogicalFilter{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *scimFilterParser) parseAnd() (scimFilter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &scimLogicalFilter{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *scimFilterParser) parseUnary() (scimFilter, error) {
+	switch t := p.peek(); {
+	case strings.EqualFold(t, "not"):
+		p.next()
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return &scimNotFilter{filter: f}, nil
+	case t == "(":
+		p.next()
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return f, nil
+	case t == "":
+		return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "unexpected end of filter")
+	}
+
+	path := p.next()
+	if !scimValidAttrPath(path) {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "invalid attribute path %q in filter", path)
+	}
+	attr, subAttr := scimAttrPath(path)
+
+	if p.peek() == "[" {
+		p.next()
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return &scimValuePathFilter{attr: attr, filter: f}, nil
+	}
+
+	op := strings.ToLower(p.next())
+	switch op {
+	case "pr":
+		return &scimAttrFilter{attr: attr, subAttr: subAttr, op: op}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "invalid operator %q in filter", op)
+	}
+
+	value, err := scimParseValue(p.next())
+	if err != nil {
+		return nil, err
+	}
+	return &scimAttrFilter{attr: attr, subAttr: subAttr, op: op, value: value}, nil
+}
+
+func scimValidAttrPath(path string) bool {
+	if path == "" || !unicode.IsLetter(rune(path[0])) && !strings.HasPrefix(path, "$") {
+		return false
+	}
+	for _, r := range path {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("$-_.:", r) {
+			return false
+		}
+	}
+	return true
+}
+
+func scimParseValue(token string) (interface{}, error) {
+	switch {
+	case token == "":
+		return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "missing comparison value in filter")
+	case strings.HasPrefix(token, `"`):
+		var s string
+		if err := json.Unmarshal([]byte(token), &s); err != nil {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "invalid string %s in filter", token)
+		}
+		return s, nil
+	case strings.EqualFold(token, "true"):
+		return true, nil
+	case strings.EqualFold(token, "false"):
+		return false, nil
+	case strings.EqualFold(token, "null"):
+		return nil, nil
+	}
+
+	f, err := strconv.ParseFloat(token, 64)
+	if err != nil {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "invalid comparison value %q in filter", token)
+	}
+	return f, nil
+}
+
+// scimPatchOperation is a single operation of a SCIM PATCH request, as
+// described in RFC 7644 section 3.5.2.
+type scimPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// scimApplyPatch applies the given operations to the JSON representation of
+// a resource.
+func scimApplyPatch(resource map[string]interface{}, ops []scimPatchOperation) error {
+	for _, op := range ops {
+		opName := strings.ToLower(op.Op)
+		switch opName {
+		case "add", "replace", "remove":
+		default:
+			return newSCIMError(http.StatusBadRequest, "invalidSyntax", "unsupported patch operation %q", op.Op)
+		}
+
+		if op.Path == "" {
+			if opName == "remove" {
+				return newSCIMError(http.StatusBadRequest, "noTarget", "remove operations require a path")
+			}
+			values, ok := op.Value.(map[string]interface{})
+			if !ok {
+				return newSCIMError(http.StatusBadRequest, "invalidValue", "patch operations without a path require an object value")
+			}
+			for k, v := range values {
+				if err := scimApplyPatchPath(resource, opName, k, v); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := scimApplyPatchPath(resource, opName, op.Path, op.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func scimApplyPatchPath(resource map[string]interface{}, op, path string, value interface{}) error {
+	// Split a value path such as members[value eq "id"].display into the
+	// attribute, the value filter and the sub-attribute
+	var filter scimFilter
+	var attr, subAttr string
+	if open := strings.Index(path, "["); open >= 0 {
+		closing := strings.LastIndex(path, "]")
+		if closing < open {
+			return newSCIMError(http.StatusBadRequest, "invalidPath", "invalid path %q", path)
+		}
+		f, err := parseSCIMFilter(path[open+1 : closing])
+		if err != nil {
+			return newSCIMError(http.StatusBadRequest, "invalidPath", "invalid path %q: %s", path, err)
+		}
+		filter = f
+		attr, _ = scimAttrPath(path[:open])
+		subAttr = strings.TrimPrefix(path[closing+1:], ".")
+	} else {
+		if !scimValidAttrPath(path) {
+			return newSCIMError(http.StatusBadRequest, "invalidPath", "invalid path %q", path)
+		}
+		attr, subAttr = scimAttrPath(path)
+	}
+
+	key, current, exists := scimLookup(resource, attr)
+	if !exists {
+		key = attr
+	}
+
+	if filter != nil {
+		items, ok := current.([]interface{})
+		if !ok {
+			if op == "remove" {
+				return nil
+			}
+			return newSCIMError(http.StatusBadRequest, "noTarget", "no values of %q match the path filter", attr)
+		}
+
+		var kept []interface{}
+		matched := false
+		for _, item := range items {
+			element, ok := item.(map[string]interface{})
+			if !ok || !filter.matches(element) {
+				kept = append(kept, item)
+				continue
+			}
+			matched = true
+
+			switch {
+			case op == "remove" && subAttr == "":
+				continue
+			case op == "remove":
+				if k, _, ok := scimLookup(element, subAttr); ok {
+					delete(element, k)
+				}
+			case subAttr == "":
+				replacement, ok := value.(map[string]interface{})
+				if !ok {
+					return newSCIMError(http.StatusBadRequest, "invalidValue", "value for %q must be an object", path)
+				}
+				item = replacement
+			default:
+				k, _, ok := scimLookup(element, subAttr)
+				if !ok {
+					k = subAttr
+				}
+				element[k] = value
+			}
+			kept = append(kept, item)
+		}
+		if !matched && op != "remove" {
+			return newSCIMError(http.StatusBadRequest, "noTarget", "no values of %q match the path filter", attr)
+		}
+		if kept == nil {
+			kept = []interface{}{}
+		}
+		resource[key] = kept
+		return nil
+	}
+
+	if subAttr != "" {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			if exists && current != nil {
+				return newSCIMError(http.StatusBadRequest, "invalidPath", "%q is not a complex attribute", attr)
+			}
+			m = map[string]interface{}{}
+		}
+		k, _, ok := scimLookup(m, subAttr)
+		if !ok {
+			k = subAttr
+		}
+		if op == "remove" {
+			delete(m, k)
+		} else {
+			m[k] = value
+		}
+		resource[key] = m
+		return nil
+	}
+
+	items, multi := current.([]interface{})
+	switch {
+	case op == "remove" && multi && value != nil:
+		// Some identity providers send the values to remove from a
+		// multi-valued attribute rather than a value filter
+		remove := map[string]bool{}
+		for _, v := range scimValueList(value) {
+			if s, ok := scimMultiValueKey(v); ok {
+				remove[s] = true
+			}
+		}
+		kept := []interface{}{}
+		for _, item := range items {
+			if s, ok := scimMultiValueKey(item); ok && remove[s] {
+				continue
+			}
+			kept = append(kept, item)
+		}
+		resource[key] = kept
+	case op == "remove":
+		delete(resource, key)
+	case op == "add" && multi:
+		seen := map[string]bool{}
+		for _, item := range items {
+			if s, ok := scimMultiValueKey(item); ok {
+				seen[s] = true
+			}
+		}
+		for _, v := range scimValueList(value) {
+			if s, ok := scimMultiValueKey(v); ok && seen[s] {
+				continue
+			}
+			items = append(items, v)
+		}
+		resource[key] = items
+	default:
+		resource[key] = value
+	}
+	return nil
+}
+
+func scimValueList(value interface{}) []interface{} {
+	if list, ok := value.([]interface{}); ok {
+		return list
+	}
+	return []interface{}{value}
+}
+
+// scimMultiValueKey returns the key identifying a value of a multi-valued
+// attribute, which is its "value" sub-attribute for complex values.
+func scimMultiValueKey(v interface{}) (string, bool) {
+	switch t := v.(type) {
+	case string:
+		return t, true
+	case map[string]interface{}:
+		if _, raw, ok := scimLookup(t, "value"); ok {
+			s, ok := raw.(string)
+			return s, ok
+		}
+	}
+	return "", false
+}
@@ -7,26 +7,1209 @@ package vault
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-memdb"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/vault/helper/activationflags"
+	"github.com/hashicorp/vault/helper/identity"
+	"github.com/hashicorp/vault/helper/namespace"
 	"github.com/hashicorp/vault/sdk/framework"
+	"github.com/hashicorp/vault/sdk/logical"
+	"google.golang.org/protobuf/proto"
 )
 
+const (
+	scimSchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimSchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimSchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimSchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimSchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	scimSchemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+
+	scimContentType = "application/scim+json"
+
+	// scimPrincipalNamePrefix is the prefix of the name of the entity that the
+	// credentials of a SCIM client are issued to
+	scimPrincipalNamePrefix = "scim-client-"
+
+	scimDefaultTokenTTL    = 30 * 24 * time.Hour
+	scimDefaultPageSize    = 100
+	scimMaxPageSize        = 1000
+	scimCleanupRetryPeriod = time.Minute
+)
+
+func scimPaths(i *IdentityStore) []*framework.Path {
+	return []*framework.Path{
+		{
+			Pattern: "scim/client/?$",
+
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "scim",
+				OperationSuffix: "clients",
+			},
+
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ListOperation: &framework.PathOperation{
+					Callback: i.pathSCIMClientList,
+					Summary:  "List the SCIM clients.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(scimHelp["scim-client-list"][0]),
+			HelpDescription: strings.TrimSpace(scimHelp["scim-client-list"][1]),
+		},
+		{
+			Pattern: "scim/client/" + framework.GenericNameRegex("name") + "$",
+
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "scim",
+				OperationSuffix: "client",
+			},
+
+			Fields: map[string]*framework.FieldSchema{
+				"name": {
+					Type:        framework.TypeString,
+					Description: "Name of the SCIM client.",
+				},
+				"alias_mount_accessor": {
+					Type:        framework.TypeString,
+					Description: "Accessor of the auth mount on which aliases are created for the users provisioned by the client.",
+				},
+			},
+
+			ExistenceCheck: i.pathSCIMClientExistenceCheck,
+
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.CreateOperation: &framework.PathOperation{
+					Callback: i.pathSCIMClientCreateUpdate,
+					Summary:  "Create a SCIM client.",
+				},
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: i.pathSCIMClientCreateUpdate,
+					Summary:  "Update a SCIM client.",
+				},
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: i.pathSCIMClientRead,
+					Summary:  "Read a SCIM client.",
+				},
+				logical.DeleteOperation: &framework.PathOperation{
+					Callback: i.pathSCIMClientDelete,
+					Summary:  "Delete a SCIM client and the users and groups it provisioned.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(scimHelp["scim-client"][0]),
+			HelpDescription: strings.TrimSpace(scimHelp["scim-client"][1]),
+		},
+		{
+			Pattern: "scim/client/" + framework.GenericNameRegex("name") + "/token$",
+
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "scim",
+				OperationVerb:   "generate",
+				OperationSuffix: "client-token",
+			},
+
+			Fields: map[string]*framework.FieldSchema{
+				"name": {
+					Type:        framework.TypeString,
+					Description: "Name of the SCIM client.",
+				},
+				"ttl": {
+					Type:        framework.TypeDurationSecond,
+					Description: "TTL of the bearer token. Defaults to 30 days.",
+					Default:     int(scimDefaultTokenTTL.Seconds()),
+				},
+			},
+
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: i.pathSCIMClientToken,
+					Summary:  "Generate a bearer token for a SCIM client.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(scimHelp["scim-client-token"][0]),
+			HelpDescription: strings.TrimSpace(scimHelp["scim-client-token"][1]),
+		},
+		{
+			Pattern: "scim/client/" + framework.GenericNameRegex("name") + "/revoke-tokens$",
+
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "scim",
+				OperationVerb:   "revoke",
+				OperationSuffix: "client-tokens",
+			},
+
+			Fields: map[string]*framework.FieldSchema{
+				"name": {
+					Type:        framework.TypeString,
+					Description: "Name of the SCIM client.",
+				},
+			},
+
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: i.pathSCIMClientRevokeTokens,
+					Summary:  "Revoke all bearer tokens of a SCIM client.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(scimHelp["scim-client-revoke-tokens"][0]),
+			HelpDescription: strings.TrimSpace(scimHelp["scim-client-revoke-tokens"][1]),
+		},
+		{
+			Pattern: "scim/v2/ServiceProviderConfig$",
+
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "scim",
+				OperationSuffix: "service-provider-config",
+			},
+
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: i.pathSCIMServiceProviderConfig,
+					Summary:  "Read the SCIM service provider configuration.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(scimHelp["scim-discovery"][0]),
+			HelpDescription: strings.TrimSpace(scimHelp["scim-discovery"][1]),
+		},
+		{
+			Pattern: "scim/v2/ResourceTypes$",
+
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "scim",
+				OperationSuffix: "resource-types",
+			},
+
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: i.pathSCIMResourceTypes,
+					Summary:  "Read the SCIM resource types.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(scimHelp["scim-discovery"][0]),
+			HelpDescription: strings.TrimSpace(scimHelp["scim-discovery"][1]),
+		},
+		{
+			Pattern: "scim/v2/Users$",
+
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "scim",
+				OperationSuffix: "users",
+			},
+
+			TakesArbitraryInput: true,
+
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: i.scimOperation(i.pathSCIMUsersList),
+					Summary:  "List or filter the users provisioned by the SCIM client.",
+				},
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: i.scimOperation(i.pathSCIMUserCreate),
+					Summary:  "Provision a user.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(scimHelp["scim-users"][0]),
+			HelpDescription: strings.TrimSpace(scimHelp["scim-users"][1]),
+		},
+		{
+			Pattern: "scim/v2/Users/" + uuidRegex("id") + "$",
+
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "scim",
+				OperationSuffix: "user",
+			},
+
+			Fields: map[string]*framework.FieldSchema{
+				"id": {
+					Type:        framework.TypeString,
+					Description: "ID of the user, which is the ID of its entity.",
+				},
+			},
+
+			TakesArbitraryInput: true,
+
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: i.scimOperation(i.pathSCIMUserRead),
+					Summary:  "Read a user.",
+				},
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: i.scimOperation(i.pathSCIMUserReplace),
+					Summary:  "Replace a user.",
+				},
+				logical.PatchOperation: &framework.PathOperation{
+					Callback: i.scimOperation(i.pathSCIMUserPatch),
+					Summary:  "Modify a user.",
+				},
+				logical.DeleteOperation: &framework.PathOperation{
+					Callback: i.scimOperation(i.pathSCIMUserDelete),
+					Summary:  "Deprovision a user.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(scimHelp["scim-users"][0]),
+			HelpDescription: strings.TrimSpace(scimHelp["scim-users"][1]),
+		},
+		{
+			Pattern: "scim/v2/Groups$",
+
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "scim",
+				OperationSuffix: "groups",
+			},
+
+			TakesArbitraryInput: true,
+
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: i.scimOperation(i.pathSCIMGroupsList),
+					Summary:  "List or filter the groups provisioned by the SCIM client.",
+				},
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: i.scimOperation(i.pathSCIMGroupCreate),
+					Summary:  "Provision a group.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(scimHelp["scim-groups"][0]),
+			HelpDescription: strings.TrimSpace(scimHelp["scim-groups"][1]),
+		},
+		{
+			Pattern: "scim/v2/Groups/" + uuidRegex("id") + "$",
+
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "scim",
+				OperationSuffix: "group",
+			},
+
+			Fields: map[string]*framework.FieldSchema{
+				"id": {
+					Type:        framework.TypeString,
+					Description: "ID of the group.",
+				},
+			},
+
+			TakesArbitraryInput: true,
+
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: i.scimOperation(i.pathSCIMGroupRead),
+					Summary:  "Read a group.",
+				},
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: i.scimOperation(i.pathSCIMGroupReplace),
+					Summary:  "Replace a group.",
+				},
+				logical.PatchOperation: &framework.PathOperation{
+					Callback: i.scimOperation(i.pathSCIMGroupPatch),
+					Summary:  "Modify a group.",
+				},
+				logical.DeleteOperation: &framework.PathOperation{
+					Callback: i.scimOperation(i.pathSCIMGroupDelete),
+					Summary:  "Deprovision a group.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(scimHelp["scim-groups"][0]),
+			HelpDescription: strings.TrimSpace(scimHelp["scim-groups"][1]),
+		},
+	}
+}
+
+func (i *IdentityStore) scimNotEnabledResponse() (*logical.Response, error) {
+	return logical.ErrorResponse("SCIM is not enabled; activate the %q feature to enable it", activationflags.SCIMEnablement), logical.ErrInvalidRequest
+}
+
+func (i *IdentityStore) pathSCIMClientExistenceCheck(ctx context.Context, req *logical.Request, d *framework.FieldData) (bool, error) {
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	client, err := i.memDBSCIMClientByName(ns.ID, d.Get("name").(string))
+	if err != nil {
+		return false, err
+	}
+
+	return client != nil, nil
+}
+
+func (i *IdentityStore) pathSCIMClientList(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if !i.scimEnabled {
+		return i.scimNotEnabledResponse()
+	}
+
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := i.memDBSCIMClientsByNamespace(ns.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	keyInfo := make(map[string]interface{}, len(clients))
+	for _, client := range clients {
+		keys = append(keys, client.ClientName)
+		keyInfo[client.ClientName] = map[string]interface{}{
+			"client_id":            client.ClientID,
+			"alias_mount_accessor": client.AliasMountAccessor,
+			"deleting":             client.Deleting,
+		}
+	}
+
+	return logical.ListResponseWithInfo(keys, keyInfo), nil
+}
+
+func (i *IdentityStore) pathSCIMClientCreateUpdate(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if !i.scimEnabled {
+		return i.scimNotEnabledResponse()
+	}
+
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	client, err := i.memDBSCIMClientByName(ns.ID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case client == nil:
+		clientID, err := uuid.GenerateUUID()
+		if err != nil {
+			return nil, err
+		}
+		client = &identity.ScimClient{
+			ClientID:    clientID,
+			ClientName:  name,
+			NamespaceID: ns.ID,
+		}
+	case client.Deleting:
+		return logical.ErrorResponse("SCIM client %q is being deleted", name), logical.ErrInvalidRequest
+	default:
+		client = proto.Clone(client).(*identity.ScimClient)
+	}
+
+	if accessorRaw, ok := d.GetOk("alias_mount_accessor"); ok {
+		accessor := accessorRaw.(string)
+		if accessor != "" {
+			mountValidationResp := i.router.ValidateMountByAccessor(accessor)
+			if mountValidationResp == nil {
+				return logical.ErrorResponse("invalid alias_mount_accessor %q", accessor), nil
+			}
+			if mountValidationResp.MountLocal {
+				return logical.ErrorResponse("alias_mount_accessor %q refers to a local mount", accessor), nil
+			}
+		}
+
+		if accessor != client.AliasMountAccessor && client.AliasMountAccessor != "" {
+			entities, err := i.scimEntities(ns.ID, client.ClientID)
+			if err != nil {
+				return nil, err
+			}
+			if len(entities) > 0 {
+				return logical.ErrorResponse("alias_mount_accessor cannot be changed while the client has provisioned users"), nil
+			}
+		}
+		client.AliasMountAccessor = accessor
+	}
+
+	if client.AccessGrantPrincipal == "" {
+		if err := i.createSCIMPrincipal(ctx, client); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := i.persistSCIMClient(ctx, req.Storage, client); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (i *IdentityStore) pathSCIMClientRead(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if !i.scimEnabled {
+		return i.scimNotEnabledResponse()
+	}
+
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := i.memDBSCIMClientByName(ns.ID, d.Get("name").(string))
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, nil
+	}
+
+	return &logical.Response{
+		Data: map[string]interface{}{
+			"client_id":              client.ClientID,
+			"name":                   client.ClientName,
+			"alias_mount_accessor":   client.AliasMountAccessor,
+			"access_grant_principal": client.AccessGrantPrincipal,
+			"deleting":               client.Deleting,
+		},
+	}, nil
+}
+
+func (i *IdentityStore) pathSCIMClientDelete(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if !i.scimEnabled {
+		return i.scimNotEnabledResponse()
+	}
+
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := i.markSCIMClientDeleting(ctx, req.Storage, ns.ID, d.Get("name").(string))
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, nil
+	}
+
+	// Remove the resources of the client right away, and keep retrying in
+	// the background if that fails
+	if err := i.cleanupSCIMClient(ctx, req.Storage, client); err != nil {
+		i.logger.Warn("failed to clean up SCIM client; retrying in the background", "client_id", client.ClientID, "error", err)
+		i.enqueueSCIMCleanup(client.ClientID, client.NamespaceID)
+
+		resp := &logical.Response{}
+		resp.AddWarning(fmt.Sprintf("The resources of the SCIM client are being removed in the background: %s", err))
+		return resp, nil
+	}
+
+	return nil, nil
+}
+
+func (i *IdentityStore) markSCIMClientDeleting(ctx context.Context, s logical.Storage, namespaceID, name string) (*identity.ScimClient, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	client, err := i.memDBSCIMClientByName(namespaceID, name)
+	if err != nil || client == nil {
+		return nil, err
+	}
+	if client.Deleting {
+		return client, nil
+	}
+
+	client = proto.Clone(client).(*identity.ScimClient)
+	client.Deleting = true
+	if err := i.persistSCIMClient(ctx, s, client); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func (i *IdentityStore) pathSCIMClientToken(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if !i.scimEnabled {
+		return i.scimNotEnabledResponse()
+	}
+
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+	client, err := i.memDBSCIMClientByName(ns.ID, name)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return logical.ErrorResponse("SCIM client %q not found", name), logical.ErrInvalidRequest
+	}
+	if client.Deleting {
+		return logical.ErrorResponse("SCIM client %q is being deleted", name), logical.ErrInvalidRequest
+	}
+
+	ttl := time.Duration(d.Get("ttl").(int)) * time.Second
+	if ttl <= 0 {
+		return logical.ErrorResponse("ttl must be positive"), nil
+	}
+
+	te := &logical.TokenEntry{
+		Type:               logical.TokenTypeBatch,
+		NamespaceID:        ns.ID,
+		Path:               req.Path,
+		TTL:                ttl,
+		CreationTime:       time.Now().Unix(),
+		EntityID:           client.AccessGrantPrincipal,
+		NoIdentityPolicies: true,
+		Meta: map[string]string{
+			"scim_client_name": client.ClientName,
+		},
+		InlinePolicy: `
+			path "identity/scim/v2/*" {
+				capabilities = ["create", "read", "update", "patch", "delete", "list"]
+			}`,
+	}
+	if err := i.tokenStorer.CreateToken(ctx, te); err != nil {
+		return nil, err
+	}
+
+	return &logical.Response{
+		Data: map[string]interface{}{
+			"token":     te.ID,
+			"client_id": client.ClientID,
+			"ttl":       int64(ttl.Seconds()),
+		},
+	}, nil
+}
+
+func (i *IdentityStore) pathSCIMClientRevokeTokens(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if !i.scimEnabled {
+		return i.scimNotEnabledResponse()
+	}
+
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := d.Get("name").(string)
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	client, err := i.memDBSCIMClientByName(ns.ID, name)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return logical.ErrorResponse("SCIM client %q not found", name), logical.ErrInvalidRequest
+	}
+	client = proto.Clone(client).(*identity.ScimClient)
+
+	// The bearer tokens of a client are batch tokens issued to its principal
+	// entity. Replacing the entity invalidates all of them, since requests
+	// made with a token whose entity no longer exists are denied.
+	if err := i.deleteSCIMPrincipal(ctx, client); err != nil {
+		return nil, err
+	}
+	if err := i.createSCIMPrincipal(ctx, client); err != nil {
+		return nil, err
+	}
+	if err := i.persistSCIMClient(ctx, req.Storage, client); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// createSCIMPrincipal creates the entity that the bearer tokens of the client
+// are issued to. The caller must hold the identity store lock.
+func (i *IdentityStore) createSCIMPrincipal(ctx context.Context, client *identity.ScimClient) error {
+	name := scimPrincipalNamePrefix + client.ClientName
+	existing, err := i.MemDBEntityByName(ctx, name, false)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return fmt.Errorf("entity %q already exists", name)
+	}
+
+	entity := &identity.Entity{
+		Name: name,
+		Metadata: map[string]string{
+			"scim_client_id": client.ClientID,
+		},
+	}
+	if err := i.sanitizeEntity(ctx, entity); err != nil {
+		return err
+	}
+	if err := i.upsertEntity(ctx, entity, nil, true); err != nil {
+		return err
+	}
+
+	client.AccessGrantPrincipal = entity.ID
+	return nil
+}
+
+// deleteSCIMPrincipal deletes the principal entity of the client. The caller
+// must hold the identity store lock.
+func (i *IdentityStore) deleteSCIMPrincipal(ctx context.Context, client *identity.ScimClient) error {
+	if client.AccessGrantPrincipal == "" {
+		return nil
+	}
+
+	txn := i.db.Txn(true)
+	defer txn.Abort()
+
+	entity, err := i.MemDBEntityByIDInTxn(txn, client.AccessGrantPrincipal, true)
+	if err != nil {
+		return err
+	}
+	if entity != nil {
+		if err := i.handleEntityDeleteCommon(ctx, txn, entity, true); err != nil {
+			return err
+		}
+	}
+	txn.Commit()
+
+	client.AccessGrantPrincipal = ""
+	return nil
+}
+
+// cleanupSCIMClient removes the users and groups provisioned by a client that
+// is being deleted, its principal entity and finally the client itself.
+func (i *IdentityStore) cleanupSCIMClient(ctx context.Context, s logical.Storage, client *identity.ScimClient) error {
+	scimCtx := addSCIMClientIDToContext(ctx, client.ClientID)
+
+	groups, err := i.scimGroups(client.NamespaceID, client.ClientID)
+	if err != nil {
+		return err
+	}
+	for _, group := range groups {
+		if _, err := i.handleGroupDeleteCommon(scimCtx, group.ID, true); err != nil {
+			return fmt.Errorf("failed to delete group %q: %w", group.ID, err)
+		}
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	entities, err := i.scimEntities(client.NamespaceID, client.ClientID)
+	if err != nil {
+		return err
+	}
+
+	txn := i.db.Txn(true)
+	defer txn.Abort()
+
+	for _, entity := range entities {
+		entity, err := i.MemDBEntityByIDInTxn(txn, entity.ID, true)
+		if err != nil {
+			return err
+		}
+		if entity == nil {
+			continue
+		}
+		if err := i.handleEntityDeleteCommon(scimCtx, txn, entity, true); err != nil {
+			return fmt.Errorf("failed to delete entity %q: %w", entity.ID, err)
+		}
+	}
+	txn.Commit()
+
+	if err := i.deleteSCIMPrincipal(ctx, client); err != nil {
+		return err
+	}
+
+	if err := s.Delete(ctx, scimClientStoragePrefix+client.ClientID); err != nil {
+		return err
+	}
+
+	txn = i.db.Txn(true)
+	defer txn.Abort()
+	if err := i.memDBDeleteSCIMClientByIDInTxn(txn, client.ClientID); err != nil {
+		return err
+	}
+	txn.Commit()
+
+	return nil
+}
+
+func (i *IdentityStore) persistSCIMClient(ctx context.Context, s logical.Storage, client *identity.ScimClient) error {
+	entry, err := logical.StorageEntryJSON(scimClientStoragePrefix+client.ClientID, client)
+	if err != nil {
+		return err
+	}
+	if err := s.Put(ctx, entry); err != nil {
+		return err
+	}
+
+	txn := i.db.Txn(true)
+	defer txn.Abort()
+	if err := i.memDBUpsertSCIMClientInTxn(txn, client); err != nil {
+		return err
+	}
+	txn.Commit()
+
+	return nil
+}
+
 func (i *IdentityStore) loadSCIMClients(ctx context.Context) error {
+	i.logger.Debug("identity loading SCIM clients")
+
+	ids, err := i.view.List(ctx, scimClientStoragePrefix)
+	if err != nil {
+		return err
+	}
+
+	txn := i.db.Txn(true)
+	defer txn.Abort()
+	for _, id := range ids {
+		entry, err := i.view.Get(ctx, scimClientStoragePrefix+id)
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			continue
+		}
+
+		var client identity.ScimClient
+		if err := entry.DecodeJSON(&client); err != nil {
+			return err
+		}
+
+		if err := i.memDBUpsertSCIMClientInTxn(txn, &client); err != nil {
+			return err
+		}
+	}
+	txn.Commit()
+
 	return nil
 }
 
 func (i *IdentityStore) invalidateSCIMClient(ctx context.Context, key string) {
+	clientID := strings.TrimPrefix(key, scimClientStoragePrefix)
+
+	txn := i.db.Txn(true)
+	defer txn.Abort()
+
+	entry, err := i.view.Get(ctx, key)
+	if err != nil {
+		i.logger.Error("failed to read SCIM client from storage", "client_id", clientID, "error", err)
+		return
+	}
+
+	if entry == nil {
+		err = i.memDBDeleteSCIMClientByIDInTxn(txn, clientID)
+	} else {
+		var client identity.ScimClient
+		if err := entry.DecodeJSON(&client); err != nil {
+			i.logger.Error("failed to decode SCIM client", "client_id", clientID, "error", err)
+			return
+		}
+		err = i.memDBUpsertSCIMClientInTxn(txn, &client)
+	}
+	if err != nil {
+		i.logger.Error("failed to update SCIM client in memdb", "client_id", clientID, "error", err)
+		return
+	}
+
+	txn.Commit()
 }
 
+// startSCIMDeletingClientCleanup resumes the cleanup of the clients that were
+// being deleted when the previous active node stepped down.
 func (i *IdentityStore) startSCIMDeletingClientCleanup(ctx context.Context, isActive bool) {
+	if !isActive {
+		return
+	}
+
+	i.stopSCIMDeletingClientCleanup()
+	i.scimCleanupCtx, i.scimCleanupCancel = context.WithCancel(ctx)
+
+	txn := i.db.Txn(false)
+	iter, err := txn.Get(scimClientsTable, "id")
+	if err != nil {
+		i.logger.Error("failed to list SCIM clients", "error", err)
+		return
+	}
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		client := raw.(*identity.ScimClient)
+		if client.Deleting {
+			i.enqueueSCIMCleanup(client.ClientID, client.NamespaceID)
+		}
+	}
 }
 
 func (i *IdentityStore) stopSCIMDeletingClientCleanup() {
+	if i.scimCleanupCancel != nil {
+		i.scimCleanupCancel()
+		i.scimCleanupCancel = nil
+	}
 }
 
+// enqueueSCIMCleanup removes the resources of a client that is being deleted
+// in the background, retrying until it succeeds or the node steps down.
 func (i *IdentityStore) enqueueSCIMCleanup(clientID string, namespaceID string) {
+	cleanupCtx := i.scimCleanupCtx
+	if cleanupCtx == nil {
+		return
+	}
+
+	go func() {
+		for {
+			err := func() error {
+				ns, err := i.namespacer.NamespaceByID(cleanupCtx, namespaceID)
+				if err != nil {
+					return err
+				}
+				if ns == nil {
+					return fmt.Errorf("namespace %q not found", namespaceID)
+				}
+
+				client, err := i.memDBSCIMClientByID(clientID)
+				if err != nil || client == nil {
+					return err
+				}
+
+				return i.cleanupSCIMClient(namespace.ContextWithNamespace(cleanupCtx, ns), i.view, client)
+			}()
+			if err == nil {
+				return
+			}
+			i.logger.Warn("failed to clean up SCIM client", "client_id", clientID, "error", err)
+
+			select {
+			case <-cleanupCtx.Done():
+				return
+			case <-time.After(scimCleanupRetryPeriod):
+			}
+		}
+	}()
+}
+
+// scimOperationFunc handles a request made by a SCIM client.
+type scimOperationFunc func(context.Context, *logical.Request, *framework.FieldData, *identity.ScimClient) (*logical.Response, error)
+
+// scimOperation resolves the SCIM client from the credentials of the request
+// and renders SCIM errors returned by the handler.
+func (i *IdentityStore) scimOperation(f scimOperationFunc) framework.OperationFunc {
+	return func(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+		if !i.scimEnabled {
+			return scimErrorResponse(newSCIMError(http.StatusNotFound, "", "SCIM is not enabled"))
+		}
+
+		ns, err := namespace.FromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := i.memDBSCIMClientByPrincipal(ns.ID, req.EntityID)
+		if err != nil {
+			return nil, err
+		}
+		if client == nil {
+			return scimErrorResponse(newSCIMError(http.StatusForbidden, "", "request was not made with SCIM client credentials"))
+		}
+		if client.Deleting {
+			return scimErrorResponse(newSCIMError(http.StatusForbidden, "", "SCIM client is being deleted"))
+		}
+
+		resp, err := f(addSCIMClientIDToContext(ctx, client.ClientID), req, d, client)
+		var scimErr *scimError
+		if errors.As(err, &scimErr) {
+			return scimErrorResponse(scimErr)
+		}
+		return resp, err
+	}
+}
+
+func (i *IdentityStore) pathSCIMServiceProviderConfig(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if !i.scimEnabled {
+		return scimErrorResponse(newSCIMError(http.StatusNotFound, "", "SCIM is not enabled"))
+	}
+
+	return scimResponse(http.StatusOK, map[string]interface{}{
+		"schemas": []string{scimSchemaServiceProviderConfig},
+		"patch": map[string]interface{}{
+			"supported": true,
+		},
+		"bulk": map[string]interface{}{
+			"supported":      false,
+			"maxOperations":  0,
+			"maxPayloadSize": 0,
+		},
+		"filter": map[string]interface{}{
+			"supported":  true,
+			"maxResults": scimMaxPageSize,
+		},
+		"changePassword": map[string]interface{}{
+			"supported": false,
+		},
+		"sort": map[string]interface{}{
+			"supported": false,
+		},
+		"etag": map[string]interface{}{
+			"supported": true,
+		},
+		"authenticationSchemes": []map[string]interface{}{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication using a bearer token generated for the SCIM client",
+				"primary":     true,
+			},
+		},
+	}, nil)
+}
+
+func (i *IdentityStore) pathSCIMResourceTypes(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if !i.scimEnabled {
+		return scimErrorResponse(newSCIMError(http.StatusNotFound, "", "SCIM is not enabled"))
+	}
+
+	resourceTypes := []map[string]interface{}{
+		{
+			"schemas":  []string{scimSchemaResourceType},
+			"id":       "User",
+			"name":     "User",
+			"endpoint": "/Users",
+			"schema":   scimSchemaUser,
+		},
+		{
+			"schemas":  []string{scimSchemaResourceType},
+			"id":       "Group",
+			"name":     "Group",
+			"endpoint": "/Groups",
+			"schema":   scimSchemaGroup,
+		},
+	}
+
+	return scimResponse(http.StatusOK, &scimListResponse{
+		Schemas:      []string{scimSchemaListResponse},
+		TotalResults: len(resourceTypes),
+		StartIndex:   1,
+		ItemsPerPage: len(resourceTypes),
+		Resources:    resourceTypes,
+	}, nil)
+}
+
+// scimResponse returns a raw SCIM response with the given status, body and
+// headers.
+func scimResponse(status int, body interface{}, headers map[string]string) (*logical.Response, error) {
+	resp := &logical.Response{
+		Data: map[string]interface{}{
+			logical.HTTPStatusCode:  status,
+			logical.HTTPContentType: scimContentType,
+		},
+	}
+
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		resp.Data[logical.HTTPRawBody] = raw
+	}
+
+	for k, v := range headers {
+		if resp.Headers == nil {
+			resp.Headers = make(map[string][]string)
+		}
+		resp.Headers[k] = []string{v}
+	}
+
+	return resp, nil
+}
+
+func scimErrorResponse(err *scimError) (*logical.Response, error) {
+	body := map[string]interface{}{
+		"schemas": []string{scimSchemaError},
+		"status":  fmt.Sprintf("%d", err.status),
+		"detail":  err.detail,
+	}
+	if err.scimType != "" {
+		body["scimType"] = err.scimType
+	}
+
+	return scimResponse(err.status, body, nil)
+}
+
+// scimVersion returns the weak ETag of a resource representation.
+func scimVersion(v interface{}) (string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return fmt.Sprintf(`W/"%x"`, sum[:12]), nil
+}
+
+// scimRequestHeader returns the value of the given request header. Only the
+// Authorization header is passed through to the identity backend, so other
+// headers are read from the original HTTP request.
+func scimRequestHeader(req *logical.Request, name string) string {
+	for k, v := range req.Headers {
+		if strings.EqualFold(k, name) && len(v) > 0 {
+			return v[0]
+		}
+	}
+	if req.HTTPRequest != nil {
+		return req.HTTPRequest.Header.Get(name)
+	}
+	return ""
+}
+
+func scimETagMatches(header, version string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(version, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// scimCheckPrecondition enforces the If-Match header of requests modifying a
+// resource.
+func scimCheckPrecondition(req *logical.Request, version string) error {
+	if ifMatch := scimRequestHeader(req, "If-Match"); ifMatch != "" && !scimETagMatches(ifMatch, version) {
+		return newSCIMError(http.StatusPreconditionFailed, "", "resource version does not match If-Match")
+	}
+	return nil
+}
+
+// scimBaseURL returns the URL of the SCIM API, used in resource locations.
+func (i *IdentityStore) scimBaseURL(ctx context.Context, req *logical.Request) string {
+	if r := req.HTTPRequest; r != nil && r.URL != nil {
+		if idx := strings.Index(r.URL.Path, "/scim/v2"); idx >= 0 {
+			scheme := "https"
+			if r.TLS == nil {
+				scheme = "http"
+			}
+			return scheme + "://" + r.Host + r.URL.Path[:idx+len("/scim/v2")]
+		}
+	}
+
+	nsPath := ""
+	if ns, err := namespace.FromContext(ctx); err == nil {
+		nsPath = ns.Path
+	}
+	return strings.TrimSuffix(i.redirectAddr, "/") + "/v1/" + nsPath + "identity/scim/v2"
+}
+
+func (i *IdentityStore) memDBSCIMClientByID(id string) (*identity.ScimClient, error) {
+	txn := i.db.Txn(false)
+	return scimClientFromRaw(txn.First(scimClientsTable, "id", id))
+}
+
+func (i *IdentityStore) memDBSCIMClientByName(namespaceID, name string) (*identity.ScimClient, error) {
+	txn := i.db.Txn(false)
+	return scimClientFromRaw(txn.First(scimClientsTable, "client_name", namespaceID, name))
+}
+
+func (i *IdentityStore) memDBSCIMClientByPrincipal(namespaceID, entityID string) (*identity.ScimClient, error) {
+	if entityID == "" {
+		return nil, nil
+	}
+	txn := i.db.Txn(false)
+	return scimClientFromRaw(txn.First(scimClientsTable, "access_grant_principal", namespaceID, entityID))
+}
+
+func (i *IdentityStore) memDBSCIMClientsByNamespace(namespaceID string) ([]*identity.ScimClient, error) {
+	txn := i.db.Txn(false)
+	iter, err := txn.Get(scimClientsTable, "namespace_id", namespaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch SCIM clients from memdb: %w", err)
+	}
+
+	var clients []*identity.ScimClient
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		clients = append(clients, raw.(*identity.ScimClient))
+	}
+	return clients, nil
+}
+
+func scimClientFromRaw(raw interface{}, err error) (*identity.ScimClient, error) {
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch SCIM client from memdb: %w", err)
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	client, ok := raw.(*identity.ScimClient)
+	if !ok {
+		return nil, errors.New("unexpected SCIM client type")
+	}
+	return client, nil
+}
+
+func (i *IdentityStore) memDBUpsertSCIMClientInTxn(txn *memdb.Txn, client *identity.ScimClient) error {
+	if err := i.memDBDeleteSCIMClientByIDInTxn(txn, client.ClientID); err != nil {
+		return err
+	}
+	if err := txn.Insert(scimClientsTable, client); err != nil {
+		return fmt.Errorf("failed to update SCIM client into memdb: %w", err)
+	}
+	return nil
+}
+
+func (i *IdentityStore) memDBDeleteSCIMClientByIDInTxn(txn *memdb.Txn, id string) error {
+	raw, err := txn.First(scimClientsTable, "id", id)
+	if err != nil {
+		return fmt.Errorf("failed to lookup SCIM client from memdb: %w", err)
+	}
+	if raw == nil {
+		return nil
+	}
+	if err := txn.Delete(scimClientsTable, raw); err != nil {
+		return fmt.Errorf("failed to delete SCIM client from memdb: %w", err)
+	}
+	return nil
 }
 
-func scimPaths(_ *IdentityStore) []*framework.Path {
-	return []*framework.Path{}
+var scimHelp = map[string][2]string{
+	"scim-client-list": {
+		"List the SCIM clients.",
+		"",
+	},
+	"scim-client": {
+		"Create, read, update or delete a SCIM client.",
+		`A SCIM client is an identity provider that provisions users and groups
+through the SCIM 2.0 API at identity/scim/v2. Users are mapped to entities,
+with an alias on the auth mount given by alias_mount_accessor, and groups are
+mapped to internal groups. Deleting a client deletes the users and groups it
+provisioned.`,
+	},
+	"scim-client-token": {
+		"Generate a bearer token for a SCIM client.",
+		`The token may only be used to call the SCIM 2.0 API, and is presented to
+it in the Authorization header.`,
+	},
+	"scim-client-revoke-tokens": {
+		"Revoke all bearer tokens of a SCIM client.",
+		"",
+	},
+	"scim-discovery": {
+		"SCIM 2.0 service provider discovery.",
+		"",
+	},
+	"scim-users": {
+		"SCIM 2.0 Users endpoint.",
+		`Users provisioned by a SCIM client are stored as entities owned by the
+client. The userName of a user is the name of its entity and of its alias on
+the auth mount of the client.`,
+	},
+	"scim-groups": {
+		"SCIM 2.0 Groups endpoint.",
+		`Groups provisioned by a SCIM client are stored as internal groups owned
+by the client, and may only have users of the same client as members.`,
+	},
 }
@@ -0,0 +1,342 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !enterprise
+
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/vault/helper/namespace"
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/stretchr/testify/require"
+)
+
+type scimTestResponse struct {
+	status  int
+	body    map[string]interface{}
+	headers map[string][]string
+}
+
+// scimTestRequest makes a request to the SCIM API through the core, using the
+// given bearer token.
+func scimTestRequest(t *testing.T, c *Core, token string, op logical.Operation, path string, data map[string]interface{}, headers map[string]string) *scimTestResponse {
+	t.Helper()
+
+	httpReq := httptest.NewRequest(http.MethodGet, "http://127.0.0.1:8200/v1/identity/scim/v2/"+path, nil)
+	for k, v := range headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	resp, err := c.HandleRequest(namespace.RootContext(nil), &logical.Request{
+		Operation:   op,
+		Path:        "identity/scim/v2/" + path,
+		ClientToken: token,
+		Data:        data,
+		HTTPRequest: httpReq,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	ret := &scimTestResponse{
+		status:  resp.Data[logical.HTTPStatusCode].(int),
+		headers: resp.Headers,
+	}
+	if raw, ok := resp.Data[logical.HTTPRawBody]; ok {
+		require.NoError(t, json.Unmarshal(raw.([]byte), &ret.body))
+	}
+	return ret
+}
+
+func TestIdentityStore_SCIM(t *testing.T) {
+	ctx := namespace.RootContext(nil)
+	is, ghAccessor, c := testIdentityStoreWithGithubAuth(ctx, t)
+
+	// The API is not available until SCIM is activated
+	resp, err := is.HandleRequest(ctx, &logical.Request{
+		Operation: logical.CreateOperation,
+		Path:      "scim/client/idp",
+		Storage:   is.view,
+	})
+	require.Error(t, err)
+	require.True(t, resp.IsError())
+
+	is.scimEnabled = true
+
+	resp, err = is.HandleRequest(ctx, &logical.Request{
+		Operation: logical.CreateOperation,
+		Path:      "scim/client/idp",
+		Storage:   is.view,
+		Data: map[string]interface{}{
+			"alias_mount_accessor": ghAccessor,
+		},
+	})
+	require.NoError(t, err)
+	require.Nil(t, resp)
+
+	resp, err = is.HandleRequest(ctx, &logical.Request{
+		Operation: logical.UpdateOperation,
+		Path:      "scim/client/idp/token",
+		Storage:   is.view,
+	})
+	require.NoError(t, err)
+	require.False(t, resp.IsError())
+	token := resp.Data["token"].(string)
+
+	// Provision a user
+	sr := scimTestRequest(t, c, token, logical.UpdateOperation, "Users", map[string]interface{}{
+		"schemas":     []interface{}{scimSchemaUser},
+		"userName":    "alice",
+		"externalId":  "00u1",
+		"displayName": "Alice",
+		"emails": []interface{}{
+			map[string]interface{}{"value": "alice@example.com", "primary": true},
+		},
+	}, nil)
+	require.Equal(t, http.StatusCreated, sr.status, sr.body)
+	userID := sr.body["id"].(string)
+	require.Equal(t, []string{"http://127.0.0.1:8200/v1/identity/scim/v2/Users/" + userID}, sr.headers["Location"])
+	require.NotEmpty(t, sr.headers["ETag"])
+
+	entity, err := is.MemDBEntityByID(userID, false)
+	require.NoError(t, err)
+	require.Equal(t, "alice", entity.Name)
+	require.Equal(t, "00u1", entity.ExternalID)
+	require.Equal(t, "alice@example.com", entity.Metadata[scimEmailMetadataKey])
+	require.NotEmpty(t, entity.ScimClientID)
+	require.Len(t, entity.Aliases, 1)
+	require.Equal(t, ghAccessor, entity.Aliases[0].MountAccessor)
+	require.Equal(t, "alice", entity.Aliases[0].Name)
+
+	// The user name must be unique
+	sr = scimTestRequest(t, c, token, logical.UpdateOperation, "Users", map[string]interface{}{
+		"userName": "alice",
+	}, nil)
+	require.Equal(t, http.StatusConflict, sr.status)
+	require.Equal(t, "uniqueness", sr.body["scimType"])
+
+	// Filtering
+	for filter, expected := range map[string]float64{
+		`userName eq "ALICE"`:                          1,
+		`emails[value co "example.com"]`:               1,
+		`externalId eq "00u2"`:                         0,
+		`not (active eq false) and displayName sw "A"`: 1,
+	} {
+		sr = scimTestRequest(t, c, token, logical.ReadOperation, "Users", map[string]interface{}{
+			"filter": filter,
+		}, nil)
+		require.Equal(t, http.StatusOK, sr.status, filter)
+		require.Equal(t, expected, sr.body["totalResults"], filter)
+	}
+
+	sr = scimTestRequest(t, c, token, logical.ReadOperation, "Users", map[string]interface{}{
+		"filter": `userName zz "alice"`,
+	}, nil)
+	require.Equal(t, http.StatusBadRequest, sr.status)
+	require.Equal(t, "invalidFilter", sr.body["scimType"])
+
+	// Conditional reads and updates
+	sr = scimTestRequest(t, c, token, logical.ReadOperation, "Users/"+userID, nil, nil)
+	require.Equal(t, http.StatusOK, sr.status)
+	etag := sr.headers["ETag"][0]
+
+	sr = scimTestRequest(t, c, token, logical.ReadOperation, "Users/"+userID, nil, map[string]string{"If-None-Match": etag})
+	require.Equal(t, http.StatusNotModified, sr.status)
+
+	deactivate := map[string]interface{}{
+		"schemas": []interface{}{"urn:ietf:params:scim:api:messages:2.0:PatchOp"},
+		"Operations": []interface{}{
+			map[string]interface{}{"op": "Replace", "value": map[string]interface{}{"active": "False"}},
+		},
+	}
+	sr = scimTestRequest(t, c, token, logical.PatchOperation, "Users/"+userID, deactivate, map[string]string{"If-Match": `W/"stale"`})
+	require.Equal(t, http.StatusPreconditionFailed, sr.status)
+
+	sr = scimTestRequest(t, c, token, logical.PatchOperation, "Users/"+userID, deactivate, map[string]string{"If-Match": etag})
+	require.Equal(t, http.StatusOK, sr.status, sr.body)
+	require.Equal(t, false, sr.body["active"])
+	require.NotEqual(t, etag, sr.headers["ETag"][0])
+
+	entity, err = is.MemDBEntityByID(userID, false)
+	require.NoError(t, err)
+	require.True(t, entity.Disabled)
+
+	// Groups
+	sr = scimTestRequest(t, c, token, logical.UpdateOperation, "Groups", map[string]interface{}{
+		"schemas":     []interface{}{scimSchemaGroup},
+		"displayName": "engineering",
+		"externalId":  "00g1",
+		"members": []interface{}{
+			map[string]interface{}{"value": userID},
+		},
+	}, nil)
+	require.Equal(t, http.StatusCreated, sr.status, sr.body)
+	groupID := sr.body["id"].(string)
+
+	group, err := is.MemDBGroupByID(groupID, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{userID}, group.MemberEntityIDs)
+	require.Equal(t, groupTypeInternal, group.Type)
+
+	sr = scimTestRequest(t, c, token, logical.ReadOperation, "Users/"+userID, nil, nil)
+	require.Len(t, sr.body["groups"], 1)
+
+	// Members must be users of the client
+	sr = scimTestRequest(t, c, token, logical.PatchOperation, "Groups/"+groupID, map[string]interface{}{
+		"Operations": []interface{}{
+			map[string]interface{}{"op": "add", "path": "members", "value": []interface{}{map[string]interface{}{"value": "not-a-user"}}},
+		},
+	}, nil)
+	require.Equal(t, http.StatusBadRequest, sr.status)
+
+	sr = scimTestRequest(t, c, token, logical.PatchOperation, "Groups/"+groupID, map[string]interface{}{
+		"Operations": []interface{}{
+			map[string]interface{}{"op": "remove", "path": `members[value eq "` + userID + `"]`},
+		},
+	}, nil)
+	require.Equal(t, http.StatusOK, sr.status, sr.body)
+	require.Nil(t, sr.body["members"])
+
+	// Resources provisioned by SCIM cannot be deleted through the API
+	_, err = is.HandleRequest(ctx, &logical.Request{
+		Operation: logical.DeleteOperation,
+		Path:      "entity/id/" + userID,
+		Storage:   is.view,
+	})
+	require.Error(t, err)
+
+	sr = scimTestRequest(t, c, token, logical.DeleteOperation, "Users/"+userID, nil, nil)
+	require.Equal(t, http.StatusNoContent, sr.status)
+	entity, err = is.MemDBEntityByID(userID, false)
+	require.NoError(t, err)
+	require.Nil(t, entity)
+
+	sr = scimTestRequest(t, c, token, logical.ReadOperation, "Users/"+userID, nil, nil)
+	require.Equal(t, http.StatusNotFound, sr.status)
+
+	// Revoking the tokens of the client invalidates the token
+	resp, err = is.HandleRequest(ctx, &logical.Request{
+		Operation: logical.UpdateOperation,
+		Path:      "scim/client/idp/revoke-tokens",
+		Storage:   is.view,
+	})
+	require.NoError(t, err)
+	require.Nil(t, resp)
+
+	_, err = c.HandleRequest(ctx, &logical.Request{
+		Operation:   logical.ReadOperation,
+		Path:        "identity/scim/v2/Users",
+		ClientToken: token,
+	})
+	require.Error(t, err)
+
+	// Deleting the client deletes its groups
+	resp, err = is.HandleRequest(ctx, &logical.Request{
+		Operation: logical.DeleteOperation,
+		Path:      "scim/client/idp",
+		Storage:   is.view,
+	})
+	require.NoError(t, err)
+	require.Nil(t, resp)
+
+	group, err = is.MemDBGroupByID(groupID, false)
+	require.NoError(t, err)
+	require.Nil(t, group)
+
+	client, err := is.memDBSCIMClientByName(namespace.RootNamespaceID, "idp")
+	require.NoError(t, err)
+	require.Nil(t, client)
+}
+
+// TestIdentityStore_SCIMClientRequired verifies that the SCIM API may only be
+// called with the credentials of a SCIM client.
+func TestIdentityStore_SCIMClientRequired(t *testing.T) {
+	ctx := namespace.RootContext(nil)
+	c, _, root := TestCoreUnsealed(t)
+	c.identityStore.scimEnabled = true
+
+	sr := scimTestRequest(t, c, root, logical.ReadOperation, "Users", nil, nil)
+	require.Equal(t, http.StatusForbidden, sr.status)
+
+	resp, err := c.HandleRequest(ctx, &logical.Request{
+		Operation: logical.ReadOperation,
+		Path:      "identity/scim/v2/ServiceProviderConfig",
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Data[logical.HTTPStatusCode])
+}
+
+func TestSCIMFilter(t *testing.T) {
+	resource := map[string]interface{}{
+		"userName": "Alice",
+		"active":   true,
+		"emails": []interface{}{
+			map[string]interface{}{"value": "alice@example.com", "type": "work"},
+			map[string]interface{}{"value": "alice@home.example", "type": "home"},
+		},
+		"meta": map[string]interface{}{"created": "2024-01-01T00:00:00Z"},
+	}
+
+	tests := map[string]bool{
+		`userName eq "alice"`: true,
+		`USERNAME ne "alice"`: false,
+		`urn:ietf:params:scim:schemas:core:2.0:User:userName sw "al"`: true,
+		`emails co "home"`: true,
+		`emails[type eq "work" and value ew "example.com"]`: true,
+		`emails[type eq "other"]`:                           false,
+		`emails.type eq "home"`:                             true,
+		`meta.created gt "2023-12-31T00:00:00Z"`:            true,
+		`title pr`:                                          false,
+		`title eq null`:                                     true,
+		`active eq true and (userName eq "bob" or userName eq "alice")`: true,
+		`not (active eq true) or userName eq "bob"`:                     false,
+	}
+	for filter, expected := range tests {
+		f, err := parseSCIMFilter(filter)
+		require.NoError(t, err, filter)
+		require.Equal(t, expected, f.matches(resource), filter)
+	}
+
+	for _, filter := range []string{``, `userName`, `userName eq`, `(userName eq "a"`, `userName eq "a" xor active eq true`, `emails[type eq "work"`} {
+		_, err := parseSCIMFilter(filter)
+		require.Error(t, err, filter)
+	}
+}
+
+func TestSCIMApplyPatch(t *testing.T) {
+	resource := map[string]interface{}{
+		"displayName": "engineering",
+		"members": []interface{}{
+			map[string]interface{}{"value": "a"},
+			map[string]interface{}{"value": "b"},
+		},
+	}
+
+	err := scimApplyPatch(resource, []scimPatchOperation{
+		{Op: "add", Path: "members", Value: []interface{}{map[string]interface{}{"value": "b"}, map[string]interface{}{"value": "c"}}},
+		{Op: "remove", Path: `members[value eq "a"]`},
+		{Op: "Remove", Path: "members", Value: []interface{}{map[string]interface{}{"value": "c"}}},
+		{Op: "replace", Value: map[string]interface{}{"displayName": "platform"}},
+		{Op: "add", Path: "urn:ietf:params:scim:schemas:core:2.0:Group:externalId", Value: "00g1"},
+		{Op: "replace", Path: `members[value eq "b"].display`, Value: "Bob"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"displayName": "platform",
+		"externalId":  "00g1",
+		"members": []interface{}{
+			map[string]interface{}{"value": "b", "display": "Bob"},
+		},
+	}, resource)
+
+	err = scimApplyPatch(resource, []scimPatchOperation{{Op: "replace", Path: `members[value eq "z"].display`, Value: "Z"}})
+	require.Error(t, err)
+	require.Equal(t, "noTarget", err.(*scimError).scimType)
+
+	err = scimApplyPatch(resource, []scimPatchOperation{{Op: "move", Path: "displayName"}})
+	require.Error(t, err)
+}
@@ -0,0 +1,886 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+//go:build !enterprise
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault/helper/identity"
+	"github.com/hashicorp/vault/helper/namespace"
+	"github.com/hashicorp/vault/sdk/framework"
+	"github.com/hashicorp/vault/sdk/logical"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Entity metadata keys holding the SCIM attributes that have no
+	// counterpart on entities
+	scimDisplayNameMetadataKey = "display_name"
+	scimEmailMetadataKey       = "email"
+
+	// scimExternalIDMetadataKey is the group metadata key holding the
+	// externalId of a SCIM group
+	scimExternalIDMetadataKey = "scim_external_id"
+)
+
+type scimMeta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+type scimMultiValue struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// scimBool is a boolean that also accepts the "True" and "False" strings sent
+// by some identity providers.
+type scimBool bool
+
+func (b *scimBool) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		v, err := strconv.ParseBool(strings.ToLower(s))
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", s)
+		}
+		*b = scimBool(v)
+		return nil
+	}
+
+	var v bool
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*b = scimBool(v)
+	return nil
+}
+
+type scimUser struct {
+	Schemas     []string         `json:"schemas"`
+	ID          string           `json:"id,omitempty"`
+	ExternalID  string           `json:"externalId,omitempty"`
+	UserName    string           `json:"userName"`
+	DisplayName string           `json:"displayName,omitempty"`
+	Active      *scimBool        `json:"active,omitempty"`
+	Emails      []scimMultiValue `json:"emails,omitempty"`
+	Groups      []scimMultiValue `json:"groups,omitempty"`
+	Meta        *scimMeta        `json:"meta,omitempty"`
+}
+
+type scimGroup struct {
+	Schemas     []string         `json:"schemas"`
+	ID          string           `json:"id,omitempty"`
+	ExternalID  string           `json:"externalId,omitempty"`
+	DisplayName string           `json:"displayName"`
+	Members     []scimMultiValue `json:"members,omitempty"`
+	Meta        *scimMeta        `json:"meta,omitempty"`
+}
+
+type scimListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+type scimPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []scimPatchOperation `json:"Operations"`
+}
+
+// scimDecode decodes the body of a request, or the JSON representation of a
+// resource, into out.
+func scimDecode(data map[string]interface{}, out interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidSyntax", "invalid request body: %s", err)
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidSyntax", "invalid request body: %s", err)
+	}
+	return nil
+}
+
+// scimEncode returns the JSON representation of a resource, as used for
+// filtering and patching.
+func scimEncode(v interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func scimTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339)
+}
+
+// scimIntParam returns the integer value of a query parameter.
+func scimIntParam(data map[string]interface{}, name string, defaultValue int) (int, error) {
+	raw, ok := data[name]
+	if !ok || raw == nil {
+		return defaultValue, nil
+	}
+
+	var s string
+	switch v := raw.(type) {
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	case []string:
+		if len(v) > 0 {
+			s = v[0]
+		}
+	default:
+		s = fmt.Sprintf("%v", v)
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, newSCIMError(http.StatusBadRequest, "invalidValue", "invalid %s %q", name, s)
+	}
+	return n, nil
+}
+
+func scimStringParam(data map[string]interface{}, name string) string {
+	switch v := data[name].(type) {
+	case string:
+		return v
+	case []string:
+		if len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// scimListResources filters and paginates the given resources.
+func scimListResources(req *logical.Request, resources []interface{}) (*logical.Response, error) {
+	var filter scimFilter
+	if raw := scimStringParam(req.Data, "filter"); raw != "" {
+		f, err := parseSCIMFilter(raw)
+		if err != nil {
+			return nil, err
+		}
+		filter = f
+	}
+
+	startIndex, err := scimIntParam(req.Data, "startIndex", 1)
+	if err != nil {
+		return nil, err
+	}
+	if startIndex < 1 {
+		startIndex = 1
+	}
+
+	count, err := scimIntParam(req.Data, "count", scimDefaultPageSize)
+	if err != nil {
+		return nil, err
+	}
+	if count < 0 {
+		count = 0
+	}
+	if count > scimMaxPageSize {
+		count = scimMaxPageSize
+	}
+
+	matched := []interface{}{}
+	for _, resource := range resources {
+		if filter != nil {
+			m, err := scimEncode(resource)
+			if err != nil {
+				return nil, err
+			}
+			if !filter.matches(m) {
+				continue
+			}
+		}
+		matched = append(matched, resource)
+	}
+
+	page := []interface{}{}
+	if start := startIndex - 1; start < len(matched) {
+		end := start + count
+		if end > len(matched) {
+			end = len(matched)
+		}
+		page = matched[start:end]
+	}
+
+	return scimResponse(http.StatusOK, &scimListResponse{
+		Schemas:      []string{scimSchemaListResponse},
+		TotalResults: len(matched),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}, nil)
+}
+
+// scimResourceResponse returns a single resource, honoring If-None-Match on
+// reads.
+func scimResourceResponse(req *logical.Request, status int, resource interface{}, meta *scimMeta, location bool) (*logical.Response, error) {
+	headers := map[string]string{
+		"ETag": meta.Version,
+	}
+	if location {
+		headers["Location"] = meta.Location
+	}
+
+	if req.Operation == logical.ReadOperation {
+		if ifNoneMatch := scimRequestHeader(req, "If-None-Match"); ifNoneMatch != "" && scimETagMatches(ifNoneMatch, meta.Version) {
+			return scimResponse(http.StatusNotModified, nil, headers)
+		}
+	}
+
+	return scimResponse(status, resource, headers)
+}
+
+// scimEntities returns the entities provisioned by the given client, sorted
+// by name.
+func (i *IdentityStore) scimEntities(namespaceID, clientID string) ([]*identity.Entity, error) {
+	txn := i.db.Txn(false)
+	iter, err := txn.Get(entitiesTable, "scim_client_id_prefix", namespaceID, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch entities from memdb: %w", err)
+	}
+
+	var entities []*identity.Entity
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		entity := raw.(*identity.Entity)
+		if entity.ScimClientID == clientID {
+			entities = append(entities, entity)
+		}
+	}
+	return entities, nil
+}
+
+// scimGroups returns the groups provisioned by the given client, sorted by
+// name.
+func (i *IdentityStore) scimGroups(namespaceID, clientID string) ([]*identity.Group, error) {
+	txn := i.db.Txn(false)
+	iter, err := txn.Get(groupsTable, "scim_client_id", clientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch groups from memdb: %w", err)
+	}
+
+	var groups []*identity.Group
+	for raw := iter.Next(); raw != nil; raw = iter.Next() {
+		group := raw.(*identity.Group)
+		if group.NamespaceID == namespaceID {
+			groups = append(groups, group)
+		}
+	}
+	sort.Slice(groups, func(a, b int) bool {
+		return groups[a].Name < groups[b].Name
+	})
+	return groups, nil
+}
+
+// scimEntity returns a clone of the entity with the given ID if it was
+// provisioned by the client.
+func (i *IdentityStore) scimEntity(ctx context.Context, client *identity.ScimClient, id string) (*identity.Entity, error) {
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := i.MemDBEntityByID(id, true)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil || entity.NamespaceID != ns.ID || entity.ScimClientID != client.ClientID {
+		return nil, newSCIMError(http.StatusNotFound, "", "user %q not found", id)
+	}
+	return entity, nil
+}
+
+// scimGroup returns a clone of the group with the given ID if it was
+// provisioned by the client.
+func (i *IdentityStore) scimGroup(ctx context.Context, client *identity.ScimClient, id string) (*identity.Group, error) {
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := i.MemDBGroupByID(id, true)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil || group.NamespaceID != ns.ID || group.ScimClientID != client.ClientID {
+		return nil, newSCIMError(http.StatusNotFound, "", "group %q not found", id)
+	}
+	return group, nil
+}
+
+// scimUserFromEntity returns the SCIM representation of an entity.
+func (i *IdentityStore) scimUserFromEntity(client *identity.ScimClient, entity *identity.Entity, baseURL string) (*scimUser, error) {
+	active := scimBool(!entity.Disabled)
+	user := &scimUser{
+		Schemas:     []string{scimSchemaUser},
+		ID:          entity.ID,
+		ExternalID:  entity.ExternalID,
+		UserName:    entity.Name,
+		DisplayName: entity.Metadata[scimDisplayNameMetadataKey],
+		Active:      &active,
+	}
+	if email := entity.Metadata[scimEmailMetadataKey]; email != "" {
+		user.Emails = []scimMultiValue{{Value: email, Primary: true}}
+	}
+
+	groups, err := i.MemDBGroupsByMemberEntityID(entity.ID, false, false)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(groups, func(a, b int) bool {
+		return groups[a].Name < groups[b].Name
+	})
+	for _, group := range groups {
+		if group.ScimClientID != client.ClientID {
+			continue
+		}
+		user.Groups = append(user.Groups, scimMultiValue{
+			Value:   group.ID,
+			Display: group.Name,
+			Ref:     baseURL + "/Groups/" + group.ID,
+		})
+	}
+
+	version, err := scimVersion(user)
+	if err != nil {
+		return nil, err
+	}
+	user.Meta = &scimMeta{
+		ResourceType: "User",
+		Created:      scimTimestamp(entity.CreationTime),
+		LastModified: scimTimestamp(entity.LastUpdateTime),
+		Location:     baseURL + "/Users/" + entity.ID,
+		Version:      version,
+	}
+
+	return user, nil
+}
+
+// scimGroupFromGroup returns the SCIM representation of a group.
+func (i *IdentityStore) scimGroupFromGroup(group *identity.Group, baseURL string) (*scimGroup, error) {
+	scimGroup := &scimGroup{
+		Schemas:     []string{scimSchemaGroup},
+		ID:          group.ID,
+		ExternalID:  group.Metadata[scimExternalIDMetadataKey],
+		DisplayName: group.Name,
+	}
+
+	for _, entityID := range group.MemberEntityIDs {
+		member := scimMultiValue{
+			Value: entityID,
+			Ref:   baseURL + "/Users/" + entityID,
+		}
+		entity, err := i.MemDBEntityByID(entityID, false)
+		if err != nil {
+			return nil, err
+		}
+		if entity != nil {
+			member.Display = entity.Name
+		}
+		scimGroup.Members = append(scimGroup.Members, member)
+	}
+
+	version, err := scimVersion(scimGroup)
+	if err != nil {
+		return nil, err
+	}
+	scimGroup.Meta = &scimMeta{
+		ResourceType: "Group",
+		Created:      scimTimestamp(group.CreationTime),
+		LastModified: scimTimestamp(group.LastUpdateTime),
+		Location:     baseURL + "/Groups/" + group.ID,
+		Version:      version,
+	}
+
+	return scimGroup, nil
+}
+
+func (i *IdentityStore) pathSCIMUsersList(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*logical.Response, error) {
+	entities, err := i.scimEntities(client.NamespaceID, client.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := i.scimBaseURL(ctx, req)
+	users := make([]interface{}, 0, len(entities))
+	for _, entity := range entities {
+		user, err := i.scimUserFromEntity(client, entity, baseURL)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	return scimListResources(req, users)
+}
+
+func (i *IdentityStore) pathSCIMUserCreate(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*logical.Response, error) {
+	var user scimUser
+	if err := scimDecode(req.Data, &user); err != nil {
+		return nil, err
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	entity := &identity.Entity{
+		ScimClientID: client.ClientID,
+	}
+	if err := i.scimSaveUser(ctx, client, entity, &user, true); err != nil {
+		return nil, err
+	}
+
+	return i.scimUserResponse(ctx, req, client, entity, http.StatusCreated)
+}
+
+func (i *IdentityStore) pathSCIMUserRead(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*logical.Response, error) {
+	entity, err := i.scimEntity(ctx, client, d.Get("id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return i.scimUserResponse(ctx, req, client, entity, http.StatusOK)
+}
+
+func (i *IdentityStore) pathSCIMUserReplace(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*logical.Response, error) {
+	var user scimUser
+	if err := scimDecode(req.Data, &user); err != nil {
+		return nil, err
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	entity, err := i.scimEntityForUpdate(ctx, req, d, client)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.scimSaveUser(ctx, client, entity, &user, false); err != nil {
+		return nil, err
+	}
+
+	return i.scimUserResponse(ctx, req, client, entity, http.StatusOK)
+}
+
+func (i *IdentityStore) pathSCIMUserPatch(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*logical.Response, error) {
+	var patch scimPatchRequest
+	if err := scimDecode(req.Data, &patch); err != nil {
+		return nil, err
+	}
+	if len(patch.Operations) == 0 {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidSyntax", "no patch operations given")
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	entity, err := i.scimEntityForUpdate(ctx, req, d, client)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := i.scimUserFromEntity(client, entity, i.scimBaseURL(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	resource, err := scimEncode(current)
+	if err != nil {
+		return nil, err
+	}
+	if err := scimApplyPatch(resource, patch.Operations); err != nil {
+		return nil, err
+	}
+
+	var user scimUser
+	if err := scimDecode(resource, &user); err != nil {
+		return nil, err
+	}
+	if err := i.scimSaveUser(ctx, client, entity, &user, false); err != nil {
+		return nil, err
+	}
+
+	return i.scimUserResponse(ctx, req, client, entity, http.StatusOK)
+}
+
+func (i *IdentityStore) pathSCIMUserDelete(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*logical.Response, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	entity, err := i.scimEntityForUpdate(ctx, req, d, client)
+	if err != nil {
+		return nil, err
+	}
+
+	txn := i.db.Txn(true)
+	defer txn.Abort()
+
+	entity, err = i.MemDBEntityByIDInTxn(txn, entity.ID, true)
+	if err != nil {
+		return nil, err
+	}
+	if entity != nil {
+		if err := i.handleEntityDeleteCommon(ctx, txn, entity, true); err != nil {
+			return nil, err
+		}
+	}
+	txn.Commit()
+
+	return scimResponse(http.StatusNoContent, nil, nil)
+}
+
+// scimEntityForUpdate returns the entity targeted by a request modifying a
+// user, after checking the If-Match header of the request.
+func (i *IdentityStore) scimEntityForUpdate(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*identity.Entity, error) {
+	entity, err := i.scimEntity(ctx, client, d.Get("id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := i.scimUserFromEntity(client, entity, i.scimBaseURL(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	if err := scimCheckPrecondition(req, current.Meta.Version); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// scimSaveUser applies the user to the entity and persists it, along with its
+// alias on the auth mount of the client. The caller must hold the identity
+// store lock.
+func (i *IdentityStore) scimSaveUser(ctx context.Context, client *identity.ScimClient, entity *identity.Entity, user *scimUser, isCreate bool) error {
+	if user.UserName == "" {
+		return newSCIMError(http.StatusBadRequest, "invalidValue", "userName is required")
+	}
+
+	existing, err := i.MemDBEntityByName(ctx, user.UserName, false)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != entity.ID {
+		return newSCIMError(http.StatusConflict, "uniqueness", "userName %q is already in use", user.UserName)
+	}
+
+	if user.ExternalID != "" {
+		existing, err := i.MemDBEntityByExternalID(ctx, user.ExternalID, false)
+		if err != nil {
+			return err
+		}
+		if existing != nil && existing.ID != entity.ID {
+			return newSCIMError(http.StatusConflict, "uniqueness", "externalId %q is already in use", user.ExternalID)
+		}
+	}
+
+	if client.AliasMountAccessor != "" {
+		alias, err := i.MemDBAliasByFactors(client.AliasMountAccessor, user.UserName, false, false)
+		if err != nil {
+			return err
+		}
+		if alias != nil && alias.CanonicalID != entity.ID {
+			return newSCIMError(http.StatusConflict, "uniqueness", "userName %q is already in use by an alias", user.UserName)
+		}
+	}
+
+	entity.Name = user.UserName
+	entity.ExternalID = user.ExternalID
+	entity.Disabled = user.Active != nil && !bool(*user.Active)
+
+	metadata := make(map[string]string, len(entity.Metadata))
+	for k, v := range entity.Metadata {
+		metadata[k] = v
+	}
+	delete(metadata, scimDisplayNameMetadataKey)
+	delete(metadata, scimEmailMetadataKey)
+	if user.DisplayName != "" {
+		metadata[scimDisplayNameMetadataKey] = user.DisplayName
+	}
+	for idx, email := range user.Emails {
+		if email.Primary || idx == 0 {
+			metadata[scimEmailMetadataKey] = email.Value
+		}
+		if email.Primary {
+			break
+		}
+	}
+	entity.Metadata = metadata
+
+	if err := i.scimResourceCheck(ctx, entity, client.ClientID, isCreate, nil); err != nil {
+		return err
+	}
+	if err := i.sanitizeEntity(ctx, entity); err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidValue", "%s", err)
+	}
+
+	if client.AliasMountAccessor != "" {
+		var alias *identity.Alias
+		for _, a := range entity.Aliases {
+			if a.MountAccessor == client.AliasMountAccessor {
+				alias = a
+				break
+			}
+		}
+		if alias == nil {
+			alias = &identity.Alias{
+				CanonicalID:   entity.ID,
+				MountAccessor: client.AliasMountAccessor,
+				ScimClientID:  client.ClientID,
+			}
+		}
+		alias.Name = user.UserName
+		if err := i.sanitizeAlias(ctx, alias); err != nil {
+			return err
+		}
+		entity.UpsertAlias(alias)
+	}
+
+	return i.upsertEntity(ctx, entity, nil, true)
+}
+
+func (i *IdentityStore) scimUserResponse(ctx context.Context, req *logical.Request, client *identity.ScimClient, entity *identity.Entity, status int) (*logical.Response, error) {
+	user, err := i.scimUserFromEntity(client, entity, i.scimBaseURL(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	return scimResourceResponse(req, status, user, user.Meta, status == http.StatusCreated)
+}
+
+func (i *IdentityStore) pathSCIMGroupsList(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*logical.Response, error) {
+	groups, err := i.scimGroups(client.NamespaceID, client.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	baseURL := i.scimBaseURL(ctx, req)
+	resources := make([]interface{}, 0, len(groups))
+	for _, group := range groups {
+		scimGroup, err := i.scimGroupFromGroup(group, baseURL)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, scimGroup)
+	}
+
+	return scimListResources(req, resources)
+}
+
+func (i *IdentityStore) pathSCIMGroupCreate(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*logical.Response, error) {
+	var scimGroup scimGroup
+	if err := scimDecode(req.Data, &scimGroup); err != nil {
+		return nil, err
+	}
+
+	i.groupLock.Lock()
+	defer i.groupLock.Unlock()
+
+	group := &identity.Group{
+		Type:         groupTypeInternal,
+		ScimClientID: client.ClientID,
+	}
+	if err := i.scimSaveGroup(ctx, client, group, &scimGroup, true); err != nil {
+		return nil, err
+	}
+
+	return i.scimGroupResponse(ctx, req, group, http.StatusCreated)
+}
+
+func (i *IdentityStore) pathSCIMGroupRead(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*logical.Response, error) {
+	group, err := i.scimGroup(ctx, client, d.Get("id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return i.scimGroupResponse(ctx, req, group, http.StatusOK)
+}
+
+func (i *IdentityStore) pathSCIMGroupReplace(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*logical.Response, error) {
+	var scimGroup scimGroup
+	if err := scimDecode(req.Data, &scimGroup); err != nil {
+		return nil, err
+	}
+
+	i.groupLock.Lock()
+	defer i.groupLock.Unlock()
+
+	group, err := i.scimGroupForUpdate(ctx, req, d, client)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.scimSaveGroup(ctx, client, group, &scimGroup, false); err != nil {
+		return nil, err
+	}
+
+	return i.scimGroupResponse(ctx, req, group, http.StatusOK)
+}
+
+func (i *IdentityStore) pathSCIMGroupPatch(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*logical.Response, error) {
+	var patch scimPatchRequest
+	if err := scimDecode(req.Data, &patch); err != nil {
+		return nil, err
+	}
+	if len(patch.Operations) == 0 {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidSyntax", "no patch operations given")
+	}
+
+	i.groupLock.Lock()
+	defer i.groupLock.Unlock()
+
+	group, err := i.scimGroupForUpdate(ctx, req, d, client)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := i.scimGroupFromGroup(group, i.scimBaseURL(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	resource, err := scimEncode(current)
+	if err != nil {
+		return nil, err
+	}
+	if err := scimApplyPatch(resource, patch.Operations); err != nil {
+		return nil, err
+	}
+
+	var scimGroup scimGroup
+	if err := scimDecode(resource, &scimGroup); err != nil {
+		return nil, err
+	}
+	if err := i.scimSaveGroup(ctx, client, group, &scimGroup, false); err != nil {
+		return nil, err
+	}
+
+	return i.scimGroupResponse(ctx, req, group, http.StatusOK)
+}
+
+func (i *IdentityStore) pathSCIMGroupDelete(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*logical.Response, error) {
+	group, err := i.scimGroup(ctx, client, d.Get("id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := i.scimGroupFromGroup(group, i.scimBaseURL(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	if err := scimCheckPrecondition(req, current.Meta.Version); err != nil {
+		return nil, err
+	}
+
+	if resp, err := i.handleGroupDeleteCommon(ctx, group.ID, true); err != nil {
+		return resp, err
+	}
+
+	return scimResponse(http.StatusNoContent, nil, nil)
+}
+
+// scimGroupForUpdate returns the group targeted by a request modifying a
+// group, after checking the If-Match header of the request.
+func (i *IdentityStore) scimGroupForUpdate(ctx context.Context, req *logical.Request, d *framework.FieldData, client *identity.ScimClient) (*identity.Group, error) {
+	group, err := i.scimGroup(ctx, client, d.Get("id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := i.scimGroupFromGroup(group, i.scimBaseURL(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+	if err := scimCheckPrecondition(req, current.Meta.Version); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// scimSaveGroup applies the SCIM group to the group and persists it. The
+// caller must hold the group lock.
+func (i *IdentityStore) scimSaveGroup(ctx context.Context, client *identity.ScimClient, group *identity.Group, scimGroup *scimGroup, isCreate bool) error {
+	if scimGroup.DisplayName == "" {
+		return newSCIMError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+
+	existing, err := i.MemDBGroupByName(ctx, scimGroup.DisplayName, false)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != group.ID {
+		return newSCIMError(http.StatusConflict, "uniqueness", "displayName %q is already in use", scimGroup.DisplayName)
+	}
+
+	memberEntityIDs := []string{}
+	for _, member := range scimGroup.Members {
+		if member.Type != "" && !strings.EqualFold(member.Type, "User") {
+			return newSCIMError(http.StatusBadRequest, "invalidValue", "only users can be members of a group")
+		}
+		if _, err := i.scimEntity(ctx, client, member.Value); err != nil {
+			return newSCIMError(http.StatusBadRequest, "invalidValue", "member %q is not a user of the client", member.Value)
+		}
+		memberEntityIDs = append(memberEntityIDs, member.Value)
+	}
+
+	metadata := make(map[string]string, len(group.Metadata))
+	for k, v := range group.Metadata {
+		metadata[k] = v
+	}
+	delete(metadata, scimExternalIDMetadataKey)
+	if scimGroup.ExternalID != "" {
+		metadata[scimExternalIDMetadataKey] = scimGroup.ExternalID
+	}
+
+	group.Name = scimGroup.DisplayName
+	group.MemberEntityIDs = memberEntityIDs
+	group.Metadata = metadata
+
+	if err := i.scimResourceCheck(ctx, group, client.ClientID, isCreate, nil); err != nil {
+		return err
+	}
+
+	return i.sanitizeAndUpsertGroup(ctx, group, nil, nil)
+}
+
+func (i *IdentityStore) scimGroupResponse(ctx context.Context, req *logical.Request, group *identity.Group, status int) (*logical.Response, error) {
+	scimGroup, err := i.scimGroupFromGroup(group, i.scimBaseURL(ctx, req))
+	if err != nil {
+		return nil, err
+	}
+
+	return scimResourceResponse(req, status, scimGroup, scimGroup.Meta, status == http.StatusCreated)
+}
@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/vault/helper/identity"
+	"github.com/hashicorp/vault/helper/namespace"
+	"github.com/hashicorp/vault/helper/storagepacker"
+	"github.com/hashicorp/vault/sdk/framework"
+	"github.com/hashicorp/vault/sdk/logical"
+)
+
+// snapshotBucketItem reads the item with the given ID from the packer's
+// storage. The context determines whether it is read from a loaded snapshot.
+func snapshotBucketItem(ctx context.Context, packer *storagepacker.StoragePacker, id string) (*storagepacker.Item, error) {
+	bucket, err := packer.GetBucket(ctx, packer.BucketKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if bucket == nil {
+		return nil, nil
+	}
+	for _, item := range bucket.Items {
+		if item.ID == id {
+			return item, nil
+		}
+	}
+	return nil, nil
+}
+
+// snapshotEntityByID reads an entity from the snapshot set in the context.
+// Local aliases are stored separately, and aren't included.
+func (i *IdentityStore) snapshotEntityByID(ctx context.Context, entityID string) (*identity.Entity, error) {
+	item, err := snapshotBucketItem(ctx, i.entityPacker, entityID)
+	if err != nil || item == nil {
+		return nil, err
+	}
+	return i.parseEntityFromBucketItem(ctx, item)
+}
+
+// snapshotGroupByID reads a group from the snapshot set in the context.
+func (i *IdentityStore) snapshotGroupByID(ctx context.Context, groupID string) (*identity.Group, error) {
+	item, err := snapshotBucketItem(ctx, i.groupPacker, groupID)
+	if err != nil || item == nil {
+		return nil, err
+	}
+	return i.parseGroupFromBucketItem(item)
+}
+
+// pathEntityIDRecover restores an entity from a loaded snapshot, replacing
+// the live entity with the same ID if there is one.
+func (i *IdentityStore) pathEntityIDRecover() framework.OperationFunc {
+	return func(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+		entityID := d.Get("id").(string)
+		if entityID == "" {
+			return logical.ErrorResponse("missing entity id"), nil
+		}
+		ns, err := namespace.FromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		i.lock.Lock()
+		defer i.lock.Unlock()
+
+		snapshotCtx := logical.CreateContextWithSnapshotID(ctx, req.RequiresSnapshotID)
+		entity, err := i.snapshotEntityByID(snapshotCtx, entityID)
+		if err != nil {
+			return nil, err
+		}
+		if entity == nil || entity.NamespaceID != ns.ID {
+			return logical.ErrorResponse("entity not found in snapshot"), logical.ErrInvalidRequest
+		}
+
+		existing, err := i.MemDBEntityByName(ctx, entity.Name, false)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil && existing.ID != entity.ID {
+			return logical.ErrorResponse("entity name %q is in use by entity %q", entity.Name, existing.ID), logical.ErrInvalidRequest
+		}
+
+		resp := &logical.Response{}
+		aliases := entity.Aliases[:0]
+		for _, alias := range entity.Aliases {
+			if i.router.ValidateMountByAccessor(alias.MountAccessor) == nil {
+				resp.AddWarning(fmt.Sprintf("dropping alias %q, its mount %q no longer exists", alias.ID, alias.MountAccessor))
+				continue
+			}
+			// Recovering an entity mustn't merge it with another one
+			existing, err := i.MemDBAliasByFactors(alias.MountAccessor, alias.Name, false, false)
+			if err != nil {
+				return nil, err
+			}
+			if existing != nil && existing.CanonicalID != entity.ID {
+				return logical.ErrorResponse("alias %q is in use by entity %q", alias.Name, existing.CanonicalID), logical.ErrInvalidRequest
+			}
+			aliases = append(aliases, alias)
+		}
+		entity.Aliases = aliases
+
+		previous, err := i.MemDBEntityByID(entity.ID, true)
+		if err != nil {
+			return nil, err
+		}
+		if err := i.upsertEntity(ctx, entity, previous, true); err != nil {
+			return nil, err
+		}
+		i.logger.Info("recovered entity from snapshot", "entity_id", entity.ID, "snapshot_id", req.RequiresSnapshotID)
+
+		if len(resp.Warnings) == 0 {
+			return nil, nil
+		}
+		return resp, nil
+	}
+}
+
+// pathGroupIDRecover restores a group from a loaded snapshot, replacing the
+// live group with the same ID if there is one. Members and parent groups
+// that no longer exist are dropped.
+func (i *IdentityStore) pathGroupIDRecover() framework.OperationFunc {
+	return func(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+		groupID := d.Get("id").(string)
+		if groupID == "" {
+			return logical.ErrorResponse("empty group ID"), nil
+		}
+		ns, err := namespace.FromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		i.groupLock.Lock()
+		defer i.groupLock.Unlock()
+
+		snapshotCtx := logical.CreateContextWithSnapshotID(ctx, req.RequiresSnapshotID)
+		group, err := i.snapshotGroupByID(snapshotCtx, groupID)
+		if err != nil {
+			return nil, err
+		}
+		if group == nil || group.NamespaceID != ns.ID {
+			return logical.ErrorResponse("group not found in snapshot"), logical.ErrInvalidRequest
+		}
+
+		existing, err := i.MemDBGroupByName(ctx, group.Name, false)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil && existing.ID != group.ID {
+			return logical.ErrorResponse("group name %q is in use by group %q", group.Name, existing.ID), logical.ErrInvalidRequest
+		}
+
+		resp := &logical.Response{}
+		if group.Alias != nil {
+			alias, err := i.MemDBAliasByFactors(group.Alias.MountAccessor, group.Alias.Name, false, true)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case i.router.ValidateMountByAccessor(group.Alias.MountAccessor) == nil:
+				resp.AddWarning(fmt.Sprintf("dropping alias %q, its mount %q no longer exists", group.Alias.ID, group.Alias.MountAccessor))
+				group.Alias = nil
+			case alias != nil && alias.CanonicalID != group.ID:
+				return logical.ErrorResponse("group alias %q is in use by group %q", group.Alias.Name, alias.CanonicalID), logical.ErrInvalidRequest
+			}
+		}
+
+		memberEntityIDs := group.MemberEntityIDs[:0]
+		for _, entityID := range group.MemberEntityIDs {
+			entity, err := i.MemDBEntityByID(entityID, false)
+			if err != nil {
+				return nil, err
+			}
+			if entity == nil {
+				resp.AddWarning(fmt.Sprintf("dropping member entity %q, it no longer exists", entityID))
+				continue
+			}
+			memberEntityIDs = append(memberEntityIDs, entityID)
+		}
+		group.MemberEntityIDs = memberEntityIDs
+
+		parentGroupIDs := group.ParentGroupIDs[:0]
+		for _, parentID := range group.ParentGroupIDs {
+			parent, err := i.MemDBGroupByID(parentID, false)
+			if err != nil {
+				return nil, err
+			}
+			if parent == nil {
+				resp.AddWarning(fmt.Sprintf("dropping parent group %q, it no longer exists", parentID))
+				continue
+			}
+			parentGroupIDs = append(parentGroupIDs, parentID)
+		}
+		group.ParentGroupIDs = parentGroupIDs
+
+		if err := i.UpsertGroup(ctx, group, true); err != nil {
+			return nil, err
+		}
+		i.logger.Info("recovered group from snapshot", "group_id", group.ID, "snapshot_id", req.RequiresSnapshotID)
+
+		if len(resp.Warnings) == 0 {
+			return nil, nil
+		}
+		return resp, nil
+	}
+}
@@ -83,6 +83,17 @@ type IdentityStore struct {
 	// for an ID token during an authorization code flow.
 	oidcAuthCodeCache *oidcCache
 
+	// oidcDeviceCodeCache stores pending OIDC device authorization requests
+	// by device code and user code during a device authorization flow.
+	oidcDeviceCodeCache *oidcCache
+
+	// oidcRefreshTokenLock serializes the use and rotation of OIDC refresh
+	// tokens so that reuse of a rotated refresh token can be detected.
+	oidcRefreshTokenLock sync.Mutex
+
+	// oidcSessionLock serializes changes to OIDC provider sessions.
+	oidcSessionLock sync.Mutex
+
 	// logger is the server logger copied over from core
 	logger log.Logger
 
@@ -148,6 +159,13 @@ type IdentityStore struct {
 	scimCleanupCtx context.Context
 	// scimCleanupCancel cancels all background SCIM client cleanup goroutines.
 	scimCleanupCancel context.CancelFunc
+
+	// backchannelLogoutCtx is the context shared by all back-channel logout
+	// requests. It is cancelled when the backend is cleaned up, which waits
+	// for in-flight requests using backchannelLogoutWG.
+	backchannelLogoutCtx    context.Context
+	backchannelLogoutCancel context.CancelFunc
+	backchannelLogoutWG     sync.WaitGroup
 }
 
 type groupDiff struct {
@@ -194,7 +212,11 @@ var _ GroupUpdater = &Core{}
 
 type TokenStorer interface {
 	LookupToken(context.Context, string) (*logical.TokenEntry, error)
+	LookupTokenByAccessor(context.Context, string) (*logical.TokenEntry, error)
 	CreateToken(context.Context, *logical.TokenEntry) error
+	EntityTokens(context.Context, *identity.Entity) ([]*logical.TokenEntry, error)
+	RevokeEntityTokens(context.Context, *identity.Entity) ([]string, error)
+	UseSubjectToken(context.Context, *logical.Request) (*logical.TokenEntry, error)
 }
 
 var _ TokenStorer = &Core{}
@@ -200,6 +200,12 @@ func NewSystemBackend(core *Core, logger log.Logger, config *logical.BackendConf
 				"leases/revoke-force/*",
 				"leases/lookup/*",
 				"storage/raft/snapshot-auto/config/*",
+				"storage/raft/snapshot-auto/snapshot-load/*",
+				"storage/raft/snapshot-load",
+				"storage/raft/snapshot-load/*",
+				"storage/raft/compact",
+				"storage/migration",
+				"storage/migration/cutover",
 				"leases",
 				"reporting/scan",
 				"internal/inspect/*",
@@ -226,6 +232,13 @@ func NewSystemBackend(core *Core, logger log.Logger, config *logical.BackendConf
 				managedKeyRegistrySubPath,
 			},
 
+			AllowSnapshotRead: []string{
+				"policy",
+				"policy/*",
+				"policies/acl",
+				"policies/acl/*",
+			},
+
 			Binary: append(append(rekeyPaths, generateRootPaths...), entBinaryPaths()...),
 		},
 		Paths: systemBackendPaths(b, true, config),
@@ -287,12 +300,16 @@ func operatorSystemBackendPaths(b *SystemBackend) []*framework.Path {
 	ret = append(ret, b.wellKnownPaths()...)
 	ret = append(ret, b.activationFlagsPaths()...)
 	ret = append(ret, b.useCaseConsumptionBillingPaths()...)
+	ret = append(ret, b.storageMigrationPaths()...)
 
 	if b.Core.rawEnabled {
 		ret = append(ret, b.rawPaths()...)
 	}
 	if backend := b.Core.getRaftBackend(); backend != nil {
 		ret = append(ret, b.raftStoragePaths()...)
+		ret = append(ret, b.raftSnapshotAutoPaths()...)
+		ret = append(ret, b.raftSnapshotLoadPaths()...)
+		ret = append(ret, b.raftCompactPaths()...)
 	}
 
 	return ret
@@ -5801,6 +5818,44 @@ func (b *SystemBackend) pathInternalCountersEntities(ctx context.Context, req *l
 	return resp, nil
 }
 
+func (b *SystemBackend) pathInternalCountersStorage(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	report, lastReconciled, err := b.Core.storageUsageReport()
+	if err != nil {
+		return logical.ErrorResponse(err.Error()), logical.ErrInvalidRequest
+	}
+
+	var totalKeys, totalBytes int64
+	mounts := make([]map[string]interface{}, 0, len(report))
+	for _, usage := range report {
+		totalKeys += usage.Keys
+		totalBytes += usage.Bytes
+		mounts = append(mounts, map[string]interface{}{
+			"namespace_path": usage.NamespacePath,
+			"mount_path":     usage.MountPath,
+			"mount_type":     usage.MountType,
+			"mount_accessor": usage.MountAccessor,
+			"mount_uuid":     usage.MountUUID,
+			"local":          usage.Local,
+			"keys":           usage.Keys,
+			"bytes":          usage.Bytes,
+		})
+	}
+
+	var reconciled string
+	if !lastReconciled.IsZero() {
+		reconciled = lastReconciled.Format(time.RFC3339)
+	}
+
+	return &logical.Response{
+		Data: map[string]interface{}{
+			"mounts":          mounts,
+			"total_keys":      totalKeys,
+			"total_bytes":     totalBytes,
+			"last_reconciled": reconciled,
+		},
+	}, nil
+}
+
 func (b *SystemBackend) pathInternalInspectRouter(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
 	b.Core.introspectionEnabledLock.Lock()
 	defer b.Core.introspectionEnabledLock.Unlock()
@@ -5848,20 +5903,44 @@ func (b *SystemBackend) pathInternalUIResultantACL(ctx context.Context, req *log
 
 	resp.Data["chroot_namespace"] = req.ChrootNamespace
 
-	if acl.root {
+	// Tokens restricted to a capability scope are only granted what both
+	// their policies and the scope grant. Root tokens are granted exactly
+	// what the scope grants. Otherwise the paths of both the policies and the
+	// scope are reported, with the capabilities the other also grants on them.
+	type resultantACL struct {
+		acl, restrictTo *ACL
+	}
+	acls := []resultantACL{{acl: acl}}
+	switch {
+	case acl.root && acl.scope == nil:
 		resp.Data["root"] = true
 		return resp, nil
+	case acl.root:
+		acls = []resultantACL{{acl: acl.scope}}
+	case acl.scope != nil:
+		acls = []resultantACL{{acl: acl, restrictTo: acl.scope}, {acl: acl.scope, restrictTo: acl}}
 	}
 
 	exact := map[string]interface{}{}
 	glob := map[string]interface{}{}
 
-	walkFn := func(pt map[string]interface{}, s string, v interface{}) {
+	walkFn := func(pt map[string]interface{}, s string, v interface{}, restrictTo *ACL) {
 		if v == nil {
 			return
 		}
 
 		perms := v.(*ACLPermissions)
+		if restrictTo != nil {
+			res := restrictTo.allowOperation(ctx, &logical.Request{
+				Path:      s,
+				Operation: logical.ListOperation,
+			}, true)
+			if !res.IsRoot {
+				restricted := *perms
+				restricted.CapabilitiesBitmap &= res.CapabilitiesBitmap
+				perms = &restricted
+			}
+		}
 		capabilities := []string{}
 
 		if perms.CapabilitiesBitmap&CreateCapabilityInt > 0 {
@@ -5921,22 +6000,24 @@ func (b *SystemBackend) pathInternalUIResultantACL(ctx context.Context, req *log
 		pt[s] = res
 	}
 
-	exactWalkFn := func(s string, v interface{}) bool {
-		walkFn(exact, s, v)
-		return false
-	}
-	acl.exactRules.Walk(exactWalkFn)
-	resp.Data["exact_paths"] = exact
+	for _, r := range acls {
+		exactWalkFn := func(s string, v interface{}) bool {
+			walkFn(exact, s, v, r.restrictTo)
+			return false
+		}
+		r.acl.exactRules.Walk(exactWalkFn)
 
-	// Combine glob (prefix) and segment wildcard (+) paths into glob_paths
-	globWalkFn := func(s string, v interface{}) bool {
-		walkFn(glob, s, v)
-		return false
-	}
-	acl.prefixRules.Walk(globWalkFn)
-	for s, v := range acl.segmentWildcardPaths {
-		walkFn(glob, s, v)
+		// Combine glob (prefix) and segment wildcard (+) paths into glob_paths
+		globWalkFn := func(s string, v interface{}) bool {
+			walkFn(glob, s, v, r.restrictTo)
+			return false
+		}
+		r.acl.prefixRules.Walk(globWalkFn)
+		for s, v := range r.acl.segmentWildcardPaths {
+			walkFn(glob, s, v, r.restrictTo)
+		}
 	}
+	resp.Data["exact_paths"] = exact
 	resp.Data["glob_paths"] = glob
 
 	return resp, nil
@@ -7785,6 +7866,18 @@ This path responds to the following HTTP methods.
 		"Count of active entities in this Vault cluster.",
 		"Count of active entities in this Vault cluster.",
 	},
+	"internal-counters-storage": {
+		"Storage used by each mount in this Vault cluster.",
+		`
+The number of keys each secrets engine and auth method has in storage, and the
+size of their stored values in bytes, largest first. Storage usage accounting
+must be enabled with enable_storage_usage_accounting in the server config. The
+usage is tracked as mounts are written to, and recounted by the active node one
+mount at a time, once it's unsealed and then hourly. It is only accurate once
+it has been reconciled, and last_reconciled is when the mount recounted the
+longest ago was recounted.
+		`,
+	},
 	"internal-inspect-router": {
 		"Information on the entries in each of the trees in the router. Inspectable trees are uuid, accessor, storage, and root.",
 		`
@@ -141,6 +141,12 @@ func TestSystemBackend_InternalUIResultantACL(t *testing.T) {
 			"identity/oidc/provider/+/authorize": map[string]interface{}{
 				"capabilities": []interface{}{"read", "update"},
 			},
+			"identity/oidc/provider/+/logout": map[string]interface{}{
+				"capabilities": []interface{}{"read", "update"},
+			},
+			"identity/oidc/provider/+/device/verify": map[string]interface{}{
+				"capabilities": []interface{}{"read", "update"},
+			},
 		},
 		"root":             false,
 		"chroot_namespace": "",
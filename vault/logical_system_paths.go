@@ -289,7 +289,7 @@ func (b *SystemBackend) configPaths() []*framework.Path {
 			Fields: map[string]*framework.FieldSchema{
 				"pgp_key": {
 					Type:        framework.TypeString,
-					Description: "Specifies a base64-encoded PGP public key.",
+					Description: "Specifies a base64-encoded PGP public key, an age X25519 recipient, or an ssh-ed25519 public key.",
 				},
 			},
 
@@ -590,11 +590,11 @@ func (b *SystemBackend) configPaths() []*framework.Path {
 			Fields: map[string]*framework.FieldSchema{
 				"pgp_keys": {
 					Type:        framework.TypeCommaStringSlice,
-					Description: "Specifies an array of PGP public keys used to encrypt the output unseal keys. Ordering is preserved. The keys must be base64-encoded from their original binary representation. The size of this array must be the same as `secret_shares`.",
+					Description: "Specifies an array of PGP public keys used to encrypt the output unseal keys. Ordering is preserved. The keys must be base64-encoded from their original binary representation, or be age X25519 recipients or ssh-ed25519 public keys. The size of this array must be the same as `secret_shares`.",
 				},
 				"root_token_pgp_key": {
 					Type:        framework.TypeString,
-					Description: "Specifies a PGP public key used to encrypt the initial root token. The key must be base64-encoded from its original binary representation.",
+					Description: "Specifies a PGP public key used to encrypt the initial root token. The key must be base64-encoded from its original binary representation, or be an age X25519 recipient or ssh-ed25519 public key.",
 				},
 				"secret_shares": {
 					Type:        framework.TypeInt,
@@ -618,7 +618,7 @@ func (b *SystemBackend) configPaths() []*framework.Path {
 				},
 				"recovery_pgp_keys": {
 					Type:        framework.TypeCommaStringSlice,
-					Description: "Specifies an array of PGP public keys used to encrypt the output recovery keys. Ordering is preserved. The keys must be base64-encoded from their original binary representation. The size of this array must be the same as `recovery_shares`.",
+					Description: "Specifies an array of PGP public keys used to encrypt the output recovery keys. Ordering is preserved. The keys must be base64-encoded from their original binary representation, or be age X25519 recipients or ssh-ed25519 public keys. The size of this array must be the same as `recovery_shares`.",
 				},
 			},
 			Operations: map[logical.Operation]framework.OperationHandler{
@@ -840,7 +840,7 @@ func (b *SystemBackend) rekeyPaths() []*framework.Path {
 				},
 				"pgp_keys": {
 					Type:        framework.TypeCommaStringSlice,
-					Description: "Specifies an array of PGP public keys used to encrypt the output unseal keys. Ordering is preserved. The keys must be base64-encoded from their original binary representation. The size of this array must be the same as secret_shares.",
+					Description: "Specifies an array of PGP public keys used to encrypt the output unseal keys. Ordering is preserved. The keys must be base64-encoded from their original binary representation, or be age X25519 recipients or ssh-ed25519 public keys. The size of this array must be the same as secret_shares.",
 				},
 				"backup": {
 					Type:        framework.TypeBool,
@@ -1230,7 +1230,7 @@ func (b *SystemBackend) rekeyPaths() []*framework.Path {
 				},
 				"pgp_keys": {
 					Type:        framework.TypeCommaStringSlice,
-					Description: "Specifies an array of PGP public keys used to encrypt the output recovery keys.",
+					Description: "Specifies an array of PGP public keys, age X25519 recipients or ssh-ed25519 public keys used to encrypt the output recovery keys.",
 				},
 				"backup": {
 					Type:        framework.TypeBool,
@@ -3234,6 +3234,45 @@ func (b *SystemBackend) internalPaths() []*framework.Path {
 			HelpSynopsis:    strings.TrimSpace(sysHelp["internal-counters-entities"][0]),
 			HelpDescription: strings.TrimSpace(sysHelp["internal-counters-entities"][1]),
 		},
+		{
+			Pattern: "internal/counters/storage",
+			DisplayAttrs: &framework.DisplayAttributes{
+				OperationPrefix: "internal",
+				OperationVerb:   "count",
+				OperationSuffix: "storage",
+			},
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: b.pathInternalCountersStorage,
+					Summary:  "Backwards compatibility is not guaranteed for this API",
+					Responses: map[int][]framework.Response{
+						http.StatusOK: {{
+							Description: "OK",
+							Fields: map[string]*framework.FieldSchema{
+								"mounts": {
+									Type:     framework.TypeSlice,
+									Required: true,
+								},
+								"total_keys": {
+									Type:     framework.TypeInt64,
+									Required: true,
+								},
+								"total_bytes": {
+									Type:     framework.TypeInt64,
+									Required: true,
+								},
+								"last_reconciled": {
+									Type:     framework.TypeString,
+									Required: true,
+								},
+							},
+						}},
+					},
+				},
+			},
+			HelpSynopsis:    strings.TrimSpace(sysHelp["internal-counters-storage"][0]),
+			HelpDescription: strings.TrimSpace(sysHelp["internal-counters-storage"][1]),
+		},
 	}
 }
 
@@ -4244,6 +4283,11 @@ func (b *SystemBackend) policyPaths() []*framework.Path {
 					},
 					Summary: "Add a new or update an existing policy.",
 				},
+				logical.RecoverOperation: &framework.PathOperation{
+					Callback: b.handlePoliciesSet(PolicyTypeACL),
+					// recover is folded into update for OpenAPI documentation
+					// purposes, so no summary is set
+				},
 				logical.DeleteOperation: &framework.PathOperation{
 					Callback: b.handlePoliciesDelete(PolicyTypeACL),
 					Responses: map[int][]framework.Response{
@@ -4345,6 +4389,11 @@ func (b *SystemBackend) policyPaths() []*framework.Path {
 					},
 					Summary: "Add a new or update an existing ACL policy.",
 				},
+				logical.RecoverOperation: &framework.PathOperation{
+					Callback: b.handlePoliciesSet(PolicyTypeACL),
+					// recover is folded into update for OpenAPI documentation
+					// purposes, so no summary is set
+				},
 				logical.DeleteOperation: &framework.PathOperation{
 					Callback: b.handlePoliciesDelete(PolicyTypeACL),
 					Responses: map[int][]framework.Response{
@@ -756,6 +756,37 @@ var sysRaftHelp = map[string][2]string{
 		"Returns autopilot configuration.",
 		"",
 	},
+	"raft-snapshot-auto-config": {
+		"Configures automated snapshots taken by the active node.",
+		`Each configuration takes a snapshot every interval and stores it in a local
+directory or an S3 compatible object store, keeping the given number of the most
+recent snapshots.`,
+	},
+	"raft-snapshot-auto-status": {
+		"Returns the status of automated snapshots.",
+		"",
+	},
+	"raft-snapshot-load": {
+		"Loads snapshots for reads and recovery alongside the live data.",
+		`A loaded snapshot is stored read-only on the active node, separately from the
+live data. Paths which support it can be read from the snapshot by passing its ID
+in the read_snapshot_id parameter, and recovered into the live data by passing
+it in the X-Vault-Recover-Snapshot-Id header. Only one snapshot can be loaded at
+a time.`,
+	},
+	"raft-compact": {
+		"Compacts the FSM database of the nodes in the cluster.",
+		`Bolt never returns the space freed by deleted data to the filesystem, so the
+database only shrinks when it's rewritten. Followers are compacted one at a time,
+once they're caught up with the leader, and the active node steps down to be
+compacted last by the next active node. Reading this path returns the progress
+of the last compaction.`,
+	},
+	"raft-snapshot-load-recover-mount": {
+		"Recovers the data of a mount from a loaded snapshot.",
+		`Every key of the mount in the snapshot is written to the live mount, which must
+be of the same type. Keys which only exist in the live mount are kept.`,
+	},
 }
 
 func NewSealAccessSealer(access seal.Access, logger hclog.Logger, use string) snapshot.Sealer {
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault/sdk/framework"
+	"github.com/hashicorp/vault/sdk/logical"
+)
+
+// raftCompactPaths returns the paths used to compact the FSM databases of the
+// raft cluster.
+func (b *SystemBackend) raftCompactPaths() []*framework.Path {
+	return []*framework.Path{
+		{
+			Pattern: "storage/raft/compact$",
+			Fields: map[string]*framework.FieldSchema{
+				"node_ids": {
+					Type:        framework.TypeCommaStringSlice,
+					Description: "IDs of the nodes to compact. Defaults to every node in the cluster.",
+				},
+				"max_lag": {
+					Type:        framework.TypeInt,
+					Default:     raftCompactionDefaultMaxLag,
+					Description: "Number of logs a node can be behind the leader to be considered caught up, before it's compacted and before moving on to the next node.",
+				},
+				"node_timeout": {
+					Type:        framework.TypeDurationSecond,
+					Default:     int(raftCompactionDefaultNodeTimeout.Seconds()),
+					Description: "How long to wait for each node to catch up and to compact before the compaction fails.",
+				},
+			},
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftCompact,
+					Summary:  "Starts a rolling compaction of the nodes' databases.",
+				},
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftCompactRead,
+					Summary:  "Returns the progress of the last compaction.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(sysRaftHelp["raft-compact"][0]),
+			HelpDescription: strings.TrimSpace(sysRaftHelp["raft-compact"][1]),
+		},
+	}
+}
+
+func (b *SystemBackend) handleStorageRaftCompact(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.raftCompactor == nil {
+		return logical.ErrorResponse("raft storage is not in use"), logical.ErrInvalidRequest
+	}
+
+	maxLag := d.Get("max_lag").(int)
+	if maxLag < 0 {
+		return logical.ErrorResponse("max_lag must not be negative"), logical.ErrInvalidRequest
+	}
+	nodeTimeout := time.Duration(d.Get("node_timeout").(int)) * time.Second
+	if nodeTimeout <= 0 {
+		return logical.ErrorResponse("node_timeout must be greater than zero"), logical.ErrInvalidRequest
+	}
+
+	compaction, err := b.Core.raftCompactor.start(d.Get("node_ids").([]string), uint64(maxLag), nodeTimeout)
+	if err != nil {
+		if errors.Is(err, errRaftCompactionRunning) {
+			return logical.ErrorResponse(err.Error()), logical.ErrInvalidRequest
+		}
+		return nil, err
+	}
+	return &logical.Response{Data: b.raftCompactionData(ctx, compaction)}, nil
+}
+
+func (b *SystemBackend) handleStorageRaftCompactRead(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	compaction, err := b.Core.loadRaftCompaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if compaction == nil {
+		return nil, nil
+	}
+	return &logical.Response{Data: b.raftCompactionData(ctx, compaction)}, nil
+}
+
+func (b *SystemBackend) raftCompactionData(ctx context.Context, compaction *RaftCompaction) map[string]interface{} {
+	// The applied index of the nodes shows how far along they are in
+	// catching up after compacting
+	var servers map[string]uint64
+	if raftBackend := b.Core.getRaftBackend(); raftBackend != nil && compaction.State == RaftCompactionStateRunning {
+		if state, err := raftBackend.GetAutopilotServerState(ctx); err == nil && state != nil {
+			servers = make(map[string]uint64, len(state.Servers))
+			for id, server := range state.Servers {
+				servers[id] = server.LastIndex
+			}
+		}
+	}
+
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	completed := 0
+	nodes := make([]map[string]interface{}, 0, len(compaction.Nodes))
+	for _, node := range compaction.Nodes {
+		if node.State == RaftCompactionNodeStateCompleted || node.State == RaftCompactionNodeStateSkipped {
+			completed++
+		}
+		data := map[string]interface{}{
+			"node_id":       node.NodeID,
+			"state":         node.State,
+			"error":         node.Error,
+			"request_index": node.RequestIndex,
+			"start_time":    formatTime(node.StartTime),
+			"end_time":      formatTime(node.EndTime),
+		}
+		if index, ok := servers[node.NodeID]; ok {
+			data["applied_index"] = index
+		}
+		nodes = append(nodes, data)
+	}
+
+	return map[string]interface{}{
+		"id":              compaction.ID,
+		"state":           compaction.State,
+		"error":           compaction.Error,
+		"start_time":      formatTime(compaction.StartTime),
+		"end_time":        formatTime(compaction.EndTime),
+		"max_lag":         compaction.MaxLag,
+		"node_timeout":    int64(compaction.NodeTimeout.Seconds()),
+		"nodes":           nodes,
+		"nodes_completed": completed,
+		"nodes_total":     len(compaction.Nodes),
+	}
+}
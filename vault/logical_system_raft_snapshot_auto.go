@@ -0,0 +1,336 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault/sdk/framework"
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/hashicorp/vault/vault/snapshots"
+)
+
+// raftSnapshotAutoPaths returns the paths used to configure automated raft
+// snapshots.
+func (b *SystemBackend) raftSnapshotAutoPaths() []*framework.Path {
+	return []*framework.Path{
+		{
+			Pattern: "storage/raft/snapshot-auto/config/?$",
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ListOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftSnapshotAutoConfigList,
+					Summary:  "Lists the automated snapshot configurations.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(sysRaftHelp["raft-snapshot-auto-config"][0]),
+			HelpDescription: strings.TrimSpace(sysRaftHelp["raft-snapshot-auto-config"][1]),
+		},
+		{
+			Pattern: "storage/raft/snapshot-auto/config/" + framework.GenericNameRegex("name"),
+			Fields: map[string]*framework.FieldSchema{
+				"name": {
+					Type:        framework.TypeString,
+					Description: "Name of the automated snapshot configuration.",
+				},
+				"interval": {
+					Type:        framework.TypeDurationSecond,
+					Description: "Time between snapshots.",
+				},
+				"retain": {
+					Type:        framework.TypeInt,
+					Default:     raftSnapshotAutoDefaultRetain,
+					Description: "Number of snapshots to keep; older ones are deleted after each snapshot.",
+				},
+				"storage_type": {
+					Type:          framework.TypeString,
+					Description:   `Where snapshots are stored: "local" or "aws-s3".`,
+					AllowedValues: []interface{}{"local", "aws-s3"},
+				},
+				"path_prefix": {
+					Type:        framework.TypeString,
+					Description: "Directory snapshots are written to for local storage, or the prefix of their object keys for aws-s3 storage.",
+				},
+				"file_prefix": {
+					Type:        framework.TypeString,
+					Default:     raftSnapshotAutoDefaultFilePrefix,
+					Description: "Prefix of the snapshot file names, which is followed by the time the snapshot was taken.",
+				},
+				"compression": {
+					Type:          framework.TypeString,
+					Default:       "gzip",
+					Description:   `Whether snapshots are stored gzip compressed ("gzip") or as uncompressed archives ("none").`,
+					AllowedValues: []interface{}{"gzip", "none"},
+				},
+				"aws_s3_bucket": {
+					Type:        framework.TypeString,
+					Description: "S3 bucket snapshots are stored in.",
+				},
+				"aws_s3_region": {
+					Type:        framework.TypeString,
+					Description: "Region of the S3 bucket.",
+				},
+				"aws_s3_endpoint": {
+					Type:        framework.TypeString,
+					Description: "Endpoint of an S3 compatible object store to use instead of AWS S3.",
+				},
+				"aws_s3_disable_tls": {
+					Type:        framework.TypeBool,
+					Description: "Whether to connect to the S3 endpoint without TLS.",
+				},
+				"aws_s3_force_path_style": {
+					Type:        framework.TypeBool,
+					Description: "Whether to use path style requests, as required by some S3 compatible object stores.",
+				},
+				"aws_s3_kms_key": {
+					Type:        framework.TypeString,
+					Description: "AWS KMS key used for server side encryption of snapshots.",
+				},
+				"aws_access_key_id": {
+					Type:        framework.TypeString,
+					Description: "AWS access key ID. If unset, credentials are sourced from the environment, credential files or instance role.",
+				},
+				"aws_secret_access_key": {
+					Type:        framework.TypeString,
+					Description: "AWS secret access key.",
+				},
+				"aws_session_token": {
+					Type:        framework.TypeString,
+					Description: "AWS session token.",
+				},
+			},
+			ExistenceCheck: b.handleStorageRaftSnapshotAutoConfigExistenceCheck,
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftSnapshotAutoConfigRead,
+					Summary:  "Returns an automated snapshot configuration.",
+				},
+				logical.CreateOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftSnapshotAutoConfigWrite,
+					Summary:  "Creates an automated snapshot configuration, which starts taking snapshots on the active node.",
+				},
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftSnapshotAutoConfigWrite,
+					Summary:  "Updates an automated snapshot configuration.",
+				},
+				logical.DeleteOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftSnapshotAutoConfigDelete,
+					Summary:  "Deletes an automated snapshot configuration, which stops taking snapshots. Snapshots already taken are kept.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(sysRaftHelp["raft-snapshot-auto-config"][0]),
+			HelpDescription: strings.TrimSpace(sysRaftHelp["raft-snapshot-auto-config"][1]),
+		},
+		{
+			Pattern: "storage/raft/snapshot-auto/status/" + framework.GenericNameRegex("name"),
+			Fields: map[string]*framework.FieldSchema{
+				"name": {
+					Type:        framework.TypeString,
+					Description: "Name of the automated snapshot configuration.",
+				},
+			},
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftSnapshotAutoStatusRead,
+					Summary:  "Returns the status of an automated snapshot configuration.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(sysRaftHelp["raft-snapshot-auto-status"][0]),
+			HelpDescription: strings.TrimSpace(sysRaftHelp["raft-snapshot-auto-status"][1]),
+		},
+	}
+}
+
+func (b *SystemBackend) handleStorageRaftSnapshotAutoConfigExistenceCheck(ctx context.Context, req *logical.Request, d *framework.FieldData) (bool, error) {
+	config, err := b.Core.loadRaftSnapshotAutoConfig(ctx, d.Get("name").(string))
+	if err != nil {
+		return false, err
+	}
+	return config != nil, nil
+}
+
+func (b *SystemBackend) handleStorageRaftSnapshotAutoConfigList(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.getRaftBackend() == nil {
+		return logical.ErrorResponse("raft storage is not in use"), logical.ErrInvalidRequest
+	}
+
+	names, err := b.Core.listRaftSnapshotAutoConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return logical.ListResponse(names), nil
+}
+
+func (b *SystemBackend) handleStorageRaftSnapshotAutoConfigRead(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.getRaftBackend() == nil {
+		return logical.ErrorResponse("raft storage is not in use"), logical.ErrInvalidRequest
+	}
+
+	config, err := b.Core.loadRaftSnapshotAutoConfig(ctx, d.Get("name").(string))
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, nil
+	}
+
+	data := map[string]interface{}{
+		"interval":     int64(config.Interval.Seconds()),
+		"retain":       config.Retain,
+		"storage_type": config.StorageType,
+		"path_prefix":  config.PathPrefix,
+		"file_prefix":  config.FilePrefix,
+		"compression":  config.Compression,
+	}
+	if config.StorageType == snapshots.TargetTypeS3 {
+		// The secret access key and session token are never returned
+		data["aws_s3_bucket"] = config.AWSS3Bucket
+		data["aws_s3_region"] = config.AWSS3Region
+		data["aws_s3_endpoint"] = config.AWSS3Endpoint
+		data["aws_s3_disable_tls"] = config.AWSS3DisableTLS
+		data["aws_s3_force_path_style"] = config.AWSS3ForcePathStyle
+		data["aws_s3_kms_key"] = config.AWSS3KMSKey
+		data["aws_access_key_id"] = config.AWSAccessKeyID
+	}
+	return &logical.Response{Data: data}, nil
+}
+
+func (b *SystemBackend) handleStorageRaftSnapshotAutoConfigWrite(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.getRaftBackend() == nil {
+		return logical.ErrorResponse("raft storage is not in use"), logical.ErrInvalidRequest
+	}
+
+	name := d.Get("name").(string)
+	config, err := b.Core.loadRaftSnapshotAutoConfig(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		config = &RaftSnapshotAutoConfig{
+			Name:        name,
+			Retain:      d.Get("retain").(int),
+			FilePrefix:  d.Get("file_prefix").(string),
+			Compression: d.Get("compression").(string),
+		}
+	}
+
+	// Only the supplied fields are changed on update
+	if v, ok := d.GetOk("interval"); ok {
+		config.Interval = time.Duration(v.(int)) * time.Second
+	}
+	if v, ok := d.GetOk("retain"); ok {
+		config.Retain = v.(int)
+	}
+	if v, ok := d.GetOk("storage_type"); ok {
+		config.StorageType = v.(string)
+	}
+	if v, ok := d.GetOk("path_prefix"); ok {
+		config.PathPrefix = v.(string)
+	}
+	if v, ok := d.GetOk("file_prefix"); ok {
+		config.FilePrefix = v.(string)
+	}
+	if v, ok := d.GetOk("compression"); ok {
+		config.Compression = v.(string)
+	}
+	if v, ok := d.GetOk("aws_s3_bucket"); ok {
+		config.AWSS3Bucket = v.(string)
+	}
+	if v, ok := d.GetOk("aws_s3_region"); ok {
+		config.AWSS3Region = v.(string)
+	}
+	if v, ok := d.GetOk("aws_s3_endpoint"); ok {
+		config.AWSS3Endpoint = v.(string)
+	}
+	if v, ok := d.GetOk("aws_s3_disable_tls"); ok {
+		config.AWSS3DisableTLS = v.(bool)
+	}
+	if v, ok := d.GetOk("aws_s3_force_path_style"); ok {
+		config.AWSS3ForcePathStyle = v.(bool)
+	}
+	if v, ok := d.GetOk("aws_s3_kms_key"); ok {
+		config.AWSS3KMSKey = v.(string)
+	}
+	if v, ok := d.GetOk("aws_access_key_id"); ok {
+		config.AWSAccessKeyID = v.(string)
+	}
+	if v, ok := d.GetOk("aws_secret_access_key"); ok {
+		config.AWSSecretAccessKey = v.(string)
+	}
+	if v, ok := d.GetOk("aws_session_token"); ok {
+		config.AWSSessionToken = v.(string)
+	}
+
+	if err := config.validate(); err != nil {
+		return logical.ErrorResponse(err.Error()), logical.ErrInvalidRequest
+	}
+	if err := b.Core.saveRaftSnapshotAutoConfig(ctx, config); err != nil {
+		return nil, err
+	}
+
+	if scheduler := b.Core.raftSnapshotScheduler; scheduler != nil {
+		scheduler.update(config)
+	}
+	return nil, nil
+}
+
+func (b *SystemBackend) handleStorageRaftSnapshotAutoConfigDelete(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.getRaftBackend() == nil {
+		return logical.ErrorResponse("raft storage is not in use"), logical.ErrInvalidRequest
+	}
+
+	name := d.Get("name").(string)
+	if scheduler := b.Core.raftSnapshotScheduler; scheduler != nil {
+		scheduler.remove(name)
+	}
+	if err := b.Core.deleteRaftSnapshotAutoConfig(ctx, name); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (b *SystemBackend) handleStorageRaftSnapshotAutoStatusRead(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.getRaftBackend() == nil {
+		return logical.ErrorResponse("raft storage is not in use"), logical.ErrInvalidRequest
+	}
+
+	name := d.Get("name").(string)
+	config, err := b.Core.loadRaftSnapshotAutoConfig(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, nil
+	}
+	status, err := b.Core.loadRaftSnapshotAutoStatus(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil {
+		status = &RaftSnapshotAutoStatus{}
+	}
+
+	data := map[string]interface{}{
+		"consecutive_errors":  status.ConsecutiveErrors,
+		"last_snapshot_start": formatSnapshotAutoTime(status.LastSnapshotStart),
+		"last_snapshot_end":   formatSnapshotAutoTime(status.LastSnapshotEnd),
+		"last_snapshot_error": status.LastSnapshotError,
+		"last_snapshot_url":   status.LastSnapshotURL,
+		"last_snapshot_size":  status.LastSnapshotSize,
+		"retained_snapshots":  status.RetainedSnapshots,
+		"next_snapshot_start": status.nextSnapshotStart(config.Interval).UTC().Format(time.RFC3339),
+	}
+	return &logical.Response{Data: data}, nil
+}
+
+func formatSnapshotAutoTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,351 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+	"github.com/hashicorp/vault/helper/namespace"
+	"github.com/hashicorp/vault/sdk/framework"
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/hashicorp/vault/vault/snapshots"
+)
+
+// raftSnapshotLoadPaths returns the paths used to load raft snapshots for
+// reads and recovery alongside the live data.
+func (b *SystemBackend) raftSnapshotLoadPaths() []*framework.Path {
+	return []*framework.Path{
+		{
+			Pattern: "storage/raft/snapshot-load/?$",
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftSnapshotLoad,
+					Summary:  "Loads the uploaded snapshot, so data can be read and recovered from it.",
+				},
+				logical.ListOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftSnapshotLoadList,
+					Summary:  "Lists the loaded snapshots.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(sysRaftHelp["raft-snapshot-load"][0]),
+			HelpDescription: strings.TrimSpace(sysRaftHelp["raft-snapshot-load"][1]),
+		},
+		{
+			Pattern: "storage/raft/snapshot-load/" + framework.GenericNameRegex("snapshot_id") + "$",
+			Fields: map[string]*framework.FieldSchema{
+				"snapshot_id": {
+					Type:        framework.TypeString,
+					Description: "ID of the loaded snapshot.",
+				},
+				"force": {
+					Type:        framework.TypeBool,
+					Description: "Unload the snapshot even if it is still loading, without waiting for requests using it to finish.",
+				},
+			},
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftSnapshotLoadRead,
+					Summary:  "Returns the status of a loaded snapshot.",
+				},
+				logical.DeleteOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftSnapshotUnload,
+					Summary:  "Unloads a snapshot.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(sysRaftHelp["raft-snapshot-load"][0]),
+			HelpDescription: strings.TrimSpace(sysRaftHelp["raft-snapshot-load"][1]),
+		},
+		{
+			Pattern: "storage/raft/snapshot-load/" + framework.GenericNameRegex("snapshot_id") + "/recover-mount$",
+			Fields: map[string]*framework.FieldSchema{
+				"snapshot_id": {
+					Type:        framework.TypeString,
+					Description: "ID of the loaded snapshot.",
+				},
+				"mount": {
+					Type:        framework.TypeString,
+					Description: `Path of the live mount the data is recovered into, e.g. "secret/" or "auth/userpass/".`,
+					Required:    true,
+				},
+				"source_mount": {
+					Type:        framework.TypeString,
+					Description: "Path of the mount in the snapshot the data is recovered from. Defaults to the mount path.",
+				},
+			},
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftSnapshotLoadRecoverMount,
+					Summary:  "Recovers the data of a mount from a loaded snapshot.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(sysRaftHelp["raft-snapshot-load-recover-mount"][0]),
+			HelpDescription: strings.TrimSpace(sysRaftHelp["raft-snapshot-load-recover-mount"][1]),
+		},
+		{
+			Pattern: "storage/raft/snapshot-auto/snapshot-load/" + framework.GenericNameRegex("name"),
+			Fields: map[string]*framework.FieldSchema{
+				"name": {
+					Type:        framework.TypeString,
+					Description: "Name of the automated snapshot configuration the snapshot was taken by.",
+				},
+				"url": {
+					Type:        framework.TypeString,
+					Description: "Location of the snapshot, as reported by the automated snapshot status.",
+					Required:    true,
+				},
+			},
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: b.handleStorageRaftSnapshotAutoLoad,
+					Summary:  "Loads a snapshot taken by an automated snapshot configuration.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(sysRaftHelp["raft-snapshot-load"][0]),
+			HelpDescription: strings.TrimSpace(sysRaftHelp["raft-snapshot-load"][1]),
+		},
+	}
+}
+
+func (b *SystemBackend) handleStorageRaftSnapshotLoad(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.snapshotManager == nil {
+		return logical.ErrorResponse("raft storage is not in use"), logical.ErrInvalidRequest
+	}
+	body, ok := logical.ContextOriginalBodyValue(ctx)
+	if !ok {
+		return nil, errors.New("no reader for request")
+	}
+	return b.loadRaftSnapshot(ctx, snapshots.NewManualSnapshotSource(body))
+}
+
+func (b *SystemBackend) handleStorageRaftSnapshotAutoLoad(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.snapshotManager == nil {
+		return logical.ErrorResponse("raft storage is not in use"), logical.ErrInvalidRequest
+	}
+
+	name := d.Get("name").(string)
+	config, err := b.Core.loadRaftSnapshotAutoConfig(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return logical.ErrorResponse("automated snapshot configuration %q not found", name), logical.ErrInvalidRequest
+	}
+	target, err := config.target(ctx, b.Core.logger.Named("snapshot-load"))
+	if err != nil {
+		return nil, err
+	}
+
+	// The snapshot must be in the configuration's target
+	url := d.Get("url").(string)
+	snapshotName := url[strings.LastIndex(url, "/")+1:]
+	if _, ok := snapshots.SnapshotTime(config.FilePrefix, snapshotName); !ok || target.Location(snapshotName) != url {
+		return logical.ErrorResponse("%q is not a snapshot taken by automated snapshot configuration %q", url, name), logical.ErrInvalidRequest
+	}
+	return b.loadRaftSnapshot(ctx, snapshots.NewTargetSource(target, snapshotName))
+}
+
+func (b *SystemBackend) loadRaftSnapshot(ctx context.Context, source snapshots.Source) (*logical.Response, error) {
+	snap, err := b.Core.snapshotManager.load(ctx, source)
+	switch {
+	case err == nil:
+	case errors.Is(err, errSnapshotAlreadyLoaded):
+		return logical.ErrorResponse(err.Error()), logical.ErrInvalidRequest
+	case strings.Contains(err.Error(), "failed to open the sealed hashes"):
+		return logical.ErrorResponse("could not verify hash file, possibly the snapshot is from a cluster with a different seal"), logical.ErrInvalidRequest
+	default:
+		return nil, err
+	}
+	return &logical.Response{Data: b.loadedSnapshotData(snap)}, nil
+}
+
+func (b *SystemBackend) handleStorageRaftSnapshotLoadList(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.snapshotManager == nil {
+		return logical.ErrorResponse("raft storage is not in use"), logical.ErrInvalidRequest
+	}
+
+	var keys []string
+	keyInfo := map[string]interface{}{}
+	for _, snap := range b.Core.snapshotManager.list() {
+		keys = append(keys, snap.ID)
+		keyInfo[snap.ID] = b.loadedSnapshotData(snap)
+	}
+	return logical.ListResponseWithInfo(keys, keyInfo), nil
+}
+
+func (b *SystemBackend) handleStorageRaftSnapshotLoadRead(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.snapshotManager == nil {
+		return logical.ErrorResponse("raft storage is not in use"), logical.ErrInvalidRequest
+	}
+
+	snap, err := b.Core.snapshotManager.get(d.Get("snapshot_id").(string))
+	if errors.Is(err, errSnapshotNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &logical.Response{Data: b.loadedSnapshotData(snap)}, nil
+}
+
+func (b *SystemBackend) handleStorageRaftSnapshotUnload(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.snapshotManager == nil {
+		return logical.ErrorResponse("raft storage is not in use"), logical.ErrInvalidRequest
+	}
+
+	err := b.Core.snapshotManager.unload(d.Get("snapshot_id").(string), d.Get("force").(bool))
+	switch {
+	case err == nil, errors.Is(err, errSnapshotNotFound):
+		return nil, nil
+	default:
+		return logical.ErrorResponse(err.Error()), logical.ErrInvalidRequest
+	}
+}
+
+func (b *SystemBackend) loadedSnapshotData(snap *loadedSnapshot) map[string]interface{} {
+	state, loadErr := b.Core.snapshotManager.status(snap)
+	return map[string]interface{}{
+		"snapshot_id": snap.ID,
+		"status":      state,
+		"error":       loadErr,
+		"source":      snap.Source,
+		"index":       snap.Index,
+		"term":        snap.Term,
+		"loaded_at":   snap.LoadedAt.Format(time.RFC3339),
+	}
+}
+
+// handleStorageRaftSnapshotLoadRecoverMount copies the storage of a mount in
+// a loaded snapshot into a live mount of the same type. Keys which only exist
+// in the live mount are kept.
+func (b *SystemBackend) handleStorageRaftSnapshotLoadRecoverMount(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	manager := b.Core.snapshotManager
+	if manager == nil {
+		return logical.ErrorResponse("raft storage is not in use"), logical.ErrInvalidRequest
+	}
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mountPath := d.Get("mount").(string)
+	if mountPath == "" {
+		return logical.ErrorResponse("mount is required"), logical.ErrInvalidRequest
+	}
+	mountPath = sanitizePath(mountPath)
+	sourcePath := mountPath
+	if v := d.Get("source_mount").(string); v != "" {
+		sourcePath = sanitizePath(v)
+	}
+
+	snapshotID := d.Get("snapshot_id").(string)
+	unlock, err := manager.lock(snapshotID)
+	if err != nil {
+		return logical.ErrorResponse(err.Error()), logical.ErrInvalidRequest
+	}
+	defer unlock()
+	snapshotStorage, err := manager.SnapshotStorage(ctx, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	backend, entry := b.Core.router.MatchingBackendAndMountEntry(ctx, mountPath)
+	if entry == nil || entry.APIPathNoNamespace() != mountPath {
+		return logical.ErrorResponse("no mount at %q", mountPath), logical.ErrInvalidRequest
+	}
+	if strutil.StrListContains(singletonMounts, entry.Type) {
+		return logical.ErrorResponse("mounts of type %q can't be recovered", entry.Type), logical.ErrInvalidRequest
+	}
+
+	sourceEntry, err := b.snapshotMountEntry(ctx, snapshotStorage, ns, sourcePath)
+	if err != nil {
+		return nil, err
+	}
+	if sourceEntry == nil {
+		return logical.ErrorResponse("no mount at %q in the snapshot", sourcePath), logical.ErrInvalidRequest
+	}
+	if sourceEntry.Type != entry.Type {
+		return logical.ErrorResponse("mount %q in the snapshot is of type %q, not %q", sourcePath, sourceEntry.Type, entry.Type), logical.ErrInvalidRequest
+	}
+
+	source := logical.NewStorageView(snapshotStorage, sourceEntry.ViewPath())
+	dest := b.Core.router.MatchingStorageByAPIPath(ctx, mountPath)
+	if dest == nil {
+		return nil, fmt.Errorf("no storage for mount %q", mountPath)
+	}
+
+	keys, err := logical.CollectKeys(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list keys in the snapshot: %w", err)
+	}
+	recovered := 0
+	for _, key := range keys {
+		storageEntry, err := source.Get(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %q from the snapshot: %w", key, err)
+		}
+		if storageEntry == nil {
+			continue
+		}
+		if err := dest.Put(ctx, storageEntry); err != nil {
+			return nil, fmt.Errorf("failed to write %q: %w", key, err)
+		}
+		recovered++
+	}
+
+	// Let the backend drop anything it cached from the keys written
+	if backend != nil {
+		for _, key := range keys {
+			backend.InvalidateKey(ctx, key)
+		}
+	}
+
+	b.Core.logger.Info("recovered mount from snapshot", "snapshot_id", snapshotID, "mount", mountPath, "source_mount", sourcePath, "keys", recovered)
+	return &logical.Response{
+		Data: map[string]interface{}{
+			"mount":          mountPath,
+			"source_mount":   sourcePath,
+			"keys_recovered": recovered,
+		},
+	}, nil
+}
+
+// snapshotMountEntry finds the mount with the given API path in the mount
+// tables of the snapshot.
+func (b *SystemBackend) snapshotMountEntry(ctx context.Context, storage logical.Storage, ns *namespace.Namespace, apiPath string) (*MountEntry, error) {
+	tablePaths := []string{coreMountConfigPath, coreLocalMountConfigPath}
+	path := apiPath
+	if strings.HasPrefix(apiPath, credentialRoutePrefix) {
+		tablePaths = []string{coreAuthConfigPath, coreLocalAuthConfigPath}
+		path = strings.TrimPrefix(apiPath, credentialRoutePrefix)
+	}
+
+	for _, tablePath := range tablePaths {
+		raw, err := storage.Get(ctx, tablePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read mount table from the snapshot: %w", err)
+		}
+		if raw == nil {
+			continue
+		}
+		table, err := b.Core.decodeMountTable(ctx, raw.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode mount table from the snapshot: %w", err)
+		}
+		for _, entry := range table.Entries {
+			if entry.Path == path && entry.NamespaceID == ns.ID {
+				return entry, nil
+			}
+		}
+	}
+	return nil, nil
+}
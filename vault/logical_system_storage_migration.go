@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault/sdk/framework"
+	"github.com/hashicorp/vault/sdk/logical"
+)
+
+// storageMigrationPaths returns the paths used to migrate Vault's storage to
+// another backend while online.
+func (b *SystemBackend) storageMigrationPaths() []*framework.Path {
+	return []*framework.Path{
+		{
+			Pattern: "storage/migration$",
+			Fields: map[string]*framework.FieldSchema{
+				"type": {
+					Type:        framework.TypeString,
+					Description: "Type of the storage backend to migrate to.",
+				},
+				"config": {
+					Type:        framework.TypeKVPairs,
+					Description: "Configuration of the storage backend to migrate to, as it would be set in its storage stanza.",
+				},
+				"resume": {
+					Type:        framework.TypeBool,
+					Description: "Resume the last migration, which failed or was canceled, from its checkpoint instead of starting a new one. The type and config of that migration are used.",
+				},
+			},
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: b.handleStorageMigrationStart,
+					Summary:  "Starts migrating storage to another backend while online.",
+				},
+				logical.ReadOperation: &framework.PathOperation{
+					Callback: b.handleStorageMigrationRead,
+					Summary:  "Returns the progress of the last storage migration.",
+				},
+				logical.DeleteOperation: &framework.PathOperation{
+					Callback: b.handleStorageMigrationCancel,
+					Summary:  "Cancels the running storage migration.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(sysStorageMigrationHelp["storage-migration"][0]),
+			HelpDescription: strings.TrimSpace(sysStorageMigrationHelp["storage-migration"][1]),
+		},
+		{
+			Pattern: "storage/migration/cutover$",
+			Operations: map[logical.Operation]framework.OperationHandler{
+				logical.UpdateOperation: &framework.PathOperation{
+					Callback: b.handleStorageMigrationCutover,
+					Summary:  "Cuts over to the new storage, switching every node to it.",
+				},
+			},
+
+			HelpSynopsis:    strings.TrimSpace(sysStorageMigrationHelp["storage-migration-cutover"][0]),
+			HelpDescription: strings.TrimSpace(sysStorageMigrationHelp["storage-migration-cutover"][1]),
+		},
+	}
+}
+
+func (b *SystemBackend) handleStorageMigrationStart(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.storageMigrator == nil {
+		return logical.ErrorResponse("storage migrations can only be managed on the active node"), logical.ErrInvalidRequest
+	}
+
+	resume := d.Get("resume").(bool)
+	storageType := d.Get("type").(string)
+	if !resume && storageType == "" {
+		return logical.ErrorResponse("type is required"), logical.ErrInvalidRequest
+	}
+	if resume && storageType != "" {
+		return logical.ErrorResponse("type and config can't be set when resuming a migration"), logical.ErrInvalidRequest
+	}
+
+	migration, err := b.Core.storageMigrator.start(storageType, d.Get("config").(map[string]string), resume)
+	if err != nil {
+		return logical.ErrorResponse(err.Error()), logical.ErrInvalidRequest
+	}
+	return &logical.Response{Data: storageMigrationData(migration)}, nil
+}
+
+func (b *SystemBackend) handleStorageMigrationRead(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	var migration *StorageMigration
+	var err error
+	if b.Core.storageMigrator != nil {
+		migration, err = b.Core.storageMigrator.status(ctx)
+	} else {
+		migration, err = b.Core.loadStorageMigration(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if migration == nil {
+		return nil, nil
+	}
+	return &logical.Response{Data: storageMigrationData(migration)}, nil
+}
+
+func (b *SystemBackend) handleStorageMigrationCancel(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.storageMigrator == nil {
+		return logical.ErrorResponse("storage migrations can only be managed on the active node"), logical.ErrInvalidRequest
+	}
+
+	migration, err := b.Core.storageMigrator.stop()
+	if err != nil {
+		if errors.Is(err, errStorageMigrationNotRunning) {
+			return logical.ErrorResponse(err.Error()), logical.ErrInvalidRequest
+		}
+		return nil, err
+	}
+	return &logical.Response{Data: storageMigrationData(migration)}, nil
+}
+
+func (b *SystemBackend) handleStorageMigrationCutover(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
+	if b.Core.storageMigrator == nil {
+		return logical.ErrorResponse("storage migrations can only be managed on the active node"), logical.ErrInvalidRequest
+	}
+
+	migration, err := b.Core.storageMigrator.cutover()
+	if err != nil {
+		return logical.ErrorResponse(err.Error()), logical.ErrInvalidRequest
+	}
+	return &logical.Response{Data: storageMigrationData(migration)}, nil
+}
+
+// storageMigrationData returns the progress of the migration. Its storage
+// config isn't returned, as it may contain credentials.
+func storageMigrationData(migration *StorageMigration) map[string]interface{} {
+	formatTime := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	return map[string]interface{}{
+		"id":            migration.ID,
+		"type":          migration.Type,
+		"state":         migration.State,
+		"error":         migration.Error,
+		"checkpoint":    migration.Checkpoint,
+		"keys_copied":   migration.KeysCopied,
+		"keys_verified": migration.KeysVerified,
+		"keys_repaired": migration.KeysRepaired,
+		"start_time":    formatTime(migration.StartTime),
+		"copy_end_time": formatTime(migration.CopyEndTime),
+		"end_time":      formatTime(migration.EndTime),
+	}
+}
+
+var sysStorageMigrationHelp = map[string][2]string{
+	"storage-migration": {
+		"Migrates storage to another backend while Vault is online.",
+		`Writes are mirrored to the new storage backend, which must be empty, while the
+active node copies the existing keys in the background, saving a checkpoint as
+it goes. Once copied, the checksum of every key is compared between the two
+backends, and keys which differ are repaired. The migration is resumed by the
+next active node if the active node steps down or is sealed. Reading this path
+returns the progress of the last migration, and deleting it cancels the
+running migration.`,
+	},
+	"storage-migration-cutover": {
+		"Cuts over to the new storage once the migration has been verified.",
+		`The old storage is marked as cut over, along with the configuration of the new
+storage, which is stored unencrypted. The active node then seals itself,
+switches to the new storage, takes its HA lock and unseals again. Standby nodes
+switch to the new storage when they find the old storage marked, and nodes
+which are restarted with the old storage configured use the new storage
+instead. Requests fail while the active node is switching. Migrations to raft
+storage can only be cut over once the other nodes have been removed, as the new
+raft cluster only has the active node, after which they can join it.`,
+	},
+}
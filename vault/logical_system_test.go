@@ -242,7 +242,7 @@ func TestSystemBackend_mounts(t *testing.T) {
 				"max_lease_ttl":               resp.Data["identity/"].(map[string]interface{})["config"].(map[string]interface{})["max_lease_ttl"].(int64),
 				"force_no_cache":              false,
 				"passthrough_request_headers": []string{"Authorization"},
-				"allowed_response_headers":    []string{"Location"},
+				"allowed_response_headers":    []string{"Location", "ETag"},
 			},
 			"local":                  false,
 			"seal_wrap":              false,
@@ -400,7 +400,7 @@ func TestSystemBackend_mount(t *testing.T) {
 				"max_lease_ttl":               resp.Data["identity/"].(map[string]interface{})["config"].(map[string]interface{})["max_lease_ttl"].(int64),
 				"force_no_cache":              false,
 				"passthrough_request_headers": []string{"Authorization"},
-				"allowed_response_headers":    []string{"Location"},
+				"allowed_response_headers":    []string{"Location", "ETag"},
 			},
 			"local":                  false,
 			"seal_wrap":              false,
@@ -4609,7 +4609,7 @@ func TestSystemBackend_InternalUIMounts(t *testing.T) {
 					"max_lease_ttl":               resp.Data["secret"].(map[string]interface{})["identity/"].(map[string]interface{})["config"].(map[string]interface{})["max_lease_ttl"].(int64),
 					"force_no_cache":              false,
 					"passthrough_request_headers": []string{"Authorization"},
-					"allowed_response_headers":    []string{"Location"},
+					"allowed_response_headers":    []string{"Location", "ETag"},
 				},
 				"local":                  false,
 				"seal_wrap":              false,
@@ -4898,6 +4898,61 @@ path "sys/*" {
 	require.Contains(t, getCaps(pref), "update")
 }
 
+// TestSystemBackend_InternalUIResultantACL_Scope verifies that the resultant
+// ACL of a token restricted to a capability scope only reports what both its
+// policies and its scope grant.
+func TestSystemBackend_InternalUIResultantACL_Scope(t *testing.T) {
+	ctx := namespace.RootContext(nil)
+	core, b, rootToken := testCoreSystemBackend(t)
+
+	rules := `
+name = "ui-res-acl-scope-test"
+path "secret/*" {
+  capabilities = ["read", "list", "update"]
+}
+path "sys/*" {
+  capabilities = ["update"]
+}`
+	pol, err := ParseACLPolicy(namespace.RootNamespace, rules)
+	require.NoError(t, err)
+	require.NoError(t, core.policyStore.SetPolicy(ctx, pol))
+
+	req := logical.TestRequest(t, logical.UpdateOperation, "create")
+	req.ClientToken = rootToken
+	req.Data["id"] = "scopedtoken"
+	req.Data["policies"] = []string{"ui-res-acl-scope-test"}
+	req.Data["scope"] = map[string]interface{}{
+		"secret/app/*": "read",
+		"sys/*":        "read",
+	}
+	resp, err := core.tokenStore.HandleRequest(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Error())
+
+	req = logical.TestRequest(t, logical.ReadOperation, "internal/ui/resultant-acl")
+	req.ClientToken = "scopedtoken"
+	resp, err = b.HandleRequest(ctx, req)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, false, resp.Data["root"])
+
+	capabilities := func(paths interface{}, path string) interface{} {
+		p, ok := paths.(map[string]interface{})[path]
+		require.True(t, ok, "path %q not found", path)
+		return p.(map[string]interface{})["capabilities"]
+	}
+
+	glob := resp.Data["glob_paths"]
+	require.Equal(t, []string{"read"}, capabilities(glob, "secret/app/"))
+	require.Equal(t, []string{"deny"}, capabilities(glob, "secret/"))
+	require.Equal(t, []string{"deny"}, capabilities(glob, "sys/"))
+
+	// The default policy grants the token access to itself, which the scope
+	// always allows
+	exact := resp.Data["exact_paths"]
+	require.Equal(t, []string{"read"}, capabilities(exact, "auth/token/lookup-self"))
+}
+
 func TestSystemBackend_OpenAPI(t *testing.T) {
 	coreConfig := &CoreConfig{
 		LogicalBackends: map[string]logical.Factory{
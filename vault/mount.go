@@ -715,6 +715,7 @@ func (c *Core) mountInternalWithRequest(ctx context.Context, entry *MountEntry,
 
 	viewPath := entry.ViewPath()
 	view := NewBarrierView(router, viewPath)
+	c.trackStorageUsage(entry, true)
 
 	// Singleton mounts cannot be filtered manually on a per-secondary basis
 	// from replication.
@@ -1021,6 +1022,7 @@ func (c *Core) unmountInternalWithRequest(ctx context.Context, path string, upda
 	}
 
 	removePathCheckers(c, entry, viewPath)
+	c.untrackStorageUsage(entry)
 
 	if c.quotaManager != nil && !c.IsPerfSecondary() {
 		if err := c.quotaManager.HandleBackendDisabling(ctx, ns.Path, path); err != nil {
@@ -1642,6 +1644,7 @@ func (c *Core) setupMounts(ctx context.Context) error {
 
 		// Create a barrier storage view using the UUID
 		view := NewBarrierView(storageRouter, barrierPath)
+		c.trackStorageUsage(entry, false)
 
 		// Singleton mounts cannot be filtered manually on a per-secondary basis
 		// from replication
@@ -2049,7 +2052,7 @@ func (c *Core) requiredMountTable() *MountTable {
 		BackendAwareUUID: identityBackendUUID,
 		Config: MountConfig{
 			PassthroughRequestHeaders: []string{"Authorization"},
-			AllowedResponseHeaders:    []string{"Location"},
+			AllowedResponseHeaders:    []string{"Location", "ETag"},
 		},
 		RunningVersion: versions.DefaultBuiltinVersion,
 	}
@@ -78,7 +78,10 @@ func (c *Core) entBuiltinPluginMetrics(ctx context.Context, entry *MountEntry, v
 }
 
 func newSnapshotStorageRouter(c *Core, storage logical.Storage) logical.Storage {
-	return storage
+	if c.snapshotManager == nil {
+		return storage
+	}
+	return logical.NewSnapshotStorageRouter(storage, c.snapshotManager)
 }
 
 func (c *Core) addRequiredNamespaceMounts(mountEntries []*MountEntry) ([]*MountEntry, bool, error) {
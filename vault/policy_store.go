@@ -68,7 +68,9 @@ path "sys/wrapping/unwrap" {
     capabilities = ["update"]
 }
 `
-	// defaultPolicy is the "default" policy
+	// defaultPolicy is the "default" policy. It is only written if the policy
+	// doesn't exist, so paths added here don't reach clusters upgraded from
+	// earlier versions; operators have to add them to their default policy.
 	defaultPolicy = `
 # Allow tokens to look up their own properties
 path "auth/token/lookup-self" {
@@ -162,6 +164,16 @@ path "sys/control-group/request" {
 path "identity/oidc/provider/+/authorize" {
     capabilities = ["read", "update"]
 }
+
+# Allow a token to end its session with OIDC providers.
+path "identity/oidc/provider/+/logout" {
+    capabilities = ["read", "update"]
+}
+
+# Allow a token to verify device authorization requests with OIDC providers.
+path "identity/oidc/provider/+/device/verify" {
+    capabilities = ["read", "update"]
+}
 `
 
 	// defaultCeilingPolicy is the default ceiling policy.
@@ -604,6 +616,13 @@ func (ps *PolicyStore) switchedGetPolicy(ctx context.Context, name string, polic
 		}
 	}
 
+	// Policies read from a loaded snapshot bypass the cache, so they don't
+	// shadow the live policies
+	snapshotID, _ := logical.ContextSnapshotIDValue(ctx)
+	if snapshotID != "" {
+		cache = nil
+	}
+
 	if cache != nil {
 		// Check for cached policy
 		if raw, ok := cache.Get(index); ok {
@@ -679,14 +698,18 @@ func (ps *PolicyStore) switchedGetPolicy(ctx context.Context, name string, polic
 		// Reset this in case they set the name in the policy itself
 		policy.Name = name
 
-		ps.policyTypeMap.Store(index, PolicyTypeACL)
+		if snapshotID == "" {
+			ps.policyTypeMap.Store(index, PolicyTypeACL)
+		}
 
 	case PolicyTypeRGP:
 		if err := ps.handleSentinelPolicy(ctx, policy, nil, nil); err != nil {
 			return nil, err
 		}
 
-		ps.policyTypeMap.Store(index, PolicyTypeRGP)
+		if snapshotID == "" {
+			ps.policyTypeMap.Store(index, PolicyTypeRGP)
+		}
 
 	case PolicyTypeEGP:
 		if err := ps.handleSentinelPolicy(ctx, policy, nil, nil); err != nil {
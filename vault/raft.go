@@ -371,6 +371,13 @@ func (c *Core) setupRaftActiveNode(ctx context.Context) error {
 		PersistedStates:     persistedState,
 		SavePersistedStates: c.saveAutopilotPersistedState,
 	})
+
+	if err := c.startRaftSnapshotScheduler(c.activeContext); err != nil {
+		c.logger.Error("failed to start automated snapshots", "error", err)
+	}
+	if err := c.startRaftCompactor(c.activeContext); err != nil {
+		c.logger.Error("failed to resume compaction", "error", err)
+	}
 	return nil
 }
 
@@ -410,6 +417,11 @@ func (c *Core) stopRaftActiveNode() {
 
 	c.pendingRaftPeers = nil
 	c.stopPeriodicRaftTLSRotate()
+	c.stopRaftSnapshotScheduler()
+	c.stopRaftCompactor()
+	if c.snapshotManager != nil {
+		c.snapshotManager.unloadAll()
+	}
 }
 
 func (c *Core) startPeriodicRaftTLSRotate(ctx context.Context) error {
@@ -0,0 +1,485 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-uuid"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/vault/physical/raft"
+	"github.com/hashicorp/vault/sdk/logical"
+)
+
+const (
+	raftCompactionStoragePath = "core/raft/compaction"
+
+	raftCompactionDefaultMaxLag      = 1000
+	raftCompactionDefaultNodeTimeout = time.Hour
+	raftCompactionPollInterval       = time.Second
+
+	// raftCompactionMinimumVersion is the first version able to apply the
+	// requests to compact a follower. Older versions fail to apply them and
+	// stop, so compaction is only requested once every node has upgraded.
+	raftCompactionMinimumVersion = "2.2.0"
+
+	RaftCompactionStateRunning   = "running"
+	RaftCompactionStateCompleted = "completed"
+	RaftCompactionStateFailed    = "failed"
+
+	RaftCompactionNodeStatePending    = "pending"
+	RaftCompactionNodeStateCompacting = "compacting"
+	RaftCompactionNodeStateCompleted  = "completed"
+	RaftCompactionNodeStateSkipped    = "skipped"
+	RaftCompactionNodeStateFailed     = "failed"
+)
+
+var (
+	errRaftCompactionRunning     = errors.New("a compaction is already running")
+	errRaftCompactionSteppedDown = errors.New("stepped down to compact the leader")
+)
+
+// RaftCompaction is a rolling compaction of the FSM database of the nodes of
+// the raft cluster. Followers are compacted one at a time, and the leader
+// steps down to be compacted last, by the new active node. It's stored so
+// that it can be resumed by the new active node.
+type RaftCompaction struct {
+	ID          string                `json:"id"`
+	State       string                `json:"state"`
+	Error       string                `json:"error,omitempty"`
+	StartTime   time.Time             `json:"start_time"`
+	EndTime     time.Time             `json:"end_time,omitempty"`
+	MaxLag      uint64                `json:"max_lag"`
+	NodeTimeout time.Duration         `json:"node_timeout"`
+	Nodes       []*RaftCompactionNode `json:"nodes"`
+}
+
+// RaftCompactionNode is the progress of the compaction of a node.
+type RaftCompactionNode struct {
+	NodeID string `json:"node_id"`
+	State  string `json:"state"`
+	Error  string `json:"error,omitempty"`
+	// RequestIndex is the index of the log requesting the node's compaction,
+	// which it has finished compacting once it has applied.
+	RequestIndex uint64    `json:"request_index,omitempty"`
+	StartTime    time.Time `json:"start_time,omitempty"`
+	EndTime      time.Time `json:"end_time,omitempty"`
+}
+
+func (c *Core) loadRaftCompaction(ctx context.Context) (*RaftCompaction, error) {
+	entry, err := c.barrier.Get(ctx, raftCompactionStoragePath)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	var compaction RaftCompaction
+	if err := entry.DecodeJSON(&compaction); err != nil {
+		return nil, err
+	}
+	return &compaction, nil
+}
+
+func (c *Core) saveRaftCompaction(ctx context.Context, compaction *RaftCompaction) error {
+	entry, err := logical.StorageEntryJSON(raftCompactionStoragePath, compaction)
+	if err != nil {
+		return err
+	}
+	return c.barrier.Put(ctx, entry)
+}
+
+// raftCompactor runs the rolling compaction on the active node.
+type raftCompactor struct {
+	core   *Core
+	logger hclog.Logger
+	ctx    context.Context
+
+	// stepDownCh is the channel the active node is stepped down with
+	stepDownCh chan struct{}
+
+	l       sync.Mutex
+	running bool
+	wg      sync.WaitGroup
+}
+
+// startRaftCompactor resumes a compaction that was running when the last
+// active node stepped down or was sealed.
+func (c *Core) startRaftCompactor(ctx context.Context) error {
+	r := &raftCompactor{
+		core:       c,
+		logger:     c.logger.Named("raft-compaction"),
+		ctx:        ctx,
+		stepDownCh: c.manualStepDownCh,
+	}
+	c.raftCompactor = r
+
+	compaction, err := c.loadRaftCompaction(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load compaction: %w", err)
+	}
+	if compaction != nil && compaction.State == RaftCompactionStateRunning {
+		r.logger.Info("resuming compaction", "id", compaction.ID)
+		r.l.Lock()
+		r.running = true
+		r.wg.Add(1)
+		r.l.Unlock()
+		go r.run(compaction)
+	}
+	return nil
+}
+
+func (c *Core) stopRaftCompactor() {
+	if c.raftCompactor == nil {
+		return
+	}
+	// The compaction stops with the active context, and is resumed by the
+	// next active node.
+	c.raftCompactor.wg.Wait()
+	c.raftCompactor = nil
+}
+
+// start begins a compaction of the given nodes, or of every node in the
+// cluster if none are given.
+func (r *raftCompactor) start(nodeIDs []string, maxLag uint64, nodeTimeout time.Duration) (*RaftCompaction, error) {
+	raftBackend := r.core.getRaftBackend()
+	if raftBackend == nil {
+		return nil, errors.New("raft storage is not in use")
+	}
+
+	r.l.Lock()
+	defer r.l.Unlock()
+	if r.running {
+		return nil, errRaftCompactionRunning
+	}
+
+	config, err := raftBackend.GetConfiguration(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkVersions(raftBackend, config); err != nil {
+		return nil, err
+	}
+	servers := make(map[string]bool, len(config.Servers))
+	for _, server := range config.Servers {
+		servers[server.NodeID] = true
+	}
+	if len(nodeIDs) == 0 {
+		for _, server := range config.Servers {
+			nodeIDs = append(nodeIDs, server.NodeID)
+		}
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, err
+	}
+	compaction := &RaftCompaction{
+		ID:          id,
+		State:       RaftCompactionStateRunning,
+		StartTime:   time.Now().UTC(),
+		MaxLag:      maxLag,
+		NodeTimeout: nodeTimeout,
+	}
+
+	// The leader goes last, as it has to step down first
+	var leader *RaftCompactionNode
+	seen := make(map[string]bool, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		if !servers[nodeID] {
+			return nil, fmt.Errorf("node %q is not part of the cluster", nodeID)
+		}
+		if seen[nodeID] {
+			continue
+		}
+		seen[nodeID] = true
+
+		node := &RaftCompactionNode{
+			NodeID: nodeID,
+			State:  RaftCompactionNodeStatePending,
+		}
+		if nodeID == raftBackend.NodeID() {
+			leader = node
+			continue
+		}
+		compaction.Nodes = append(compaction.Nodes, node)
+	}
+	if leader != nil {
+		compaction.Nodes = append(compaction.Nodes, leader)
+	}
+
+	if err := r.core.saveRaftCompaction(r.ctx, compaction); err != nil {
+		return nil, err
+	}
+	r.logger.Info("starting compaction", "id", compaction.ID, "nodes", len(compaction.Nodes))
+
+	r.running = true
+	r.wg.Add(1)
+	go r.run(compaction)
+	return compaction, nil
+}
+
+// run compacts each node of the compaction in turn, stopping at the first
+// node that fails.
+func (r *raftCompactor) run(compaction *RaftCompaction) {
+	defer r.wg.Done()
+	defer func() {
+		r.l.Lock()
+		r.running = false
+		r.l.Unlock()
+	}()
+
+	for _, node := range compaction.Nodes {
+		if node.State == RaftCompactionNodeStateCompleted || node.State == RaftCompactionNodeStateSkipped {
+			continue
+		}
+
+		err := r.compactNode(compaction, node)
+		switch {
+		case err == nil:
+			continue
+		case errors.Is(err, errRaftCompactionSteppedDown):
+			return
+		case r.ctx.Err() != nil:
+			// The compaction is resumed by the next active node
+			r.logger.Info("compaction interrupted, it will be resumed by the next active node", "id", compaction.ID)
+			return
+		}
+
+		r.logger.Error("failed to compact node", "id", compaction.ID, "node_id", node.NodeID, "error", err)
+		now := time.Now().UTC()
+		node.State = RaftCompactionNodeStateFailed
+		node.Error = err.Error()
+		node.EndTime = now
+		compaction.State = RaftCompactionStateFailed
+		compaction.Error = fmt.Sprintf("failed to compact node %q: %s", node.NodeID, err)
+		compaction.EndTime = now
+		r.save(compaction)
+		return
+	}
+
+	r.logger.Info("compaction completed", "id", compaction.ID)
+	compaction.State = RaftCompactionStateCompleted
+	compaction.EndTime = time.Now().UTC()
+	r.save(compaction)
+}
+
+// compactNode compacts the database of a node. Followers compact when they
+// apply a request through raft, which is only issued once they're caught up
+// with the leader.
+func (r *raftCompactor) compactNode(compaction *RaftCompaction, node *RaftCompactionNode) error {
+	raftBackend := r.core.getRaftBackend()
+	if raftBackend == nil {
+		return errors.New("raft storage is not in use")
+	}
+
+	if node.NodeID == raftBackend.NodeID() {
+		return r.compactLeader(compaction, node, raftBackend)
+	}
+
+	if node.State == RaftCompactionNodeStatePending {
+		config, err := raftBackend.GetConfiguration(r.ctx)
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, server := range config.Servers {
+			found = found || server.NodeID == node.NodeID
+		}
+		if !found {
+			r.logger.Warn("skipping node that is no longer part of the cluster", "node_id", node.NodeID)
+			node.State = RaftCompactionNodeStateSkipped
+			node.Error = "node is no longer part of the cluster"
+			return r.save(compaction)
+		}
+
+		// Nodes may have joined or been replaced since the compaction
+		// started
+		if err := r.checkVersions(raftBackend, config); err != nil {
+			return err
+		}
+
+		// The node has to catch up after compacting, so it shouldn't start
+		// out behind
+		if err := r.waitForNode(compaction, node.NodeID, 0); err != nil {
+			return fmt.Errorf("node is not caught up with the leader: %w", err)
+		}
+
+		index, err := raftBackend.RequestCompaction(r.ctx, node.NodeID, compaction.ID)
+		if err != nil {
+			return fmt.Errorf("failed to request compaction: %w", err)
+		}
+		r.logger.Info("requested node compaction", "node_id", node.NodeID, "index", index)
+		node.State = RaftCompactionNodeStateCompacting
+		node.RequestIndex = index
+		node.StartTime = time.Now().UTC()
+		if err := r.save(compaction); err != nil {
+			return err
+		}
+	}
+
+	if err := r.waitForNode(compaction, node.NodeID, node.RequestIndex); err != nil {
+		return fmt.Errorf("node did not finish compacting: %w", err)
+	}
+	r.logger.Info("node compacted", "node_id", node.NodeID)
+	node.State = RaftCompactionNodeStateCompleted
+	node.EndTime = time.Now().UTC()
+	return r.save(compaction)
+}
+
+// compactLeader steps down so that the leader can be compacted by the next
+// active node, or compacts it in place if there are no other voters to take
+// over.
+func (r *raftCompactor) compactLeader(compaction *RaftCompaction, node *RaftCompactionNode, raftBackend *raft.RaftBackend) error {
+	config, err := raftBackend.GetConfiguration(r.ctx)
+	if err != nil {
+		return err
+	}
+	for _, server := range config.Servers {
+		if server.NodeID == node.NodeID || !server.Voter {
+			continue
+		}
+
+		r.logger.Info("stepping down so this node can be compacted", "node_id", node.NodeID)
+		if err := r.save(compaction); err != nil {
+			return err
+		}
+		select {
+		case r.stepDownCh <- struct{}{}:
+		default:
+		}
+		return errRaftCompactionSteppedDown
+	}
+
+	r.logger.Warn("compacting the leader in place, as there are no other voters; requests will be blocked until it's done", "node_id", node.NodeID)
+	node.State = RaftCompactionNodeStateCompacting
+	node.StartTime = time.Now().UTC()
+	if err := r.save(compaction); err != nil {
+		return err
+	}
+	if err := raftBackend.CompactLocal(compaction.ID); err != nil {
+		return err
+	}
+	node.State = RaftCompactionNodeStateCompleted
+	node.EndTime = time.Now().UTC()
+	return r.save(compaction)
+}
+
+// waitForNode waits until the node has applied the given index, and is
+// within the compaction's max lag of the leader.
+func (r *raftCompactor) waitForNode(compaction *RaftCompaction, nodeID string, index uint64) error {
+	raftBackend := r.core.getRaftBackend()
+	if raftBackend == nil {
+		return errors.New("raft storage is not in use")
+	}
+
+	timeout := time.NewTimer(compaction.NodeTimeout)
+	defer timeout.Stop()
+	ticker := time.NewTicker(raftCompactionPollInterval)
+	defer ticker.Stop()
+
+	for {
+		state, err := raftBackend.GetAutopilotServerState(r.ctx)
+		if err != nil {
+			return err
+		}
+		if state == nil {
+			return errors.New("autopilot is required to track the progress of followers")
+		}
+		server, leader := state.Servers[nodeID], state.Servers[state.Leader]
+		if server != nil && leader != nil && server.LastIndex >= index &&
+			(server.LastIndex >= leader.LastIndex || leader.LastIndex-server.LastIndex <= compaction.MaxLag) {
+			return nil
+		}
+
+		select {
+		case <-r.ctx.Done():
+			return r.ctx.Err()
+		case <-timeout.C:
+			if server == nil {
+				return errors.New("timed out waiting for the node's state")
+			}
+			return fmt.Errorf("timed out waiting for the node, which has applied index %d", server.LastIndex)
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkVersions returns an error unless every node in the raft configuration
+// is able to apply a request to compact a follower. Requests are applied by
+// every node, not just the one being compacted.
+func (r *raftCompactor) checkVersions(raftBackend *raft.RaftBackend, config *raft.RaftConfigurationResponse) error {
+	// A single node is compacted in place, without a request
+	if len(config.Servers) == 1 && config.Servers[0].NodeID == raftBackend.NodeID() {
+		return nil
+	}
+
+	state, err := raftBackend.GetAutopilotServerState(r.ctx)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return errors.New("autopilot is required to check the versions of the nodes")
+	}
+	return checkRaftCompactionVersions(config, state)
+}
+
+// checkRaftCompactionVersions checks the versions autopilot reports for the
+// servers in the raft configuration against raftCompactionMinimumVersion.
+func checkRaftCompactionVersions(config *raft.RaftConfigurationResponse, state *raft.AutopilotState) error {
+	return checkRaftNodeVersions(config, state, raftCompactionMinimumVersion, "compact followers")
+}
+
+// checkRaftNodeVersions checks the versions autopilot reports for the servers
+// in the raft configuration against minimumVersion, which every node must be
+// running to use the feature. Servers that haven't reported their version yet
+// are treated as too old.
+func checkRaftNodeVersions(config *raft.RaftConfigurationResponse, state *raft.AutopilotState, minimumVersion, feature string) error {
+	for _, server := range config.Servers {
+		var version string
+		if autopilotServer := state.Servers[server.NodeID]; autopilotServer != nil {
+			version = autopilotServer.Version
+		}
+		if err := checkNodeVersion(server.NodeID, version, minimumVersion, feature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkNodeVersion checks that the version a node reported is at least
+// minimumVersion, where an empty version means the node hasn't reported it.
+func checkNodeVersion(node, version, minimumVersion, feature string) error {
+	minimum, err := goversion.NewSemver(minimumVersion)
+	if err != nil {
+		return err
+	}
+	if version == "" {
+		return fmt.Errorf("node %q hasn't reported its version yet", node)
+	}
+	nodeVersion, err := goversion.NewSemver(version)
+	if err != nil {
+		return fmt.Errorf("failed to parse version %q of node %q: %w", version, node, err)
+	}
+	// Pre-releases of the minimum version are supported
+	if nodeVersion.Core().LessThan(minimum) {
+		return fmt.Errorf("node %q is running version %s, and every node must be running at least version %s to %s",
+			node, version, minimumVersion, feature)
+	}
+	return nil
+}
+
+func (r *raftCompactor) save(compaction *RaftCompaction) error {
+	if err := r.core.saveRaftCompaction(r.ctx, compaction); err != nil {
+		r.logger.Error("failed to save compaction", "id", compaction.ID, "error", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/vault/physical/raft"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckRaftCompactionVersions verifies that compaction requires every
+// node in the raft configuration to report a version that supports it.
+func TestCheckRaftCompactionVersions(t *testing.T) {
+	t.Parallel()
+
+	config := &raft.RaftConfigurationResponse{
+		Servers: []*raft.RaftServer{{NodeID: "node1"}, {NodeID: "node2"}},
+	}
+	for name, tc := range map[string]struct {
+		versions map[string]string
+		wantErr  string
+	}{
+		"supported": {
+			versions: map[string]string{"node1": "2.2.0", "node2": "2.3.1"},
+		},
+		"prerelease": {
+			versions: map[string]string{"node1": "2.2.0", "node2": "2.2.0-beta1"},
+		},
+		"old": {
+			versions: map[string]string{"node1": "2.2.0", "node2": "2.1.4"},
+			wantErr:  `node "node2" is running version 2.1.4`,
+		},
+		"not reported": {
+			versions: map[string]string{"node1": "2.2.0", "node2": ""},
+			wantErr:  `node "node2" hasn't reported its version yet`,
+		},
+		"unknown": {
+			versions: map[string]string{"node1": "2.2.0"},
+			wantErr:  `node "node2" hasn't reported its version yet`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := &raft.AutopilotState{Servers: map[string]*raft.AutopilotServer{}}
+			for id, version := range tc.versions {
+				state.Servers[id] = &raft.AutopilotServer{ID: id, Version: version}
+			}
+			err := checkRaftCompactionVersions(config, state)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/vault/helper/namespace"
+	"github.com/hashicorp/vault/sdk/logical"
+)
+
+const (
+	// readReplicaCheckInterval is how often a standby configured to serve
+	// stale reads checks whether it can start serving them.
+	readReplicaCheckInterval = time.Second
+
+	// maxReadReplicaPendingKeys is the number of keys applied to the FSM while
+	// the read replica is being set up that are kept to be invalidated once
+	// it's set up. If more keys are applied, it's set up again instead.
+	maxReadReplicaPendingKeys = 10000
+)
+
+// readReplicaTablePaths are the storage paths of the tables loaded when a read
+// replica is set up. When they change, the read replica is set up again.
+var readReplicaTablePaths = []string{
+	coreMountConfigPath,
+	coreLocalMountConfigPath,
+	coreAuthConfigPath,
+	coreLocalAuthConfigPath,
+	coreAuditConfigPath,
+	coreLocalAuditConfigPath,
+}
+
+type readReplicaState int
+
+const (
+	readReplicaStopped readReplicaState = iota
+	readReplicaStarting
+	readReplicaRunning
+)
+
+// readReplica tracks a raft standby that serves reads which tolerate some
+// staleness from its own FSM. While it's running, the standby has its mounts,
+// policies and tokens set up read-only like a performance standby, and keeps
+// them up to date from the keys applied to its FSM.
+type readReplica struct {
+	// lifecycleLock serializes setting up and tearing down the replica
+	lifecycleLock sync.Mutex
+
+	// l protects the fields below, and is held while invalidating
+	l     sync.Mutex
+	state readReplicaState
+	// paused is set while the node is becoming active
+	paused bool
+	// pending holds the keys applied while the replica is being set up
+	pending []string
+	// reload is set when the replica must be set up again, such as when the
+	// mount tables change. Reads aren't served until then.
+	reload   bool
+	reloadCh chan struct{}
+
+	// active is set while the replica is being set up or running, and serving
+	// while it's running and doesn't need to be set up again
+	active  atomic.Bool
+	serving atomic.Bool
+}
+
+func newReadReplica() *readReplica {
+	return &readReplica{
+		reloadCh: make(chan struct{}, 1),
+	}
+}
+
+// setState must be called with l held.
+func (r *readReplica) setState(state readReplicaState) {
+	r.state = state
+	r.active.Store(state != readReplicaStopped)
+	r.serving.Store(state == readReplicaRunning && !r.reload)
+}
+
+// requestReload marks the replica to be set up again. It must be called with l
+// held.
+func (r *readReplica) requestReload() {
+	r.reload = true
+	r.pending = nil
+	r.serving.Store(false)
+	select {
+	case r.reloadCh <- struct{}{}:
+	default:
+	}
+}
+
+// serveStaleReads returns whether this node is configured to serve stale reads
+// while it's a standby.
+func (c *Core) serveStaleReads() bool {
+	raftBackend := c.getRaftBackend()
+	return raftBackend != nil && raftBackend.ServeStaleReads()
+}
+
+// RaftReadReplica returns whether this standby is set up to serve reads that
+// tolerate some staleness from its raft FSM. It forwards all other requests to
+// the active node.
+func (c *Core) RaftReadReplica() bool {
+	return c.readReplica.active.Load()
+}
+
+// runReadReplica is a long running routine used by standbys configured with
+// serve_stale_reads. It sets up the read replica once the local FSM is known to
+// be caught up with the active node, sets it up again when asked to, and tears
+// it down when readReplicaStop is closed. stopCh is the standby's stop channel,
+// which is closed by a caller sealing the node while holding the state lock.
+func (c *Core) runReadReplica(readReplicaStop, stopCh chan struct{}) {
+	c.logger.Info("serving stale reads once caught up with the active node")
+
+	ticker := time.NewTicker(readReplicaCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-readReplicaStop:
+			c.stopReadReplica(stopCh)
+			return
+		case <-c.readReplica.reloadCh:
+			c.logger.Debug("setting up read replica again")
+			c.stopReadReplica(stopCh)
+		case <-ticker.C:
+		}
+
+		if _, ok := c.RaftReadStaleness(); ok {
+			c.startReadReplica(stopCh)
+		}
+	}
+}
+
+// pauseReadReplica tears down the read replica and keeps it from being set up
+// until resumeReadReplica is called. It's called when the node is about to
+// become active.
+func (c *Core) pauseReadReplica(stopCh chan struct{}) {
+	c.readReplica.l.Lock()
+	c.readReplica.paused = true
+	c.readReplica.l.Unlock()
+
+	c.stopReadReplica(stopCh)
+}
+
+// resumeReadReplica allows the read replica to be set up again once the node
+// is a standby.
+func (c *Core) resumeReadReplica() {
+	c.readReplica.l.Lock()
+	c.readReplica.paused = false
+	c.readReplica.l.Unlock()
+}
+
+// startReadReplica sets up the read replica, if this node is a standby and the
+// replica isn't already set up or paused.
+func (c *Core) startReadReplica(stopCh chan struct{}) {
+	r := c.readReplica
+	r.lifecycleLock.Lock()
+	defer r.lifecycleLock.Unlock()
+
+	r.l.Lock()
+	if r.paused || r.state != readReplicaStopped {
+		r.l.Unlock()
+		return
+	}
+	r.l.Unlock()
+
+	l := newLockGrabber(c.stateLock.Lock, c.stateLock.Unlock, stopCh)
+	go l.grab()
+	if stopped := l.lockOrStop(); stopped {
+		return
+	}
+	defer c.stateLock.Unlock()
+
+	raftBackend := c.getRaftBackend()
+	if c.Sealed() || !c.standby || c.perfStandby || raftBackend == nil {
+		return
+	}
+
+	c.logger.Info("setting up read replica")
+
+	r.l.Lock()
+	r.reload = false
+	r.pending = nil
+	r.setState(readReplicaStarting)
+	r.l.Unlock()
+
+	// Keys applied from here on are invalidated once the replica is set up
+	raftBackend.SetFSMInvalidateCallback(c.invalidateReadReplica)
+
+	ctx, cancel := context.WithCancel(namespace.RootContext(nil))
+	c.perfStandby = true
+	c.activeContext = ctx
+	c.activeContextCancelFunc.Store(context.CancelFunc(cancel))
+
+	if err := runUnsealSetupFunctions(ctx, c.readReplicaSetupFunctions()); err != nil {
+		c.logger.Error("failed to set up read replica", "error", err)
+		c.teardownReadReplica()
+		return
+	}
+
+	r.l.Lock()
+	defer r.l.Unlock()
+	if !r.reload {
+		c.invalidateReadReplicaKeys(ctx, r.pending)
+	}
+	r.pending = nil
+	r.setState(readReplicaRunning)
+
+	c.logger.Info("read replica set up")
+}
+
+// stopReadReplica tears down the read replica, if it's set up. If stopCh is
+// closed before the state lock is grabbed, the node is being sealed by a
+// caller that holds the lock, and the replica is torn down without it.
+func (c *Core) stopReadReplica(stopCh chan struct{}) {
+	r := c.readReplica
+	r.lifecycleLock.Lock()
+	defer r.lifecycleLock.Unlock()
+
+	r.l.Lock()
+	if r.state == readReplicaStopped {
+		r.l.Unlock()
+		return
+	}
+	// The replica stays active until it's torn down, so that requests keep
+	// being forwarded
+	r.state = readReplicaStopped
+	r.pending = nil
+	r.serving.Store(false)
+	r.l.Unlock()
+
+	l := newLockGrabber(c.stateLock.Lock, c.stateLock.Unlock, stopCh)
+	go l.grab()
+	stopped := l.lockOrStop()
+
+	c.logger.Info("tearing down read replica")
+	c.teardownReadReplica()
+
+	if !stopped {
+		c.stateLock.Unlock()
+	}
+}
+
+// teardownReadReplica must be called with the state lock held, or by a caller
+// sealing the node.
+func (c *Core) teardownReadReplica() {
+	if raftBackend := c.getRaftBackend(); raftBackend != nil {
+		raftBackend.SetFSMInvalidateCallback(nil)
+	}
+
+	if cancel, ok := c.activeContextCancelFunc.Load().(context.CancelFunc); ok && cancel != nil {
+		cancel()
+	}
+	c.activeContextCancelFunc.Store((context.CancelFunc)(nil))
+
+	if err := c.preSeal(); err != nil {
+		c.logger.Error("read replica teardown failed", "error", err)
+	}
+	c.perfStandby = false
+
+	c.readReplica.l.Lock()
+	c.readReplica.setState(readReplicaStopped)
+	c.readReplica.l.Unlock()
+}
+
+// readReplicaSetupFunctions returns the functions that set up a read replica.
+// They're a subset of the ones run when a node becomes active, and rely on
+// perfStandby being set to not write to storage. Leases are looked up but not
+// restored, so that a read replica never revokes or renews them.
+func (c *Core) readReplicaSetupFunctions() []func(context.Context) error {
+	return []func(context.Context) error{
+		c.setupPluginRuntimeCatalog,
+		c.setupPluginCatalog,
+		c.loadMounts,
+		c.setupMounts,
+		c.setupPolicyStore,
+		c.loadCredentials,
+		c.setupCredentials,
+		c.setupReadReplicaExpiration,
+		c.loadAudits,
+		c.setupAuditedHeadersConfig,
+		c.setupAudits,
+		func(ctx context.Context) error {
+			if c.identityStore == nil {
+				return nil
+			}
+			return c.identityStore.loadArtifacts(ctx, false)
+		},
+	}
+}
+
+// setupReadReplicaExpiration sets up an expiration manager that only looks up
+// leases, without restoring them.
+func (c *Core) setupReadReplicaExpiration(_ context.Context) error {
+	c.metricsMutex.Lock()
+	defer c.metricsMutex.Unlock()
+
+	view := c.systemBarrierView.SubView(expirationSubPath)
+	expLogger := c.baseLogger.Named("expiration")
+	c.AddLogger(expLogger)
+
+	mgr := NewExpirationManager(c, view, expireLeaseStrategyFairsharing, expLogger, false)
+	atomic.StoreInt32(mgr.restoreMode, 0)
+	c.expiration = mgr
+	c.tokenStore.SetExpirationManager(mgr)
+	return nil
+}
+
+// invalidateReadReplica is called with the keys applied to the raft FSM while
+// the read replica is set up, before they are reported as applied.
+func (c *Core) invalidateReadReplica(keys []string) {
+	r := c.readReplica
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	switch {
+	case r.state == readReplicaStopped || r.reload:
+		return
+	case keys == nil:
+		// A snapshot was installed
+		r.requestReload()
+	case r.state == readReplicaStarting:
+		if len(r.pending)+len(keys) > maxReadReplicaPendingKeys {
+			r.requestReload()
+			return
+		}
+		r.pending = append(r.pending, keys...)
+	default:
+		c.invalidateReadReplicaKeys(namespace.RootContext(nil), keys)
+	}
+}
+
+// invalidateReadReplicaKeys drops anything the read replica loaded from the
+// given keys. It must be called with the read replica's lock held.
+func (c *Core) invalidateReadReplicaKeys(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		for _, tablePath := range readReplicaTablePaths {
+			if key == tablePath || strings.HasPrefix(key, tablePath+"/") {
+				c.readReplica.requestReload()
+				return
+			}
+		}
+
+		if name, ok := strings.CutPrefix(key, systemBarrierPrefix+policyACLSubPath); ok {
+			if c.policyStore != nil {
+				c.policyStore.invalidate(ctx, name, PolicyTypeACL)
+			}
+			continue
+		}
+
+		c.router.invalidateStoragePath(ctx, key)
+	}
+}
+
+// readReplicaServes returns whether a read replica serves the request locally,
+// which it does for reads of kv mounts.
+func readReplicaServes(req *logical.Request, entry *MountEntry) bool {
+	if entry == nil || entry.Type != mountTypeKV {
+		return false
+	}
+	switch req.Operation {
+	case logical.ReadOperation, logical.ListOperation:
+		return true
+	default:
+		return false
+	}
+}
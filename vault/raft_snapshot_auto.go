@@ -0,0 +1,422 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/hashicorp/vault/vault/snapshots"
+)
+
+const (
+	raftSnapshotAutoConfigStoragePrefix = "core/raft/snapshot-auto/config/"
+	raftSnapshotAutoStatusStoragePrefix = "core/raft/snapshot-auto/status/"
+
+	raftSnapshotAutoDefaultFilePrefix = "vault-snapshot"
+	raftSnapshotAutoDefaultRetain     = 1
+)
+
+// RaftSnapshotAutoConfig configures a schedule of raft snapshots taken by the
+// active node, and where they are stored.
+type RaftSnapshotAutoConfig struct {
+	Name     string        `json:"name"`
+	Interval time.Duration `json:"interval"`
+	// Retain is the number of snapshots kept in the target; older ones are
+	// deleted after each snapshot.
+	Retain      int    `json:"retain"`
+	StorageType string `json:"storage_type"`
+	// PathPrefix is the directory snapshots are written to for local
+	// storage, or the prefix of their object keys for S3 storage.
+	PathPrefix  string `json:"path_prefix"`
+	FilePrefix  string `json:"file_prefix"`
+	Compression string `json:"compression"`
+
+	AWSS3Bucket         string `json:"aws_s3_bucket,omitempty"`
+	AWSS3Region         string `json:"aws_s3_region,omitempty"`
+	AWSS3Endpoint       string `json:"aws_s3_endpoint,omitempty"`
+	AWSS3DisableTLS     bool   `json:"aws_s3_disable_tls,omitempty"`
+	AWSS3ForcePathStyle bool   `json:"aws_s3_force_path_style,omitempty"`
+	AWSS3KMSKey         string `json:"aws_s3_kms_key,omitempty"`
+	AWSAccessKeyID      string `json:"aws_access_key_id,omitempty"`
+	AWSSecretAccessKey  string `json:"aws_secret_access_key,omitempty"`
+	AWSSessionToken     string `json:"aws_session_token,omitempty"`
+}
+
+// validate checks the configuration, filling in defaults.
+func (c *RaftSnapshotAutoConfig) validate() error {
+	if c.Interval <= 0 {
+		return errors.New("interval must be greater than zero")
+	}
+	if c.Retain < 1 {
+		return errors.New("retain must be at least 1")
+	}
+	if c.FilePrefix == "" {
+		c.FilePrefix = raftSnapshotAutoDefaultFilePrefix
+	}
+	if strings.ContainsAny(c.FilePrefix, `/\`) || strings.HasPrefix(c.FilePrefix, ".") {
+		return fmt.Errorf("invalid file_prefix %q", c.FilePrefix)
+	}
+	if c.Compression == "" {
+		c.Compression = snapshots.CompressionGzip
+	}
+	if !snapshots.ValidCompression(c.Compression) {
+		return fmt.Errorf("unsupported compression %q, must be %q or %q", c.Compression, snapshots.CompressionGzip, snapshots.CompressionNone)
+	}
+
+	switch c.StorageType {
+	case snapshots.TargetTypeLocal:
+		if c.PathPrefix == "" {
+			return errors.New("path_prefix is required for local storage")
+		}
+	case snapshots.TargetTypeS3:
+		if c.AWSS3Bucket == "" {
+			return errors.New("aws_s3_bucket is required for aws-s3 storage")
+		}
+	case "":
+		return errors.New("storage_type is required")
+	default:
+		return fmt.Errorf("unsupported storage_type %q, must be %q or %q", c.StorageType, snapshots.TargetTypeLocal, snapshots.TargetTypeS3)
+	}
+	return nil
+}
+
+// target returns the target that snapshots are stored in.
+func (c *RaftSnapshotAutoConfig) target(ctx context.Context, logger hclog.Logger) (snapshots.Target, error) {
+	switch c.StorageType {
+	case snapshots.TargetTypeLocal:
+		return snapshots.NewLocalTarget(c.PathPrefix)
+	case snapshots.TargetTypeS3:
+		return snapshots.NewS3Target(ctx, &snapshots.S3TargetConfig{
+			Bucket:          c.AWSS3Bucket,
+			KeyPrefix:       c.PathPrefix,
+			Region:          c.AWSS3Region,
+			Endpoint:        c.AWSS3Endpoint,
+			ForcePathStyle:  c.AWSS3ForcePathStyle,
+			DisableTLS:      c.AWSS3DisableTLS,
+			AccessKeyID:     c.AWSAccessKeyID,
+			SecretAccessKey: c.AWSSecretAccessKey,
+			SessionToken:    c.AWSSessionToken,
+			KMSKeyID:        c.AWSS3KMSKey,
+			Logger:          logger,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported storage_type %q", c.StorageType)
+	}
+}
+
+// RaftSnapshotAutoStatus is the status of a snapshot schedule. It's persisted
+// so that the schedule survives leadership changes.
+type RaftSnapshotAutoStatus struct {
+	ConsecutiveErrors int       `json:"consecutive_errors"`
+	LastSnapshotStart time.Time `json:"last_snapshot_start"`
+	LastSnapshotEnd   time.Time `json:"last_snapshot_end"`
+	LastSnapshotError string    `json:"last_snapshot_error,omitempty"`
+	LastSnapshotURL   string    `json:"last_snapshot_url,omitempty"`
+	LastSnapshotSize  int64     `json:"last_snapshot_size"`
+	RetainedSnapshots int       `json:"retained_snapshots"`
+}
+
+// nextSnapshotStart returns when the next snapshot of the schedule is due.
+func (s *RaftSnapshotAutoStatus) nextSnapshotStart(interval time.Duration) time.Time {
+	if s == nil || s.LastSnapshotStart.IsZero() {
+		return time.Now()
+	}
+	return s.LastSnapshotStart.Add(interval)
+}
+
+func (c *Core) loadRaftSnapshotAutoConfig(ctx context.Context, name string) (*RaftSnapshotAutoConfig, error) {
+	entry, err := c.barrier.Get(ctx, raftSnapshotAutoConfigStoragePrefix+name)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	var config RaftSnapshotAutoConfig
+	if err := entry.DecodeJSON(&config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+func (c *Core) saveRaftSnapshotAutoConfig(ctx context.Context, config *RaftSnapshotAutoConfig) error {
+	entry, err := logical.StorageEntryJSON(raftSnapshotAutoConfigStoragePrefix+config.Name, config)
+	if err != nil {
+		return err
+	}
+	return c.barrier.Put(ctx, entry)
+}
+
+func (c *Core) deleteRaftSnapshotAutoConfig(ctx context.Context, name string) error {
+	if err := c.barrier.Delete(ctx, raftSnapshotAutoConfigStoragePrefix+name); err != nil {
+		return err
+	}
+	return c.barrier.Delete(ctx, raftSnapshotAutoStatusStoragePrefix+name)
+}
+
+func (c *Core) listRaftSnapshotAutoConfigs(ctx context.Context) ([]string, error) {
+	names, err := c.barrier.List(ctx, raftSnapshotAutoConfigStoragePrefix)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (c *Core) loadRaftSnapshotAutoStatus(ctx context.Context, name string) (*RaftSnapshotAutoStatus, error) {
+	entry, err := c.barrier.Get(ctx, raftSnapshotAutoStatusStoragePrefix+name)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	var status RaftSnapshotAutoStatus
+	if err := entry.DecodeJSON(&status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+func (c *Core) saveRaftSnapshotAutoStatus(ctx context.Context, name string, status *RaftSnapshotAutoStatus) error {
+	entry, err := logical.StorageEntryJSON(raftSnapshotAutoStatusStoragePrefix+name, status)
+	if err != nil {
+		return err
+	}
+	return c.barrier.Put(ctx, entry)
+}
+
+// raftSnapshotScheduler takes the snapshots configured under
+// sys/storage/raft/snapshot-auto on the active node, each schedule in its own
+// goroutine.
+type raftSnapshotScheduler struct {
+	core   *Core
+	logger hclog.Logger
+	ctx    context.Context
+
+	l    sync.Mutex
+	jobs map[string]*raftSnapshotJob
+	wg   sync.WaitGroup
+}
+
+type raftSnapshotJob struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// startRaftSnapshotScheduler starts every configured snapshot schedule. The
+// schedules run until stopRaftSnapshotScheduler is called or the active
+// context is done. Schedules configured later are started by the scheduler
+// even if loading the existing ones failed.
+func (c *Core) startRaftSnapshotScheduler(ctx context.Context) error {
+	s := &raftSnapshotScheduler{
+		core:   c,
+		logger: c.logger.Named("snapshot-auto"),
+		ctx:    ctx,
+		jobs:   make(map[string]*raftSnapshotJob),
+	}
+	c.raftSnapshotScheduler = s
+
+	names, err := c.listRaftSnapshotAutoConfigs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list automated snapshot configurations: %w", err)
+	}
+	for _, name := range names {
+		config, err := c.loadRaftSnapshotAutoConfig(ctx, name)
+		if err != nil {
+			return fmt.Errorf("failed to load automated snapshot configuration %q: %w", name, err)
+		}
+		if config != nil {
+			s.update(config)
+		}
+	}
+	return nil
+}
+
+func (c *Core) stopRaftSnapshotScheduler() {
+	if c.raftSnapshotScheduler == nil {
+		return
+	}
+	c.raftSnapshotScheduler.stop()
+	c.raftSnapshotScheduler = nil
+}
+
+// update starts the schedule with the given configuration, replacing any
+// running schedule of the same name.
+func (s *raftSnapshotScheduler) update(config *RaftSnapshotAutoConfig) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	s.removeLocked(config.Name)
+	if s.ctx.Err() != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	job := &raftSnapshotJob{cancel: cancel, done: make(chan struct{})}
+	s.jobs[config.Name] = job
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(job.done)
+		s.run(ctx, config)
+	}()
+}
+
+// remove stops the schedule with the given name, if it's running.
+func (s *raftSnapshotScheduler) remove(name string) {
+	s.l.Lock()
+	defer s.l.Unlock()
+
+	s.removeLocked(name)
+}
+
+func (s *raftSnapshotScheduler) removeLocked(name string) {
+	job, ok := s.jobs[name]
+	if !ok {
+		return
+	}
+	job.cancel()
+	<-job.done
+	delete(s.jobs, name)
+}
+
+func (s *raftSnapshotScheduler) stop() {
+	s.l.Lock()
+	for name := range s.jobs {
+		s.jobs[name].cancel()
+	}
+	s.jobs = make(map[string]*raftSnapshotJob)
+	s.l.Unlock()
+
+	s.wg.Wait()
+}
+
+// run takes snapshots at the configured interval until the context is done.
+// The first snapshot is due one interval after the last one recorded in the
+// status, so leadership changes don't reset the schedule.
+func (s *raftSnapshotScheduler) run(ctx context.Context, config *RaftSnapshotAutoConfig) {
+	logger := s.logger.With("name", config.Name)
+	logger.Debug("starting automated snapshots", "interval", config.Interval)
+	defer logger.Debug("stopped automated snapshots")
+
+	status, err := s.core.loadRaftSnapshotAutoStatus(ctx, config.Name)
+	if err != nil {
+		logger.Error("failed to load automated snapshot status", "error", err)
+	}
+	if status == nil {
+		status = &RaftSnapshotAutoStatus{}
+	}
+
+	for {
+		timer := time.NewTimer(time.Until(status.nextSnapshotStart(config.Interval)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		status.LastSnapshotStart = time.Now().UTC()
+		url, size, retained, err := s.takeSnapshot(ctx, logger, config, status.LastSnapshotStart)
+		status.LastSnapshotEnd = time.Now().UTC()
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Error("failed to take automated snapshot", "error", err)
+			status.ConsecutiveErrors++
+			status.LastSnapshotError = err.Error()
+		} else {
+			logger.Info("took automated snapshot", "url", url, "size", size, "duration", status.LastSnapshotEnd.Sub(status.LastSnapshotStart))
+			status.ConsecutiveErrors = 0
+			status.LastSnapshotError = ""
+			status.LastSnapshotURL = url
+			status.LastSnapshotSize = size
+			status.RetainedSnapshots = retained
+		}
+
+		if err := s.core.saveRaftSnapshotAutoStatus(ctx, config.Name, status); err != nil && ctx.Err() == nil {
+			logger.Error("failed to save automated snapshot status", "error", err)
+		}
+	}
+}
+
+// takeSnapshot writes a snapshot to the configured target, then deletes the
+// oldest snapshots beyond the retention count. It returns the location and
+// size of the snapshot and the number of snapshots retained.
+func (s *raftSnapshotScheduler) takeSnapshot(ctx context.Context, logger hclog.Logger, config *RaftSnapshotAutoConfig, start time.Time) (string, int64, int, error) {
+	raftBackend := s.core.getRaftBackend()
+	if raftBackend == nil {
+		return "", 0, 0, errors.New("raft storage is not in use")
+	}
+
+	target, err := config.target(ctx, logger)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("failed to set up snapshot storage: %w", err)
+	}
+
+	// Spool the snapshot to a temporary file first, so that only complete
+	// snapshots are stored in the target
+	f, err := os.CreateTemp("", "vault-snapshot-auto-*")
+	if err != nil {
+		return "", 0, 0, err
+	}
+	defer func() {
+		f.Close()
+		os.Remove(f.Name())
+	}()
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(raftBackend.Snapshot(pw, NewSealAccessSealer(s.core.seal.GetAccess(), logger, "snapshot_auto")))
+	}()
+	r, err := snapshots.Decompress(pr, config.Compression)
+	if err == nil {
+		_, err = io.Copy(f, r)
+	}
+	pr.CloseWithError(err)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("failed to take snapshot: %w", err)
+	}
+
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", 0, 0, err
+	}
+
+	name := snapshots.SnapshotName(config.FilePrefix, start, config.Compression)
+	if err := target.Put(ctx, name, f); err != nil {
+		return "", 0, 0, fmt.Errorf("failed to store snapshot: %w", err)
+	}
+
+	stored, err := snapshots.ListSnapshots(ctx, target, config.FilePrefix)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("failed to list snapshots for retention: %w", err)
+	}
+	for len(stored) > config.Retain {
+		if err := target.Delete(ctx, stored[0].Name); err != nil {
+			return "", 0, 0, fmt.Errorf("failed to delete snapshot %q for retention: %w", stored[0].Name, err)
+		}
+		logger.Debug("deleted automated snapshot", "url", target.Location(stored[0].Name))
+		stored = stored[1:]
+	}
+
+	return target.Location(name), size, len(stored), nil
+}
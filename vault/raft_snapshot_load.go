@@ -0,0 +1,301 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/vault/physical/raft"
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/hashicorp/vault/vault/snapshots"
+)
+
+const (
+	loadedSnapshotStateLoading = "loading"
+	loadedSnapshotStateLoaded  = "loaded"
+	loadedSnapshotStateError   = "error"
+)
+
+var (
+	errSnapshotAlreadyLoaded = errors.New("a snapshot is already loaded, it must be unloaded first")
+	errSnapshotNotFound      = errors.New("snapshot not found")
+	errSnapshotNotReady      = errors.New("snapshot has not finished loading")
+)
+
+// loadedSnapshot is a raft snapshot loaded into a separate, read-only FSM on
+// the active node. Its data is decrypted with the live keyring, so it can be
+// read through logical paths alongside the live data.
+type loadedSnapshot struct {
+	ID       string
+	Source   string
+	LoadedAt time.Time
+	Index    uint64
+	Term     uint64
+
+	// State and Error are protected by the snapshot manager's lock
+	State string
+	Error string
+
+	dir     string
+	fsm     *raft.FSM
+	barrier *AESGCMBarrier
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	// inUse is read locked by requests using the snapshot, so it isn't
+	// unloaded while they're in flight.
+	inUse sync.RWMutex
+}
+
+// snapshotManager tracks the raft snapshot loaded on this node. Only one
+// snapshot can be loaded at a time.
+type snapshotManager struct {
+	core   *Core
+	logger hclog.Logger
+
+	l        sync.RWMutex
+	snapshot *loadedSnapshot
+}
+
+var _ logical.SnapshotStorageProvider = (*snapshotManager)(nil)
+
+func newSnapshotManager(c *Core) *snapshotManager {
+	return &snapshotManager{
+		core:   c,
+		logger: c.logger.Named("snapshot-load"),
+	}
+}
+
+// load reads the snapshot from the source and starts loading it in the
+// background. The snapshot is validated against the seal before this
+// returns, and can be used once its state is loaded.
+func (m *snapshotManager) load(ctx context.Context, source snapshots.Source) (*loadedSnapshot, error) {
+	raftBackend := m.core.getRaftBackend()
+	if raftBackend == nil {
+		return nil, errors.New("raft storage is not in use")
+	}
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	snap := &loadedSnapshot{
+		ID:       id,
+		Source:   source.Type(ctx),
+		LoadedAt: time.Now().UTC(),
+		State:    loadedSnapshotStateLoading,
+		done:     make(chan struct{}),
+	}
+
+	// Reserve the slot while the snapshot is read, which can take a while
+	m.l.Lock()
+	if m.snapshot != nil {
+		m.l.Unlock()
+		return nil, errSnapshotAlreadyLoaded
+	}
+	m.snapshot = snap
+	m.l.Unlock()
+
+	release := func() {
+		m.l.Lock()
+		if m.snapshot == snap {
+			m.snapshot = nil
+		}
+		m.l.Unlock()
+	}
+
+	keyring, err := m.core.barrier.Keyring()
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("failed to read keyring: %w", err)
+	}
+
+	r, err := source.ReadCloser(ctx)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	sealer := NewSealAccessSealer(m.core.seal.GetAccess(), m.logger, "snapshot_load")
+	snapFile, cleanup, metadata, err := raftBackend.WriteSnapshotToTemp(r, sealer)
+	r.Close()
+	if err != nil {
+		release()
+		return nil, err
+	}
+	snap.Index = metadata.Index
+	snap.Term = metadata.Term
+
+	loadCtx, cancel := context.WithCancel(context.Background())
+	snap.cancel = cancel
+
+	go func() {
+		defer close(snap.done)
+		defer cleanup()
+
+		err := m.loadFSM(loadCtx, snap, snapFile, keyring)
+
+		m.l.Lock()
+		defer m.l.Unlock()
+		if err != nil {
+			m.logger.Error("failed to load snapshot", "snapshot_id", snap.ID, "error", err)
+			snap.State = loadedSnapshotStateError
+			snap.Error = err.Error()
+			return
+		}
+		m.logger.Info("loaded snapshot", "snapshot_id", snap.ID, "index", snap.Index, "term", snap.Term)
+		snap.State = loadedSnapshotStateLoaded
+	}()
+
+	return snap, nil
+}
+
+// loadFSM writes the snapshot data into a new FSM in a temporary directory
+// and sets up a barrier over it.
+func (m *snapshotManager) loadFSM(ctx context.Context, snap *loadedSnapshot, snapFile *os.File, keyring *Keyring) error {
+	dir, err := os.MkdirTemp("", "vault-snapshot-load-")
+	if err != nil {
+		return err
+	}
+	snap.dir = dir
+
+	fsm, err := raft.NewFSM(dir, snap.ID, m.logger)
+	if err != nil {
+		return err
+	}
+	snap.fsm = fsm
+
+	if err := raft.LoadReadOnlySnapshot(ctx, fsm, snapFile, nil, m.logger); err != nil {
+		return err
+	}
+
+	barrier, err := NewAESGCMBarrier(fsm, false)
+	if err != nil {
+		return err
+	}
+	// Snapshots of this cluster are encrypted with terms of the live keyring,
+	// which are never removed.
+	barrier.keyring = keyring
+	barrier.sealed = false
+	snap.barrier = barrier
+	return nil
+}
+
+// get returns the loaded snapshot with the given ID.
+func (m *snapshotManager) get(id string) (*loadedSnapshot, error) {
+	m.l.RLock()
+	defer m.l.RUnlock()
+
+	if m.snapshot == nil || m.snapshot.ID != id {
+		return nil, errSnapshotNotFound
+	}
+	return m.snapshot, nil
+}
+
+// list returns the loaded snapshot, if any.
+func (m *snapshotManager) list() []*loadedSnapshot {
+	m.l.RLock()
+	defer m.l.RUnlock()
+
+	if m.snapshot == nil {
+		return nil
+	}
+	return []*loadedSnapshot{m.snapshot}
+}
+
+// status returns the state of the snapshot and the error it failed to load
+// with, if any.
+func (m *snapshotManager) status(snap *loadedSnapshot) (string, string) {
+	m.l.RLock()
+	defer m.l.RUnlock()
+	return snap.State, snap.Error
+}
+
+// lock prevents the snapshot with the given ID from being unloaded until the
+// returned function is called. The snapshot must have finished loading.
+func (m *snapshotManager) lock(id string) (func(), error) {
+	m.l.RLock()
+	defer m.l.RUnlock()
+
+	snap := m.snapshot
+	if snap == nil || snap.ID != id {
+		return nil, errSnapshotNotFound
+	}
+	if snap.State != loadedSnapshotStateLoaded {
+		return nil, errSnapshotNotReady
+	}
+	snap.inUse.RLock()
+	return snap.inUse.RUnlock, nil
+}
+
+// SnapshotStorage returns the storage of the loaded snapshot with the given
+// ID, which callers are expected to have locked.
+func (m *snapshotManager) SnapshotStorage(_ context.Context, id string) (logical.Storage, error) {
+	m.l.RLock()
+	defer m.l.RUnlock()
+
+	snap := m.snapshot
+	if snap == nil || snap.ID != id {
+		return nil, errSnapshotNotFound
+	}
+	if snap.State != loadedSnapshotStateLoaded {
+		return nil, errSnapshotNotReady
+	}
+	return snap.barrier, nil
+}
+
+// unload removes the snapshot with the given ID. Snapshots that are still
+// loading are only unloaded when forced, which also doesn't wait for
+// requests using the snapshot to finish.
+func (m *snapshotManager) unload(id string, force bool) error {
+	m.l.Lock()
+	snap := m.snapshot
+	if snap == nil || snap.ID != id {
+		m.l.Unlock()
+		return errSnapshotNotFound
+	}
+	if snap.State == loadedSnapshotStateLoading && !force {
+		m.l.Unlock()
+		return errors.New("snapshot is still loading, use force to unload it")
+	}
+	m.snapshot = nil
+	m.l.Unlock()
+
+	snap.cancel()
+	<-snap.done
+	if !force {
+		snap.inUse.Lock()
+		defer snap.inUse.Unlock()
+	}
+
+	var retErr error
+	if snap.fsm != nil {
+		if err := snap.fsm.Close(); err != nil {
+			retErr = fmt.Errorf("failed to close snapshot storage: %w", err)
+		}
+	}
+	if snap.dir != "" {
+		if err := os.RemoveAll(snap.dir); err != nil && retErr == nil {
+			retErr = fmt.Errorf("failed to remove snapshot storage: %w", err)
+		}
+	}
+	m.logger.Info("unloaded snapshot", "snapshot_id", snap.ID)
+	return retErr
+}
+
+// unloadAll forcibly unloads any loaded snapshot, as they're only available
+// on the active node.
+func (m *snapshotManager) unloadAll() {
+	for _, snap := range m.list() {
+		if err := m.unload(snap.ID, true); err != nil {
+			m.logger.Error("failed to unload snapshot", "snapshot_id", snap.ID, "error", err)
+		}
+	}
+}
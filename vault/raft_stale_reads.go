@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"sync"
+	"time"
+)
+
+// maxStaleReadSamples is the number of echo samples kept to bound the
+// staleness of local reads. Echoes are sent every few seconds, so this covers
+// a follower that is up to about a minute behind the active node.
+const maxStaleReadSamples = 32
+
+// staleReadSample records the active node's applied raft index as reported in
+// the reply to an echo sent at sentAt.
+type staleReadSample struct {
+	leaderIndex uint64
+	sentAt      time.Time
+}
+
+// staleReadTracker bounds how far behind the active node the local raft FSM
+// is, using the applied indexes reported by the active node in echo replies.
+// The active node handles an echo after it was sent, so once the local FSM has
+// applied the index in the reply, it has every write the active node had
+// applied when the echo was sent.
+type staleReadTracker struct {
+	l       sync.Mutex
+	samples []staleReadSample
+}
+
+// record adds a sample from an echo reply. Samples are expected in the order
+// their echoes were sent.
+func (t *staleReadTracker) record(leaderIndex uint64, sentAt time.Time) {
+	t.l.Lock()
+	defer t.l.Unlock()
+
+	if n := len(t.samples); n > 0 && !sentAt.After(t.samples[n-1].sentAt) {
+		return
+	}
+	t.samples = append(t.samples, staleReadSample{leaderIndex: leaderIndex, sentAt: sentAt})
+	if len(t.samples) > maxStaleReadSamples {
+		t.samples = t.samples[len(t.samples)-maxStaleReadSamples:]
+	}
+}
+
+// staleness returns how stale a read served at now from a FSM that has
+// applied appliedIndex may be at most. It returns false if no sample has been
+// applied yet, in which case the staleness is unknown.
+func (t *staleReadTracker) staleness(appliedIndex uint64, now time.Time) (time.Duration, bool) {
+	t.l.Lock()
+	defer t.l.Unlock()
+
+	for i := len(t.samples) - 1; i >= 0; i-- {
+		sample := t.samples[i]
+		if sample.leaderIndex > appliedIndex {
+			continue
+		}
+
+		// Older samples can't give a tighter bound, so drop them
+		t.samples = t.samples[i:]
+		staleness := now.Sub(sample.sentAt)
+		if staleness < 0 {
+			staleness = 0
+		}
+		return staleness, true
+	}
+	return 0, false
+}
+
+// reset drops all samples, such as when the active node changes.
+func (t *staleReadTracker) reset() {
+	t.l.Lock()
+	defer t.l.Unlock()
+
+	t.samples = nil
+}
+
+// RaftReadStaleness returns an upper bound on how far behind the active node
+// the data in this node's raft FSM is. It returns false if this isn't a
+// standby using raft storage, or if the staleness isn't known yet.
+func (c *Core) RaftReadStaleness() (time.Duration, bool) {
+	if standby, _ := c.StandbyStates(); !standby {
+		return 0, false
+	}
+	raftBackend := c.getRaftBackend()
+	if raftBackend == nil {
+		return 0, false
+	}
+	return c.staleReads.staleness(raftBackend.AppliedIndex(), time.Now())
+}
+
+// ServeStaleRead returns whether a read that tolerates up to maxStaleness of
+// staleness may be served by this node instead of the active node, along with
+// the staleness bound of the local data. Only standbys set up as read replicas
+// serve stale reads; all other standbys keep forwarding them to the active
+// node.
+func (c *Core) ServeStaleRead(maxStaleness time.Duration) (time.Duration, bool) {
+	if !c.readReplica.serving.Load() {
+		return 0, false
+	}
+	staleness, ok := c.RaftReadStaleness()
+	if !ok || staleness > maxStaleness {
+		return staleness, false
+	}
+	return staleness, true
+}
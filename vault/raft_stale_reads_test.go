@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestStaleReadTracker_Staleness verifies that staleness is bounded by the
+// newest echo whose reported applied index has been applied locally.
+func TestStaleReadTracker_Staleness(t *testing.T) {
+	t.Parallel()
+
+	tracker := &staleReadTracker{}
+	now := time.Now()
+
+	_, ok := tracker.staleness(100, now)
+	require.False(t, ok)
+
+	tracker.record(10, now.Add(-30*time.Second))
+	tracker.record(20, now.Add(-20*time.Second))
+	tracker.record(30, now.Add(-10*time.Second))
+
+	// Samples that are out of order are ignored
+	tracker.record(40, now.Add(-15*time.Second))
+
+	_, ok = tracker.staleness(5, now)
+	require.False(t, ok)
+
+	staleness, ok := tracker.staleness(25, now)
+	require.True(t, ok)
+	require.Equal(t, 20*time.Second, staleness)
+
+	staleness, ok = tracker.staleness(30, now)
+	require.True(t, ok)
+	require.Equal(t, 10*time.Second, staleness)
+
+	// Older samples were dropped once a newer one was applied
+	_, ok = tracker.staleness(25, now)
+	require.False(t, ok)
+
+	tracker.reset()
+	_, ok = tracker.staleness(30, now)
+	require.False(t, ok)
+}
+
+// TestStaleReadTracker_MaxSamples verifies that only the most recent samples
+// are kept.
+func TestStaleReadTracker_MaxSamples(t *testing.T) {
+	t.Parallel()
+
+	tracker := &staleReadTracker{}
+	now := time.Now()
+	for i := 0; i < 2*maxStaleReadSamples; i++ {
+		tracker.record(uint64(i+1), now.Add(time.Duration(i)*time.Second))
+	}
+	require.Len(t, tracker.samples, maxStaleReadSamples)
+	require.Equal(t, uint64(maxStaleReadSamples+1), tracker.samples[0].leaderIndex)
+}
@@ -445,6 +445,7 @@ func (c *Core) clearForwardingClients() {
 	}
 	c.clusterLeaderParams.Store((*ClusterLeaderParams)(nil))
 	c.rpcLastSuccessfulHeartbeat.Store(time.Time{})
+	c.staleReads.reset()
 }
 
 // ForwardRequest forwards a given request to the active node and returns the
@@ -233,6 +233,9 @@ func (c *forwardingClient) startHeartbeat() {
 			// Store the active node's replication state to display in
 			// sys/health calls
 			atomic.StoreUint32(c.core.activeNodeReplicationState, resp.ReplicationState)
+			if resp.RaftAppliedIndex > 0 {
+				c.core.staleReads.record(resp.RaftAppliedIndex, start)
+			}
 		}
 
 		// store a value before the first tick to indicate that we've started
@@ -6,6 +6,8 @@ package vault
 import (
 	"context"
 	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -330,6 +332,21 @@ func (c *Core) fetchACLTokenEntryAndEntity(ctx context.Context, req *logical.Req
 		}
 	}
 
+	// Tokens bound to a client certificate may only be used over connections
+	// presenting that same certificate
+	if te.BoundCertThumbprint != "" {
+		var thumbprint string
+		if req.Connection != nil {
+			thumbprint = clientCertThumbprint(req.Connection.ConnState)
+		}
+		if thumbprint == "" || thumbprint != te.BoundCertThumbprint {
+			if c.Logger().IsDebug() {
+				c.Logger().Debug("token is bound to a client certificate not presented by the request", "accessor", te.Accessor)
+			}
+			return nil, nil, nil, nil, logical.ErrPermissionDenied
+		}
+	}
+
 	policyNames := make(map[string][]string)
 	// Add tokens policies
 	policyNames[te.NamespaceID] = append(policyNames[te.NamespaceID], te.Policies...)
@@ -406,6 +423,10 @@ func (c *Core) fetchACLTokenEntryAndEntity(ctx context.Context, req *logical.Req
 		c.logger.Error("failed to construct ACL", "error", err)
 		return nil, nil, nil, nil, ErrInternalError
 	}
+	if err := c.restrictACLToTokenScope(ctx, tokenNS, acl, te, entity); err != nil {
+		c.logger.Error("failed to restrict ACL to token scope", "error", err)
+		return nil, nil, nil, nil, ErrInternalError
+	}
 
 	if actorEntity != nil {
 		newAcl, err := c.performDelegationTokenChecks(tokenCtx, acl, actorEntity, actorEntityPolicyNames)
@@ -828,6 +849,12 @@ func (c *Core) handleCancelableRequest(ctx context.Context, req *logical.Request
 		return logical.ErrorResponse("mounts of type %q aren't supported by license", entry.Type), logical.ErrInvalidRequest
 	}
 
+	// Read replicas only serve reads of kv mounts, and leave everything else
+	// to the active node
+	if c.perfStandby && c.readReplica.active.Load() && !readReplicaServes(req, entry) {
+		return nil, logical.ErrPerfStandbyPleaseForward
+	}
+
 	// If the request requires a snapshot ID, we need to perform checks to
 	// ensure the request is valid and lock the snapshot, so it doesn't get
 	// unloaded while the request is being processed.
@@ -2052,6 +2079,19 @@ func (c *Core) handleLoginRequest(ctx context.Context, req *logical.Request) (re
 		// Determine the source of the login
 		source := c.router.MatchingMount(ctx, req.Path)
 
+		// Bind the token to the client certificate presented during login,
+		// if requested by the auth method
+		if auth.BindClientCert {
+			var thumbprint string
+			if req.Connection != nil {
+				thumbprint = clientCertThumbprint(req.Connection.ConnState)
+			}
+			if thumbprint == "" {
+				return logical.ErrorResponse("a client certificate is required to issue a token bound to it"), nil, logical.ErrInvalidRequest
+			}
+			auth.BoundCertThumbprint = thumbprint
+		}
+
 		// Login MFA
 		entity, _, err := c.fetchEntityAndDerivedPolicies(ctx, ns, auth.EntityID, true)
 		if err != nil {
@@ -2804,19 +2844,20 @@ func (c *Core) RegisterAuth(ctx context.Context, tokenTTL time.Duration, path st
 		return err
 	}
 	te := logical.TokenEntry{
-		Path:           path,
-		Meta:           auth.Metadata,
-		DisplayName:    auth.DisplayName,
-		CreationTime:   time.Now().Unix(),
-		TTL:            tokenTTL,
-		NumUses:        auth.NumUses,
-		EntityID:       auth.EntityID,
-		BoundCIDRs:     auth.BoundCIDRs,
-		Policies:       auth.TokenPolicies,
-		NamespaceID:    ns.ID,
-		ExplicitMaxTTL: auth.ExplicitMaxTTL,
-		Period:         auth.Period,
-		Type:           auth.TokenType,
+		Path:                path,
+		Meta:                auth.Metadata,
+		DisplayName:         auth.DisplayName,
+		CreationTime:        time.Now().Unix(),
+		TTL:                 tokenTTL,
+		NumUses:             auth.NumUses,
+		EntityID:            auth.EntityID,
+		BoundCIDRs:          auth.BoundCIDRs,
+		BoundCertThumbprint: auth.BoundCertThumbprint,
+		Policies:            auth.TokenPolicies,
+		NamespaceID:         ns.ID,
+		ExplicitMaxTTL:      auth.ExplicitMaxTTL,
+		Period:              auth.Period,
+		Type:                auth.TokenType,
 	}
 
 	if te.TTL == 0 && (len(te.Policies) != 1 || te.Policies[0] != "root") {
@@ -3125,3 +3166,15 @@ func (c *Core) checkSSCTokenInternal(ctx context.Context, token string, isPerfSt
 	// status code.
 	return "", logical.ErrMissingRequiredState
 }
+
+// clientCertThumbprint returns the base64url-encoded SHA-256 thumbprint of the
+// leaf client certificate presented on the given connection, in the form used
+// by the x5t#S256 confirmation method of RFC 8705. An empty string is returned
+// if no client certificate was presented.
+func clientCertThumbprint(connState *tls.ConnectionState) string {
+	if connState == nil || len(connState.PeerCertificates) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(connState.PeerCertificates[0].Raw)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
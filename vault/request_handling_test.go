@@ -5,6 +5,8 @@ package vault
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"strings"
 	"testing"
@@ -938,6 +940,65 @@ func TestRequestHandling_fetchACLTokenEntryAndEntity_NonExpiring_RootIgnoresCIDR
 	require.Equal(t, time.Duration(0), te.TTL)
 }
 
+// TestRequestHandling_fetchACLTokenEntryAndEntity_BoundCert verifies that a
+// token bound to a client certificate is only accepted over connections
+// presenting that certificate.
+func TestRequestHandling_fetchACLTokenEntryAndEntity_BoundCert(t *testing.T) {
+	core, _, root := TestCoreUnsealed(t)
+	ctx := namespace.RootContext(context.Background())
+
+	boundCert := &x509.Certificate{Raw: []byte("bound certificate")}
+	otherCert := &x509.Certificate{Raw: []byte("other certificate")}
+
+	te, err := core.tokenStore.Lookup(ctx, root)
+	require.NoError(t, err)
+	te.TTL = time.Hour
+	te.BoundCertThumbprint = clientCertThumbprint(&tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{boundCert},
+	})
+	require.NotEmpty(t, te.BoundCertThumbprint)
+
+	tests := map[string]struct {
+		conn    *logical.Connection
+		allowed bool
+	}{
+		"no connection": {},
+		"no client certificate": {
+			conn: &logical.Connection{RemoteAddr: "10.1.2.3", ConnState: &tls.ConnectionState{}},
+		},
+		"different client certificate": {
+			conn: &logical.Connection{
+				RemoteAddr: "10.1.2.3",
+				ConnState:  &tls.ConnectionState{PeerCertificates: []*x509.Certificate{otherCert}},
+			},
+		},
+		"bound client certificate": {
+			conn: &logical.Connection{
+				RemoteAddr: "10.1.2.3",
+				ConnState:  &tls.ConnectionState{PeerCertificates: []*x509.Certificate{boundCert}},
+			},
+			allowed: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := &logical.Request{
+				ClientToken: root,
+				Connection:  tc.conn,
+			}
+			req.SetTokenEntry(te)
+
+			_, _, _, _, err := core.fetchACLTokenEntryAndEntity(ctx, req)
+			if tc.allowed {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, logical.ErrPermissionDenied)
+			}
+		})
+	}
+}
+
 // TestRequestHandling_handleCancelableTestNumericToken tests that if a token
 // that is passed in, is somehow a number rather than a string (not currently
 // possible), then the handling will error, not panic.
@@ -953,3 +1014,65 @@ func TestRequestHandling_handleCancelableTestNumericToken(t *testing.T) {
 	require.ErrorContains(t, err, logical.ErrPermissionDenied.Error())
 	require.ErrorContains(t, resp.Error(), "invalid token")
 }
+
+// TestRequestHandling_LoginBindClientCert verifies that logins binding the
+// token to the client certificate fail when no certificate is presented, and
+// otherwise bind the token to the presented certificate.
+func TestRequestHandling_LoginBindClientCert(t *testing.T) {
+	noop := &NoopBackend{
+		Login: []string{"login"},
+		Response: &logical.Response{
+			Auth: &logical.Auth{
+				Policies:       []string{"foo"},
+				DisplayName:    "armon",
+				BindClientCert: true,
+			},
+		},
+		BackendType: logical.TypeCredential,
+	}
+	c, _, root := TestCoreUnsealed(t)
+	c.credentialBackends["noop"] = func(ctx context.Context, conf *logical.BackendConfig) (logical.Backend, error) {
+		return noop, nil
+	}
+	ctx := namespace.RootContext(context.Background())
+
+	req := logical.TestRequest(t, logical.UpdateOperation, "sys/auth/foo")
+	req.Data["type"] = "noop"
+	req.ClientToken = root
+	_, err := c.HandleRequest(ctx, req)
+	require.NoError(t, err)
+
+	for name, conn := range map[string]*logical.Connection{
+		"no connection":         nil,
+		"no client certificate": {RemoteAddr: "10.1.2.3", ConnState: &tls.ConnectionState{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := c.HandleRequest(ctx, &logical.Request{
+				Path:       "auth/foo/login",
+				Operation:  logical.UpdateOperation,
+				Connection: conn,
+			})
+			require.ErrorIs(t, err, logical.ErrInvalidRequest)
+			require.EqualError(t, resp.Error(), "a client certificate is required to issue a token bound to it")
+			require.Nil(t, resp.Auth)
+		})
+	}
+
+	connState := &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{{Raw: []byte("client certificate")}},
+	}
+	resp, err := c.HandleRequest(ctx, &logical.Request{
+		Path:       "auth/foo/login",
+		Operation:  logical.UpdateOperation,
+		Connection: &logical.Connection{RemoteAddr: "10.1.2.3", ConnState: connState},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Auth)
+
+	token, err := c.DecodeSSCToken(resp.Auth.ClientToken)
+	require.NoError(t, err)
+	te, err := c.tokenStore.Lookup(ctx, token)
+	require.NoError(t, err)
+	require.NotNil(t, te)
+	require.Equal(t, clientCertThumbprint(connState), te.BoundCertThumbprint)
+}
@@ -8,6 +8,8 @@ package vault
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
@@ -87,5 +89,24 @@ func forwardCreateTokenRegisterAuth(ctx context.Context, c *Core, te *logical.To
 }
 
 func (c *Core) lockSnapshotForRequest(ctx context.Context, req *logical.Request, entry *MountEntry) (func(), error) {
-	return nil, errors.New("loaded snapshots not supported")
+	if c.snapshotManager == nil {
+		return nil, errors.New("loaded snapshots require raft storage")
+	}
+	if entry == nil {
+		return nil, errors.New("no mount for request path")
+	}
+
+	// Recover operations read from the source path in the snapshot
+	readPath := req.Path
+	if req.Operation == logical.RecoverOperation && req.RecoverSourcePath != "" {
+		readPath = req.RecoverSourcePath
+	}
+	if !c.router.AllowSnapshotReadPath(ctx, readPath) {
+		return nil, logical.CodedError(http.StatusBadRequest, fmt.Sprintf("path %q does not support reading from a snapshot", readPath))
+	}
+	unlock, err := c.snapshotManager.lock(req.RequiresSnapshotID)
+	if err != nil {
+		return nil, logical.CodedError(http.StatusBadRequest, err.Error())
+	}
+	return unlock, nil
 }
@@ -519,6 +519,26 @@ func (r *Router) MatchingSystemView(ctx context.Context, path string) logical.Sy
 	return backend.System()
 }
 
+// invalidateStoragePath calls InvalidateKey on the backend whose storage holds
+// the given storage path, with the key relative to the backend's storage.
+func (r *Router) invalidateStoragePath(ctx context.Context, path string) {
+	r.l.RLock()
+	_, raw, ok := r.storagePrefix.LongestPrefix(path)
+	r.l.RUnlock()
+	if !ok {
+		return
+	}
+
+	re := raw.(*routeEntry)
+	re.l.RLock()
+	backend := re.backend
+	re.l.RUnlock()
+	if backend == nil {
+		return
+	}
+	backend.InvalidateKey(ctx, strings.TrimPrefix(path, re.storagePrefix))
+}
+
 // MatchingStoragePrefixByAPIPath the storage prefix for the given api path
 func (r *Router) MatchingStoragePrefixByAPIPath(ctx context.Context, path string) (string, bool) {
 	ns, err := namespace.FromContext(ctx)
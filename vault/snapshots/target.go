@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package snapshots
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// CompressionGzip stores snapshots gzip compressed, as they're written by
+	// raft.
+	CompressionGzip = "gzip"
+
+	// CompressionNone stores snapshots as uncompressed tar archives, which is
+	// useful when the target compresses or deduplicates data itself.
+	CompressionNone = "none"
+
+	gzipExtension = ".snap"
+	tarExtension  = ".tar"
+
+	// nameTimeFormat is the format of the time in snapshot names. It sorts
+	// lexically in time order.
+	nameTimeFormat = "20060102T150405Z"
+)
+
+// Object describes a snapshot stored in a Target.
+type Object struct {
+	Name    string
+	Size    int64
+	ModTime time.Time
+}
+
+// Target is a location that snapshots are stored in, such as a local directory
+// or an object store bucket. Snapshots are identified by their name, which
+// must not contain path separators.
+type Target interface {
+	// Type returns the type of the target, such as "local" or "aws-s3".
+	Type() string
+
+	// Put stores the snapshot read from r under the given name.
+	Put(ctx context.Context, name string, r io.ReadSeeker) error
+
+	// Open returns a reader for the snapshot with the given name.
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+
+	// List returns the snapshots whose name starts with the given prefix.
+	List(ctx context.Context, prefix string) ([]*Object, error)
+
+	// Delete removes the snapshot with the given name.
+	Delete(ctx context.Context, name string) error
+
+	// Location returns a human readable location of the snapshot with the
+	// given name, such as a file path or URL.
+	Location(name string) string
+}
+
+// ValidCompression returns whether the given snapshot compression is
+// supported.
+func ValidCompression(compression string) bool {
+	switch compression {
+	case CompressionGzip, CompressionNone:
+		return true
+	}
+	return false
+}
+
+// SnapshotName returns the name of a snapshot with the given prefix taken at
+// the given time. Names with the same prefix sort in the order the snapshots
+// were taken.
+func SnapshotName(prefix string, t time.Time, compression string) string {
+	ext := gzipExtension
+	if compression == CompressionNone {
+		ext = tarExtension
+	}
+	return fmt.Sprintf("%s-%s%s", prefix, t.UTC().Format(nameTimeFormat), ext)
+}
+
+// SnapshotTime parses the time a snapshot was taken from its name, as
+// returned by SnapshotName. It returns false if the name isn't a snapshot
+// name with the given prefix.
+func SnapshotTime(prefix, name string) (time.Time, bool) {
+	rest, ok := strings.CutPrefix(name, prefix+"-")
+	if !ok {
+		return time.Time{}, false
+	}
+	for _, ext := range []string{gzipExtension, tarExtension} {
+		if raw, ok := strings.CutSuffix(rest, ext); ok {
+			t, err := time.Parse(nameTimeFormat, raw)
+			return t, err == nil
+		}
+	}
+	return time.Time{}, false
+}
+
+// ListSnapshots returns the snapshots with the given prefix stored in the
+// target, oldest first. Objects that aren't snapshots are ignored.
+func ListSnapshots(ctx context.Context, target Target, prefix string) ([]*Object, error) {
+	objects, err := target.List(ctx, prefix+"-")
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*Object, 0, len(objects))
+	for _, object := range objects {
+		if _, ok := SnapshotTime(prefix, object.Name); ok {
+			snapshots = append(snapshots, object)
+		}
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Name < snapshots[j].Name
+	})
+	return snapshots, nil
+}
+
+// Compress returns a reader for the gzip compressed snapshot read from r,
+// given the compression it's stored with.
+func Compress(r io.ReadCloser, compression string) io.ReadCloser {
+	if compression != CompressionNone {
+		return r
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		gz := gzip.NewWriter(pw)
+		_, err := io.Copy(gz, r)
+		if err == nil {
+			err = gz.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	return &pipeReadCloser{PipeReader: pr, source: r}
+}
+
+// Decompress returns a reader for the snapshot read from the gzip compressed
+// r, stored with the given compression.
+func Decompress(r io.Reader, compression string) (io.Reader, error) {
+	if compression != CompressionNone {
+		return r, nil
+	}
+	return gzip.NewReader(r)
+}
+
+type pipeReadCloser struct {
+	*io.PipeReader
+	source io.Closer
+}
+
+func (p *pipeReadCloser) Close() error {
+	p.PipeReader.Close()
+	return p.source.Close()
+}
+
+var _ Source = (*targetSource)(nil)
+
+type targetSource struct {
+	target Target
+	name   string
+}
+
+// NewTargetSource creates a new Source that reads the snapshot with the given
+// name from the target. Snapshots stored uncompressed are compressed as
+// they're read, so the data is always a gzip compressed snapshot archive.
+func NewTargetSource(target Target, name string) Source {
+	return &targetSource{target: target, name: name}
+}
+
+func (t *targetSource) Type(_ context.Context) string {
+	return t.target.Type()
+}
+
+func (t *targetSource) ReadCloser(ctx context.Context) (io.ReadCloser, error) {
+	r, err := t.target.Open(ctx, t.name)
+	if err != nil {
+		return nil, err
+	}
+	compression := CompressionGzip
+	if strings.HasSuffix(t.name, tarExtension) {
+		compression = CompressionNone
+	}
+	return &ctxAwareReadCloser{ctx, Compress(r, compression)}, nil
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package snapshots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// TargetTypeLocal is the type of targets that store snapshots in a local
+// directory.
+const TargetTypeLocal = "local"
+
+var _ Target = (*LocalTarget)(nil)
+
+// LocalTarget stores snapshots as files in a local directory.
+type LocalTarget struct {
+	dir string
+}
+
+// NewLocalTarget creates a target that stores snapshots in the given
+// directory, creating it if it doesn't exist.
+func NewLocalTarget(dir string) (*LocalTarget, error) {
+	if dir == "" {
+		return nil, errors.New("no directory provided")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating snapshot directory: %w", err)
+	}
+	return &LocalTarget{dir: dir}, nil
+}
+
+func (l *LocalTarget) Type() string {
+	return TargetTypeLocal
+}
+
+// Put writes the snapshot to a temporary file, and renames it once it's
+// complete so that partial snapshots are never listed.
+func (l *LocalTarget) Put(ctx context.Context, name string, r io.ReadSeeker) error {
+	path, err := l.path(name)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(l.dir, "."+name+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := io.Copy(f, &ctxAwareReadCloser{ctx, io.NopCloser(r)}); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func (l *LocalTarget) Open(_ context.Context, name string) (io.ReadCloser, error) {
+	path, err := l.path(name)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+func (l *LocalTarget) List(_ context.Context, prefix string) ([]*Object, error) {
+	entries, err := os.ReadDir(l.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []*Object
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), prefix) || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		objects = append(objects, &Object{
+			Name:    entry.Name(),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+	}
+	return objects, nil
+}
+
+func (l *LocalTarget) Delete(_ context.Context, name string) error {
+	path, err := l.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (l *LocalTarget) Location(name string) string {
+	return filepath.Join(l.dir, name)
+}
+
+func (l *LocalTarget) path(name string) (string, error) {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("invalid snapshot name %q", name)
+	}
+	return filepath.Join(l.dir, name), nil
+}
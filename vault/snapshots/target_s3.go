@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package snapshots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/go-hclog"
+	awsutil "github.com/hashicorp/go-secure-stdlib/awsutil/v2"
+)
+
+// TargetTypeS3 is the type of targets that store snapshots in an S3
+// compatible object store.
+const TargetTypeS3 = "aws-s3"
+
+// S3TargetConfig configures a target that stores snapshots in an S3
+// compatible object store.
+type S3TargetConfig struct {
+	Bucket string
+	// KeyPrefix is prepended to the names of snapshots to build their keys.
+	KeyPrefix string
+	Region    string
+
+	// Endpoint is the URL of an S3 compatible object store, used instead of
+	// AWS S3.
+	Endpoint       string
+	ForcePathStyle bool
+	DisableTLS     bool
+
+	// AccessKeyID, SecretAccessKey and SessionToken are static credentials.
+	// If they aren't set, credentials are sourced from the environment, AWS
+	// credential files or the instance role.
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+
+	// KMSKeyID enables server side encryption with the given AWS KMS key.
+	KMSKeyID string
+
+	Logger hclog.Logger
+}
+
+var _ Target = (*S3Target)(nil)
+
+// S3Target stores snapshots as objects in an S3 compatible object store.
+type S3Target struct {
+	client    *s3.Client
+	bucket    string
+	keyPrefix string
+	kmsKeyID  string
+}
+
+// NewS3Target creates a target that stores snapshots in the configured
+// bucket.
+func NewS3Target(ctx context.Context, conf *S3TargetConfig) (*S3Target, error) {
+	if conf.Bucket == "" {
+		return nil, errors.New("no bucket provided")
+	}
+	region := conf.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+	logger := conf.Logger
+	if logger == nil {
+		logger = hclog.NewNullLogger()
+	}
+
+	credsConfig := &awsutil.CredentialsConfig{
+		AccessKey:    conf.AccessKeyID,
+		SecretKey:    conf.SecretAccessKey,
+		SessionToken: conf.SessionToken,
+		Region:       region,
+		Logger:       logger,
+	}
+	awsConfig, err := credsConfig.GenerateCredentialChain(ctx, awsutil.WithSharedCredentials(false))
+	if err != nil {
+		return nil, fmt.Errorf("error building credential chain: %w", err)
+	}
+
+	endpoint := conf.Endpoint
+	if endpoint != "" && !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		if conf.DisableTLS {
+			endpoint = "http://" + endpoint
+		} else {
+			endpoint = "https://" + endpoint
+		}
+	}
+
+	client := s3.NewFromConfig(*awsConfig, func(o *s3.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+		o.UsePathStyle = conf.ForcePathStyle
+	})
+
+	return &S3Target{
+		client:    client,
+		bucket:    conf.Bucket,
+		keyPrefix: conf.KeyPrefix,
+		kmsKeyID:  conf.KMSKeyID,
+	}, nil
+}
+
+func (s *S3Target) Type() string {
+	return TargetTypeS3
+}
+
+func (s *S3Target) Put(ctx context.Context, name string, r io.ReadSeeker) error {
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+		Body:   r,
+	}
+	if s.kmsKeyID != "" {
+		input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+		input.SSEKMSKeyId = aws.String(s.kmsKeyID)
+	}
+
+	if _, err := s.client.PutObject(ctx, input); err != nil {
+		return fmt.Errorf("error uploading snapshot to bucket %q: %w", s.bucket, err)
+	}
+	return nil
+}
+
+func (s *S3Target) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	resp, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error downloading snapshot from bucket %q: %w", s.bucket, err)
+	}
+	return resp.Body, nil
+}
+
+func (s *S3Target) List(ctx context.Context, prefix string) ([]*Object, error) {
+	keyPrefix := s.key(prefix)
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(keyPrefix),
+	})
+
+	var objects []*Object
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error listing snapshots in bucket %q: %w", s.bucket, err)
+		}
+		for _, obj := range page.Contents {
+			name := strings.TrimPrefix(aws.ToString(obj.Key), s.key(""))
+			// Objects in "subdirectories" aren't snapshots
+			if strings.Contains(name, "/") {
+				continue
+			}
+			objects = append(objects, &Object{
+				Name:    name,
+				Size:    aws.ToInt64(obj.Size),
+				ModTime: aws.ToTime(obj.LastModified),
+			})
+		}
+	}
+	return objects, nil
+}
+
+func (s *S3Target) Delete(ctx context.Context, name string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting snapshot from bucket %q: %w", s.bucket, err)
+	}
+	return nil
+}
+
+func (s *S3Target) Location(name string) string {
+	return fmt.Sprintf("s3://%s/%s", s.bucket, s.key(name))
+}
+
+// key returns the object key of the snapshot with the given name, which is
+// in the "directory" of the key prefix.
+func (s *S3Target) key(name string) string {
+	prefix := strings.Trim(s.keyPrefix, "/")
+	if prefix == "" {
+		return name
+	}
+	return prefix + "/" + name
+}
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package snapshots
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is a minimal stand-in for an S3 compatible object store, supporting
+// the path style requests made by S3Target for a single bucket.
+type fakeS3 struct {
+	bucket string
+
+	l       sync.Mutex
+	objects map[string][]byte
+}
+
+type fakeS3ListResult struct {
+	XMLName  xml.Name `xml:"ListBucketResult"`
+	Name     string
+	Prefix   string
+	KeyCount int
+	Contents []fakeS3Object
+}
+
+type fakeS3Object struct {
+	Key          string
+	Size         int64
+	LastModified string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.l.Lock()
+	defer f.l.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != f.bucket {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && key == "":
+		prefix := r.URL.Query().Get("prefix")
+		result := fakeS3ListResult{Name: f.bucket, Prefix: prefix}
+		for k, v := range f.objects {
+			if strings.HasPrefix(k, prefix) {
+				result.Contents = append(result.Contents, fakeS3Object{
+					Key:          k,
+					Size:         int64(len(v)),
+					LastModified: time.Now().UTC().Format(time.RFC3339),
+				})
+			}
+		}
+		sort.Slice(result.Contents, func(i, j int) bool {
+			return result.Contents[i].Key < result.Contents[j].Key
+		})
+		result.KeyCount = len(result.Contents)
+		w.Header().Set("Content-Type", "application/xml")
+		xml.NewEncoder(w).Encode(result)
+	case r.Method == http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		f.objects[key] = data
+	case r.Method == http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(data)
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func testS3Target(t *testing.T, keyPrefix string) (*S3Target, *fakeS3) {
+	t.Helper()
+
+	fake := &fakeS3{bucket: "snapshots", objects: map[string][]byte{}}
+	ts := httptest.NewServer(fake)
+	t.Cleanup(ts.Close)
+
+	target, err := NewS3Target(context.Background(), &S3TargetConfig{
+		Bucket:          "snapshots",
+		KeyPrefix:       keyPrefix,
+		Endpoint:        ts.URL,
+		ForcePathStyle:  true,
+		AccessKeyID:     "access",
+		SecretAccessKey: "secret",
+	})
+	require.NoError(t, err)
+	return target, fake
+}
+
+// testTarget exercises the snapshot lifecycle against the given target.
+func testTarget(t *testing.T, target Target) {
+	t.Helper()
+	ctx := context.Background()
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	names := []string{
+		SnapshotName("hourly", now, CompressionGzip),
+		SnapshotName("hourly", now.Add(time.Hour), CompressionGzip),
+		SnapshotName("daily", now, CompressionGzip),
+	}
+	for i, name := range names {
+		require.NoError(t, target.Put(ctx, name, bytes.NewReader([]byte{byte(i)})))
+	}
+
+	snapshots, err := ListSnapshots(ctx, target, "hourly")
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	require.Equal(t, names[0], snapshots[0].Name)
+	require.Equal(t, names[1], snapshots[1].Name)
+	require.Equal(t, int64(1), snapshots[1].Size)
+
+	r, err := target.Open(ctx, names[1])
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, []byte{1}, data)
+
+	require.NoError(t, target.Delete(ctx, names[0]))
+	snapshots, err = ListSnapshots(ctx, target, "hourly")
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, names[1], snapshots[0].Name)
+}
+
+func TestLocalTarget(t *testing.T) {
+	target, err := NewLocalTarget(t.TempDir())
+	require.NoError(t, err)
+	testTarget(t, target)
+
+	_, err = target.Open(context.Background(), "../escape.snap")
+	require.Error(t, err)
+}
+
+func TestS3Target(t *testing.T) {
+	target, fake := testS3Target(t, "vault/snapshots/")
+	testTarget(t, target)
+
+	for key := range fake.objects {
+		require.True(t, strings.HasPrefix(key, "vault/snapshots/"), key)
+	}
+	require.Equal(t, "s3://snapshots/vault/snapshots/foo.snap", target.Location("foo.snap"))
+}
+
+func TestSnapshotName(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	name := SnapshotName("vault", now, CompressionGzip)
+	require.Equal(t, "vault-20250102T030405Z.snap", name)
+	parsed, ok := SnapshotTime("vault", name)
+	require.True(t, ok)
+	require.Equal(t, now, parsed)
+
+	name = SnapshotName("vault", now, CompressionNone)
+	require.Equal(t, "vault-20250102T030405Z.tar", name)
+	_, ok = SnapshotTime("vault", name)
+	require.True(t, ok)
+
+	_, ok = SnapshotTime("other", name)
+	require.False(t, ok)
+	_, ok = SnapshotTime("vault", "vault-notatime.snap")
+	require.False(t, ok)
+}
+
+// TestTargetSource verifies that snapshots stored uncompressed are read back
+// compressed.
+func TestTargetSource(t *testing.T) {
+	ctx := context.Background()
+	target, err := NewLocalTarget(t.TempDir())
+	require.NoError(t, err)
+
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, err = gz.Write([]byte("snapshot data"))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	uncompressed, err := Decompress(bytes.NewReader(compressed.Bytes()), CompressionNone)
+	require.NoError(t, err)
+	data, err := io.ReadAll(uncompressed)
+	require.NoError(t, err)
+	require.Equal(t, "snapshot data", string(data))
+
+	now := time.Now()
+	gzipName := SnapshotName("vault", now, CompressionGzip)
+	tarName := SnapshotName("vault", now, CompressionNone)
+	require.NoError(t, target.Put(ctx, gzipName, bytes.NewReader(compressed.Bytes())))
+	require.NoError(t, target.Put(ctx, tarName, bytes.NewReader(data)))
+
+	for _, name := range []string{gzipName, tarName} {
+		source := NewTargetSource(target, name)
+		require.Equal(t, TargetTypeLocal, source.Type(ctx))
+
+		r, err := source.ReadCloser(ctx)
+		require.NoError(t, err)
+		gr, err := gzip.NewReader(r)
+		require.NoError(t, err)
+		read, err := io.ReadAll(gr)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		require.Equal(t, "snapshot data", string(read))
+	}
+}
@@ -0,0 +1,866 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/vault/helper/namespace"
+	"github.com/hashicorp/vault/physical/raft"
+	"github.com/hashicorp/vault/sdk/helper/jsonutil"
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/hashicorp/vault/sdk/physical"
+)
+
+const (
+	storageMigrationStoragePath = "core/storage-migration"
+
+	// StorageCutoverPath marks storage which was cut over to another backend
+	// by an online storage migration, so that the nodes still using it, and
+	// those restarted with it configured, switch to the new backend. It's
+	// written directly to the old storage, so that it can be read before
+	// unsealing, and it's never copied to the new storage.
+	StorageCutoverPath = "core/storage-migration-cutover"
+
+	// offlineStorageMigrationLockPath is the lock held by vault operator
+	// migrate, which isn't copied either.
+	offlineStorageMigrationLockPath = "core/migration"
+
+	// storageMigrationCheckpointKeys is how many keys are copied between
+	// saving the progress of the copy.
+	storageMigrationCheckpointKeys = 1000
+
+	// storageSwitchLockTimeout is how long a node switching storage waits
+	// for the HA lock of the new storage, before unsealing as a standby.
+	storageSwitchLockTimeout = 10 * time.Second
+
+	StorageMigrationStateCopying   = "copying"
+	StorageMigrationStateVerifying = "verifying"
+	StorageMigrationStateVerified  = "verified"
+	StorageMigrationStateCutOver   = "cut_over"
+	StorageMigrationStateFailed    = "failed"
+	StorageMigrationStateCanceled  = "canceled"
+)
+
+var (
+	errStorageMigrationRunning    = errors.New("a storage migration is already running")
+	errStorageMigrationNotRunning = errors.New("no storage migration is running")
+)
+
+// StorageMigration is an online migration of Vault's storage to another
+// backend. Writes are mirrored to the new backend while the existing keys are
+// copied and then verified, after which Vault can be cut over to the new
+// backend. It's stored so that it can be resumed by the next active node.
+type StorageMigration struct {
+	ID     string            `json:"id"`
+	Type   string            `json:"type"`
+	Config map[string]string `json:"config"`
+	State  string            `json:"state"`
+	Error  string            `json:"error,omitempty"`
+	// Checkpoint is the last key copied, after which the copy is resumed
+	Checkpoint   string    `json:"checkpoint,omitempty"`
+	KeysCopied   uint64    `json:"keys_copied"`
+	KeysVerified uint64    `json:"keys_verified"`
+	KeysRepaired uint64    `json:"keys_repaired"`
+	StartTime    time.Time `json:"start_time"`
+	CopyEndTime  time.Time `json:"copy_end_time,omitempty"`
+	EndTime      time.Time `json:"end_time,omitempty"`
+}
+
+// StorageCutover is the marker left in storage which was cut over. It holds
+// the configuration of the new storage, unencrypted, so that it can be used
+// before unsealing.
+type StorageCutover struct {
+	ID     string            `json:"id"`
+	Type   string            `json:"type"`
+	Config map[string]string `json:"config"`
+	Time   time.Time         `json:"time"`
+}
+
+// ReadStorageCutover returns the cutover marker of the storage, or nil if it
+// was never cut over to another backend.
+func ReadStorageCutover(ctx context.Context, b physical.Backend) (*StorageCutover, error) {
+	entry, err := b.Get(ctx, StorageCutoverPath)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	var cutover StorageCutover
+	if err := jsonutil.DecodeJSON(entry.Value, &cutover); err != nil {
+		return nil, err
+	}
+	return &cutover, nil
+}
+
+// checkStorageCutover prevents a node from becoming active on storage which
+// was cut over, as the new storage is the source of truth. The node switches
+// to the new storage instead, after which it can become active.
+func (c *Core) checkStorageCutover(ctx context.Context) error {
+	cutover, err := ReadStorageCutover(ctx, c.underlyingPhysical)
+	if err != nil {
+		return fmt.Errorf("failed to check for storage cutover: %w", err)
+	}
+	if cutover != nil {
+		go c.followStorageCutover(cutover)
+		return fmt.Errorf("storage was cut over to %s storage by migration %s at %s, switching to it",
+			cutover.Type, cutover.ID, cutover.Time.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// followStorageCutover switches a node which wasn't active when the storage
+// was cut over to the new storage.
+func (c *Core) followStorageCutover(cutover *StorageCutover) {
+	if !c.storageSwitching.CompareAndSwap(false, true) {
+		return
+	}
+	defer c.storageSwitching.Store(false)
+
+	logger := c.logger.Named("storage-migration")
+	if cutover.Type == "raft" {
+		// The raft storage migrated to only has the node which cut over
+		logger.Error("storage was cut over to raft storage, which this node must join", "id", cutover.ID)
+		return
+	}
+	factory, ok := c.physicalBackends[cutover.Type]
+	if !ok {
+		logger.Error("storage was cut over to an unknown storage type", "id", cutover.ID, "type", cutover.Type)
+		return
+	}
+	backend, err := factory(cutover.Config, logger.Named(cutover.Type))
+	if err != nil {
+		logger.Error("failed to create the storage which was cut over to", "id", cutover.ID, "type", cutover.Type, "error", err)
+		return
+	}
+
+	logger.Info("storage was cut over, switching to the new storage", "id", cutover.ID, "type", cutover.Type)
+	if err := c.switchStorage(cutover.Type, backend, false); err != nil {
+		logger.Error("failed to switch to the storage which was cut over to", "id", cutover.ID, "type", cutover.Type, "error", err)
+	}
+}
+
+// storageSwitchLock is an HA lock held by a node which has switched storage,
+// but hasn't become active yet.
+type storageSwitchLock struct {
+	uuid         string
+	lock         physical.Lock
+	leaderLostCh <-chan struct{}
+}
+
+// switchStorage replaces the storage of the node with backend, sealing it and
+// unsealing it again with the same root key. If takeLock is set, the HA lock
+// of the new storage is taken before unsealing, unless another node already
+// holds it, so that the node becomes active with it.
+func (c *Core) switchStorage(storageType string, backend physical.Backend, takeLock bool) error {
+	keyring, err := c.barrier.Keyring()
+	if err != nil {
+		return err
+	}
+	rootKey := make([]byte, len(keyring.RootKey()))
+	copy(rootKey, keyring.RootKey())
+	defer memzero(rootKey)
+
+	// Nothing may unseal the node until it has switched storage, so the state
+	// lock is held throughout. In-flight requests are canceled if they take
+	// too long, as they are when sealing.
+	var timer *time.Timer
+	if cancel, _ := c.activeContextCancelFunc.Load().(context.CancelFunc); cancel != nil {
+		timer = time.AfterFunc(DefaultMaxRequestDuration, cancel)
+	}
+	c.stateLock.Lock()
+	defer c.stateLock.Unlock()
+	if timer != nil {
+		timer.Stop()
+	}
+
+	if err := c.sealInternalWithOptions(false, false, true); err != nil {
+		return fmt.Errorf("failed to seal: %w", err)
+	}
+
+	// The raft cluster of the new storage is set up again when unsealing,
+	// with the cluster listener
+	if raftBackend, ok := backend.(*raft.RaftBackend); ok {
+		if err := raftBackend.TeardownCluster(nil); err != nil {
+			return fmt.Errorf("failed to stop the new raft storage: %w", err)
+		}
+	}
+
+	oldPhysical, oldHA := c.underlyingPhysical, c.ha
+	c.storageMirror.setPrimary(backend)
+	c.underlyingPhysical = backend
+	c.storageType = storageType
+	if !c.separateHAStorage {
+		c.ha = nil
+		if ha, ok := backend.(physical.HABackend); ok && ha.HAEnabled() {
+			c.ha = ha
+		}
+	}
+	c.physicalCache.Purge(context.Background())
+
+	// The HA backend is the old storage itself, while the storage may be
+	// wrapped
+	if backend, ok := oldHA.(physical.Backend); ok && !c.separateHAStorage {
+		oldPhysical = backend
+	}
+	if err := closeStorageBackend(oldPhysical); err != nil {
+		c.logger.Warn("failed to close the old storage", "error", err)
+	}
+
+	if takeLock {
+		c.takeStorageSwitchLock()
+	}
+	if err := c.unsealInternal(namespace.RootContext(nil), rootKey); err != nil {
+		if held := c.storageSwitchLock.Swap(nil); held != nil {
+			held.lock.Unlock()
+		}
+		return fmt.Errorf("failed to unseal with the new storage, Vault remains sealed: %w", err)
+	}
+	c.logger.Info("switched storage", "type", storageType)
+	return nil
+}
+
+// takeStorageSwitchLock takes the HA lock of the storage being switched to
+// before unsealing, so that no other node can become active until this one
+// has. Raft's lock can only be taken once its cluster is running, but only
+// the node which cut over is part of it.
+func (c *Core) takeStorageSwitchLock() {
+	if c.ha == nil {
+		return
+	}
+	if _, ok := c.ha.(*raft.RaftBackend); ok {
+		return
+	}
+
+	lockUUID, err := uuid.GenerateUUID()
+	if err != nil {
+		c.logger.Error("failed to generate uuid", "error", err)
+		return
+	}
+	lock, err := c.ha.LockWith(CoreLockPath, lockUUID)
+	if err != nil {
+		c.logger.Error("failed to create lock", "error", err)
+		return
+	}
+
+	stopCh := make(chan struct{})
+	timer := time.AfterFunc(storageSwitchLockTimeout, func() { close(stopCh) })
+	defer timer.Stop()
+	leaderLostCh, err := lock.Lock(stopCh)
+	switch {
+	case err != nil:
+		c.logger.Error("failed to acquire the lock of the new storage", "error", err)
+	case leaderLostCh == nil:
+		c.logger.Info("another node holds the lock of the new storage, unsealing as a standby")
+	default:
+		c.storageSwitchLock.Store(&storageSwitchLock{
+			uuid:         lockUUID,
+			lock:         lock,
+			leaderLostCh: leaderLostCh,
+		})
+	}
+}
+
+func (c *Core) loadStorageMigration(ctx context.Context) (*StorageMigration, error) {
+	entry, err := c.barrier.Get(ctx, storageMigrationStoragePath)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	var migration StorageMigration
+	if err := entry.DecodeJSON(&migration); err != nil {
+		return nil, err
+	}
+	return &migration, nil
+}
+
+// storageMigrator runs the online storage migration on the active node.
+type storageMigrator struct {
+	core   *Core
+	logger hclog.Logger
+	ctx    context.Context
+
+	l         sync.Mutex
+	migration *StorageMigration
+	// secondary is the new storage, which writes are mirrored to while the
+	// migration is in progress
+	secondary physical.Backend
+	cancel    context.CancelFunc
+	doneCh    chan struct{}
+}
+
+// startStorageMigrator resumes a migration that was in progress when the last
+// active node stepped down or was sealed. Writes that weren't mirrored in the
+// meantime are repaired when the migration is verified again.
+func (c *Core) startStorageMigrator(ctx context.Context) error {
+	m := &storageMigrator{
+		core:   c,
+		logger: c.logger.Named("storage-migration"),
+		ctx:    ctx,
+	}
+	c.storageMigrator = m
+
+	migration, err := c.loadStorageMigration(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load storage migration: %w", err)
+	}
+	if migration == nil {
+		return nil
+	}
+	switch migration.State {
+	case StorageMigrationStateCopying, StorageMigrationStateVerifying, StorageMigrationStateVerified:
+	default:
+		return nil
+	}
+
+	m.logger.Info("resuming storage migration", "id", migration.ID, "type", migration.Type)
+	m.l.Lock()
+	defer m.l.Unlock()
+	if err := m.resumeLocked(migration); err != nil {
+		m.failLocked(migration, err)
+		return err
+	}
+	return nil
+}
+
+func (c *Core) stopStorageMigrator() {
+	if c.storageMigrator == nil {
+		return
+	}
+	// The migration stops with the active context, and is resumed by the
+	// next active node
+	m := c.storageMigrator
+	m.l.Lock()
+	closeSecondary := m.detachLocked()
+	m.l.Unlock()
+	closeSecondary()
+	c.storageMigrator = nil
+}
+
+// start begins a migration to a new backend, which must be empty. If resume
+// is set, the last migration, which must have failed or been canceled, is
+// resumed from where it stopped instead.
+func (m *storageMigrator) start(storageType string, config map[string]string, resume bool) (*StorageMigration, error) {
+	m.l.Lock()
+	defer m.l.Unlock()
+	if m.secondary != nil {
+		return nil, errStorageMigrationRunning
+	}
+
+	if resume {
+		migration, err := m.core.loadStorageMigration(m.ctx)
+		if err != nil {
+			return nil, err
+		}
+		if migration == nil || (migration.State != StorageMigrationStateFailed && migration.State != StorageMigrationStateCanceled) {
+			return nil, errors.New("there is no failed or canceled storage migration to resume")
+		}
+		m.logger.Info("resuming storage migration", "id", migration.ID, "type", migration.Type)
+		migration.Error = ""
+		migration.EndTime = time.Time{}
+		if err := m.resumeLocked(migration); err != nil {
+			return nil, err
+		}
+		return m.statusLocked(), nil
+	}
+
+	secondary, err := m.newSecondary(storageType, config, false)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		closeStorageBackend(secondary)
+		return nil, err
+	}
+	migration := &StorageMigration{
+		ID:        id,
+		Type:      storageType,
+		Config:    config,
+		State:     StorageMigrationStateCopying,
+		StartTime: time.Now().UTC(),
+	}
+	m.logger.Info("starting storage migration", "id", migration.ID, "type", storageType)
+	m.runLocked(migration, secondary)
+	return m.statusLocked(), nil
+}
+
+// resumeLocked opens the migration's storage, which may already contain data,
+// and resumes the copy from its checkpoint, or verifies the storage again if
+// the copy had finished.
+func (m *storageMigrator) resumeLocked(migration *StorageMigration) error {
+	secondary, err := m.newSecondary(migration.Type, migration.Config, true)
+	if err != nil {
+		return err
+	}
+	if migration.CopyEndTime.IsZero() {
+		migration.State = StorageMigrationStateCopying
+	} else {
+		migration.State = StorageMigrationStateVerifying
+	}
+	m.runLocked(migration, secondary)
+	return nil
+}
+
+// runLocked starts mirroring writes to secondary, and copies and verifies
+// the existing keys in the background.
+func (m *storageMigrator) runLocked(migration *StorageMigration, secondary physical.Backend) {
+	ctx, cancel := context.WithCancel(m.ctx)
+	doneCh := make(chan struct{})
+	m.migration = migration
+	m.secondary = secondary
+	m.cancel = cancel
+	m.doneCh = doneCh
+	m.saveLocked()
+
+	// Writes have to be mirrored before the keys they may change are copied
+	m.core.storageMirror.setSecondary(secondary, func(err error) {
+		m.fail(secondary, fmt.Errorf("failed to mirror write: %w", err))
+	})
+	go m.run(ctx, migration, secondary, doneCh)
+}
+
+func (m *storageMigrator) run(ctx context.Context, migration *StorageMigration, secondary physical.Backend, doneCh chan struct{}) {
+	defer close(doneCh)
+
+	err := m.migrate(ctx, migration, secondary)
+	switch {
+	case err == nil:
+		return
+	case m.ctx.Err() != nil:
+		m.logger.Info("storage migration interrupted, it will be resumed by the next active node", "id", migration.ID)
+		return
+	case ctx.Err() != nil:
+		// The migration was canceled or has already failed
+		return
+	}
+	m.fail(secondary, err)
+}
+
+func (m *storageMigrator) migrate(ctx context.Context, migration *StorageMigration, secondary physical.Backend) error {
+	m.l.Lock()
+	state := migration.State
+	m.l.Unlock()
+
+	if state == StorageMigrationStateCopying {
+		if err := m.copy(ctx, migration, secondary); err != nil {
+			return err
+		}
+		m.l.Lock()
+		migration.State = StorageMigrationStateVerifying
+		migration.CopyEndTime = time.Now().UTC()
+		m.saveLocked()
+		m.l.Unlock()
+		m.logger.Info("copied storage, verifying", "id", migration.ID, "keys", migration.KeysCopied)
+	}
+
+	if err := m.verify(ctx, migration, secondary); err != nil {
+		return err
+	}
+	m.l.Lock()
+	migration.State = StorageMigrationStateVerified
+	m.saveLocked()
+	m.l.Unlock()
+	m.logger.Info("verified storage, it can now be cut over", "id", migration.ID,
+		"keys", migration.KeysVerified, "repaired", migration.KeysRepaired)
+	return nil
+}
+
+// copy copies every key after the checkpoint to the secondary, saving the
+// checkpoint as it goes.
+func (m *storageMigrator) copy(ctx context.Context, migration *StorageMigration, secondary physical.Backend) error {
+	mirror := m.core.storageMirror
+
+	m.l.Lock()
+	checkpoint := migration.Checkpoint
+	m.l.Unlock()
+
+	return storageMigrationScan(ctx, mirror.primary, func(key string) error {
+		if checkpoint != "" && key <= checkpoint {
+			return nil
+		}
+		if err := mirror.copyKey(ctx, secondary, key); err != nil {
+			return fmt.Errorf("failed to copy %q: %w", key, err)
+		}
+
+		m.l.Lock()
+		defer m.l.Unlock()
+		migration.KeysCopied++
+		migration.Checkpoint = key
+		if migration.KeysCopied%storageMigrationCheckpointKeys == 0 {
+			m.saveLocked()
+		}
+		return nil
+	})
+}
+
+// verify compares the checksum of every key in the primary with the
+// secondary, repairing any which differ, and then removes keys which only
+// exist in the secondary.
+func (m *storageMigrator) verify(ctx context.Context, migration *StorageMigration, secondary physical.Backend) error {
+	mirror := m.core.storageMirror
+
+	m.l.Lock()
+	migration.KeysVerified = 0
+	migration.KeysRepaired = 0
+	m.saveLocked()
+	m.l.Unlock()
+
+	repaired := func() {
+		m.l.Lock()
+		migration.KeysRepaired++
+		m.l.Unlock()
+	}
+
+	err := storageMigrationScan(ctx, mirror.primary, func(key string) error {
+		err := mirror.compareKey(ctx, secondary, key, func(primaryEntry, secondaryEntry *physical.Entry) error {
+			switch {
+			case primaryEntry == nil && secondaryEntry == nil:
+				return nil
+			case primaryEntry == nil:
+				repaired()
+				return secondary.Delete(ctx, key)
+			case secondaryEntry == nil || sha256.Sum256(primaryEntry.Value) != sha256.Sum256(secondaryEntry.Value):
+				m.logger.Debug("repairing key", "key", key)
+				repaired()
+				return secondary.Put(ctx, primaryEntry)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to verify %q: %w", key, err)
+		}
+
+		m.l.Lock()
+		migration.KeysVerified++
+		m.l.Unlock()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return storageMigrationScan(ctx, secondary, func(key string) error {
+		err := mirror.compareKey(ctx, secondary, key, func(primaryEntry, secondaryEntry *physical.Entry) error {
+			if primaryEntry != nil || secondaryEntry == nil {
+				return nil
+			}
+			m.logger.Debug("removing key which only exists in the secondary", "key", key)
+			repaired()
+			return secondary.Delete(ctx, key)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to verify %q: %w", key, err)
+		}
+		return nil
+	})
+}
+
+// cutover switches over to the new storage once it's been verified. From
+// then on writes are made to the new storage first, and fail if it can't be
+// written to, and the old storage is marked as cut over. The active node then
+// switches to the new storage while sealed, releasing the HA lock of the old
+// storage, and the standbys switch as they find the marker.
+func (m *storageMigrator) cutover() (*StorageMigration, error) {
+	m.l.Lock()
+	defer m.l.Unlock()
+	if m.secondary == nil {
+		return nil, errStorageMigrationNotRunning
+	}
+	migration := m.migration
+	if migration.State != StorageMigrationStateVerified {
+		return nil, fmt.Errorf("the storage migration must be verified before cutting over, it is %s", migration.State)
+	}
+	if _, ok := m.secondary.(*raft.RaftBackend); ok && len(m.core.GetHAPeerNodesCached()) > 0 {
+		return nil, errors.New("the raft storage migrated to only has this node, so the other nodes must be removed before cutting over, after which they can join it")
+	}
+	if !m.core.storageSwitching.CompareAndSwap(false, true) {
+		return nil, errors.New("the storage is already being switched")
+	}
+
+	secondary := m.secondary
+	if err := m.markCutoverLocked(migration, secondary); err != nil {
+		m.core.storageMirror.requireSecondary(secondary, false)
+		m.core.storageSwitching.Store(false)
+		return nil, err
+	}
+
+	// The secondary is handed over to the core rather than closed when the
+	// migrator stops, and writes are mirrored to it until it's switched to
+	m.secondary = nil
+	m.cancel()
+	m.cancel = nil
+	m.doneCh = nil
+
+	m.logger.Info("cut over storage, switching to it", "id", migration.ID, "type", migration.Type)
+	go func() {
+		defer m.core.storageSwitching.Store(false)
+		if err := m.core.switchStorage(migration.Type, secondary, true); err != nil {
+			m.logger.Error("failed to switch to the new storage", "id", migration.ID, "type", migration.Type, "error", err)
+		}
+	}()
+	return m.statusLocked(), nil
+}
+
+// markCutoverLocked records the migration as cut over, and marks the old
+// storage as cut over.
+func (m *storageMigrator) markCutoverLocked(migration *StorageMigration, secondary physical.Backend) error {
+	if !m.core.storageMirror.requireSecondary(secondary, true) {
+		return errors.New("writes are no longer mirrored to the new storage")
+	}
+
+	now := time.Now().UTC()
+	migration.State = StorageMigrationStateCutOver
+	migration.EndTime = now
+	if err := m.saveLocked(); err != nil {
+		migration.State = StorageMigrationStateVerified
+		migration.EndTime = time.Time{}
+		return err
+	}
+
+	value, err := json.Marshal(&StorageCutover{
+		ID:     migration.ID,
+		Type:   migration.Type,
+		Config: migration.Config,
+		Time:   now,
+	})
+	if err == nil {
+		// The marker is written around the mirror, so it only exists in the
+		// old storage
+		err = m.core.underlyingPhysical.Put(m.ctx, &physical.Entry{Key: StorageCutoverPath, Value: value})
+	}
+	if err != nil {
+		migration.State = StorageMigrationStateVerified
+		migration.EndTime = time.Time{}
+		m.saveLocked()
+		return fmt.Errorf("failed to mark storage as cut over: %w", err)
+	}
+	return nil
+}
+
+// stop cancels the migration. The data already copied to the new storage is
+// left as it is.
+func (m *storageMigrator) stop() (*StorageMigration, error) {
+	m.l.Lock()
+	if m.secondary == nil {
+		m.l.Unlock()
+		return nil, errStorageMigrationNotRunning
+	}
+	m.logger.Info("canceling storage migration", "id", m.migration.ID)
+	m.migration.State = StorageMigrationStateCanceled
+	m.migration.EndTime = time.Now().UTC()
+	m.saveLocked()
+	migration := m.statusLocked()
+	closeSecondary := m.detachLocked()
+	m.l.Unlock()
+
+	go closeSecondary()
+	return migration, nil
+}
+
+// fail marks the migration as failed, unless it has already stopped using
+// secondary.
+func (m *storageMigrator) fail(secondary physical.Backend, err error) {
+	m.l.Lock()
+	defer m.l.Unlock()
+	if m.secondary != secondary {
+		return
+	}
+	m.failLocked(m.migration, err)
+	closeSecondary := m.detachLocked()
+	go closeSecondary()
+}
+
+func (m *storageMigrator) failLocked(migration *StorageMigration, err error) {
+	m.logger.Error("storage migration failed", "id", migration.ID, "error", err)
+	m.migration = migration
+	migration.State = StorageMigrationStateFailed
+	migration.Error = err.Error()
+	migration.EndTime = time.Now().UTC()
+	m.saveLocked()
+}
+
+// detachLocked stops mirroring writes and the background work, and returns a
+// function which closes the secondary once the work has stopped.
+func (m *storageMigrator) detachLocked() func() {
+	secondary, cancel, doneCh := m.secondary, m.cancel, m.doneCh
+	if secondary == nil {
+		return func() {}
+	}
+	m.core.storageMirror.setSecondary(nil, nil)
+	m.secondary = nil
+	m.cancel = nil
+	m.doneCh = nil
+	cancel()
+
+	return func() {
+		<-doneCh
+		if err := closeStorageBackend(secondary); err != nil {
+			m.logger.Error("failed to close the secondary storage", "error", err)
+		}
+	}
+}
+
+// status returns the progress of the current migration, or of the last one
+// if none is running.
+func (m *storageMigrator) status(ctx context.Context) (*StorageMigration, error) {
+	m.l.Lock()
+	defer m.l.Unlock()
+	if m.migration != nil {
+		return m.statusLocked(), nil
+	}
+	return m.core.loadStorageMigration(ctx)
+}
+
+func (m *storageMigrator) statusLocked() *StorageMigration {
+	migration := *m.migration
+	return &migration
+}
+
+func (m *storageMigrator) saveLocked() error {
+	entry, err := logical.StorageEntryJSON(storageMigrationStoragePath, m.migration)
+	if err == nil {
+		err = m.core.barrier.Put(m.ctx, entry)
+	}
+	if err != nil {
+		m.logger.Error("failed to save storage migration", "id", m.migration.ID, "error", err)
+	}
+	return err
+}
+
+// newSecondary creates the backend being migrated to. Unless allowExisting
+// is set, it must not contain any data. Raft storage is bootstrapped as a
+// single node cluster led by this node.
+func (m *storageMigrator) newSecondary(storageType string, config map[string]string, allowExisting bool) (physical.Backend, error) {
+	factory, ok := m.core.physicalBackends[storageType]
+	if !ok {
+		return nil, fmt.Errorf("unknown storage type %q", storageType)
+	}
+	backend, err := factory(config, m.logger.Named(storageType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s storage: %w", storageType, err)
+	}
+	// The storage is only switched to while sealed, after which transactions
+	// must still be supported
+	if _, ok := m.core.storageMirror.primary.(physical.Transactional); ok {
+		if _, ok := backend.(physical.Transactional); !ok {
+			closeStorageBackend(backend)
+			return nil, fmt.Errorf("%s storage doesn't support transactions, which the current storage does", storageType)
+		}
+	}
+
+	raftBackend, ok := backend.(*raft.RaftBackend)
+	if !ok {
+		if !allowExisting {
+			keys, err := backend.List(m.ctx, "")
+			if err != nil {
+				return nil, fmt.Errorf("failed to check if %s storage is empty: %w", storageType, err)
+			}
+			if len(keys) > 0 {
+				return nil, fmt.Errorf("%s storage already contains data", storageType)
+			}
+		}
+		return backend, nil
+	}
+
+	hasState, err := raftBackend.HasState()
+	if err != nil {
+		raftBackend.Close()
+		return nil, fmt.Errorf("error checking raft storage state: %w", err)
+	}
+	if hasState && !allowExisting {
+		raftBackend.Close()
+		return nil, errors.New("raft storage already contains data")
+	}
+	if !hasState {
+		parsedClusterAddr, err := url.Parse(m.core.ClusterAddr())
+		if err != nil || parsedClusterAddr.Host == "" {
+			raftBackend.Close()
+			return nil, errors.New("cluster_addr must be set to migrate to raft storage")
+		}
+		if err := raftBackend.Bootstrap([]raft.Peer{
+			{
+				ID:      raftBackend.NodeID(),
+				Address: parsedClusterAddr.Host,
+			},
+		}); err != nil {
+			raftBackend.Close()
+			return nil, fmt.Errorf("could not bootstrap raft storage: %w", err)
+		}
+	}
+	if err := raftBackend.SetupCluster(m.ctx, raft.SetupOpts{
+		StartAsLeader: true,
+	}); err != nil {
+		raftBackend.Close()
+		return nil, fmt.Errorf("could not start raft storage: %w", err)
+	}
+	return raftBackend, nil
+}
+
+func closeStorageBackend(b physical.Backend) error {
+	if raftBackend, ok := b.(*raft.RaftBackend); ok {
+		if err := raftBackend.TeardownCluster(nil); err != nil {
+			return err
+		}
+		return raftBackend.Close()
+	}
+	if closer, ok := b.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// storageMigrationScan calls cb with every key of the backend in
+// lexicographic order, skipping the keys which are never migrated.
+func storageMigrationScan(ctx context.Context, b physical.Backend, cb func(key string) error) error {
+	dfs := []string{""}
+	for len(dfs) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		key := dfs[len(dfs)-1]
+		dfs = dfs[:len(dfs)-1]
+		if key != "" && !strings.HasSuffix(key, "/") {
+			switch key {
+			case CoreLockPath, offlineStorageMigrationLockPath, StorageCutoverPath:
+				continue
+			}
+			if err := cb(key); err != nil {
+				return err
+			}
+			continue
+		}
+
+		children, err := b.List(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to list %q: %w", key, err)
+		}
+		sort.Strings(children)
+		for i := len(children) - 1; i >= 0; i-- {
+			if children[i] != "" {
+				dfs = append(dfs, key+children[i])
+			}
+		}
+	}
+	return nil
+}
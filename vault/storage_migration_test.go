@@ -0,0 +1,318 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/helper/namespace"
+	"github.com/hashicorp/vault/helper/testhelpers/corehelpers"
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/hashicorp/vault/sdk/physical"
+	physInmem "github.com/hashicorp/vault/sdk/physical/inmem"
+	"github.com/stretchr/testify/require"
+)
+
+// testStorageMigrationCore returns an unsealed core which can migrate to the
+// returned inmem storage.
+func testStorageMigrationCore(t *testing.T) (*Core, *physical.ErrorInjector) {
+	t.Helper()
+
+	logger := corehelpers.NewTestLogger(t)
+	inm, err := physInmem.NewInmem(nil, logger)
+	require.NoError(t, err)
+	secondary := physical.NewErrorInjector(inm, 0, logger)
+
+	c, _, _ := TestCoreUnsealedWithConfig(t, &CoreConfig{
+		PhysicalBackends: map[string]physical.Factory{
+			"inmem": func(map[string]string, hclog.Logger) (physical.Backend, error) {
+				return secondary, nil
+			},
+		},
+	})
+	return c, secondary
+}
+
+func testStorageMigrationRequest(t *testing.T, c *Core, op logical.Operation, path string, data map[string]interface{}) *logical.Response {
+	t.Helper()
+
+	req := logical.TestRequest(t, op, path)
+	req.Data = data
+	resp, err := c.systemBackend.HandleRequest(namespace.RootContext(nil), req)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.False(t, resp.IsError(), resp.Error())
+	return resp
+}
+
+func waitForStorageMigrationState(t *testing.T, c *Core, state string) *StorageMigration {
+	t.Helper()
+
+	var migration *StorageMigration
+	require.Eventually(t, func() bool {
+		var err error
+		migration, err = c.storageMigrator.status(context.Background())
+		require.NoError(t, err)
+		return migration != nil && migration.State == state
+	}, 10*time.Second, 10*time.Millisecond)
+	return migration
+}
+
+// requireStorageMirrored checks that every key of the primary storage, other
+// than those which aren't migrated, has the same value in the secondary.
+func requireStorageMirrored(t *testing.T, c *Core, secondary physical.Backend) {
+	t.Helper()
+
+	ctx := context.Background()
+	var keys int
+	err := storageMigrationScan(ctx, c.storageMirror.primary, func(key string) error {
+		keys++
+		return c.storageMirror.compareKey(ctx, secondary, key, func(primaryEntry, secondaryEntry *physical.Entry) error {
+			require.NotNil(t, secondaryEntry, key)
+			require.Equal(t, primaryEntry.Value, secondaryEntry.Value, key)
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	err = storageMigrationScan(ctx, secondary, func(key string) error {
+		keys--
+		return nil
+	})
+	require.NoError(t, err)
+	require.Zero(t, keys)
+}
+
+// TestStorageMigration migrates the storage of a core while it's being
+// written to, and cuts over to the new storage.
+func TestStorageMigration(t *testing.T) {
+	c, secondary := testStorageMigrationCore(t)
+	ctx := namespace.RootContext(nil)
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, c.barrier.Put(ctx, &logical.StorageEntry{
+			Key:   fmt.Sprintf("test/%d", i),
+			Value: []byte(fmt.Sprintf("value-%d", i)),
+		}))
+	}
+
+	// Cutting over requires a verified migration
+	req := logical.TestRequest(t, logical.UpdateOperation, "storage/migration/cutover")
+	resp, err := c.systemBackend.HandleRequest(ctx, req)
+	require.Error(t, err)
+	require.True(t, resp.IsError())
+
+	resp = testStorageMigrationRequest(t, c, logical.UpdateOperation, "storage/migration", map[string]interface{}{
+		"type": "inmem",
+	})
+	require.Equal(t, "inmem", resp.Data["type"])
+
+	// Writes are mirrored while the keys are being copied
+	for i := 0; i < 100; i += 2 {
+		require.NoError(t, c.barrier.Put(ctx, &logical.StorageEntry{
+			Key:   fmt.Sprintf("test/%d", i),
+			Value: []byte("updated"),
+		}))
+		require.NoError(t, c.barrier.Delete(ctx, fmt.Sprintf("test/%d", i+1)))
+	}
+
+	// Only one migration runs at a time
+	req = logical.TestRequest(t, logical.UpdateOperation, "storage/migration")
+	req.Data = map[string]interface{}{"type": "inmem"}
+	resp, err = c.systemBackend.HandleRequest(ctx, req)
+	require.Error(t, err)
+	require.Contains(t, resp.Error().Error(), errStorageMigrationRunning.Error())
+
+	migration := waitForStorageMigrationState(t, c, StorageMigrationStateVerified)
+	require.NotZero(t, migration.KeysCopied)
+	require.NotZero(t, migration.KeysVerified)
+	requireStorageMirrored(t, c, secondary)
+
+	// Writes are still mirrored once the migration is verified
+	require.NoError(t, c.barrier.Put(ctx, &logical.StorageEntry{Key: "test/after", Value: []byte("after")}))
+	requireStorageMirrored(t, c, secondary)
+
+	resp = testStorageMigrationRequest(t, c, logical.ReadOperation, "storage/migration", nil)
+	require.Equal(t, StorageMigrationStateVerified, resp.Data["state"])
+	require.NotContains(t, resp.Data, "config")
+
+	oldStorage := c.underlyingPhysical
+	resp = testStorageMigrationRequest(t, c, logical.UpdateOperation, "storage/migration/cutover", nil)
+	require.Equal(t, StorageMigrationStateCutOver, resp.Data["state"])
+
+	// The core switches to the new storage and unseals again
+	require.Eventually(t, func() bool {
+		c.stateLock.RLock()
+		defer c.stateLock.RUnlock()
+		return !c.Sealed() && c.underlyingPhysical == secondary
+	}, 10*time.Second, 10*time.Millisecond)
+	entry, err := c.barrier.Get(ctx, "test/after")
+	require.NoError(t, err)
+	require.Equal(t, []byte("after"), entry.Value)
+	migration = waitForStorageMigrationState(t, c, StorageMigrationStateCutOver)
+	require.False(t, migration.EndTime.IsZero())
+	require.NoError(t, c.checkStorageCutover(context.Background()))
+
+	// Only the old storage is marked as cut over, with the new storage's
+	// configuration
+	cutover, err := ReadStorageCutover(context.Background(), oldStorage)
+	require.NoError(t, err)
+	require.NotNil(t, cutover)
+	require.Equal(t, migration.ID, cutover.ID)
+	require.Equal(t, "inmem", cutover.Type)
+	cutover, err = ReadStorageCutover(context.Background(), secondary)
+	require.NoError(t, err)
+	require.Nil(t, cutover)
+
+	// Writes are no longer mirrored to the old storage
+	require.NoError(t, c.barrier.Put(ctx, &logical.StorageEntry{Key: "test/switched", Value: []byte("switched")}))
+	physEntry, err := oldStorage.Get(ctx, "test/switched")
+	require.NoError(t, err)
+	require.Nil(t, physEntry)
+	physEntry, err = secondary.Get(ctx, "test/switched")
+	require.NoError(t, err)
+	require.NotNil(t, physEntry)
+}
+
+// TestStorageMigration_CutoverHA cuts over the storage of an HA cluster, and
+// checks that the standbys switch to the new storage too, and that only the
+// node which cut over becomes active with its HA lock.
+func TestStorageMigration_CutoverHA(t *testing.T) {
+	logger := corehelpers.NewTestLogger(t)
+	primary, err := physInmem.NewInmemHA(nil, logger)
+	require.NoError(t, err)
+	secondary, err := physInmem.NewInmemHA(nil, logger)
+	require.NoError(t, err)
+
+	cluster := NewTestCluster(t, &CoreConfig{
+		Physical:   primary,
+		HAPhysical: primary.(physical.HABackend),
+		PhysicalBackends: map[string]physical.Factory{
+			"inmem_ha": func(map[string]string, hclog.Logger) (physical.Backend, error) {
+				return secondary, nil
+			},
+		},
+	}, nil)
+	active := cluster.Cores[0].Core
+	TestWaitActive(t, active)
+	ctx := namespace.RootContext(nil)
+
+	require.NoError(t, active.barrier.Put(ctx, &logical.StorageEntry{Key: "test/before", Value: []byte("before")}))
+	testStorageMigrationRequest(t, active, logical.UpdateOperation, "storage/migration", map[string]interface{}{
+		"type": "inmem_ha",
+	})
+	waitForStorageMigrationState(t, active, StorageMigrationStateVerified)
+	testStorageMigrationRequest(t, active, logical.UpdateOperation, "storage/migration/cutover", nil)
+
+	var newActive *Core
+	require.Eventually(t, func() bool {
+		newActive = nil
+		for _, core := range cluster.Cores {
+			c := core.Core
+			c.stateLock.RLock()
+			switched := !c.Sealed() && c.underlyingPhysical == secondary && c.ha == secondary.(physical.HABackend)
+			isActive := !c.standby
+			c.stateLock.RUnlock()
+			if !switched {
+				return false
+			}
+			if isActive {
+				if newActive != nil {
+					return false
+				}
+				newActive = c
+			}
+		}
+		return newActive != nil
+	}, 30*time.Second, 100*time.Millisecond)
+
+	// The node which cut over took the new storage's HA lock before
+	// unsealing, so it's still active
+	require.Same(t, active, newActive)
+	entry, err := newActive.barrier.Get(ctx, "test/before")
+	require.NoError(t, err)
+	require.Equal(t, []byte("before"), entry.Value)
+	held, _, _, err := newActive.Leader()
+	require.NoError(t, err)
+	require.True(t, held)
+}
+
+// TestStorageMirror_RequireSecondary checks that once the secondary is about
+// to replace the primary, writes which fail on the secondary fail without
+// being made to the primary.
+func TestStorageMirror_RequireSecondary(t *testing.T) {
+	logger := corehelpers.NewTestLogger(t)
+	primary, err := physInmem.NewInmem(nil, logger)
+	require.NoError(t, err)
+	inm, err := physInmem.NewInmem(nil, logger)
+	require.NoError(t, err)
+	secondary := physical.NewErrorInjector(inm, 0, logger)
+	mirror, backend := newStorageMirror(primary, logger)
+	ctx := context.Background()
+
+	mirror.setSecondary(secondary, func(error) {})
+	require.True(t, mirror.requireSecondary(secondary, true))
+	require.NoError(t, backend.Put(ctx, &physical.Entry{Key: "foo", Value: []byte("bar")}))
+	entry, err := secondary.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+
+	secondary.SetErrorPercentage(100)
+	require.Error(t, backend.Put(ctx, &physical.Entry{Key: "baz", Value: []byte("qux")}))
+	require.Error(t, backend.Delete(ctx, "foo"))
+	entry, err = primary.Get(ctx, "baz")
+	require.NoError(t, err)
+	require.Nil(t, entry)
+	entry, err = primary.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+
+	// Mirroring hasn't stopped, and the primary can be replaced
+	require.True(t, mirror.requireSecondary(secondary, true))
+	secondary.SetErrorPercentage(0)
+	mirror.setPrimary(secondary)
+	require.False(t, mirror.requireSecondary(secondary, true))
+	entry, err = backend.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+}
+
+// TestStorageMigration_Resume checks that a migration which failed to write to
+// the new storage can be resumed, and that keys missed while it wasn't
+// running are repaired.
+func TestStorageMigration_Resume(t *testing.T) {
+	c, secondary := testStorageMigrationCore(t)
+	ctx := namespace.RootContext(nil)
+
+	testStorageMigrationRequest(t, c, logical.UpdateOperation, "storage/migration", map[string]interface{}{
+		"type": "inmem",
+	})
+	waitForStorageMigrationState(t, c, StorageMigrationStateVerified)
+
+	secondary.SetErrorPercentage(100)
+	require.NoError(t, c.barrier.Put(ctx, &logical.StorageEntry{Key: "test/failed", Value: []byte("failed")}))
+	migration := waitForStorageMigrationState(t, c, StorageMigrationStateFailed)
+	require.Contains(t, migration.Error, "failed to mirror write")
+	secondary.SetErrorPercentage(0)
+
+	// Writes aren't mirrored once the migration has failed
+	require.NoError(t, c.barrier.Put(ctx, &logical.StorageEntry{Key: "test/missed", Value: []byte("missed")}))
+
+	testStorageMigrationRequest(t, c, logical.UpdateOperation, "storage/migration", map[string]interface{}{
+		"resume": true,
+	})
+	migration = waitForStorageMigrationState(t, c, StorageMigrationStateVerified)
+	require.NotZero(t, migration.KeysRepaired)
+	requireStorageMirrored(t, c, secondary)
+
+	resp := testStorageMigrationRequest(t, c, logical.DeleteOperation, "storage/migration", nil)
+	require.Equal(t, StorageMigrationStateCanceled, resp.Data["state"])
+	require.NoError(t, c.barrier.Put(ctx, &logical.StorageEntry{Key: "test/canceled", Value: []byte("canceled")}))
+	entry, err := secondary.Get(ctx, "test/canceled")
+	require.NoError(t, err)
+	require.Nil(t, entry)
+}
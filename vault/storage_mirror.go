@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/sdk/helper/locksutil"
+	"github.com/hashicorp/vault/sdk/physical"
+)
+
+// newStorageMirror wraps the primary storage so that its writes can be
+// mirrored to a secondary backend during an online storage migration. Until
+// a secondary is set, it passes everything through to the primary. The
+// returned backend is transactional if the primary is, and so must be any
+// backend which later replaces the primary.
+func newStorageMirror(primary physical.Backend, logger log.Logger) (*storageMirror, physical.Backend) {
+	ret := &storageMirror{
+		primary: primary,
+		logger:  logger,
+		locks:   locksutil.CreateLocks(),
+	}
+
+	if _, ok := primary.(physical.Transactional); ok {
+		return ret, &transactionalStorageMirror{
+			storageMirror: ret,
+		}
+	}
+
+	return ret, ret
+}
+
+var (
+	_ physical.Backend             = (*storageMirror)(nil)
+	_ physical.Transactional       = (*transactionalStorageMirror)(nil)
+	_ physical.TransactionalLimits = (*transactionalStorageMirror)(nil)
+)
+
+type storageMirror struct {
+	logger log.Logger
+
+	// locks are held while a key is written to both backends, so that the
+	// copier can't interleave a stale copy of the key with a mirrored write
+	locks []*locksutil.LockEntry
+
+	// l is held for reading for the duration of each operation, so that
+	// setting the backends waits for operations which didn't see them to
+	// finish
+	l         sync.RWMutex
+	primary   physical.Backend
+	secondary physical.Backend
+	onError   func(error)
+	// required is set once the secondary is about to replace the primary,
+	// after which writes are made to the secondary first, and fail rather
+	// than stopping mirroring if it can't be written to
+	required bool
+}
+
+type transactionalStorageMirror struct {
+	*storageMirror
+}
+
+// setSecondary starts mirroring writes to secondary, calling onError if
+// writing to it fails, at which point mirroring stops. Passing a nil
+// secondary stops mirroring.
+func (m *storageMirror) setSecondary(secondary physical.Backend, onError func(error)) {
+	m.l.Lock()
+	defer m.l.Unlock()
+	m.secondary = secondary
+	m.onError = onError
+	m.required = false
+}
+
+// requireSecondary makes every write fail unless it's written to secondary,
+// which is about to replace the primary. It returns false if writes are no
+// longer being mirrored to secondary.
+func (m *storageMirror) requireSecondary(secondary physical.Backend, required bool) bool {
+	m.l.Lock()
+	defer m.l.Unlock()
+	if m.secondary != secondary {
+		return false
+	}
+	m.required = required
+	return true
+}
+
+// setPrimary replaces the primary, and stops mirroring writes.
+func (m *storageMirror) setPrimary(primary physical.Backend) {
+	m.l.Lock()
+	defer m.l.Unlock()
+	m.primary = primary
+	m.secondary = nil
+	m.onError = nil
+	m.required = false
+}
+
+// secondaryFailed stops mirroring after a write to the secondary failed. It
+// must be called while holding l for reading, so the error callback is run
+// once the write has released it.
+func (m *storageMirror) secondaryFailed(secondary physical.Backend, err error) {
+	m.logger.Error("failed to write to the secondary storage, mirroring has stopped", "error", err)
+	go func() {
+		m.l.Lock()
+		if m.secondary != secondary {
+			m.l.Unlock()
+			return
+		}
+		onError := m.onError
+		m.secondary = nil
+		m.onError = nil
+		m.l.Unlock()
+
+		if onError != nil {
+			onError(err)
+		}
+	}()
+}
+
+func (m *storageMirror) Put(ctx context.Context, entry *physical.Entry) error {
+	m.l.RLock()
+	defer m.l.RUnlock()
+	if m.secondary == nil {
+		return m.primary.Put(ctx, entry)
+	}
+
+	lock := locksutil.LockForKey(m.locks, entry.Key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if m.required {
+		if err := m.secondary.Put(ctx, entry); err != nil {
+			return err
+		}
+		return m.primary.Put(ctx, entry)
+	}
+
+	if err := m.primary.Put(ctx, entry); err != nil {
+		return err
+	}
+	if err := m.secondary.Put(ctx, entry); err != nil {
+		m.secondaryFailed(m.secondary, err)
+	}
+	return nil
+}
+
+func (m *storageMirror) Get(ctx context.Context, key string) (*physical.Entry, error) {
+	m.l.RLock()
+	defer m.l.RUnlock()
+	return m.primary.Get(ctx, key)
+}
+
+func (m *storageMirror) Delete(ctx context.Context, key string) error {
+	m.l.RLock()
+	defer m.l.RUnlock()
+	if m.secondary == nil {
+		return m.primary.Delete(ctx, key)
+	}
+
+	lock := locksutil.LockForKey(m.locks, key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if m.required {
+		if err := m.secondary.Delete(ctx, key); err != nil {
+			return err
+		}
+		return m.primary.Delete(ctx, key)
+	}
+
+	if err := m.primary.Delete(ctx, key); err != nil {
+		return err
+	}
+	if err := m.secondary.Delete(ctx, key); err != nil {
+		m.secondaryFailed(m.secondary, err)
+	}
+	return nil
+}
+
+func (m *storageMirror) List(ctx context.Context, prefix string) ([]string, error) {
+	m.l.RLock()
+	defer m.l.RUnlock()
+	return m.primary.List(ctx, prefix)
+}
+
+// copyKey copies the primary's value of key to the secondary, deleting it
+// from the secondary if it no longer exists.
+func (m *storageMirror) copyKey(ctx context.Context, secondary physical.Backend, key string) error {
+	lock := locksutil.LockForKey(m.locks, key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	entry, err := m.primary.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return secondary.Delete(ctx, key)
+	}
+	return secondary.Put(ctx, entry)
+}
+
+// compareKey runs cb with the primary's and the secondary's values of key,
+// without any writes to the key in between.
+func (m *storageMirror) compareKey(ctx context.Context, secondary physical.Backend, key string, cb func(primary, secondary *physical.Entry) error) error {
+	lock := locksutil.LockForKey(m.locks, key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	primaryEntry, err := m.primary.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	secondaryEntry, err := secondary.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	return cb(primaryEntry, secondaryEntry)
+}
+
+func (m *transactionalStorageMirror) Transaction(ctx context.Context, txns []*physical.TxnEntry) error {
+	m.l.RLock()
+	defer m.l.RUnlock()
+	primary := m.primary.(physical.Transactional)
+	if m.secondary == nil {
+		return primary.Transaction(ctx, txns)
+	}
+
+	var keys []string
+	var writes []*physical.TxnEntry
+	for _, txn := range txns {
+		keys = append(keys, txn.Entry.Key)
+		if txn.Operation != physical.GetOperation {
+			writes = append(writes, txn)
+		}
+	}
+	for _, l := range locksutil.LocksForKeys(m.locks, keys) {
+		l.Lock()
+		defer l.Unlock()
+	}
+
+	if m.required && len(writes) > 0 {
+		if err := m.secondaryTransaction(ctx, writes); err != nil {
+			return err
+		}
+		return primary.Transaction(ctx, txns)
+	}
+
+	if err := primary.Transaction(ctx, txns); err != nil {
+		return err
+	}
+	if len(writes) == 0 {
+		return nil
+	}
+	if err := m.secondaryTransaction(ctx, writes); err != nil {
+		m.secondaryFailed(m.secondary, err)
+	}
+	return nil
+}
+
+// secondaryTransaction applies the writes of a transaction to the secondary,
+// one at a time if it isn't transactional.
+func (m *transactionalStorageMirror) secondaryTransaction(ctx context.Context, writes []*physical.TxnEntry) error {
+	if secondaryTxn, ok := m.secondary.(physical.Transactional); ok {
+		return secondaryTxn.Transaction(ctx, writes)
+	}
+
+	for _, txn := range writes {
+		var err error
+		switch txn.Operation {
+		case physical.PutOperation:
+			err = m.secondary.Put(ctx, txn.Entry)
+		case physical.DeleteOperation:
+			err = m.secondary.Delete(ctx, txn.Entry.Key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *transactionalStorageMirror) TransactionLimits() (int, int) {
+	m.l.RLock()
+	defer m.l.RUnlock()
+	if tl, ok := m.primary.(physical.TransactionalLimits); ok {
+		return tl.TransactionLimits()
+	}
+	// We don't have any specific limits of our own so return zeros to signal that
+	// the caller should use whatever reasonable defaults it would if it used a
+	// non-TransactionalLimits backend.
+	return 0, 0
+}
@@ -0,0 +1,462 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/armon/go-radix"
+	metrics "github.com/hashicorp/go-metrics/compat"
+	"github.com/hashicorp/vault/helper/metricsutil"
+	"github.com/hashicorp/vault/helper/namespace"
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/hashicorp/vault/sdk/physical"
+)
+
+// storageUsageReconcileInterval is how often the storage usage of each mount
+// is recounted, to correct any drift in the usage tracked as it's written to.
+// The mounts are recounted one at a time, spread out over the interval.
+var storageUsageReconcileInterval = time.Hour
+
+var errStorageUsageDisabled = errors.New("storage usage accounting is not enabled, it can be enabled with enable_storage_usage_accounting in the server config")
+
+// mountStorageUsage is the number of keys a mount has in storage, and the
+// size of their stored values in bytes.
+type mountStorageUsage struct {
+	keys  atomic.Int64
+	bytes atomic.Int64
+
+	// reconciled is when the usage was last recounted, which is guarded by
+	// the tracker's lock
+	reconciled time.Time
+}
+
+// update accounts for an entry being replaced, where either entry is nil if
+// the key is being created or deleted.
+func (u *mountStorageUsage) update(old, entry *physical.Entry) {
+	if old != nil {
+		u.keys.Add(-1)
+		u.bytes.Add(-int64(len(old.Value)))
+	}
+	if entry != nil {
+		u.keys.Add(1)
+		u.bytes.Add(int64(len(entry.Value)))
+	}
+}
+
+// storageUsageTracker tracks the storage usage of each mount, by the path of
+// its view in storage. The usage is updated as the physical backend is
+// written to, and reconciled by the active node, so the usage isn't accurate
+// on a node until it has been active long enough to reconcile it.
+type storageUsageTracker struct {
+	l      sync.RWMutex
+	mounts *radix.Tree
+}
+
+// newStorageUsageTracker wraps the physical backend so that writes to the
+// storage of the tracked mounts update their usage. The size of the entry
+// being replaced is read from the backend before each write. The returned
+// backend is transactional if b is.
+func newStorageUsageTracker(b physical.Backend) (*storageUsageTracker, physical.Backend) {
+	tracker := &storageUsageTracker{
+		mounts: radix.New(),
+	}
+	ret := &storageUsageBackend{
+		Backend: b,
+		tracker: tracker,
+	}
+
+	if bTxn, ok := b.(physical.Transactional); ok {
+		return tracker, &transactionalStorageUsageBackend{
+			storageUsageBackend: ret,
+			Transactional:       bTxn,
+		}
+	}
+
+	return tracker, ret
+}
+
+// track starts tracking the usage of the storage under viewPath. If empty is
+// set, the storage is known to be empty, so the usage doesn't need to be
+// reconciled. Otherwise it's reconciled as soon as possible, since writes made
+// while it wasn't tracked were missed.
+func (t *storageUsageTracker) track(viewPath string, empty bool) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	raw, ok := t.mounts.Get(viewPath)
+	if !ok {
+		raw = &mountStorageUsage{}
+		t.mounts.Insert(viewPath, raw)
+	}
+	usage := raw.(*mountStorageUsage)
+	if empty {
+		usage.reconciled = time.Now().UTC()
+	} else {
+		usage.reconciled = time.Time{}
+	}
+}
+
+func (t *storageUsageTracker) untrack(viewPath string) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	t.mounts.Delete(viewPath)
+}
+
+// lookup returns the usage of the mount whose storage contains key, or nil if
+// it isn't tracked.
+func (t *storageUsageTracker) lookup(key string) *mountStorageUsage {
+	t.l.RLock()
+	defer t.l.RUnlock()
+	_, raw, ok := t.mounts.LongestPrefix(key)
+	if !ok {
+		return nil
+	}
+	return raw.(*mountStorageUsage)
+}
+
+// leastRecentlyReconciled returns the mount whose usage was reconciled the
+// longest ago, and when.
+func (t *storageUsageTracker) leastRecentlyReconciled() (string, *mountStorageUsage, time.Time) {
+	t.l.RLock()
+	defer t.l.RUnlock()
+	var viewPath string
+	var usage *mountStorageUsage
+	t.mounts.Walk(func(path string, raw interface{}) bool {
+		candidate := raw.(*mountStorageUsage)
+		if usage == nil || candidate.reconciled.Before(usage.reconciled) {
+			viewPath, usage = path, candidate
+		}
+		return false
+	})
+	if usage == nil {
+		return "", nil, time.Time{}
+	}
+	return viewPath, usage, usage.reconciled
+}
+
+// reconcileWait is how long to wait between reconciling mounts, so that every
+// mount is reconciled once per interval.
+func (t *storageUsageTracker) reconcileWait() time.Duration {
+	t.l.RLock()
+	defer t.l.RUnlock()
+	if t.mounts.Len() == 0 {
+		return storageUsageReconcileInterval
+	}
+	return storageUsageReconcileInterval / time.Duration(t.mounts.Len())
+}
+
+// trackStorageUsage starts tracking the usage of the mount's storage, if
+// storage usage accounting is enabled. The system mount isn't tracked, as its
+// storage contains that of the token store as well as Vault's own.
+func (c *Core) trackStorageUsage(entry *MountEntry, empty bool) {
+	if c.storageUsage == nil || entry.Type == mountTypeSystem {
+		return
+	}
+	c.storageUsage.track(entry.ViewPath(), empty)
+}
+
+func (c *Core) untrackStorageUsage(entry *MountEntry) {
+	if c.storageUsage == nil {
+		return
+	}
+	c.storageUsage.untrack(entry.ViewPath())
+}
+
+var (
+	_ physical.Backend             = (*storageUsageBackend)(nil)
+	_ physical.Transactional       = (*transactionalStorageUsageBackend)(nil)
+	_ physical.TransactionalLimits = (*transactionalStorageUsageBackend)(nil)
+)
+
+type storageUsageBackend struct {
+	physical.Backend
+	tracker *storageUsageTracker
+}
+
+type transactionalStorageUsageBackend struct {
+	*storageUsageBackend
+	physical.Transactional
+}
+
+func (b *storageUsageBackend) Put(ctx context.Context, entry *physical.Entry) error {
+	usage := b.tracker.lookup(entry.Key)
+	if usage == nil {
+		return b.Backend.Put(ctx, entry)
+	}
+
+	// The usage isn't updated if the existing entry can't be read, it's
+	// corrected when the mount is next reconciled
+	old, getErr := b.Backend.Get(ctx, entry.Key)
+	if err := b.Backend.Put(ctx, entry); err != nil {
+		return err
+	}
+	if getErr == nil {
+		usage.update(old, entry)
+	}
+	return nil
+}
+
+func (b *storageUsageBackend) Delete(ctx context.Context, key string) error {
+	usage := b.tracker.lookup(key)
+	if usage == nil {
+		return b.Backend.Delete(ctx, key)
+	}
+
+	old, getErr := b.Backend.Get(ctx, key)
+	if err := b.Backend.Delete(ctx, key); err != nil {
+		return err
+	}
+	if getErr == nil {
+		usage.update(old, nil)
+	}
+	return nil
+}
+
+func (b *transactionalStorageUsageBackend) Transaction(ctx context.Context, txns []*physical.TxnEntry) error {
+	type storageUsageChange struct {
+		usage      *mountStorageUsage
+		old, entry *physical.Entry
+	}
+	var changes []storageUsageChange
+	for _, txn := range txns {
+		if txn.Operation == physical.GetOperation {
+			continue
+		}
+		usage := b.tracker.lookup(txn.Entry.Key)
+		if usage == nil {
+			continue
+		}
+		old, err := b.Backend.Get(ctx, txn.Entry.Key)
+		if err != nil {
+			continue
+		}
+		change := storageUsageChange{usage: usage, old: old}
+		if txn.Operation == physical.PutOperation {
+			change.entry = txn.Entry
+		}
+		changes = append(changes, change)
+	}
+
+	if err := b.Transactional.Transaction(ctx, txns); err != nil {
+		return err
+	}
+	for _, change := range changes {
+		change.usage.update(change.old, change.entry)
+	}
+	return nil
+}
+
+func (b *transactionalStorageUsageBackend) TransactionLimits() (int, int) {
+	if tl, ok := b.Transactional.(physical.TransactionalLimits); ok {
+		return tl.TransactionLimits()
+	}
+	// We don't have any specific limits of our own so return zeros to signal that
+	// the caller should use whatever reasonable defaults it would if it used a
+	// non-TransactionalLimits backend.
+	return 0, 0
+}
+
+// MountStorageUsage is the storage usage of a mount.
+type MountStorageUsage struct {
+	NamespacePath string `json:"namespace_path"`
+	MountPath     string `json:"mount_path"`
+	MountType     string `json:"mount_type"`
+	MountAccessor string `json:"mount_accessor"`
+	MountUUID     string `json:"mount_uuid"`
+	Local         bool   `json:"local"`
+	Keys          int64  `json:"keys"`
+	Bytes         int64  `json:"bytes"`
+
+	namespace *namespace.Namespace
+}
+
+// storageUsageMounts returns the secrets engines and auth methods whose
+// storage usage is tracked.
+func (c *Core) storageUsageMounts() []*MountEntry {
+	var entries []*MountEntry
+
+	c.mountsLock.RLock()
+	if c.mounts != nil {
+		for _, entry := range c.mounts.Entries {
+			if entry.Type != mountTypeSystem {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	c.mountsLock.RUnlock()
+
+	c.authLock.RLock()
+	if c.auth != nil {
+		for _, entry := range c.auth.Entries {
+			entries = append(entries, entry)
+		}
+	}
+	c.authLock.RUnlock()
+
+	return entries
+}
+
+// storageUsageReport returns the storage usage of every mount, largest first,
+// and the time the usage of the mount reconciled the longest ago was
+// reconciled, which is zero if any mount hasn't been reconciled yet.
+func (c *Core) storageUsageReport() ([]*MountStorageUsage, time.Time, error) {
+	if c.storageUsage == nil {
+		return nil, time.Time{}, errStorageUsageDisabled
+	}
+	entries := c.storageUsageMounts()
+
+	c.storageUsage.l.RLock()
+	var lastReconciled time.Time
+	report := make([]*MountStorageUsage, 0, len(entries))
+	for _, entry := range entries {
+		raw, ok := c.storageUsage.mounts.Get(entry.ViewPath())
+		if !ok {
+			continue
+		}
+		usage := raw.(*mountStorageUsage)
+		if len(report) == 0 || usage.reconciled.Before(lastReconciled) {
+			lastReconciled = usage.reconciled
+		}
+
+		mountPath := entry.Path
+		if entry.Table == credentialTableType {
+			mountPath = credentialRoutePrefix + mountPath
+		}
+		ns := entry.Namespace()
+		var nsPath string
+		if ns != nil {
+			nsPath = ns.Path
+		}
+		report = append(report, &MountStorageUsage{
+			NamespacePath: nsPath,
+			MountPath:     mountPath,
+			MountType:     entry.Type,
+			MountAccessor: entry.Accessor,
+			MountUUID:     entry.UUID,
+			Local:         entry.Local,
+			Keys:          usage.keys.Load(),
+			Bytes:         usage.bytes.Load(),
+			namespace:     ns,
+		})
+	}
+	c.storageUsage.l.RUnlock()
+
+	sort.SliceStable(report, func(i, j int) bool {
+		if report[i].Bytes != report[j].Bytes {
+			return report[i].Bytes > report[j].Bytes
+		}
+		return report[i].NamespacePath+report[i].MountPath < report[j].NamespacePath+report[j].MountPath
+	})
+	return report, lastReconciled, nil
+}
+
+// reconcileMountStorageUsage recounts the keys and bytes in the storage under
+// viewPath. The entries are read from the underlying physical backend, so
+// they aren't decrypted and don't displace the cached entries. Writes made
+// while the mount is being counted may be missed, until the next time it's
+// reconciled.
+func (c *Core) reconcileMountStorageUsage(ctx context.Context, viewPath string, usage *mountStorageUsage) error {
+	view := physical.NewView(c.underlyingPhysical, viewPath)
+
+	var keys, bytes int64
+	var getErr error
+	err := logical.AbortableScanView(ctx, view, func(path string) bool {
+		entry, err := view.Get(ctx, path)
+		if err != nil {
+			getErr = err
+			return false
+		}
+		if entry != nil {
+			keys++
+			bytes += int64(len(entry.Value))
+		}
+		return true
+	})
+	if err == nil {
+		err = getErr
+	}
+	if err != nil {
+		return err
+	}
+
+	usage.keys.Store(keys)
+	usage.bytes.Store(bytes)
+	c.storageUsage.l.Lock()
+	usage.reconciled = time.Now().UTC()
+	c.storageUsage.l.Unlock()
+	return nil
+}
+
+// startStorageUsageReconciler reconciles the storage usage of the mounts on
+// the active node until the active context is canceled. Mounts which haven't
+// been reconciled since they were set up are recounted straight away, after
+// which the mount reconciled the longest ago is recounted at a steady pace, so
+// that every mount is reconciled once per interval.
+func (c *Core) startStorageUsageReconciler(ctx context.Context) {
+	if c.storageUsage == nil {
+		return
+	}
+
+	go func() {
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+
+			viewPath, usage, reconciled := c.storageUsage.leastRecentlyReconciled()
+			for usage != nil {
+				if err := c.reconcileMountStorageUsage(ctx, viewPath, usage); err != nil {
+					if ctx.Err() == nil {
+						c.logger.Error("failed to reconcile storage usage", "path", viewPath, "error", err)
+					}
+					break
+				}
+				if !reconciled.IsZero() {
+					break
+				}
+				viewPath, usage, reconciled = c.storageUsage.leastRecentlyReconciled()
+				if !reconciled.IsZero() {
+					break
+				}
+			}
+			timer.Reset(c.storageUsage.reconcileWait())
+		}
+	}()
+}
+
+func (c *Core) storageUsageKeysGaugeCollector(ctx context.Context) ([]metricsutil.GaugeLabelValues, error) {
+	return c.storageUsageGauges(func(usage *MountStorageUsage) int64 { return usage.Keys }), nil
+}
+
+func (c *Core) storageUsageBytesGaugeCollector(ctx context.Context) ([]metricsutil.GaugeLabelValues, error) {
+	return c.storageUsageGauges(func(usage *MountStorageUsage) int64 { return usage.Bytes }), nil
+}
+
+func (c *Core) storageUsageGauges(value func(*MountStorageUsage) int64) []metricsutil.GaugeLabelValues {
+	report, _, err := c.storageUsageReport()
+	if err != nil {
+		return nil
+	}
+	results := make([]metricsutil.GaugeLabelValues, 0, len(report))
+	for _, usage := range report {
+		results = append(results, metricsutil.GaugeLabelValues{
+			Labels: []metrics.Label{
+				metricsutil.NamespaceLabel(usage.namespace),
+				{Name: "mount_point", Value: usage.MountPath},
+				{Name: "mount_type", Value: usage.MountType},
+			},
+			Value: float32(value(usage)),
+		})
+	}
+	return results
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2016, 2025
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vault/helper/namespace"
+	"github.com/hashicorp/vault/sdk/logical"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStorageUsage checks that the storage usage of a mount is tracked as it's
+// written to, and that it matches the usage once it's reconciled.
+func TestStorageUsage(t *testing.T) {
+	c, _, root := TestCoreUnsealedWithConfig(t, &CoreConfig{StorageUsageAccounting: true})
+	ctx := namespace.RootContext(nil)
+
+	require.Eventually(t, func() bool {
+		_, lastReconciled, err := c.storageUsageReport()
+		require.NoError(t, err)
+		return !lastReconciled.IsZero()
+	}, 10*time.Second, 10*time.Millisecond)
+
+	usage := func(mountPath string) *MountStorageUsage {
+		t.Helper()
+		report, _, err := c.storageUsageReport()
+		require.NoError(t, err)
+		for _, usage := range report {
+			if usage.MountPath == mountPath {
+				return usage
+			}
+		}
+		return nil
+	}
+	write := func(op logical.Operation, path string, data map[string]interface{}) {
+		t.Helper()
+		resp, err := c.HandleRequest(ctx, &logical.Request{
+			Operation:   op,
+			Path:        path,
+			ClientToken: root,
+			Data:        data,
+		})
+		require.NoError(t, err)
+		require.False(t, resp.IsError(), resp.Error())
+	}
+
+	// The system mount isn't tracked, but the token store is
+	require.Nil(t, usage("sys/"))
+	require.NotNil(t, usage("auth/token/"))
+
+	before := usage("secret/")
+	require.NotNil(t, before)
+	for i := 0; i < 10; i++ {
+		write(logical.UpdateOperation, fmt.Sprintf("secret/foo-%d", i), map[string]interface{}{"value": "bar"})
+	}
+	after := usage("secret/")
+	require.Equal(t, before.Keys+10, after.Keys)
+	require.Greater(t, after.Bytes, before.Bytes)
+
+	// Overwriting a key only changes its size
+	write(logical.UpdateOperation, "secret/foo-0", map[string]interface{}{"value": strings.Repeat("a", 100)})
+	overwritten := usage("secret/")
+	require.Equal(t, after.Keys, overwritten.Keys)
+	require.Greater(t, overwritten.Bytes, after.Bytes)
+
+	write(logical.DeleteOperation, "secret/foo-1", nil)
+	deleted := usage("secret/")
+	require.Equal(t, after.Keys-1, deleted.Keys)
+
+	mount := c.router.MatchingMountEntry(ctx, "secret/")
+	require.NotNil(t, mount)
+	require.NoError(t, c.reconcileMountStorageUsage(ctx, mount.ViewPath(), c.storageUsage.lookup(mount.ViewPath())))
+	reconciled := usage("secret/")
+	require.Equal(t, deleted.Keys, reconciled.Keys)
+	require.Equal(t, deleted.Bytes, reconciled.Bytes)
+
+	req := logical.TestRequest(t, logical.ReadOperation, "internal/counters/storage")
+	resp, err := c.systemBackend.HandleRequest(ctx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Data["last_reconciled"])
+	require.GreaterOrEqual(t, resp.Data["total_keys"].(int64), reconciled.Keys)
+	var found bool
+	for _, mount := range resp.Data["mounts"].([]map[string]interface{}) {
+		if mount["mount_path"] == "secret/" {
+			found = true
+			require.Equal(t, reconciled.Keys, mount["keys"])
+			require.Equal(t, reconciled.Bytes, mount["bytes"])
+		}
+	}
+	require.True(t, found)
+
+	// Unmounted mounts are no longer tracked
+	write(logical.DeleteOperation, "sys/mounts/secret", nil)
+	require.Nil(t, usage("secret/"))
+}
+
+// TestStorageUsage_Disabled checks that storage usage isn't tracked unless
+// it's enabled.
+func TestStorageUsage_Disabled(t *testing.T) {
+	c, _, _ := TestCoreUnsealed(t)
+	ctx := namespace.RootContext(nil)
+
+	_, _, err := c.storageUsageReport()
+	require.ErrorIs(t, err, errStorageUsageDisabled)
+
+	req := logical.TestRequest(t, logical.ReadOperation, "internal/counters/storage")
+	resp, err := c.systemBackend.HandleRequest(ctx, req)
+	require.ErrorIs(t, err, logical.ErrInvalidRequest)
+	require.True(t, resp.IsError())
+}
@@ -250,6 +250,9 @@ func TestCoreWithSealAndUINoCleanup(t testing.TB, opts *CoreConfig) *Core {
 	conf.AdministrativeNamespacePath = opts.AdministrativeNamespacePath
 	conf.OperatorNamespacePath = opts.OperatorNamespacePath
 	conf.ImpreciseLeaseRoleTracking = opts.ImpreciseLeaseRoleTracking
+	conf.PhysicalBackends = opts.PhysicalBackends
+	conf.StorageUsageAccounting = opts.StorageUsageAccounting
+	conf.StorageEntryCompression = opts.StorageEntryCompression
 
 	if opts.Logger != nil {
 		conf.Logger = opts.Logger
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -57,6 +58,14 @@ const (
 	// secondary parent based index
 	parentPrefix = "parent/"
 
+	// tokenEntityPrefix is the prefix used to store the index from
+	// entity ID to the accessors of the tokens issued to it
+	tokenEntityPrefix = "entity/"
+
+	// tokenEntityIndexCompletePath is the path of the marker written once
+	// the tokens created before the entity index existed have been indexed
+	tokenEntityIndexCompletePath = "entity-index-complete"
+
 	// tokenSubPath is the sub-path used for the token store
 	// view. This is nested under the system view.
 	tokenSubPath = "token/"
@@ -197,6 +206,10 @@ func (ts *TokenStore) paths() []*framework.Path {
 			Type:        framework.TypeStringSlice,
 			Description: "List of policies for the token",
 		},
+		"scope": {
+			Type:        framework.TypeMap,
+			Description: tokenScopeHelp,
+		},
 	}
 
 	fieldsForCreateWithRole := map[string]*framework.FieldSchema{
@@ -694,6 +707,35 @@ func (c *Core) LookupToken(ctx context.Context, token string) (*logical.TokenEnt
 	return c.tokenStore.Lookup(ctx, token)
 }
 
+// LookupTokenByAccessor returns the properties of the token with the given
+// accessor from the token store, or nil if the token is no longer valid.
+//
+// Should be called with read stateLock held.
+func (c *Core) LookupTokenByAccessor(ctx context.Context, accessor string) (*logical.TokenEntry, error) {
+	if c.Sealed() {
+		return nil, consts.ErrSealed
+	}
+
+	if c.standby && !c.perfStandby {
+		return nil, consts.ErrStandby
+	}
+
+	// Many tests don't have a token store running
+	if c.tokenStore == nil || c.tokenStore.expiration == nil {
+		return nil, nil
+	}
+
+	aEntry, err := c.tokenStore.lookupByAccessor(ctx, accessor, false, false)
+	if err != nil {
+		return nil, err
+	}
+	if aEntry == nil || aEntry.TokenID == "" {
+		return nil, nil
+	}
+
+	return c.tokenStore.Lookup(ctx, aEntry.TokenID)
+}
+
 // CreateToken creates the given token in the core's token store.
 func (c *Core) CreateToken(ctx context.Context, entry *logical.TokenEntry) error {
 	if c.tokenStore == nil {
@@ -703,6 +745,69 @@ func (c *Core) CreateToken(ctx context.Context, entry *logical.TokenEntry) error
 	return c.tokenStore.create(ctx, entry)
 }
 
+// EntityTokens returns the tokens issued to the given entity, including
+// tokens issued to entities that were merged into it.
+func (c *Core) EntityTokens(ctx context.Context, entity *identity.Entity) ([]*logical.TokenEntry, error) {
+	if c.tokenStore == nil {
+		return nil, errors.New("unable to look up tokens with nil token store")
+	}
+
+	return c.tokenStore.entityTokens(ctx, entity)
+}
+
+// RevokeEntityTokens revokes the tokens issued to the given entity and
+// returns the accessors of the revoked tokens.
+func (c *Core) RevokeEntityTokens(ctx context.Context, entity *identity.Entity) ([]string, error) {
+	if c.tokenStore == nil {
+		return nil, errors.New("unable to revoke tokens with nil token store")
+	}
+
+	return c.tokenStore.revokeEntityTokens(ctx, entity)
+}
+
+// UseSubjectToken validates a token that is presented on behalf of a client
+// rather than used to authenticate the request carrying it, such as the
+// subject token of an OIDC token exchange. The token is checked as if it made
+// the given request, so its bound CIDRs, client certificate binding, scope
+// and policies apply, and one of its uses is consumed.
+func (c *Core) UseSubjectToken(ctx context.Context, req *logical.Request) (*logical.TokenEntry, error) {
+	if c.tokenStore == nil {
+		return nil, errors.New("unable to use token with nil token store")
+	}
+
+	_, te, checkErr := c.CheckToken(ctx, req, false)
+	if te == nil {
+		return nil, checkErr
+	}
+
+	// As with requests, the use count is decremented even if the checks
+	// failed
+	if te.IsStorageBacked() {
+		var err error
+		te, err = c.tokenStore.UseToken(ctx, te)
+		if err != nil {
+			return nil, err
+		}
+		if te == nil {
+			return nil, multierror.Append(logical.ErrPermissionDenied, logical.ErrInvalidToken)
+		}
+		if te.NumUses == tokenRevocationPending {
+			leaseID, err := c.expiration.CreateOrFetchRevocationLeaseByToken(ctx, te)
+			if err == nil {
+				err = c.expiration.LazyRevoke(ctx, leaseID)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if checkErr != nil {
+		return nil, checkErr
+	}
+
+	return te, nil
+}
+
 // TokenStore is used to manage client tokens. Tokens are used for
 // clients to authenticate, and each token is mapped to an applicable
 // set of policy which is used for authorization.
@@ -719,6 +824,7 @@ type TokenStore struct {
 	idBarrierView       *BarrierView
 	accessorBarrierView *BarrierView
 	parentBarrierView   *BarrierView
+	entityBarrierView   *BarrierView
 	rolesBarrierView    *BarrierView
 
 	expiration *ExpirationManager
@@ -742,6 +848,10 @@ type TokenStore struct {
 
 	tidyLock *uint32
 
+	// entityIndexCompleted is set once the entity index is known to cover
+	// every token
+	entityIndexCompleted *uint32
+
 	identityPoliciesDeriverFunc func(string) (*identity.Entity, []string, error)
 
 	quitContext context.Context
@@ -778,6 +888,7 @@ func NewTokenStore(ctx context.Context, logger log.Logger, core *Core, config *l
 		idBarrierView:         view.SubView(idPrefix),
 		accessorBarrierView:   view.SubView(accessorPrefix),
 		parentBarrierView:     view.SubView(parentPrefix),
+		entityBarrierView:     view.SubView(tokenEntityPrefix),
 		rolesBarrierView:      view.SubView(rolesPrefix),
 		cubbyholeDestroyer:    destroyCubbyhole,
 		logger:                logger,
@@ -785,6 +896,7 @@ func NewTokenStore(ctx context.Context, logger log.Logger, core *Core, config *l
 		tokensPendingDeletion: &sync.Map{},
 		saltLock:              sync.RWMutex{},
 		tidyLock:              new(uint32),
+		entityIndexCompleted:  new(uint32),
 		quitContext:           core.activeContext,
 		salts:                 make(map[string]*salt.Salt),
 	}
@@ -805,6 +917,8 @@ func NewTokenStore(ctx context.Context, logger log.Logger, core *Core, config *l
 				idPrefix,
 				accessorPrefix,
 				parentPrefix,
+				tokenEntityPrefix,
+				tokenEntityIndexCompletePath,
 				salt.DefaultLocation,
 			},
 		},
@@ -1208,20 +1322,22 @@ func (ts *TokenStore) create(ctx context.Context, entry *logical.TokenEntry) err
 		// encrypt, skip persistence
 		entry.ID = ""
 		pEntry := &pb.TokenEntry{
-			Parent:             entry.Parent,
-			Policies:           entry.Policies,
-			Path:               entry.Path,
-			Meta:               entry.Meta,
-			DisplayName:        entry.DisplayName,
-			CreationTime:       entry.CreationTime,
-			TTL:                int64(entry.TTL),
-			Role:               entry.Role,
-			EntityID:           entry.EntityID,
-			NamespaceID:        entry.NamespaceID,
-			Type:               uint32(entry.Type),
-			InternalMeta:       entry.InternalMeta,
-			InlinePolicy:       entry.InlinePolicy,
-			NoIdentityPolicies: entry.NoIdentityPolicies,
+			Parent:              entry.Parent,
+			Policies:            entry.Policies,
+			Path:                entry.Path,
+			Meta:                entry.Meta,
+			DisplayName:         entry.DisplayName,
+			CreationTime:        entry.CreationTime,
+			TTL:                 int64(entry.TTL),
+			Role:                entry.Role,
+			EntityID:            entry.EntityID,
+			NamespaceID:         entry.NamespaceID,
+			Type:                uint32(entry.Type),
+			InternalMeta:        entry.InternalMeta,
+			InlinePolicy:        entry.InlinePolicy,
+			NoIdentityPolicies:  entry.NoIdentityPolicies,
+			BoundCertThumbprint: entry.BoundCertThumbprint,
+			Scope:               pb.LogicalTokenScopeToProtoTokenScope(entry.Scope),
 		}
 
 		boundCIDRs := make([]string, len(entry.BoundCIDRs))
@@ -1428,6 +1544,12 @@ func (ts *TokenStore) storeCommon(ctx context.Context, entry *logical.TokenEntry
 				return fmt.Errorf("failed to persist entry: %w", err)
 			}
 		}
+
+		// Index the token by the entity it was issued to, so that all the
+		// tokens of an entity can be found without scanning the store
+		if err := ts.indexTokenEntity(saltCtx, tokenNS, entry); err != nil {
+			return err
+		}
 	}
 
 	// Write the primary ID
@@ -1972,6 +2094,13 @@ func (ts *TokenStore) revokeInternal(ctx context.Context, saltedID string, skipO
 		if err = ts.accessorView(tokenNS).Delete(ctx, accessorSaltedID); err != nil {
 			return fmt.Errorf("failed to delete entry: %w", err)
 		}
+
+		// Clear the entity index if any
+		if entry.EntityID != "" {
+			if err = ts.entityView(tokenNS).Delete(ctx, entry.EntityID+"/"+accessorSaltedID); err != nil {
+				return fmt.Errorf("failed to delete entity index entry: %w", err)
+			}
+		}
 	}
 
 	if !skipOrphan {
@@ -2502,6 +2631,50 @@ func (ts *TokenStore) handleTidy(ctx context.Context, req *logical.Request, data
 				}
 			}
 
+			// Delete entity index entries that no longer refer to a token
+			// issued to the entity
+			var countEntityIndexEntries, deletedCountEntityIndexEntries int64
+			entityIDs, err := ts.entityView(ns).List(quitCtx, "")
+			if err != nil {
+				return fmt.Errorf("failed to fetch entity index entries: %w", err)
+			}
+			for _, entityID := range entityIDs {
+				saltedAccessors, err := ts.entityView(ns).List(quitCtx, entityID)
+				if err != nil {
+					tidyErrors = multierror.Append(tidyErrors, fmt.Errorf("failed to fetch entity index entries: %w", err))
+					continue
+				}
+
+				for _, saltedAccessor := range saltedAccessors {
+					countEntityIndexEntries++
+
+					accessorEntry, err := ts.lookupByAccessor(quitCtx, saltedAccessor, true, true)
+					if err != nil {
+						tidyErrors = multierror.Append(tidyErrors, fmt.Errorf("failed to read the accessor index: %w", err))
+						continue
+					}
+
+					var te *logical.TokenEntry
+					if accessorEntry != nil && accessorEntry.TokenID != "" {
+						te, err = ts.lookupInternal(quitCtx, accessorEntry.TokenID, false, true)
+						if err != nil {
+							tidyErrors = multierror.Append(tidyErrors, fmt.Errorf("failed to lookup tainted ID: %w", err))
+							continue
+						}
+					}
+					if te != nil && te.EntityID+"/" == entityID {
+						continue
+					}
+
+					ts.logger.Info("deleting invalid entity index entry", "index", tokenEntityPrefix+entityID+saltedAccessor)
+					if err := ts.entityView(ns).Delete(quitCtx, entityID+saltedAccessor); err != nil {
+						tidyErrors = multierror.Append(tidyErrors, fmt.Errorf("failed to delete entity index entry: %w", err))
+						continue
+					}
+					deletedCountEntityIndexEntries++
+				}
+			}
+
 			ts.logger.Info("number of entries scanned in parent prefix", "count", countParentEntries)
 			ts.logger.Info("number of entries deleted in parent prefix", "count", deletedCountParentEntries)
 			ts.logger.Info("number of tokens scanned in parent index list", "count", countParentList)
@@ -2511,6 +2684,8 @@ func (ts *TokenStore) handleTidy(ctx context.Context, req *logical.Request, data
 			ts.logger.Info("number of revoked tokens which were invalid but present in accessors", "count", deletedCountInvalidTokenInAccessor)
 			ts.logger.Info("number of deleted accessors which had invalid tokens", "count", deletedCountAccessorInvalidToken)
 			ts.logger.Info("number of deleted cubbyhole keys that were invalid", "count", deletedCountInvalidCubbyholeKey)
+			ts.logger.Info("number of entity index entries scanned", "count", countEntityIndexEntries)
+			ts.logger.Info("number of deleted entity index entries that were invalid", "count", deletedCountEntityIndexEntries)
 
 			return tidyErrors.ErrorOrNil()
 		}
@@ -2672,6 +2847,270 @@ func (ts *TokenStore) handleUpdateRevokeAccessor(ctx context.Context, req *logic
 	return nil, nil
 }
 
+// indexTokenEntity writes the entry of the entity index for the token, if it
+// was issued to an entity.
+func (ts *TokenStore) indexTokenEntity(ctx context.Context, tokenNS *namespace.Namespace, entry *logical.TokenEntry) error {
+	if entry.EntityID == "" || entry.Accessor == "" {
+		return nil
+	}
+
+	accessorSaltedID, err := ts.SaltID(ctx, entry.Accessor)
+	if err != nil {
+		return err
+	}
+
+	aEntry := &accessorEntry{
+		AccessorID:  entry.Accessor,
+		NamespaceID: entry.NamespaceID,
+	}
+	aEntryBytes, err := jsonutil.EncodeJSON(aEntry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal entity index entry: %w", err)
+	}
+
+	le := &logical.StorageEntry{Key: entry.EntityID + "/" + accessorSaltedID, Value: aEntryBytes}
+	if err := ts.entityView(tokenNS).Put(ctx, le); err != nil {
+		return fmt.Errorf("failed to persist entity index entry: %w", err)
+	}
+	return nil
+}
+
+// entityIndexComplete returns whether the entity index covers every token in
+// the namespace, including the tokens created before the index was introduced.
+func (ts *TokenStore) entityIndexComplete(ctx context.Context, ns *namespace.Namespace) (bool, error) {
+	if atomic.LoadUint32(ts.entityIndexCompleted) == 1 {
+		return true, nil
+	}
+
+	entry, err := ts.baseView(ns).Get(ctx, tokenEntityIndexCompletePath)
+	if err != nil {
+		return false, fmt.Errorf("failed to read entity index state: %w", err)
+	}
+	if entry == nil {
+		return false, nil
+	}
+
+	atomic.StoreUint32(ts.entityIndexCompleted, 1)
+	return true, nil
+}
+
+// reindexEntities adds the tokens created before the entity index was
+// introduced to it. It only runs once; until it has completed, the tokens of
+// an entity are found by scanning every token instead.
+func (ts *TokenStore) reindexEntities(ctx context.Context) error {
+	ns, err := namespace.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	complete, err := ts.entityIndexComplete(ctx, ns)
+	if err != nil || complete {
+		return err
+	}
+
+	ts.logger.Info("indexing existing tokens by entity")
+
+	var count int
+	err = ts.walkTokens(ctx, ns, func(te *logical.TokenEntry) error {
+		if te.EntityID == "" {
+			return nil
+		}
+
+		// Hold the token's lock so that the index entry of a token being
+		// revoked isn't written back after revocation deleted it
+		lock := locksutil.LockForKey(ts.tokenLocks, te.ID)
+		lock.RLock()
+		defer lock.RUnlock()
+
+		te, err := ts.lookupInternal(ctx, te.ID, false, false)
+		if err != nil || te == nil {
+			return err
+		}
+		count++
+		return ts.indexTokenEntity(ctx, ns, te)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to index tokens by entity: %w", err)
+	}
+
+	if err := ts.baseView(ns).Put(ctx, &logical.StorageEntry{Key: tokenEntityIndexCompletePath}); err != nil {
+		return fmt.Errorf("failed to persist entity index state: %w", err)
+	}
+	atomic.StoreUint32(ts.entityIndexCompleted, 1)
+
+	ts.logger.Info("finished indexing existing tokens by entity", "count", count)
+	return nil
+}
+
+// startEntityReindex reindexes the existing tokens by entity in the
+// background, if that hasn't been done yet.
+func (ts *TokenStore) startEntityReindex() {
+	go func() {
+		ctx := namespace.RootContext(ts.quitContext)
+		if err := ts.reindexEntities(ctx); err != nil && ctx.Err() == nil {
+			ts.logger.Error("failed to index existing tokens by entity, the tokens of entities will be found by scanning every token", "error", err)
+		}
+	}()
+}
+
+// walkTokens calls fn with every token in the namespace found through the
+// accessor index, skipping tainted tokens.
+func (ts *TokenStore) walkTokens(ctx context.Context, ns *namespace.Namespace, fn func(*logical.TokenEntry) error) error {
+	saltedAccessors, err := ts.accessorView(ns).List(ctx, "")
+	if err != nil {
+		return fmt.Errorf("failed to list accessor index entries: %w", err)
+	}
+
+	for _, saltedAccessor := range saltedAccessors {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		aEntry, err := ts.lookupByAccessor(ctx, saltedAccessor, true, false)
+		if err != nil {
+			return err
+		}
+		if aEntry == nil || aEntry.TokenID == "" {
+			continue
+		}
+
+		te, err := ts.Lookup(ctx, aEntry.TokenID)
+		if err != nil {
+			return err
+		}
+		if te == nil {
+			continue
+		}
+
+		if err := fn(te); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// entityTokens uses the entity index to find the tokens issued to the given
+// entity and to the entities merged into it. Index entries of tokens that no
+// longer exist are skipped. Until the tokens created before the index was
+// introduced have been added to it, every token is scanned instead.
+func (ts *TokenStore) entityTokens(ctx context.Context, entity *identity.Entity) ([]*logical.TokenEntry, error) {
+	entityNS, err := NamespaceByID(ctx, entity.NamespaceID, ts.core)
+	if err != nil {
+		return nil, err
+	}
+	if entityNS == nil {
+		return nil, namespace.ErrNoNamespace
+	}
+	ctx = namespace.ContextWithNamespace(ctx, entityNS)
+
+	complete, err := ts.entityIndexComplete(ctx, entityNS)
+	if err != nil {
+		return nil, err
+	}
+	if !complete {
+		entityIDs := append([]string{entity.ID}, entity.MergedEntityIDs...)
+		var tokens []*logical.TokenEntry
+		err := ts.walkTokens(ctx, entityNS, func(te *logical.TokenEntry) error {
+			if slices.Contains(entityIDs, te.EntityID) {
+				tokens = append(tokens, te)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tokens: %w", err)
+		}
+		return tokens, nil
+	}
+
+	var tokens []*logical.TokenEntry
+	for _, entityID := range append([]string{entity.ID}, entity.MergedEntityIDs...) {
+		keys, err := ts.entityView(entityNS).List(ctx, entityID+"/")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list entity index entries: %w", err)
+		}
+
+		for _, key := range keys {
+			raw, err := ts.entityView(entityNS).Get(ctx, entityID+"/"+key)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read entity index entry: %w", err)
+			}
+			if raw == nil {
+				continue
+			}
+
+			var indexEntry accessorEntry
+			if err := jsonutil.DecodeJSON(raw.Value, &indexEntry); err != nil {
+				return nil, fmt.Errorf("failed to decode entity index entry: %w", err)
+			}
+
+			aEntry, err := ts.lookupByAccessor(ctx, indexEntry.AccessorID, false, false)
+			if err != nil {
+				return nil, err
+			}
+			if aEntry == nil || aEntry.TokenID == "" {
+				continue
+			}
+
+			te, err := ts.Lookup(ctx, aEntry.TokenID)
+			if err != nil {
+				return nil, err
+			}
+			if te == nil || te.EntityID != entityID {
+				continue
+			}
+
+			tokens = append(tokens, te)
+		}
+	}
+
+	return tokens, nil
+}
+
+// revokeEntityTokens revokes the tokens issued to the given entity, along
+// with their children, and returns the accessors of the revoked tokens.
+func (ts *TokenStore) revokeEntityTokens(ctx context.Context, entity *identity.Entity) ([]string, error) {
+	tokens, err := ts.entityTokens(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var accessors []string
+	for _, te := range tokens {
+		// A token may already have been revoked as the child of a token
+		// revoked earlier in the loop
+		te, err := ts.Lookup(ctx, te.ID)
+		if err != nil {
+			return accessors, err
+		}
+		if te == nil {
+			continue
+		}
+
+		tokenNS, err := NamespaceByID(ctx, te.NamespaceID, ts.core)
+		if err != nil {
+			return accessors, err
+		}
+		if tokenNS == nil {
+			return accessors, namespace.ErrNoNamespace
+		}
+
+		revokeCtx := namespace.ContextWithNamespace(ts.quitContext, tokenNS)
+		leaseID, err := ts.expiration.CreateOrFetchRevocationLeaseByToken(revokeCtx, te)
+		if err != nil {
+			return accessors, err
+		}
+
+		if err := ts.expiration.Revoke(revokeCtx, leaseID); err != nil {
+			return accessors, err
+		}
+
+		accessors = append(accessors, te.Accessor)
+	}
+
+	return accessors, nil
+}
+
 // handleCreate handles the auth/token/create path for creation of new orphan
 // tokens
 func (ts *TokenStore) handleCreateOrphan(ctx context.Context, req *logical.Request, d *framework.FieldData) (*logical.Response, error) {
@@ -3067,6 +3506,25 @@ func (ts *TokenStore) handleCreateCommon(ctx context.Context, req *logical.Reque
 		te.Policies = strutil.StrListDelete(te.Policies, "default")
 	}
 
+	// Restrict the token to the requested capability scope. Tokens created by
+	// a scoped token inherit its scope, otherwise the scope could be escaped
+	// by simply creating another token.
+	scope, err := parseTokenScope(d.Get("scope").(map[string]interface{}))
+	if err != nil {
+		return logical.ErrorResponse(err.Error()), logical.ErrInvalidRequest
+	}
+	switch {
+	case len(parent.Scope) > 0 && len(scope) > 0:
+		return logical.ErrorResponse("tokens with a capability scope cannot create tokens with a different scope"), logical.ErrInvalidRequest
+	case len(parent.Scope) > 0:
+		te.Scope = parent.Scope
+	case len(scope) > 0:
+		if _, err := tokenScopePolicy(ns, scope); err != nil {
+			return logical.ErrorResponse(fmt.Sprintf("invalid scope: %v", err)), logical.ErrInvalidRequest
+		}
+		te.Scope = scope
+	}
+
 	// Prevent internal policies from being assigned to tokens
 	for _, policy := range te.Policies {
 		if strutil.StrListContains(nonAssignablePolicies, policy) {
@@ -3136,6 +3594,11 @@ func (ts *TokenStore) handleCreateCommon(ctx context.Context, req *logical.Reque
 		if role == nil {
 			te.BoundCIDRs = parent.BoundCIDRs
 		}
+
+		// Children of a token bound to a client certificate are bound to the
+		// same certificate, otherwise the binding could be escaped by simply
+		// creating a child token.
+		te.BoundCertThumbprint = parent.BoundCertThumbprint
 	}
 
 	var explicitMaxTTLToUse time.Duration
@@ -3521,6 +3984,14 @@ func (ts *TokenStore) handleLookup(ctx context.Context, req *logical.Request, da
 		resp.Data["bound_cidrs"] = out.BoundCIDRs
 	}
 
+	if out.BoundCertThumbprint != "" {
+		resp.Data["bound_cert_thumbprint"] = out.BoundCertThumbprint
+	}
+
+	if len(out.Scope) > 0 {
+		resp.Data["scope"] = out.Scope
+	}
+
 	tokenNS, err := NamespaceByID(ctx, out.NamespaceID, ts.core)
 	if err != nil {
 		return logical.ErrorResponse(err.Error()), logical.ErrInvalidRequest
@@ -4310,7 +4781,98 @@ func (ts *TokenStore) gaugeCollectorByMethod(ctx context.Context) ([]metricsutil
 	return flattenedResults, nil
 }
 
+// parseTokenScope parses the capability scope given on token creation, which
+// maps path globs to a list or comma-separated string of capabilities.
+func parseTokenScope(raw map[string]interface{}) (map[string][]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	scope := make(map[string][]string, len(raw))
+	for path, capabilitiesRaw := range raw {
+		capabilities, err := parseutil.ParseCommaStringSlice(capabilitiesRaw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid capabilities for scope path %q: %w", path, err)
+		}
+		if len(capabilities) == 0 {
+			return nil, fmt.Errorf("no capabilities given for scope path %q", path)
+		}
+		scope[path] = capabilities
+	}
+
+	return scope, nil
+}
+
+// tokenScopeSelfPaths are the paths a token uses to manage itself, which a
+// capability scope always grants, as the default policy does. The token's
+// policies must still grant them.
+var tokenScopeSelfPaths = map[string][]string{
+	"auth/token/lookup-self": {ReadCapability},
+	"auth/token/renew-self":  {UpdateCapability},
+	"auth/token/revoke-self": {UpdateCapability},
+}
+
+// tokenScopePolicy converts the capability scope of a token into an ACL
+// policy in the given namespace.
+func tokenScopePolicy(ns *namespace.Namespace, scope map[string][]string) (*Policy, error) {
+	paths := make(map[string]interface{}, len(scope)+len(tokenScopeSelfPaths))
+	for path, capabilities := range tokenScopeSelfPaths {
+		paths[path] = map[string]interface{}{
+			"capabilities": slices.Concat(capabilities, scope[path]),
+		}
+	}
+	for path, capabilities := range scope {
+		if _, ok := paths[path]; ok {
+			continue
+		}
+		paths[path] = map[string]interface{}{
+			"capabilities": capabilities,
+		}
+	}
+
+	// JSON is valid HCL, so the scope can be parsed like any other policy
+	rules, err := json.Marshal(map[string]interface{}{
+		"path": paths,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := ParseACLPolicy(ns, string(rules))
+	if err != nil {
+		return nil, err
+	}
+	policy.Name = "token-scope"
+
+	return policy, nil
+}
+
+// restrictACLToTokenScope restricts the given ACL, constructed for the given
+// token, to the capability scope of the token if it has one.
+func (c *Core) restrictACLToTokenScope(ctx context.Context, tokenNS *namespace.Namespace, acl *ACL, te *logical.TokenEntry, entity *identity.Entity) error {
+	if len(te.Scope) == 0 {
+		return nil
+	}
+
+	scopePolicy, err := tokenScopePolicy(tokenNS, te.Scope)
+	if err != nil {
+		return fmt.Errorf("failed to parse token scope: %w", err)
+	}
+
+	scopeACL, err := c.policyStore.ACL(namespace.ContextWithNamespace(ctx, tokenNS), entity, nil, scopePolicy)
+	if err != nil {
+		return fmt.Errorf("failed to construct token scope ACL: %w", err)
+	}
+
+	acl.restrictToScope(scopeACL)
+	return nil
+}
+
 const (
+	tokenScopeHelp = `Map of path globs to the capabilities the token is restricted to on them.
+If set, the token is only granted capabilities that both its policies and
+this scope grant. The token can always look up, renew and revoke itself.
+Tokens created by a scoped token inherit its scope.`
 	tokenTidyHelp = `
 This endpoint performs cleanup tasks that can be run if certain error
 conditions have occurred.
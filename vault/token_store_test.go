@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/vault/sdk/helper/tokenutil"
 	"github.com/hashicorp/vault/sdk/logical"
 	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTokenStore_CreateOrphanResponse(t *testing.T) {
@@ -1802,6 +1803,102 @@ func TestTokenStore_HandleRequest_CreateToken_NoPolicy(t *testing.T) {
 	}
 }
 
+func TestTokenStore_HandleRequest_CreateToken_Scope(t *testing.T) {
+	c, _, root := TestCoreUnsealed(t)
+	ts := c.tokenStore
+	ctx := namespace.RootContext(nil)
+
+	req := logical.TestRequest(t, logical.UpdateOperation, "create")
+	req.ClientToken = root
+	req.Data["scope"] = map[string]interface{}{
+		"secret/data/app/ci/*": "read",
+		"secret/metadata/app/": []interface{}{"read", "list"},
+	}
+	resp, err := ts.HandleRequest(ctx, req)
+	if err != nil || (resp != nil && resp.IsError()) {
+		t.Fatalf("err: %v\nresp: %#v", err, resp)
+	}
+	scoped := resp.Auth.ClientToken
+
+	expectedScope := map[string][]string{
+		"secret/data/app/ci/*": {"read"},
+		"secret/metadata/app/": {"read", "list"},
+	}
+	out, err := ts.Lookup(ctx, scoped)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if !reflect.DeepEqual(out.Scope, expectedScope) {
+		t.Fatalf("bad: expected:%#v\nactual:%#v", expectedScope, out.Scope)
+	}
+
+	// The scope is shown in lookups
+	req = logical.TestRequest(t, logical.UpdateOperation, "lookup")
+	req.ClientToken = root
+	req.Data["token"] = scoped
+	resp, err = ts.HandleRequest(ctx, req)
+	if err != nil || (resp != nil && resp.IsError()) {
+		t.Fatalf("err: %v\nresp: %#v", err, resp)
+	}
+	if !reflect.DeepEqual(resp.Data["scope"], expectedScope) {
+		t.Fatalf("bad: expected:%#v\nactual:%#v", expectedScope, resp.Data["scope"])
+	}
+
+	// Even though the token has the root policy, it is restricted to the scope
+	for path, expected := range map[string][]string{
+		"secret/data/app/ci/foo":   {"read"},
+		"secret/data/app/prod/foo": {"deny"},
+		"sys/mounts":               {"deny"},
+		// The token can always manage itself
+		"auth/token/lookup-self": {"read"},
+		"auth/token/renew-self":  {"update"},
+	} {
+		caps, err := c.Capabilities(ctx, scoped, path)
+		if err != nil {
+			t.Fatalf("err: %v", err)
+		}
+		if !reflect.DeepEqual(caps, expected) {
+			t.Fatalf("bad: path %q: expected:%#v\nactual:%#v", path, expected, caps)
+		}
+	}
+
+	// Children inherit the scope of their parent and cannot change it
+	req = logical.TestRequest(t, logical.UpdateOperation, "create")
+	req.ClientToken = scoped
+	resp, err = ts.HandleRequest(ctx, req)
+	if err != nil || (resp != nil && resp.IsError()) {
+		t.Fatalf("err: %v\nresp: %#v", err, resp)
+	}
+	out, err = ts.Lookup(ctx, resp.Auth.ClientToken)
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if !reflect.DeepEqual(out.Scope, expectedScope) {
+		t.Fatalf("bad: expected:%#v\nactual:%#v", expectedScope, out.Scope)
+	}
+
+	req = logical.TestRequest(t, logical.UpdateOperation, "create")
+	req.ClientToken = scoped
+	req.Data["scope"] = map[string]interface{}{
+		"secret/*": "read",
+	}
+	resp, err = ts.HandleRequest(ctx, req)
+	if err != logical.ErrInvalidRequest {
+		t.Fatalf("expected invalid request error, got: %v\nresp: %#v", err, resp)
+	}
+
+	// Invalid capabilities are rejected
+	req = logical.TestRequest(t, logical.UpdateOperation, "create")
+	req.ClientToken = root
+	req.Data["scope"] = map[string]interface{}{
+		"secret/*": "frobnicate",
+	}
+	resp, err = ts.HandleRequest(ctx, req)
+	if err != logical.ErrInvalidRequest {
+		t.Fatalf("expected invalid request error, got: %v\nresp: %#v", err, resp)
+	}
+}
+
 func TestTokenStore_HandleRequest_CreateToken_BadParent(t *testing.T) {
 	c, _, _ := TestCoreUnsealed(t)
 	ts := c.tokenStore
@@ -5599,6 +5696,135 @@ func TestTokenStore_HandleTidyCase1(t *testing.T) {
 	}
 }
 
+func TestTokenStore_HandleTidy_entityIndex(t *testing.T) {
+	c, _, root := TestCoreUnsealed(t)
+	ts := c.tokenStore
+	ctx := namespace.RootContext(nil)
+
+	valid := &logical.TokenEntry{
+		Path:     "auth/token/create",
+		Policies: []string{"default"},
+		EntityID: "entity-id",
+		TTL:      time.Hour,
+	}
+	testMakeTokenDirectly(t, ts, valid)
+	leaked := &logical.TokenEntry{
+		Path:     "auth/token/create",
+		Policies: []string{"default"},
+		EntityID: "entity-id",
+		TTL:      time.Hour,
+	}
+	testMakeTokenDirectly(t, ts, leaked)
+
+	keys, err := ts.entityView(namespace.RootNamespace).List(ctx, "entity-id/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("bad: number of entity index entries. Expected: 2, Actual: %d", len(keys))
+	}
+
+	// Leak the entity index entry of a token by destroying its token entry
+	saltedID, err := ts.SaltID(ctx, leaked.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.idView(namespace.RootNamespace).Delete(ctx, saltedID); err != nil {
+		t.Fatalf("failed to delete token entry: %v", err)
+	}
+
+	resp, err := ts.HandleRequest(ctx, &logical.Request{
+		Path:        "tidy",
+		Operation:   logical.UpdateOperation,
+		ClientToken: root,
+	})
+	if err != nil || (resp != nil && resp.IsError()) {
+		t.Fatalf("err:%v resp:%v", err, resp)
+	}
+
+	// Tidy runs async so give it time
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		keys, err = ts.entityView(namespace.RootNamespace).List(ctx, "entity-id/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keys) == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("bad: number of entity index entries. Expected: 1, Actual: %d", len(keys))
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	accessorSaltedID, err := ts.SaltID(ctx, valid.Accessor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys[0] != accessorSaltedID {
+		t.Fatalf("bad: expected the entity index entry of the valid token to remain, got %q", keys[0])
+	}
+}
+
+// TestTokenStore_EntityIndex_Reindex verifies that tokens created before the
+// entity index existed are found by scanning until they have been indexed.
+func TestTokenStore_EntityIndex_Reindex(t *testing.T) {
+	c, _, _ := TestCoreUnsealed(t)
+	ts := c.tokenStore
+	ctx := namespace.RootContext(nil)
+
+	te := &logical.TokenEntry{
+		Path:     "auth/token/create",
+		Policies: []string{"default"},
+		EntityID: "entity-id",
+		TTL:      time.Hour,
+	}
+	testMakeTokenDirectly(t, ts, te)
+	entity := &identity.Entity{ID: "entity-id", NamespaceID: namespace.RootNamespaceID}
+
+	// Wait for the reindex started on unseal to finish, then remove the index
+	// entry and the marker as if the token predated the index
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		complete, err := ts.entityIndexComplete(ctx, namespace.RootNamespace)
+		require.NoError(t, err)
+		if complete {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("entity index was not completed")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	accessorSaltedID, err := ts.SaltID(ctx, te.Accessor)
+	require.NoError(t, err)
+	require.NoError(t, ts.entityView(namespace.RootNamespace).Delete(ctx, "entity-id/"+accessorSaltedID))
+	require.NoError(t, ts.baseView(namespace.RootNamespace).Delete(ctx, tokenEntityIndexCompletePath))
+	atomic.StoreUint32(ts.entityIndexCompleted, 0)
+
+	// The token is found by scanning
+	tokens, err := ts.entityTokens(ctx, entity)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.Equal(t, te.ID, tokens[0].ID)
+
+	require.NoError(t, ts.reindexEntities(ctx))
+
+	keys, err := ts.entityView(namespace.RootNamespace).List(ctx, "entity-id/")
+	require.NoError(t, err)
+	require.Equal(t, []string{accessorSaltedID}, keys)
+	complete, err := ts.entityIndexComplete(ctx, namespace.RootNamespace)
+	require.NoError(t, err)
+	require.True(t, complete)
+
+	// The token is found through the index
+	tokens, err = ts.entityTokens(ctx, entity)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.Equal(t, te.ID, tokens[0].ID)
+}
+
 // Create a set of tokens along with a child token for each of them, delete the
 // token entry while leaking accessors, invoke tidy and check if the dangling
 // accessor entry is getting removed and check if child tokens are still present
@@ -6250,3 +6476,53 @@ path "auth/token/create" {
 		t.Fatalf("expected a valid token in response; got resp=%#v", resp)
 	}
 }
+
+// TestTokenStore_BoundCertThumbprint verifies that child tokens, including
+// batch tokens, inherit the client certificate binding of their parent, and
+// that the binding is returned by token lookups.
+func TestTokenStore_BoundCertThumbprint(t *testing.T) {
+	c, _, root := TestCoreUnsealed(t)
+	ts := c.tokenStore
+	ctx := namespace.RootContext(nil)
+
+	parent := &logical.TokenEntry{
+		ID:                  "parent",
+		Path:                "test",
+		Policies:            []string{"default"},
+		TTL:                 time.Hour,
+		BoundCertThumbprint: "thumbprint",
+	}
+	testMakeTokenDirectly(t, ts, parent)
+
+	for _, tokenType := range []string{"service", "batch"} {
+		t.Run(tokenType, func(t *testing.T) {
+			req := logical.TestRequest(t, logical.UpdateOperation, "create")
+			req.ClientToken = parent.ID
+			req.Data["type"] = tokenType
+			resp, err := ts.HandleRequest(ctx, req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Error())
+
+			child, err := ts.Lookup(ctx, resp.Auth.ClientToken)
+			require.NoError(t, err)
+			require.NotNil(t, child)
+			require.Equal(t, "thumbprint", child.BoundCertThumbprint)
+
+			req = logical.TestRequest(t, logical.UpdateOperation, "lookup")
+			req.ClientToken = root
+			req.Data["token"] = resp.Auth.ClientToken
+			resp, err = ts.HandleRequest(ctx, req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Error())
+			require.Equal(t, "thumbprint", resp.Data["bound_cert_thumbprint"])
+		})
+	}
+
+	// Tokens which aren't bound don't report a thumbprint
+	req := logical.TestRequest(t, logical.UpdateOperation, "lookup")
+	req.ClientToken = root
+	req.Data["token"] = root
+	resp, err := ts.HandleRequest(ctx, req)
+	require.NoError(t, err)
+	require.NotContains(t, resp.Data, "bound_cert_thumbprint")
+}
@@ -25,6 +25,10 @@ func (ts *TokenStore) parentView(ns *namespace.Namespace) *BarrierView {
 	return ts.parentBarrierView
 }
 
+func (ts *TokenStore) entityView(ns *namespace.Namespace) *BarrierView {
+	return ts.entityBarrierView
+}
+
 func (ts *TokenStore) rolesView(ns *namespace.Namespace) *BarrierView {
 	return ts.rolesBarrierView
 }